@@ -0,0 +1,117 @@
+package mysql
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"zqzqsb/gomall/app/cart/biz/model"
+)
+
+// ErrCartItemNotFound 购物车项不存在，或不属于当前用户
+var ErrCartItemNotFound = errors.New("cart item not found")
+
+// CreateCartItem 创建购物车项
+func CreateCartItem(db *gorm.DB, item *model.CartItem) (int64, error) {
+	now := time.Now()
+	item.CreatedAt = now
+	item.UpdatedAt = now
+
+	result := db.Create(item)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return item.ID, nil
+}
+
+// GetCartItem 获取用户的某个购物车项
+func GetCartItem(db *gorm.DB, userID, id int64) (*model.CartItem, error) {
+	var item model.CartItem
+	result := db.Where("id = ? AND user_id = ?", id, userID).First(&item)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrCartItemNotFound
+		}
+		return nil, result.Error
+	}
+	return &item, nil
+}
+
+// FindCartItem 根据商品和所选属性查找用户已有的购物车项，不存在时返回 nil
+func FindCartItem(db *gorm.DB, userID, productID int64, attributes string) (*model.CartItem, error) {
+	var item model.CartItem
+	result := db.Where("user_id = ? AND product_id = ? AND selected_attributes = ?", userID, productID, attributes).
+		First(&item)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return &item, nil
+}
+
+// UpdateCartItem 更新购物车项
+func UpdateCartItem(db *gorm.DB, item *model.CartItem) error {
+	item.UpdatedAt = time.Now()
+	return db.Save(item).Error
+}
+
+// MergeCartItem 将用户的购物车项 fromID 合并到 into：保存 into 后删除 fromID
+func MergeCartItem(db *gorm.DB, into *model.CartItem, fromID int64) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := UpdateCartItem(tx, into); err != nil {
+			return err
+		}
+		return DeleteCartItem(tx, into.UserID, fromID)
+	})
+}
+
+// DeleteCartItem 删除用户的某个购物车项
+func DeleteCartItem(db *gorm.DB, userID, id int64) error {
+	result := db.Where("id = ? AND user_id = ?", id, userID).Delete(&model.CartItem{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrCartItemNotFound
+	}
+	return nil
+}
+
+// ListCartItems 获取用户的全部购物车项
+func ListCartItems(db *gorm.DB, userID int64) ([]*model.CartItem, error) {
+	var items []*model.CartItem
+	result := db.Where("user_id = ?", userID).Order("id DESC").Find(&items)
+	return items, result.Error
+}
+
+// ListCartItemsByIDs 获取用户指定的购物车项
+func ListCartItemsByIDs(db *gorm.DB, userID int64, ids []int64) ([]*model.CartItem, error) {
+	var items []*model.CartItem
+	result := db.Where("user_id = ? AND id IN ?", userID, ids).Order("id DESC").Find(&items)
+	return items, result.Error
+}
+
+// ClearCart 清空用户购物车
+func ClearCart(db *gorm.DB, userID int64) error {
+	return db.Where("user_id = ?", userID).Delete(&model.CartItem{}).Error
+}
+
+// SelectCartItems 将指定购物车项标记为选中，其余项取消选中
+func SelectCartItems(db *gorm.DB, userID int64, ids []int64) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		if err := tx.Model(&model.CartItem{}).
+			Where("user_id = ?", userID).
+			Updates(map[string]interface{}{"selected": false, "updated_at": now}).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		return tx.Model(&model.CartItem{}).
+			Where("user_id = ? AND id IN ?", userID, ids).
+			Updates(map[string]interface{}{"selected": true, "updated_at": now}).Error
+	})
+}
@@ -0,0 +1,29 @@
+package mysql
+
+import (
+	"zqzqsb/gomall/app/cart/biz/model"
+	"zqzqsb/gomall/app/cart/conf"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+var (
+	DB  *gorm.DB
+	err error
+)
+
+func Init() {
+	DB, err = gorm.Open(mysql.Open(conf.GetConf().MySQL.DSN),
+		&gorm.Config{
+			PrepareStmt:            true,
+			SkipDefaultTransaction: true,
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+	if err = DB.AutoMigrate(&model.CartItem{}); err != nil {
+		panic(err)
+	}
+}
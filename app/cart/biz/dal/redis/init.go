@@ -0,0 +1,39 @@
+package redis
+
+import (
+	"context"
+
+	"github.com/redis/go-redis/v9"
+	"zqzqsb/gomall/app/cart/conf"
+)
+
+var (
+	RedisClient *redis.Client
+	// TokenClient 读取用户服务写入的令牌吊销状态
+	TokenClient *redis.Client
+)
+
+func Init() {
+	RedisClient = redis.NewClient(&redis.Options{
+		Addr:     conf.GetConf().Redis.Address,
+		Username: conf.GetConf().Redis.Username,
+		Password: conf.GetConf().Redis.Password,
+		DB:       conf.GetConf().Redis.DB,
+	})
+	if err := RedisClient.Ping(context.Background()).Err(); err != nil {
+		panic(err)
+	}
+
+	TokenClient = RedisClient
+	if cfg := conf.GetConf().Jwt.TokenRedis; cfg.Address != "" {
+		TokenClient = redis.NewClient(&redis.Options{
+			Addr:     cfg.Address,
+			Username: cfg.Username,
+			Password: cfg.Password,
+			DB:       cfg.DB,
+		})
+		if err := TokenClient.Ping(context.Background()).Err(); err != nil {
+			panic(err)
+		}
+	}
+}
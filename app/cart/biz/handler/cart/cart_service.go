@@ -0,0 +1,157 @@
+// Code generated by hertz generator.
+
+package cart
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"zqzqsb/gomall/app/cart/biz/service"
+	cart "zqzqsb/gomall/app/cart/kitex_gen/cart"
+)
+
+// AddToCart .
+// @router /cart/items [POST]
+func AddToCart(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req cart.AddToCartReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用服务层添加购物车项
+	resp, err := service.NewAddToCartService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// UpdateCartItem .
+// @router /cart/items/{cart_item_id} [PUT]
+func UpdateCartItem(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req cart.UpdateCartItemReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取购物车项ID
+	idStr := c.Param("cart_item_id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid cart item ID")
+		return
+	}
+	req.CartItemId = id
+
+	// 调用服务层更新购物车项
+	resp, err := service.NewUpdateCartItemService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RemoveFromCart .
+// @router /cart/items/{cart_item_id} [DELETE]
+func RemoveFromCart(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req cart.RemoveFromCartReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取购物车项ID
+	idStr := c.Param("cart_item_id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid cart item ID")
+		return
+	}
+	req.CartItemId = id
+
+	// 调用服务层删除购物车项
+	resp, err := service.NewRemoveFromCartService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetCart .
+// @router /cart [GET]
+func GetCart(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req cart.GetCartReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用服务层获取购物车
+	resp, err := service.NewGetCartService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ClearCart .
+// @router /cart [DELETE]
+func ClearCart(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req cart.ClearCartReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用服务层清空购物车
+	resp, err := service.NewClearCartService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// SelectCartItems .
+// @router /cart/select [POST]
+func SelectCartItems(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req cart.SelectCartItemsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用服务层选择结算项
+	resp, err := service.NewSelectCartItemsService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// CartItem 购物车项模型
+type CartItem struct {
+	ID                 int64     `gorm:"primarykey"`
+	UserID             int64     `gorm:"not null;index:idx_user_product"`
+	ProductID          int64     `gorm:"not null;index:idx_user_product"`
+	Quantity           int32     `gorm:"not null"`
+	Price              int64     `gorm:"not null"`           // 加入时的价格，单位：分
+	SelectedAttributes string    `gorm:"type:varchar(1024)"` // JSON 格式存储所选属性
+	Selected           bool      `gorm:"default:false"`      // 是否被选中用于结算
+	CreatedAt          time.Time `gorm:"not null"`
+	UpdatedAt          time.Time `gorm:"not null"`
+}
+
+// TableName 设置表名
+func (CartItem) TableName() string {
+	return "cart_items"
+}
+
+// GetSelectedAttributes 获取所选属性
+func (c *CartItem) GetSelectedAttributes() map[string]string {
+	var attributes map[string]string
+	if c.SelectedAttributes != "" {
+		_ = json.Unmarshal([]byte(c.SelectedAttributes), &attributes)
+	}
+	if attributes == nil {
+		attributes = make(map[string]string)
+	}
+	return attributes
+}
+
+// SetSelectedAttributes 设置所选属性
+// encoding/json 会按 key 排序输出，因此相同的属性组合总是得到相同的字符串，可直接用于比较
+func (c *CartItem) SetSelectedAttributes(attributes map[string]string) error {
+	if len(attributes) == 0 {
+		c.SelectedAttributes = ""
+		return nil
+	}
+	data, err := json.Marshal(attributes)
+	if err != nil {
+		return err
+	}
+	c.SelectedAttributes = string(data)
+	return nil
+}
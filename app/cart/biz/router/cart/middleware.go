@@ -0,0 +1,56 @@
+// Code generated by hertz generator.
+
+package cart
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+	mw "zqzqsb/gomall/app/cart/biz/router/middleware"
+)
+
+func rootMw() []app.HandlerFunc {
+	// 购物车接口全部需要登录，用户身份从 JWT 中获取
+	return []app.HandlerFunc{
+		mw.JwtMiddleware.MiddlewareFunc(),
+		mw.IdentityMiddleware(),
+	}
+}
+
+func _clearcartMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getcartMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _cartMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _addtocartMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _itemsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _removefromcartMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updatecartitemMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _selectcartitemsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"zqzqsb.com/gomall/common/identity"
+	"zqzqsb/gomall/app/cart/biz/dal/mysql"
+	"zqzqsb/gomall/app/cart/biz/model"
+	cart "zqzqsb/gomall/app/cart/kitex_gen/cart"
+)
+
+type AddToCartService struct {
+	ctx context.Context
+} // NewAddToCartService new AddToCartService
+func NewAddToCartService(ctx context.Context) *AddToCartService {
+	return &AddToCartService{ctx: ctx}
+}
+
+// Run add product to cart
+func (s *AddToCartService) Run(req *cart.AddToCartReq) (resp *cart.AddToCartResp, err error) {
+	// 用户身份只从 JWT / 元信息中获取
+	userID, err := identity.GetUserID(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 参数验证
+	if req.ProductId <= 0 {
+		return nil, errors.New("invalid product id")
+	}
+	if req.Quantity <= 0 {
+		return nil, errors.New("invalid quantity")
+	}
+
+	// 获取商品快照
+	p, err := fetchProduct(s.ctx, req.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	item := &model.CartItem{
+		UserID:    userID,
+		ProductID: req.ProductId,
+	}
+	if err = item.SetSelectedAttributes(req.SelectedAttributes); err != nil {
+		return nil, err
+	}
+
+	// 相同商品和属性组合已在购物车中时合并数量
+	existing, err := mysql.FindCartItem(mysql.DB, userID, req.ProductId, item.SelectedAttributes)
+	if err != nil {
+		return nil, err
+	}
+	quantity := req.Quantity
+	if existing != nil {
+		quantity += existing.Quantity
+	}
+	price, err := checkProduct(p, req.SelectedAttributes, quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing != nil {
+		existing.Quantity = quantity
+		existing.Price = price
+		if err = mysql.UpdateCartItem(mysql.DB, existing); err != nil {
+			return nil, err
+		}
+		return &cart.AddToCartResp{CartItemId: existing.ID}, nil
+	}
+
+	item.Quantity = quantity
+	item.Price = price
+	cartItemID, err := mysql.CreateCartItem(mysql.DB, item)
+	if err != nil {
+		return nil, err
+	}
+
+	// 返回响应
+	resp = &cart.AddToCartResp{
+		CartItemId: cartItemID,
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+	"testing"
+	cart "zqzqsb/gomall/app/cart/kitex_gen/cart"
+)
+
+func TestAddToCart_Run(t *testing.T) {
+	ctx := context.Background()
+	s := NewAddToCartService(ctx)
+	// init req and assert value
+
+	req := &cart.AddToCartReq{}
+	resp, err := s.Run(req)
+	t.Logf("err: %v", err)
+	t.Logf("resp: %v", resp)
+
+	// todo: edit your unit test
+
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"zqzqsb/gomall/app/cart/biz/model"
+	"zqzqsb/gomall/app/cart/infra/rpc"
+	cart "zqzqsb/gomall/app/cart/kitex_gen/cart"
+	product "zqzqsb/gomall/app/cart/kitex_gen/product"
+)
+
+// fetchProduct 通过商品服务获取商品快照
+func fetchProduct(ctx context.Context, productID int64) (*product.Product, error) {
+	resp, err := rpc.ProductClient.GetProduct(ctx, &product.GetProductReq{Id: productID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Product, nil
+}
+
+// checkProduct 校验商品可以按 quantity 件加入购物车，加入和修改购物车项使用同一套规则，返回结算单价
+// 有 SKU 的商品按所选规格值匹配 SKU，使用 SKU 的价格和库存，与下单时的匹配规则一致
+func checkProduct(p *product.Product, selected map[string]string, quantity int32) (int64, error) {
+	if !p.IsOnSale {
+		return 0, errors.New("product is not on sale")
+	}
+	price, stock := p.Price, p.Stock
+	if len(p.Skus) > 0 {
+		sku, err := matchSku(p, selected)
+		if err != nil {
+			return 0, err
+		}
+		price, stock = sku.Price, sku.Stock
+	}
+	if quantity > stock {
+		return 0, errors.New("insufficient stock")
+	}
+	return price, nil
+}
+
+// matchSku 按购物车中选择的规格值找到商品的 SKU，所选值需覆盖 SKU 的全部规格，多余的属性忽略
+func matchSku(p *product.Product, selected map[string]string) (*product.Sku, error) {
+	for _, sku := range p.Skus {
+		matched := true
+		for name, value := range sku.Attributes {
+			if selected[name] != value {
+				matched = false
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+		if !sku.IsOnSale {
+			return nil, fmt.Errorf("product %d sku %d is not available", p.Id, sku.Id)
+		}
+		return sku, nil
+	}
+	return nil, fmt.Errorf("product %d: please select a valid specification", p.Id)
+}
+
+// buildCartItems 补全购物车项的商品快照，并在服务端计算总价和总件数
+// 已下架、无法获取或所选规格已失效的商品不计入合计
+func buildCartItems(ctx context.Context, items []*model.CartItem) ([]*cart.CartItem, int64, int32) {
+	products := make(map[int64]*product.Product, len(items))
+	for _, item := range items {
+		if _, ok := products[item.ProductID]; ok {
+			continue
+		}
+		p, err := fetchProduct(ctx, item.ProductID)
+		if err != nil {
+			klog.CtxWarnf(ctx, "get product %d for cart failed: %v", item.ProductID, err)
+		}
+		products[item.ProductID] = p
+	}
+
+	var (
+		totalPrice int64
+		totalItems int32
+		result     = make([]*cart.CartItem, 0, len(items))
+	)
+	for _, item := range items {
+		p := products[item.ProductID]
+		selected := item.GetSelectedAttributes()
+		result = append(result, &cart.CartItem{
+			Id:                 item.ID,
+			UserId:             item.UserID,
+			ProductId:          item.ProductID,
+			Quantity:           item.Quantity,
+			Price:              item.Price,
+			Product:            p,
+			CreateTime:         item.CreatedAt.Unix(),
+			UpdateTime:         item.UpdatedAt.Unix(),
+			SelectedAttributes: selected,
+		})
+
+		if p == nil || !p.IsOnSale {
+			continue
+		}
+		// 以商品或所选 SKU 的当前价格结算，加入时的价格仅作为快照展示，规格已失效的项不计入合计
+		price := p.Price
+		if len(p.Skus) > 0 {
+			sku, err := matchSku(p, selected)
+			if err != nil {
+				continue
+			}
+			price = sku.Price
+		}
+		totalPrice += price * int64(item.Quantity)
+		totalItems += item.Quantity
+	}
+
+	return result, totalPrice, totalItems
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+
+	"zqzqsb.com/gomall/common/identity"
+	"zqzqsb/gomall/app/cart/biz/dal/mysql"
+	cart "zqzqsb/gomall/app/cart/kitex_gen/cart"
+)
+
+type ClearCartService struct {
+	ctx context.Context
+} // NewClearCartService new ClearCartService
+func NewClearCartService(ctx context.Context) *ClearCartService {
+	return &ClearCartService{ctx: ctx}
+}
+
+// Run clear user cart
+func (s *ClearCartService) Run(req *cart.ClearCartReq) (resp *cart.ClearCartResp, err error) {
+	userID, err := identity.GetUserID(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = mysql.ClearCart(mysql.DB, userID); err != nil {
+		return nil, err
+	}
+
+	// 返回响应
+	resp = &cart.ClearCartResp{
+		Success: true,
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+
+	"zqzqsb.com/gomall/common/identity"
+	"zqzqsb/gomall/app/cart/biz/dal/mysql"
+	cart "zqzqsb/gomall/app/cart/kitex_gen/cart"
+)
+
+type GetCartService struct {
+	ctx context.Context
+} // NewGetCartService new GetCartService
+func NewGetCartService(ctx context.Context) *GetCartService {
+	return &GetCartService{ctx: ctx}
+}
+
+// Run get user cart
+func (s *GetCartService) Run(req *cart.GetCartReq) (resp *cart.GetCartResp, err error) {
+	userID, err := identity.GetUserID(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 从数据库获取购物车项
+	items, err := mysql.ListCartItems(mysql.DB, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 补全商品信息并计算合计
+	cartItems, totalPrice, totalItems := buildCartItems(s.ctx, items)
+
+	// 构建响应
+	resp = &cart.GetCartResp{
+		Items:      cartItems,
+		TotalPrice: totalPrice,
+		TotalItems: totalItems,
+	}
+
+	return resp, nil
+}
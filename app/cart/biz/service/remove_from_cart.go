@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"zqzqsb.com/gomall/common/identity"
+	"zqzqsb/gomall/app/cart/biz/dal/mysql"
+	cart "zqzqsb/gomall/app/cart/kitex_gen/cart"
+)
+
+type RemoveFromCartService struct {
+	ctx context.Context
+} // NewRemoveFromCartService new RemoveFromCartService
+func NewRemoveFromCartService(ctx context.Context) *RemoveFromCartService {
+	return &RemoveFromCartService{ctx: ctx}
+}
+
+// Run remove item from cart
+func (s *RemoveFromCartService) Run(req *cart.RemoveFromCartReq) (resp *cart.RemoveFromCartResp, err error) {
+	userID, err := identity.GetUserID(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 参数验证
+	if req.CartItemId <= 0 {
+		return nil, errors.New("invalid cart item id")
+	}
+
+	if err = mysql.DeleteCartItem(mysql.DB, userID, req.CartItemId); err != nil {
+		return nil, err
+	}
+
+	// 返回响应
+	resp = &cart.RemoveFromCartResp{
+		Success: true,
+	}
+
+	return resp, nil
+}
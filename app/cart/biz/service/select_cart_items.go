@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"zqzqsb.com/gomall/common/identity"
+	"zqzqsb/gomall/app/cart/biz/dal/mysql"
+	cart "zqzqsb/gomall/app/cart/kitex_gen/cart"
+)
+
+type SelectCartItemsService struct {
+	ctx context.Context
+} // NewSelectCartItemsService new SelectCartItemsService
+func NewSelectCartItemsService(ctx context.Context) *SelectCartItemsService {
+	return &SelectCartItemsService{ctx: ctx}
+}
+
+// Run select cart items for checkout
+func (s *SelectCartItemsService) Run(req *cart.SelectCartItemsReq) (resp *cart.SelectCartItemsResp, err error) {
+	userID, err := identity.GetUserID(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 参数验证
+	if len(req.CartItemIds) == 0 {
+		return nil, errors.New("no cart item selected")
+	}
+
+	// 去重
+	ids := make([]int64, 0, len(req.CartItemIds))
+	seen := make(map[int64]struct{}, len(req.CartItemIds))
+	for _, id := range req.CartItemIds {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+
+	// 只能选择属于当前用户的购物车项
+	items, err := mysql.ListCartItemsByIDs(mysql.DB, userID, ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) != len(ids) {
+		return nil, mysql.ErrCartItemNotFound
+	}
+
+	// 记录选中状态，供结算时使用
+	if err = mysql.SelectCartItems(mysql.DB, userID, ids); err != nil {
+		return nil, err
+	}
+
+	selectedItems, totalPrice, _ := buildCartItems(s.ctx, items)
+
+	// 构建响应
+	resp = &cart.SelectCartItemsResp{
+		SelectedItems: selectedItems,
+		TotalPrice:    totalPrice,
+	}
+
+	return resp, nil
+}
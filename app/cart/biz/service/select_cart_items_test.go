@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+	"testing"
+	cart "zqzqsb/gomall/app/cart/kitex_gen/cart"
+)
+
+func TestSelectCartItems_Run(t *testing.T) {
+	ctx := context.Background()
+	s := NewSelectCartItemsService(ctx)
+	// init req and assert value
+
+	req := &cart.SelectCartItemsReq{}
+	resp, err := s.Run(req)
+	t.Logf("err: %v", err)
+	t.Logf("resp: %v", resp)
+
+	// todo: edit your unit test
+
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"zqzqsb.com/gomall/common/identity"
+	"zqzqsb/gomall/app/cart/biz/dal/mysql"
+	"zqzqsb/gomall/app/cart/biz/model"
+	cart "zqzqsb/gomall/app/cart/kitex_gen/cart"
+)
+
+type UpdateCartItemService struct {
+	ctx context.Context
+} // NewUpdateCartItemService new UpdateCartItemService
+func NewUpdateCartItemService(ctx context.Context) *UpdateCartItemService {
+	return &UpdateCartItemService{ctx: ctx}
+}
+
+// Run update cart item
+// 修改属性导致与另一项重复时两项合并，被修改的购物车项被删除
+func (s *UpdateCartItemService) Run(req *cart.UpdateCartItemReq) (resp *cart.UpdateCartItemResp, err error) {
+	userID, err := identity.GetUserID(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 参数验证
+	if req.CartItemId <= 0 {
+		return nil, errors.New("invalid cart item id")
+	}
+	if req.Quantity <= 0 {
+		return nil, errors.New("invalid quantity")
+	}
+
+	item, err := mysql.GetCartItem(mysql.DB, userID, req.CartItemId)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := fetchProduct(s.ctx, item.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 修改属性后与购物车中另一项的商品和属性组合相同时，合并到那一项并累加数量，与加入购物车的规则一致
+	var existing *model.CartItem
+	if len(req.SelectedAttributes) > 0 {
+		if err = item.SetSelectedAttributes(req.SelectedAttributes); err != nil {
+			return nil, err
+		}
+		existing, err = mysql.FindCartItem(mysql.DB, userID, item.ProductID, item.SelectedAttributes)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil && existing.ID == item.ID {
+			existing = nil
+		}
+	}
+	quantity := req.Quantity
+	if existing != nil {
+		quantity += existing.Quantity
+	}
+	// 按修改后的规格值重新匹配 SKU，规格改变时价格快照随之更新
+	price, err := checkProduct(p, item.GetSelectedAttributes(), quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing != nil {
+		existing.Quantity = quantity
+		existing.Price = price
+		existing.Selected = existing.Selected || item.Selected
+		err = mysql.MergeCartItem(mysql.DB, existing, item.ID)
+	} else {
+		item.Quantity = quantity
+		item.Price = price
+		err = mysql.UpdateCartItem(mysql.DB, item)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 返回响应
+	resp = &cart.UpdateCartItemResp{
+		Success: true,
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"testing"
+	cart "zqzqsb/gomall/app/cart/kitex_gen/cart"
+	product "zqzqsb/gomall/app/cart/kitex_gen/product"
+)
+
+func TestUpdateCartItem_Run(t *testing.T) {
+	ctx := context.Background()
+	s := NewUpdateCartItemService(ctx)
+	// init req and assert value
+
+	req := &cart.UpdateCartItemReq{}
+	resp, err := s.Run(req)
+	t.Logf("err: %v", err)
+	t.Logf("resp: %v", resp)
+
+	// todo: edit your unit test
+
+}
+
+func TestCheckProduct(t *testing.T) {
+	withSkus := &product.Product{Id: 2, Price: 800, Stock: 12, IsOnSale: true, Skus: []*product.Sku{
+		{Id: 20, Attributes: map[string]string{"color": "red", "size": "M"}, Price: 800, Stock: 2, IsOnSale: true},
+		{Id: 21, Attributes: map[string]string{"color": "blue", "size": "M"}, Price: 900, Stock: 10, IsOnSale: true},
+		{Id: 22, Attributes: map[string]string{"color": "blue", "size": "L"}, Price: 950, Stock: 10, IsOnSale: false},
+	}}
+	cases := []struct {
+		name      string
+		product   *product.Product
+		selected  map[string]string
+		quantity  int32
+		wantPrice int64
+		wantErr   bool
+	}{
+		{"on sale in stock", &product.Product{IsOnSale: true, Price: 1000, Stock: 5}, nil, 5, 1000, false},
+		{"not on sale", &product.Product{IsOnSale: false, Stock: 5}, nil, 1, 0, true},
+		{"merged quantity exceeds stock", &product.Product{IsOnSale: true, Stock: 5}, nil, 6, 0, true},
+		{"sku price", withSkus, map[string]string{"color": "blue", "size": "M"}, 3, 900, false},
+		// 商品级库存是各 SKU 之和，不能用来校验单个 SKU
+		{"sku stock exceeded", withSkus, map[string]string{"color": "red", "size": "M"}, 3, 0, true},
+		{"no matching sku", withSkus, map[string]string{"color": "green", "size": "M"}, 1, 0, true},
+		{"incomplete specification", withSkus, map[string]string{"color": "red"}, 1, 0, true},
+		{"missing specification", withSkus, nil, 1, 0, true},
+		{"sku not on sale", withSkus, map[string]string{"color": "blue", "size": "L"}, 1, 0, true},
+	}
+	for _, c := range cases {
+		price, err := checkProduct(c.product, c.selected, c.quantity)
+		if (err != nil) != c.wantErr {
+			t.Errorf("%s: checkProduct() err = %v, wantErr %v", c.name, err, c.wantErr)
+		}
+		if price != c.wantPrice {
+			t.Errorf("%s: checkProduct() price = %d, want %d", c.name, price, c.wantPrice)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package conf
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/kr/pretty"
+	"gopkg.in/validator.v2"
+	"gopkg.in/yaml.v2"
+	"zqzqsb.com/gomall/common/clientsuite"
+)
+
+var (
+	conf *Config
+	once sync.Once
+)
+
+type Config struct {
+	Env            string
+	Kitex          Kitex          `yaml:"kitex"`
+	MySQL          MySQL          `yaml:"mysql"`
+	Redis          Redis          `yaml:"redis"`
+	Registry       Registry       `yaml:"registry"`
+	Jwt            Jwt            `yaml:"jwt"`
+	ProductService ProductService `yaml:"product_service"`
+}
+
+type MySQL struct {
+	DSN string `yaml:"dsn"`
+}
+
+type Redis struct {
+	Address  string `yaml:"address"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	DB       int    `yaml:"db"`
+}
+
+type Kitex struct {
+	Service       string `yaml:"service"`
+	Address       string `yaml:"address"`
+	LogLevel      string `yaml:"log_level"`
+	LogFileName   string `yaml:"log_file_name"`
+	LogMaxSize    int    `yaml:"log_max_size"`
+	LogMaxBackups int    `yaml:"log_max_backups"`
+	LogMaxAge     int    `yaml:"log_max_age"`
+}
+
+type Registry struct {
+	RegistryAddress []string `yaml:"registry_address"`
+	Username        string   `yaml:"username"`
+	Password        string   `yaml:"password"`
+	Weight          int      `yaml:"weight"`  // 实例权重，调用方按权重负载均衡
+	Version         string   `yaml:"version"` // 作为 version 标签注册到 Consul
+}
+
+// Address 首个注册中心地址，未配置时返回空，不注册也不发现服务
+func (r Registry) Address() string {
+	if len(r.RegistryAddress) == 0 {
+		return ""
+	}
+	return r.RegistryAddress[0]
+}
+
+// Jwt 与用户服务签发 JWT 时使用的配置保持一致
+type Jwt struct {
+	Secret string `yaml:"secret"`
+	// TokenRedis 用户服务写入令牌黑名单和令牌版本的 Redis，未配置地址时使用本服务的 Redis
+	TokenRedis Redis `yaml:"token_redis"`
+}
+
+// ProductService 商品服务的下游地址和调用策略，未配置地址时通过 Consul 发现服务
+type ProductService struct {
+	Address []string           `yaml:"address"`
+	Policy  clientsuite.Policy `yaml:"policy"`
+}
+
+// GetConf gets configuration instance
+func GetConf() *Config {
+	once.Do(initConf)
+	return conf
+}
+
+func initConf() {
+	prefix := "conf"
+	confFileRelPath := filepath.Join(prefix, filepath.Join(GetEnv(), "conf.yaml"))
+	content, err := ioutil.ReadFile(confFileRelPath)
+	if err != nil {
+		panic(err)
+	}
+	conf = new(Config)
+	err = yaml.Unmarshal(content, conf)
+	if err != nil {
+		klog.Error("parse yaml error - %v", err)
+		panic(err)
+	}
+	if err := validator.Validate(conf); err != nil {
+		klog.Error("validate config error - %v", err)
+		panic(err)
+	}
+	conf.Env = GetEnv()
+	pretty.Printf("%+v\n", conf)
+}
+
+func GetEnv() string {
+	e := os.Getenv("GO_ENV")
+	if len(e) == 0 {
+		return "test"
+	}
+	return e
+}
+
+func LogLevel() klog.Level {
+	level := GetConf().Kitex.LogLevel
+	switch level {
+	case "trace":
+		return klog.LevelTrace
+	case "debug":
+		return klog.LevelDebug
+	case "info":
+		return klog.LevelInfo
+	case "notice":
+		return klog.LevelNotice
+	case "warn":
+		return klog.LevelWarn
+	case "error":
+		return klog.LevelError
+	case "fatal":
+		return klog.LevelFatal
+	default:
+		return klog.LevelInfo
+	}
+}
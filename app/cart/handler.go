@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	cart "zqzqsb/gomall/app/cart/kitex_gen/cart"
+	"zqzqsb/gomall/app/cart/biz/service"
+)
+
+// CartServiceImpl implements the last service interface defined in the IDL.
+type CartServiceImpl struct{}
+
+// AddToCart implements the CartServiceImpl interface.
+func (s *CartServiceImpl) AddToCart(ctx context.Context, req *cart.AddToCartReq) (resp *cart.AddToCartResp, err error) {
+	resp, err = service.NewAddToCartService(ctx).Run(req)
+
+	return resp, err
+}
+
+// UpdateCartItem implements the CartServiceImpl interface.
+func (s *CartServiceImpl) UpdateCartItem(ctx context.Context, req *cart.UpdateCartItemReq) (resp *cart.UpdateCartItemResp, err error) {
+	resp, err = service.NewUpdateCartItemService(ctx).Run(req)
+
+	return resp, err
+}
+
+// RemoveFromCart implements the CartServiceImpl interface.
+func (s *CartServiceImpl) RemoveFromCart(ctx context.Context, req *cart.RemoveFromCartReq) (resp *cart.RemoveFromCartResp, err error) {
+	resp, err = service.NewRemoveFromCartService(ctx).Run(req)
+
+	return resp, err
+}
+
+// GetCart implements the CartServiceImpl interface.
+func (s *CartServiceImpl) GetCart(ctx context.Context, req *cart.GetCartReq) (resp *cart.GetCartResp, err error) {
+	resp, err = service.NewGetCartService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ClearCart implements the CartServiceImpl interface.
+func (s *CartServiceImpl) ClearCart(ctx context.Context, req *cart.ClearCartReq) (resp *cart.ClearCartResp, err error) {
+	resp, err = service.NewClearCartService(ctx).Run(req)
+
+	return resp, err
+}
+
+// SelectCartItems implements the CartServiceImpl interface.
+func (s *CartServiceImpl) SelectCartItems(ctx context.Context, req *cart.SelectCartItemsReq) (resp *cart.SelectCartItemsResp, err error) {
+	resp, err = service.NewSelectCartItemsService(ctx).Run(req)
+
+	return resp, err
+}
@@ -0,0 +1,54 @@
+package rpc
+
+import (
+	"sync"
+
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/transmeta"
+	"zqzqsb.com/gomall/common/clientsuite"
+	"zqzqsb/gomall/app/cart/conf"
+	"zqzqsb/gomall/app/cart/kitex_gen/product/productservice"
+)
+
+var (
+	ProductClient productservice.Client
+	once          sync.Once
+	err           error
+)
+
+// InitClient 初始化下游服务客户端
+func InitClient() {
+	once.Do(func() {
+		initProductClient()
+	})
+}
+
+func initProductClient() {
+	opts := []client.Option{
+		// gRPC 传输下需要显式开启元信息透传，用户身份才能带到下游
+		client.WithMetaHandler(transmeta.ClientHTTP2Handler),
+		client.WithMiddleware(recordProductMW),
+	}
+	policy := conf.GetConf().ProductService.Policy
+	policy.RegisterFallback("GetProduct", getProductFallback)
+	suite := clientsuite.CommonClientSuite{
+		CurrentServiceName: conf.GetConf().Kitex.Service,
+		Policy:             policy,
+	}
+	opts = append(opts, target(&suite, conf.GetConf().ProductService.Address)...)
+	opts = append(opts, suite.Options()...)
+
+	ProductClient, err = productservice.NewClient("product", opts...)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// target 配置了下游地址时直连，否则通过 Consul 发现服务
+func target(suite *clientsuite.CommonClientSuite, addrs []string) []client.Option {
+	if len(addrs) > 0 {
+		return []client.Option{client.WithHostPorts(addrs...)}
+	}
+	suite.RegisteryAddr = conf.GetConf().Registry.Address()
+	return nil
+}
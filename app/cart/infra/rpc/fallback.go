@@ -0,0 +1,66 @@
+package rpc
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cloudwego/kitex/pkg/endpoint"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/cloudwego/kitex/pkg/utils"
+	"google.golang.org/protobuf/proto"
+	"zqzqsb/gomall/app/cart/kitex_gen/product"
+)
+
+// maxProductSnapshots 降级时可用的商品快照数量上限
+const maxProductSnapshots = 10000
+
+// productSnapshots 最近一次成功获取的商品信息，商品服务不可用时用于展示购物车
+var productSnapshots = struct {
+	sync.RWMutex
+	m map[int64]*product.Product
+}{m: make(map[int64]*product.Product)}
+
+// recordProductMW 记录 GetProduct 成功返回的商品
+func recordProductMW(next endpoint.Endpoint) endpoint.Endpoint {
+	return func(ctx context.Context, req, resp interface{}) error {
+		err := next(ctx, req, resp)
+		if err != nil || rpcinfo.GetRPCInfo(ctx).To().Method() != "GetProduct" {
+			return err
+		}
+		result, ok := resp.(utils.KitexResult)
+		if !ok {
+			return err
+		}
+		if r, ok := result.GetResult().(*product.GetProductResp); ok && r.Product != nil {
+			productSnapshots.Lock()
+			if len(productSnapshots.m) >= maxProductSnapshots {
+				productSnapshots.m = make(map[int64]*product.Product)
+			}
+			productSnapshots.m[r.Product.Id] = r.Product
+			productSnapshots.Unlock()
+		}
+		return err
+	}
+}
+
+// getProductFallback 商品服务不可用时返回最近一次的商品快照，没有快照时只返回商品 ID。
+// 降级结果一律视为不在售：购物车照常展示但不计入合计，加购和下单会被拒绝，避免按过期价格结算
+func getProductFallback(ctx context.Context, req, resp interface{}, err error) (interface{}, error) {
+	r, ok := req.(*product.GetProductReq)
+	if !ok {
+		return nil, err
+	}
+	klog.CtxWarnf(ctx, "product service unavailable, degrade GetProduct %d: %v", r.Id, err)
+
+	productSnapshots.RLock()
+	snapshot := productSnapshots.m[r.Id]
+	productSnapshots.RUnlock()
+
+	p := &product.Product{Id: r.Id}
+	if snapshot != nil {
+		p = proto.Clone(snapshot).(*product.Product)
+	}
+	p.IsOnSale = false
+	return &product.GetProductResp{Product: p}, nil
+}
@@ -0,0 +1,1112 @@
+// Code generated by Kitex v0.9.1. DO NOT EDIT.
+
+package cartservice
+
+import (
+	"context"
+	"errors"
+	client "github.com/cloudwego/kitex/client"
+	kitex "github.com/cloudwego/kitex/pkg/serviceinfo"
+	streaming "github.com/cloudwego/kitex/pkg/streaming"
+	proto "google.golang.org/protobuf/proto"
+	cart "zqzqsb/gomall/app/cart/kitex_gen/cart"
+)
+
+var errInvalidMessageType = errors.New("invalid message type for service method handler")
+
+var serviceMethods = map[string]kitex.MethodInfo{
+	"AddToCart": kitex.NewMethodInfo(
+		addToCartHandler,
+		newAddToCartArgs,
+		newAddToCartResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"UpdateCartItem": kitex.NewMethodInfo(
+		updateCartItemHandler,
+		newUpdateCartItemArgs,
+		newUpdateCartItemResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RemoveFromCart": kitex.NewMethodInfo(
+		removeFromCartHandler,
+		newRemoveFromCartArgs,
+		newRemoveFromCartResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"GetCart": kitex.NewMethodInfo(
+		getCartHandler,
+		newGetCartArgs,
+		newGetCartResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ClearCart": kitex.NewMethodInfo(
+		clearCartHandler,
+		newClearCartArgs,
+		newClearCartResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"SelectCartItems": kitex.NewMethodInfo(
+		selectCartItemsHandler,
+		newSelectCartItemsArgs,
+		newSelectCartItemsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+}
+
+var (
+	cartServiceServiceInfo                = NewServiceInfo()
+	cartServiceServiceInfoForClient       = NewServiceInfoForClient()
+	cartServiceServiceInfoForStreamClient = NewServiceInfoForStreamClient()
+)
+
+// for server
+func serviceInfo() *kitex.ServiceInfo {
+	return cartServiceServiceInfo
+}
+
+// for client
+func serviceInfoForStreamClient() *kitex.ServiceInfo {
+	return cartServiceServiceInfoForStreamClient
+}
+
+// for stream client
+func serviceInfoForClient() *kitex.ServiceInfo {
+	return cartServiceServiceInfoForClient
+}
+
+// NewServiceInfo creates a new ServiceInfo containing all methods
+func NewServiceInfo() *kitex.ServiceInfo {
+	return newServiceInfo(false, true, true)
+}
+
+// NewServiceInfo creates a new ServiceInfo containing non-streaming methods
+func NewServiceInfoForClient() *kitex.ServiceInfo {
+	return newServiceInfo(false, false, true)
+}
+func NewServiceInfoForStreamClient() *kitex.ServiceInfo {
+	return newServiceInfo(true, true, false)
+}
+
+func newServiceInfo(hasStreaming bool, keepStreamingMethods bool, keepNonStreamingMethods bool) *kitex.ServiceInfo {
+	serviceName := "CartService"
+	handlerType := (*cart.CartService)(nil)
+	methods := map[string]kitex.MethodInfo{}
+	for name, m := range serviceMethods {
+		if m.IsStreaming() && !keepStreamingMethods {
+			continue
+		}
+		if !m.IsStreaming() && !keepNonStreamingMethods {
+			continue
+		}
+		methods[name] = m
+	}
+	extra := map[string]interface{}{
+		"PackageName": "cart",
+	}
+	if hasStreaming {
+		extra["streaming"] = hasStreaming
+	}
+	svcInfo := &kitex.ServiceInfo{
+		ServiceName:     serviceName,
+		HandlerType:     handlerType,
+		Methods:         methods,
+		PayloadCodec:    kitex.Protobuf,
+		KiteXGenVersion: "v0.9.1",
+		Extra:           extra,
+	}
+	return svcInfo
+}
+
+func addToCartHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(cart.AddToCartReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(cart.CartService).AddToCart(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *AddToCartArgs:
+		success, err := handler.(cart.CartService).AddToCart(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*AddToCartResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newAddToCartArgs() interface{} {
+	return &AddToCartArgs{}
+}
+
+func newAddToCartResult() interface{} {
+	return &AddToCartResult{}
+}
+
+type AddToCartArgs struct {
+	Req *cart.AddToCartReq
+}
+
+func (p *AddToCartArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(cart.AddToCartReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *AddToCartArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *AddToCartArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *AddToCartArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *AddToCartArgs) Unmarshal(in []byte) error {
+	msg := new(cart.AddToCartReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var AddToCartArgs_Req_DEFAULT *cart.AddToCartReq
+
+func (p *AddToCartArgs) GetReq() *cart.AddToCartReq {
+	if !p.IsSetReq() {
+		return AddToCartArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *AddToCartArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AddToCartArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type AddToCartResult struct {
+	Success *cart.AddToCartResp
+}
+
+var AddToCartResult_Success_DEFAULT *cart.AddToCartResp
+
+func (p *AddToCartResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(cart.AddToCartResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *AddToCartResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *AddToCartResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *AddToCartResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *AddToCartResult) Unmarshal(in []byte) error {
+	msg := new(cart.AddToCartResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *AddToCartResult) GetSuccess() *cart.AddToCartResp {
+	if !p.IsSetSuccess() {
+		return AddToCartResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *AddToCartResult) SetSuccess(x interface{}) {
+	p.Success = x.(*cart.AddToCartResp)
+}
+
+func (p *AddToCartResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AddToCartResult) GetResult() interface{} {
+	return p.Success
+}
+
+func updateCartItemHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(cart.UpdateCartItemReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(cart.CartService).UpdateCartItem(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UpdateCartItemArgs:
+		success, err := handler.(cart.CartService).UpdateCartItem(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UpdateCartItemResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUpdateCartItemArgs() interface{} {
+	return &UpdateCartItemArgs{}
+}
+
+func newUpdateCartItemResult() interface{} {
+	return &UpdateCartItemResult{}
+}
+
+type UpdateCartItemArgs struct {
+	Req *cart.UpdateCartItemReq
+}
+
+func (p *UpdateCartItemArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(cart.UpdateCartItemReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UpdateCartItemArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UpdateCartItemArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UpdateCartItemArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UpdateCartItemArgs) Unmarshal(in []byte) error {
+	msg := new(cart.UpdateCartItemReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UpdateCartItemArgs_Req_DEFAULT *cart.UpdateCartItemReq
+
+func (p *UpdateCartItemArgs) GetReq() *cart.UpdateCartItemReq {
+	if !p.IsSetReq() {
+		return UpdateCartItemArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UpdateCartItemArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UpdateCartItemArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UpdateCartItemResult struct {
+	Success *cart.UpdateCartItemResp
+}
+
+var UpdateCartItemResult_Success_DEFAULT *cart.UpdateCartItemResp
+
+func (p *UpdateCartItemResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(cart.UpdateCartItemResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UpdateCartItemResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UpdateCartItemResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UpdateCartItemResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UpdateCartItemResult) Unmarshal(in []byte) error {
+	msg := new(cart.UpdateCartItemResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UpdateCartItemResult) GetSuccess() *cart.UpdateCartItemResp {
+	if !p.IsSetSuccess() {
+		return UpdateCartItemResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UpdateCartItemResult) SetSuccess(x interface{}) {
+	p.Success = x.(*cart.UpdateCartItemResp)
+}
+
+func (p *UpdateCartItemResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UpdateCartItemResult) GetResult() interface{} {
+	return p.Success
+}
+
+func removeFromCartHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(cart.RemoveFromCartReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(cart.CartService).RemoveFromCart(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *RemoveFromCartArgs:
+		success, err := handler.(cart.CartService).RemoveFromCart(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RemoveFromCartResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newRemoveFromCartArgs() interface{} {
+	return &RemoveFromCartArgs{}
+}
+
+func newRemoveFromCartResult() interface{} {
+	return &RemoveFromCartResult{}
+}
+
+type RemoveFromCartArgs struct {
+	Req *cart.RemoveFromCartReq
+}
+
+func (p *RemoveFromCartArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(cart.RemoveFromCartReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RemoveFromCartArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RemoveFromCartArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RemoveFromCartArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RemoveFromCartArgs) Unmarshal(in []byte) error {
+	msg := new(cart.RemoveFromCartReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RemoveFromCartArgs_Req_DEFAULT *cart.RemoveFromCartReq
+
+func (p *RemoveFromCartArgs) GetReq() *cart.RemoveFromCartReq {
+	if !p.IsSetReq() {
+		return RemoveFromCartArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RemoveFromCartArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RemoveFromCartArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RemoveFromCartResult struct {
+	Success *cart.RemoveFromCartResp
+}
+
+var RemoveFromCartResult_Success_DEFAULT *cart.RemoveFromCartResp
+
+func (p *RemoveFromCartResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(cart.RemoveFromCartResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RemoveFromCartResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RemoveFromCartResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RemoveFromCartResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RemoveFromCartResult) Unmarshal(in []byte) error {
+	msg := new(cart.RemoveFromCartResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RemoveFromCartResult) GetSuccess() *cart.RemoveFromCartResp {
+	if !p.IsSetSuccess() {
+		return RemoveFromCartResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RemoveFromCartResult) SetSuccess(x interface{}) {
+	p.Success = x.(*cart.RemoveFromCartResp)
+}
+
+func (p *RemoveFromCartResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RemoveFromCartResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getCartHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(cart.GetCartReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(cart.CartService).GetCart(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetCartArgs:
+		success, err := handler.(cart.CartService).GetCart(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetCartResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetCartArgs() interface{} {
+	return &GetCartArgs{}
+}
+
+func newGetCartResult() interface{} {
+	return &GetCartResult{}
+}
+
+type GetCartArgs struct {
+	Req *cart.GetCartReq
+}
+
+func (p *GetCartArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(cart.GetCartReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetCartArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetCartArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetCartArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetCartArgs) Unmarshal(in []byte) error {
+	msg := new(cart.GetCartReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetCartArgs_Req_DEFAULT *cart.GetCartReq
+
+func (p *GetCartArgs) GetReq() *cart.GetCartReq {
+	if !p.IsSetReq() {
+		return GetCartArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetCartArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetCartArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetCartResult struct {
+	Success *cart.GetCartResp
+}
+
+var GetCartResult_Success_DEFAULT *cart.GetCartResp
+
+func (p *GetCartResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(cart.GetCartResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetCartResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetCartResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetCartResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetCartResult) Unmarshal(in []byte) error {
+	msg := new(cart.GetCartResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetCartResult) GetSuccess() *cart.GetCartResp {
+	if !p.IsSetSuccess() {
+		return GetCartResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetCartResult) SetSuccess(x interface{}) {
+	p.Success = x.(*cart.GetCartResp)
+}
+
+func (p *GetCartResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetCartResult) GetResult() interface{} {
+	return p.Success
+}
+
+func clearCartHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(cart.ClearCartReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(cart.CartService).ClearCart(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ClearCartArgs:
+		success, err := handler.(cart.CartService).ClearCart(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ClearCartResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newClearCartArgs() interface{} {
+	return &ClearCartArgs{}
+}
+
+func newClearCartResult() interface{} {
+	return &ClearCartResult{}
+}
+
+type ClearCartArgs struct {
+	Req *cart.ClearCartReq
+}
+
+func (p *ClearCartArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(cart.ClearCartReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ClearCartArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ClearCartArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ClearCartArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ClearCartArgs) Unmarshal(in []byte) error {
+	msg := new(cart.ClearCartReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ClearCartArgs_Req_DEFAULT *cart.ClearCartReq
+
+func (p *ClearCartArgs) GetReq() *cart.ClearCartReq {
+	if !p.IsSetReq() {
+		return ClearCartArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ClearCartArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ClearCartArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ClearCartResult struct {
+	Success *cart.ClearCartResp
+}
+
+var ClearCartResult_Success_DEFAULT *cart.ClearCartResp
+
+func (p *ClearCartResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(cart.ClearCartResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ClearCartResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ClearCartResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ClearCartResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ClearCartResult) Unmarshal(in []byte) error {
+	msg := new(cart.ClearCartResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ClearCartResult) GetSuccess() *cart.ClearCartResp {
+	if !p.IsSetSuccess() {
+		return ClearCartResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ClearCartResult) SetSuccess(x interface{}) {
+	p.Success = x.(*cart.ClearCartResp)
+}
+
+func (p *ClearCartResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ClearCartResult) GetResult() interface{} {
+	return p.Success
+}
+
+func selectCartItemsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(cart.SelectCartItemsReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(cart.CartService).SelectCartItems(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *SelectCartItemsArgs:
+		success, err := handler.(cart.CartService).SelectCartItems(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SelectCartItemsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newSelectCartItemsArgs() interface{} {
+	return &SelectCartItemsArgs{}
+}
+
+func newSelectCartItemsResult() interface{} {
+	return &SelectCartItemsResult{}
+}
+
+type SelectCartItemsArgs struct {
+	Req *cart.SelectCartItemsReq
+}
+
+func (p *SelectCartItemsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(cart.SelectCartItemsReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *SelectCartItemsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *SelectCartItemsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *SelectCartItemsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SelectCartItemsArgs) Unmarshal(in []byte) error {
+	msg := new(cart.SelectCartItemsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SelectCartItemsArgs_Req_DEFAULT *cart.SelectCartItemsReq
+
+func (p *SelectCartItemsArgs) GetReq() *cart.SelectCartItemsReq {
+	if !p.IsSetReq() {
+		return SelectCartItemsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SelectCartItemsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SelectCartItemsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type SelectCartItemsResult struct {
+	Success *cart.SelectCartItemsResp
+}
+
+var SelectCartItemsResult_Success_DEFAULT *cart.SelectCartItemsResp
+
+func (p *SelectCartItemsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(cart.SelectCartItemsResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *SelectCartItemsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *SelectCartItemsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *SelectCartItemsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SelectCartItemsResult) Unmarshal(in []byte) error {
+	msg := new(cart.SelectCartItemsResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SelectCartItemsResult) GetSuccess() *cart.SelectCartItemsResp {
+	if !p.IsSetSuccess() {
+		return SelectCartItemsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SelectCartItemsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*cart.SelectCartItemsResp)
+}
+
+func (p *SelectCartItemsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SelectCartItemsResult) GetResult() interface{} {
+	return p.Success
+}
+
+type kClient struct {
+	c client.Client
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c: c,
+	}
+}
+
+func (p *kClient) AddToCart(ctx context.Context, Req *cart.AddToCartReq) (r *cart.AddToCartResp, err error) {
+	var _args AddToCartArgs
+	_args.Req = Req
+	var _result AddToCartResult
+	if err = p.c.Call(ctx, "AddToCart", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateCartItem(ctx context.Context, Req *cart.UpdateCartItemReq) (r *cart.UpdateCartItemResp, err error) {
+	var _args UpdateCartItemArgs
+	_args.Req = Req
+	var _result UpdateCartItemResult
+	if err = p.c.Call(ctx, "UpdateCartItem", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RemoveFromCart(ctx context.Context, Req *cart.RemoveFromCartReq) (r *cart.RemoveFromCartResp, err error) {
+	var _args RemoveFromCartArgs
+	_args.Req = Req
+	var _result RemoveFromCartResult
+	if err = p.c.Call(ctx, "RemoveFromCart", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetCart(ctx context.Context, Req *cart.GetCartReq) (r *cart.GetCartResp, err error) {
+	var _args GetCartArgs
+	_args.Req = Req
+	var _result GetCartResult
+	if err = p.c.Call(ctx, "GetCart", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ClearCart(ctx context.Context, Req *cart.ClearCartReq) (r *cart.ClearCartResp, err error) {
+	var _args ClearCartArgs
+	_args.Req = Req
+	var _result ClearCartResult
+	if err = p.c.Call(ctx, "ClearCart", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SelectCartItems(ctx context.Context, Req *cart.SelectCartItemsReq) (r *cart.SelectCartItemsResp, err error) {
+	var _args SelectCartItemsArgs
+	_args.Req = Req
+	var _result SelectCartItemsResult
+	if err = p.c.Call(ctx, "SelectCartItems", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -0,0 +1,8327 @@
+// Code generated by Fastpb v0.0.2. DO NOT EDIT.
+
+package product
+
+import (
+	fmt "fmt"
+	fastpb "github.com/cloudwego/fastpb"
+	api "zqzqsb/gomall/app/cart/kitex_gen/api"
+)
+
+var (
+	_ = fmt.Errorf
+	_ = fastpb.Skip
+)
+
+func (x *Product) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 9:
+		offset, err = x.fastReadField9(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 10:
+		offset, err = x.fastReadField10(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 11:
+		offset, err = x.fastReadField11(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 12:
+		offset, err = x.fastReadField12(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 13:
+		offset, err = x.fastReadField13(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 14:
+		offset, err = x.fastReadField14(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 15:
+		offset, err = x.fastReadField15(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 16:
+		offset, err = x.fastReadField16(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 17:
+		offset, err = x.fastReadField17(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 18:
+		offset, err = x.fastReadField18(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 19:
+		offset, err = x.fastReadField19(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_Product[number], err)
+}
+
+func (x *Product) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Id, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Product) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Product) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Description, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Product) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Price, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Product) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Stock, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *Product) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.ImageUrl, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Product) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	var v string
+	v, offset, err = fastpb.ReadString(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.Gallery = append(x.Gallery, v)
+	return offset, err
+}
+
+func (x *Product) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.Category, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Product) fastReadField9(buf []byte, _type int8) (offset int, err error) {
+	x.IsOnSale, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *Product) fastReadField10(buf []byte, _type int8) (offset int, err error) {
+	x.CreateTime, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Product) fastReadField11(buf []byte, _type int8) (offset int, err error) {
+	x.UpdateTime, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Product) fastReadField12(buf []byte, _type int8) (offset int, err error) {
+	if x.Attributes == nil {
+		x.Attributes = make(map[string]string)
+	}
+	var key string
+	var value string
+	offset, err = fastpb.ReadMapEntry(buf, _type,
+		func(buf []byte, _type int8) (offset int, err error) {
+			key, offset, err = fastpb.ReadString(buf, _type)
+			return offset, err
+		},
+		func(buf []byte, _type int8) (offset int, err error) {
+			value, offset, err = fastpb.ReadString(buf, _type)
+			return offset, err
+		})
+	if err != nil {
+		return offset, err
+	}
+	x.Attributes[key] = value
+	return offset, nil
+}
+
+func (x *Product) fastReadField13(buf []byte, _type int8) (offset int, err error) {
+	x.Rating, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *Product) fastReadField14(buf []byte, _type int8) (offset int, err error) {
+	x.SalesCount, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *Product) fastReadField15(buf []byte, _type int8) (offset int, err error) {
+	var v ProductOption
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Options = append(x.Options, &v)
+	return offset, nil
+}
+
+func (x *Product) fastReadField16(buf []byte, _type int8) (offset int, err error) {
+	var v Sku
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Skus = append(x.Skus, &v)
+	return offset, nil
+}
+
+func (x *Product) fastReadField17(buf []byte, _type int8) (offset int, err error) {
+	x.CategoryId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Product) fastReadField18(buf []byte, _type int8) (offset int, err error) {
+	x.Version, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Product) fastReadField19(buf []byte, _type int8) (offset int, err error) {
+	x.ExternalCode, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Category) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_Category[number], err)
+}
+
+func (x *Category) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Id, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Category) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.ParentId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Category) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Category) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Slug, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Category) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Icon, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Category) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.SortOrder, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *Category) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	var v Category
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Children = append(x.Children, &v)
+	return offset, nil
+}
+
+func (x *ProductOption) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ProductOption[number], err)
+}
+
+func (x *ProductOption) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ProductOption) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v string
+	v, offset, err = fastpb.ReadString(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.Values = append(x.Values, v)
+	return offset, err
+}
+
+func (x *Sku) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_Sku[number], err)
+}
+
+func (x *Sku) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Id, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Sku) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.ProductId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Sku) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	if x.Attributes == nil {
+		x.Attributes = make(map[string]string)
+	}
+	var key string
+	var value string
+	offset, err = fastpb.ReadMapEntry(buf, _type,
+		func(buf []byte, _type int8) (offset int, err error) {
+			key, offset, err = fastpb.ReadString(buf, _type)
+			return offset, err
+		},
+		func(buf []byte, _type int8) (offset int, err error) {
+			value, offset, err = fastpb.ReadString(buf, _type)
+			return offset, err
+		})
+	if err != nil {
+		return offset, err
+	}
+	x.Attributes[key] = value
+	return offset, nil
+}
+
+func (x *Sku) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Price, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Sku) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Stock, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *Sku) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.Barcode, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Sku) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.IsOnSale, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *Sku) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.SalesCount, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *CreateProductReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 9:
+		offset, err = x.fastReadField9(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 10:
+		offset, err = x.fastReadField10(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 11:
+		offset, err = x.fastReadField11(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CreateProductReq[number], err)
+}
+
+func (x *CreateProductReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateProductReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Description, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateProductReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Price, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *CreateProductReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Stock, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *CreateProductReq) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.ImageUrl, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateProductReq) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	var v string
+	v, offset, err = fastpb.ReadString(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.Gallery = append(x.Gallery, v)
+	return offset, err
+}
+
+func (x *CreateProductReq) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.Category, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateProductReq) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.IsOnSale, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *CreateProductReq) fastReadField9(buf []byte, _type int8) (offset int, err error) {
+	if x.Attributes == nil {
+		x.Attributes = make(map[string]string)
+	}
+	var key string
+	var value string
+	offset, err = fastpb.ReadMapEntry(buf, _type,
+		func(buf []byte, _type int8) (offset int, err error) {
+			key, offset, err = fastpb.ReadString(buf, _type)
+			return offset, err
+		},
+		func(buf []byte, _type int8) (offset int, err error) {
+			value, offset, err = fastpb.ReadString(buf, _type)
+			return offset, err
+		})
+	if err != nil {
+		return offset, err
+	}
+	x.Attributes[key] = value
+	return offset, nil
+}
+
+func (x *CreateProductReq) fastReadField10(buf []byte, _type int8) (offset int, err error) {
+	x.CategoryId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *CreateProductReq) fastReadField11(buf []byte, _type int8) (offset int, err error) {
+	x.Reason, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateProductResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CreateProductResp[number], err)
+}
+
+func (x *CreateProductResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.ProductId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateProductReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 9:
+		offset, err = x.fastReadField9(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 10:
+		offset, err = x.fastReadField10(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 11:
+		offset, err = x.fastReadField11(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 12:
+		offset, err = x.fastReadField12(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 13:
+		offset, err = x.fastReadField13(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 14:
+		offset, err = x.fastReadField14(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UpdateProductReq[number], err)
+}
+
+func (x *UpdateProductReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Id, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateProductReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateProductReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Description, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateProductReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Price, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateProductReq) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Stock, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateProductReq) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.ImageUrl, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateProductReq) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	var v string
+	v, offset, err = fastpb.ReadString(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.Gallery = append(x.Gallery, v)
+	return offset, err
+}
+
+func (x *UpdateProductReq) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.Category, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateProductReq) fastReadField9(buf []byte, _type int8) (offset int, err error) {
+	x.IsOnSale, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateProductReq) fastReadField10(buf []byte, _type int8) (offset int, err error) {
+	if x.Attributes == nil {
+		x.Attributes = make(map[string]string)
+	}
+	var key string
+	var value string
+	offset, err = fastpb.ReadMapEntry(buf, _type,
+		func(buf []byte, _type int8) (offset int, err error) {
+			key, offset, err = fastpb.ReadString(buf, _type)
+			return offset, err
+		},
+		func(buf []byte, _type int8) (offset int, err error) {
+			value, offset, err = fastpb.ReadString(buf, _type)
+			return offset, err
+		})
+	if err != nil {
+		return offset, err
+	}
+	x.Attributes[key] = value
+	return offset, nil
+}
+
+func (x *UpdateProductReq) fastReadField11(buf []byte, _type int8) (offset int, err error) {
+	x.CategoryId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateProductReq) fastReadField12(buf []byte, _type int8) (offset int, err error) {
+	var v string
+	v, offset, err = fastpb.ReadString(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.UpdateMask = append(x.UpdateMask, v)
+	return offset, err
+}
+
+func (x *UpdateProductReq) fastReadField13(buf []byte, _type int8) (offset int, err error) {
+	x.Version, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateProductReq) fastReadField14(buf []byte, _type int8) (offset int, err error) {
+	x.Reason, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateProductResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UpdateProductResp[number], err)
+}
+
+func (x *UpdateProductResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateProductResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Version, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *GetProductReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetProductReq[number], err)
+}
+
+func (x *GetProductReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Id, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *GetProductResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetProductResp[number], err)
+}
+
+func (x *GetProductResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Product
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Product = &v
+	return offset, nil
+}
+
+func (x *DeleteProductReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_DeleteProductReq[number], err)
+}
+
+func (x *DeleteProductReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Id, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *DeleteProductReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Reason, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *DeleteProductResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_DeleteProductResp[number], err)
+}
+
+func (x *DeleteProductResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *ListProductsReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 9:
+		offset, err = x.fastReadField9(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 10:
+		offset, err = x.fastReadField10(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 11:
+		offset, err = x.fastReadField11(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 12:
+		offset, err = x.fastReadField12(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 13:
+		offset, err = x.fastReadField13(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListProductsReq[number], err)
+}
+
+func (x *ListProductsReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Category, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListProductsReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Keyword, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListProductsReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.OnSaleOnly, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *ListProductsReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Page, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ListProductsReq) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.PageSize, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ListProductsReq) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.SortBy, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListProductsReq) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.Ascending, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *ListProductsReq) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.MinPrice, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ListProductsReq) fastReadField9(buf []byte, _type int8) (offset int, err error) {
+	x.MaxPrice, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ListProductsReq) fastReadField10(buf []byte, _type int8) (offset int, err error) {
+	x.CategoryId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ListProductsReq) fastReadField11(buf []byte, _type int8) (offset int, err error) {
+	x.UseCursor, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *ListProductsReq) fastReadField12(buf []byte, _type int8) (offset int, err error) {
+	x.Cursor, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListProductsReq) fastReadField13(buf []byte, _type int8) (offset int, err error) {
+	x.SkipTotal, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *ListProductsResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListProductsResp[number], err)
+}
+
+func (x *ListProductsResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Product
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Products = append(x.Products, &v)
+	return offset, nil
+}
+
+func (x *ListProductsResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Total, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ListProductsResp) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Page, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ListProductsResp) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.PageSize, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ListProductsResp) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.TotalPages, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ListProductsResp) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.HasMore, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *ListProductsResp) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.NextCursor, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *SearchProductsReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_SearchProductsReq[number], err)
+}
+
+func (x *SearchProductsReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Keyword, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *SearchProductsReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.CategoryId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *SearchProductsReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.MinPrice, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *SearchProductsReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.MaxPrice, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *SearchProductsReq) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.OnSaleOnly, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *SearchProductsReq) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	if x.Attributes == nil {
+		x.Attributes = make(map[string]string)
+	}
+	var key string
+	var value string
+	offset, err = fastpb.ReadMapEntry(buf, _type,
+		func(buf []byte, _type int8) (offset int, err error) {
+			key, offset, err = fastpb.ReadString(buf, _type)
+			return offset, err
+		},
+		func(buf []byte, _type int8) (offset int, err error) {
+			value, offset, err = fastpb.ReadString(buf, _type)
+			return offset, err
+		})
+	if err != nil {
+		return offset, err
+	}
+	x.Attributes[key] = value
+	return offset, nil
+}
+
+func (x *SearchProductsReq) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.Page, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *SearchProductsReq) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.PageSize, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *SearchHit) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_SearchHit[number], err)
+}
+
+func (x *SearchHit) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Product
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Product = &v
+	return offset, nil
+}
+
+func (x *SearchHit) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Score, offset, err = fastpb.ReadDouble(buf, _type)
+	return offset, err
+}
+
+func (x *CategoryFacet) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CategoryFacet[number], err)
+}
+
+func (x *CategoryFacet) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.CategoryId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *CategoryFacet) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CategoryFacet) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Count, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *PriceFacet) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_PriceFacet[number], err)
+}
+
+func (x *PriceFacet) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Min, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *PriceFacet) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Max, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *PriceFacet) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Count, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *AttributeValueFacet) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_AttributeValueFacet[number], err)
+}
+
+func (x *AttributeValueFacet) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Value, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *AttributeValueFacet) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Count, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *AttributeFacet) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_AttributeFacet[number], err)
+}
+
+func (x *AttributeFacet) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *AttributeFacet) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v AttributeValueFacet
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Values = append(x.Values, &v)
+	return offset, nil
+}
+
+func (x *SearchProductsResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_SearchProductsResp[number], err)
+}
+
+func (x *SearchProductsResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v SearchHit
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Hits = append(x.Hits, &v)
+	return offset, nil
+}
+
+func (x *SearchProductsResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Total, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *SearchProductsResp) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Page, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *SearchProductsResp) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.PageSize, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *SearchProductsResp) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	var v CategoryFacet
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.CategoryFacets = append(x.CategoryFacets, &v)
+	return offset, nil
+}
+
+func (x *SearchProductsResp) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	var v PriceFacet
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.PriceFacets = append(x.PriceFacets, &v)
+	return offset, nil
+}
+
+func (x *SearchProductsResp) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	var v AttributeFacet
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.AttributeFacets = append(x.AttributeFacets, &v)
+	return offset, nil
+}
+
+func (x *GetCategoriesReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+}
+
+func (x *GetCategoriesResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetCategoriesResp[number], err)
+}
+
+func (x *GetCategoriesResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v string
+	v, offset, err = fastpb.ReadString(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.Categories = append(x.Categories, v)
+	return offset, err
+}
+
+func (x *GetCategoriesResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v Category
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Tree = append(x.Tree, &v)
+	return offset, nil
+}
+
+func (x *CreateCategoryReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CreateCategoryReq[number], err)
+}
+
+func (x *CreateCategoryReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.ParentId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *CreateCategoryReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateCategoryReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Slug, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateCategoryReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Icon, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateCategoryReq) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.SortOrder, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *CreateCategoryResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CreateCategoryResp[number], err)
+}
+
+func (x *CreateCategoryResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Category
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Category = &v
+	return offset, nil
+}
+
+func (x *UpdateCategoryReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UpdateCategoryReq[number], err)
+}
+
+func (x *UpdateCategoryReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Id, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateCategoryReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.ParentId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateCategoryReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateCategoryReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Slug, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateCategoryReq) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Icon, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateCategoryReq) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.SortOrder, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateCategoryResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UpdateCategoryResp[number], err)
+}
+
+func (x *UpdateCategoryResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Category
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Category = &v
+	return offset, nil
+}
+
+func (x *DeleteCategoryReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_DeleteCategoryReq[number], err)
+}
+
+func (x *DeleteCategoryReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Id, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *DeleteCategoryResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_DeleteCategoryResp[number], err)
+}
+
+func (x *DeleteCategoryResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *SetProductOptionsReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_SetProductOptionsReq[number], err)
+}
+
+func (x *SetProductOptionsReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.ProductId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *SetProductOptionsReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v ProductOption
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Options = append(x.Options, &v)
+	return offset, nil
+}
+
+func (x *SetProductOptionsResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_SetProductOptionsResp[number], err)
+}
+
+func (x *SetProductOptionsResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *SaveSkusReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_SaveSkusReq[number], err)
+}
+
+func (x *SaveSkusReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.ProductId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *SaveSkusReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v Sku
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Skus = append(x.Skus, &v)
+	return offset, nil
+}
+
+func (x *SaveSkusResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_SaveSkusResp[number], err)
+}
+
+func (x *SaveSkusResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Sku
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Skus = append(x.Skus, &v)
+	return offset, nil
+}
+
+func (x *DeleteSkuReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_DeleteSkuReq[number], err)
+}
+
+func (x *DeleteSkuReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.ProductId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *DeleteSkuReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.SkuId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *DeleteSkuResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_DeleteSkuResp[number], err)
+}
+
+func (x *DeleteSkuResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *FieldChange) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_FieldChange[number], err)
+}
+
+func (x *FieldChange) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Field, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *FieldChange) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Before, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *FieldChange) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.After, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ProductRevision) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ProductRevision[number], err)
+}
+
+func (x *ProductRevision) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Id, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ProductRevision) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.ProductId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ProductRevision) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Action, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ProductRevision) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.OperatorId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ProductRevision) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Reason, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ProductRevision) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.SkuId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ProductRevision) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	var v FieldChange
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Changes = append(x.Changes, &v)
+	return offset, nil
+}
+
+func (x *ProductRevision) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.CreateTime, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ListProductRevisionsReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListProductRevisionsReq[number], err)
+}
+
+func (x *ListProductRevisionsReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.ProductId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ListProductRevisionsReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Page, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ListProductRevisionsReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.PageSize, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ListProductRevisionsResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListProductRevisionsResp[number], err)
+}
+
+func (x *ListProductRevisionsResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v ProductRevision
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Revisions = append(x.Revisions, &v)
+	return offset, nil
+}
+
+func (x *ListProductRevisionsResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Total, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *RestoreProductReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RestoreProductReq[number], err)
+}
+
+func (x *RestoreProductReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.ProductId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *RestoreProductReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.RevisionId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *RestoreProductReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Reason, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RestoreProductResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RestoreProductResp[number], err)
+}
+
+func (x *RestoreProductResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *RestoreProductResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Version, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ProductRecord) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 9:
+		offset, err = x.fastReadField9(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 10:
+		offset, err = x.fastReadField10(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 11:
+		offset, err = x.fastReadField11(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ProductRecord[number], err)
+}
+
+func (x *ProductRecord) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.ExternalCode, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ProductRecord) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ProductRecord) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Description, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ProductRecord) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Price, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ProductRecord) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Stock, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ProductRecord) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.ImageUrl, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ProductRecord) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	var v string
+	v, offset, err = fastpb.ReadString(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.Gallery = append(x.Gallery, v)
+	return offset, err
+}
+
+func (x *ProductRecord) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.Category, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ProductRecord) fastReadField9(buf []byte, _type int8) (offset int, err error) {
+	x.IsOnSale, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *ProductRecord) fastReadField10(buf []byte, _type int8) (offset int, err error) {
+	if x.Attributes == nil {
+		x.Attributes = make(map[string]string)
+	}
+	var key string
+	var value string
+	offset, err = fastpb.ReadMapEntry(buf, _type,
+		func(buf []byte, _type int8) (offset int, err error) {
+			key, offset, err = fastpb.ReadString(buf, _type)
+			return offset, err
+		},
+		func(buf []byte, _type int8) (offset int, err error) {
+			value, offset, err = fastpb.ReadString(buf, _type)
+			return offset, err
+		})
+	if err != nil {
+		return offset, err
+	}
+	x.Attributes[key] = value
+	return offset, nil
+}
+
+func (x *ProductRecord) fastReadField11(buf []byte, _type int8) (offset int, err error) {
+	x.ProductId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *BulkImportProductsReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_BulkImportProductsReq[number], err)
+}
+
+func (x *BulkImportProductsReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Mode, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *BulkImportProductsReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Reason, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *BulkImportProductsReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	var v ProductRecord
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Records = append(x.Records, &v)
+	return offset, nil
+}
+
+func (x *ImportRowError) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ImportRowError[number], err)
+}
+
+func (x *ImportRowError) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Row, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ImportRowError) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.ExternalCode, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ImportRowError) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Message, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *BulkImportProductsResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_BulkImportProductsResp[number], err)
+}
+
+func (x *BulkImportProductsResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Total, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *BulkImportProductsResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Created, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *BulkImportProductsResp) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Updated, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *BulkImportProductsResp) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	var v ImportRowError
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Errors = append(x.Errors, &v)
+	return offset, nil
+}
+
+func (x *BulkImportProductsResp) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Committed, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *ExportProductsReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ExportProductsReq[number], err)
+}
+
+func (x *ExportProductsReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.CategoryId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ExportProductsReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.BatchSize, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ExportProductsResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ExportProductsResp[number], err)
+}
+
+func (x *ExportProductsResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v ProductRecord
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Records = append(x.Records, &v)
+	return offset, nil
+}
+
+func (x *UpdateStockReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UpdateStockReq[number], err)
+}
+
+func (x *UpdateStockReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.ProductId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateStockReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Quantity, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateStockReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.SkuId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateStockReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Reason, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateStockResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UpdateStockResp[number], err)
+}
+
+func (x *UpdateStockResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateStockResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.CurrentStock, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *StockItem) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_StockItem[number], err)
+}
+
+func (x *StockItem) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.ProductId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *StockItem) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Quantity, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *StockItem) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.SkuId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *StockItem) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	if x.Attributes == nil {
+		x.Attributes = make(map[string]string)
+	}
+	var key string
+	var value string
+	offset, err = fastpb.ReadMapEntry(buf, _type,
+		func(buf []byte, _type int8) (offset int, err error) {
+			key, offset, err = fastpb.ReadString(buf, _type)
+			return offset, err
+		},
+		func(buf []byte, _type int8) (offset int, err error) {
+			value, offset, err = fastpb.ReadString(buf, _type)
+			return offset, err
+		})
+	if err != nil {
+		return offset, err
+	}
+	x.Attributes[key] = value
+	return offset, nil
+}
+
+func (x *ReserveStockReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ReserveStockReq[number], err)
+}
+
+func (x *ReserveStockReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ReserveStockReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v StockItem
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Items = append(x.Items, &v)
+	return offset, nil
+}
+
+func (x *ReserveStockReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.TtlSeconds, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ReserveStockResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ReserveStockResp[number], err)
+}
+
+func (x *ReserveStockResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ReserveStockResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v int32
+	v, offset, err = fastpb.ReadInt32(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.Status = ReservationStatus(v)
+	return offset, nil
+}
+
+func (x *ReserveStockResp) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.ExpireTime, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ConfirmReservationReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ConfirmReservationReq[number], err)
+}
+
+func (x *ConfirmReservationReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ConfirmReservationResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ConfirmReservationResp[number], err)
+}
+
+func (x *ConfirmReservationResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *ReleaseReservationReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ReleaseReservationReq[number], err)
+}
+
+func (x *ReleaseReservationReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ReleaseReservationResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ReleaseReservationResp[number], err)
+}
+
+func (x *ReleaseReservationResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *Product) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	offset += x.fastWriteField10(buf[offset:])
+	offset += x.fastWriteField11(buf[offset:])
+	offset += x.fastWriteField12(buf[offset:])
+	offset += x.fastWriteField13(buf[offset:])
+	offset += x.fastWriteField14(buf[offset:])
+	offset += x.fastWriteField15(buf[offset:])
+	offset += x.fastWriteField16(buf[offset:])
+	offset += x.fastWriteField17(buf[offset:])
+	offset += x.fastWriteField18(buf[offset:])
+	offset += x.fastWriteField19(buf[offset:])
+	return offset
+}
+
+func (x *Product) fastWriteField1(buf []byte) (offset int) {
+	if x.Id == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetId())
+	return offset
+}
+
+func (x *Product) fastWriteField2(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetName())
+	return offset
+}
+
+func (x *Product) fastWriteField3(buf []byte) (offset int) {
+	if x.Description == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetDescription())
+	return offset
+}
+
+func (x *Product) fastWriteField4(buf []byte) (offset int) {
+	if x.Price == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 4, x.GetPrice())
+	return offset
+}
+
+func (x *Product) fastWriteField5(buf []byte) (offset int) {
+	if x.Stock == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 5, x.GetStock())
+	return offset
+}
+
+func (x *Product) fastWriteField6(buf []byte) (offset int) {
+	if x.ImageUrl == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 6, x.GetImageUrl())
+	return offset
+}
+
+func (x *Product) fastWriteField7(buf []byte) (offset int) {
+	if len(x.Gallery) == 0 {
+		return offset
+	}
+	for i := range x.GetGallery() {
+		offset += fastpb.WriteString(buf[offset:], 7, x.GetGallery()[i])
+	}
+	return offset
+}
+
+func (x *Product) fastWriteField8(buf []byte) (offset int) {
+	if x.Category == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 8, x.GetCategory())
+	return offset
+}
+
+func (x *Product) fastWriteField9(buf []byte) (offset int) {
+	if !x.IsOnSale {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 9, x.GetIsOnSale())
+	return offset
+}
+
+func (x *Product) fastWriteField10(buf []byte) (offset int) {
+	if x.CreateTime == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 10, x.GetCreateTime())
+	return offset
+}
+
+func (x *Product) fastWriteField11(buf []byte) (offset int) {
+	if x.UpdateTime == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 11, x.GetUpdateTime())
+	return offset
+}
+
+func (x *Product) fastWriteField12(buf []byte) (offset int) {
+	if x.Attributes == nil {
+		return offset
+	}
+	for k, v := range x.GetAttributes() {
+		offset += fastpb.WriteMapEntry(buf[offset:], 12,
+			func(buf []byte, numTagOrKey, numIdxOrVal int32) int {
+				offset := 0
+				offset += fastpb.WriteString(buf[offset:], numTagOrKey, k)
+				offset += fastpb.WriteString(buf[offset:], numIdxOrVal, v)
+				return offset
+			})
+	}
+	return offset
+}
+
+func (x *Product) fastWriteField13(buf []byte) (offset int) {
+	if x.Rating == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 13, x.GetRating())
+	return offset
+}
+
+func (x *Product) fastWriteField14(buf []byte) (offset int) {
+	if x.SalesCount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 14, x.GetSalesCount())
+	return offset
+}
+
+func (x *Product) fastWriteField15(buf []byte) (offset int) {
+	if x.Options == nil {
+		return offset
+	}
+	for i := range x.GetOptions() {
+		offset += fastpb.WriteMessage(buf[offset:], 15, x.GetOptions()[i])
+	}
+	return offset
+}
+
+func (x *Product) fastWriteField16(buf []byte) (offset int) {
+	if x.Skus == nil {
+		return offset
+	}
+	for i := range x.GetSkus() {
+		offset += fastpb.WriteMessage(buf[offset:], 16, x.GetSkus()[i])
+	}
+	return offset
+}
+
+func (x *Product) fastWriteField17(buf []byte) (offset int) {
+	if x.CategoryId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 17, x.GetCategoryId())
+	return offset
+}
+
+func (x *Product) fastWriteField18(buf []byte) (offset int) {
+	if x.Version == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 18, x.GetVersion())
+	return offset
+}
+
+func (x *Product) fastWriteField19(buf []byte) (offset int) {
+	if x.ExternalCode == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 19, x.GetExternalCode())
+	return offset
+}
+
+func (x *Category) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	return offset
+}
+
+func (x *Category) fastWriteField1(buf []byte) (offset int) {
+	if x.Id == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetId())
+	return offset
+}
+
+func (x *Category) fastWriteField2(buf []byte) (offset int) {
+	if x.ParentId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetParentId())
+	return offset
+}
+
+func (x *Category) fastWriteField3(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetName())
+	return offset
+}
+
+func (x *Category) fastWriteField4(buf []byte) (offset int) {
+	if x.Slug == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetSlug())
+	return offset
+}
+
+func (x *Category) fastWriteField5(buf []byte) (offset int) {
+	if x.Icon == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetIcon())
+	return offset
+}
+
+func (x *Category) fastWriteField6(buf []byte) (offset int) {
+	if x.SortOrder == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 6, x.GetSortOrder())
+	return offset
+}
+
+func (x *Category) fastWriteField7(buf []byte) (offset int) {
+	if x.Children == nil {
+		return offset
+	}
+	for i := range x.GetChildren() {
+		offset += fastpb.WriteMessage(buf[offset:], 7, x.GetChildren()[i])
+	}
+	return offset
+}
+
+func (x *ProductOption) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ProductOption) fastWriteField1(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetName())
+	return offset
+}
+
+func (x *ProductOption) fastWriteField2(buf []byte) (offset int) {
+	if len(x.Values) == 0 {
+		return offset
+	}
+	for i := range x.GetValues() {
+		offset += fastpb.WriteString(buf[offset:], 2, x.GetValues()[i])
+	}
+	return offset
+}
+
+func (x *Sku) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	return offset
+}
+
+func (x *Sku) fastWriteField1(buf []byte) (offset int) {
+	if x.Id == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetId())
+	return offset
+}
+
+func (x *Sku) fastWriteField2(buf []byte) (offset int) {
+	if x.ProductId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetProductId())
+	return offset
+}
+
+func (x *Sku) fastWriteField3(buf []byte) (offset int) {
+	if x.Attributes == nil {
+		return offset
+	}
+	for k, v := range x.GetAttributes() {
+		offset += fastpb.WriteMapEntry(buf[offset:], 3,
+			func(buf []byte, numTagOrKey, numIdxOrVal int32) int {
+				offset := 0
+				offset += fastpb.WriteString(buf[offset:], numTagOrKey, k)
+				offset += fastpb.WriteString(buf[offset:], numIdxOrVal, v)
+				return offset
+			})
+	}
+	return offset
+}
+
+func (x *Sku) fastWriteField4(buf []byte) (offset int) {
+	if x.Price == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 4, x.GetPrice())
+	return offset
+}
+
+func (x *Sku) fastWriteField5(buf []byte) (offset int) {
+	if x.Stock == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 5, x.GetStock())
+	return offset
+}
+
+func (x *Sku) fastWriteField6(buf []byte) (offset int) {
+	if x.Barcode == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 6, x.GetBarcode())
+	return offset
+}
+
+func (x *Sku) fastWriteField7(buf []byte) (offset int) {
+	if !x.IsOnSale {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 7, x.GetIsOnSale())
+	return offset
+}
+
+func (x *Sku) fastWriteField8(buf []byte) (offset int) {
+	if x.SalesCount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 8, x.GetSalesCount())
+	return offset
+}
+
+func (x *CreateProductReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	offset += x.fastWriteField10(buf[offset:])
+	offset += x.fastWriteField11(buf[offset:])
+	return offset
+}
+
+func (x *CreateProductReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetName())
+	return offset
+}
+
+func (x *CreateProductReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Description == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetDescription())
+	return offset
+}
+
+func (x *CreateProductReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Price == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetPrice())
+	return offset
+}
+
+func (x *CreateProductReq) fastWriteField4(buf []byte) (offset int) {
+	if x.Stock == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 4, x.GetStock())
+	return offset
+}
+
+func (x *CreateProductReq) fastWriteField5(buf []byte) (offset int) {
+	if x.ImageUrl == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetImageUrl())
+	return offset
+}
+
+func (x *CreateProductReq) fastWriteField6(buf []byte) (offset int) {
+	if len(x.Gallery) == 0 {
+		return offset
+	}
+	for i := range x.GetGallery() {
+		offset += fastpb.WriteString(buf[offset:], 6, x.GetGallery()[i])
+	}
+	return offset
+}
+
+func (x *CreateProductReq) fastWriteField7(buf []byte) (offset int) {
+	if x.Category == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 7, x.GetCategory())
+	return offset
+}
+
+func (x *CreateProductReq) fastWriteField8(buf []byte) (offset int) {
+	if !x.IsOnSale {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 8, x.GetIsOnSale())
+	return offset
+}
+
+func (x *CreateProductReq) fastWriteField9(buf []byte) (offset int) {
+	if x.Attributes == nil {
+		return offset
+	}
+	for k, v := range x.GetAttributes() {
+		offset += fastpb.WriteMapEntry(buf[offset:], 9,
+			func(buf []byte, numTagOrKey, numIdxOrVal int32) int {
+				offset := 0
+				offset += fastpb.WriteString(buf[offset:], numTagOrKey, k)
+				offset += fastpb.WriteString(buf[offset:], numIdxOrVal, v)
+				return offset
+			})
+	}
+	return offset
+}
+
+func (x *CreateProductReq) fastWriteField10(buf []byte) (offset int) {
+	if x.CategoryId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 10, x.GetCategoryId())
+	return offset
+}
+
+func (x *CreateProductReq) fastWriteField11(buf []byte) (offset int) {
+	if x.Reason == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 11, x.GetReason())
+	return offset
+}
+
+func (x *CreateProductResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *CreateProductResp) fastWriteField1(buf []byte) (offset int) {
+	if x.ProductId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetProductId())
+	return offset
+}
+
+func (x *UpdateProductReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	offset += x.fastWriteField10(buf[offset:])
+	offset += x.fastWriteField11(buf[offset:])
+	offset += x.fastWriteField12(buf[offset:])
+	offset += x.fastWriteField13(buf[offset:])
+	offset += x.fastWriteField14(buf[offset:])
+	return offset
+}
+
+func (x *UpdateProductReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Id == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetId())
+	return offset
+}
+
+func (x *UpdateProductReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetName())
+	return offset
+}
+
+func (x *UpdateProductReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Description == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetDescription())
+	return offset
+}
+
+func (x *UpdateProductReq) fastWriteField4(buf []byte) (offset int) {
+	if x.Price == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 4, x.GetPrice())
+	return offset
+}
+
+func (x *UpdateProductReq) fastWriteField5(buf []byte) (offset int) {
+	if x.Stock == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 5, x.GetStock())
+	return offset
+}
+
+func (x *UpdateProductReq) fastWriteField6(buf []byte) (offset int) {
+	if x.ImageUrl == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 6, x.GetImageUrl())
+	return offset
+}
+
+func (x *UpdateProductReq) fastWriteField7(buf []byte) (offset int) {
+	if len(x.Gallery) == 0 {
+		return offset
+	}
+	for i := range x.GetGallery() {
+		offset += fastpb.WriteString(buf[offset:], 7, x.GetGallery()[i])
+	}
+	return offset
+}
+
+func (x *UpdateProductReq) fastWriteField8(buf []byte) (offset int) {
+	if x.Category == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 8, x.GetCategory())
+	return offset
+}
+
+func (x *UpdateProductReq) fastWriteField9(buf []byte) (offset int) {
+	if !x.IsOnSale {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 9, x.GetIsOnSale())
+	return offset
+}
+
+func (x *UpdateProductReq) fastWriteField10(buf []byte) (offset int) {
+	if x.Attributes == nil {
+		return offset
+	}
+	for k, v := range x.GetAttributes() {
+		offset += fastpb.WriteMapEntry(buf[offset:], 10,
+			func(buf []byte, numTagOrKey, numIdxOrVal int32) int {
+				offset := 0
+				offset += fastpb.WriteString(buf[offset:], numTagOrKey, k)
+				offset += fastpb.WriteString(buf[offset:], numIdxOrVal, v)
+				return offset
+			})
+	}
+	return offset
+}
+
+func (x *UpdateProductReq) fastWriteField11(buf []byte) (offset int) {
+	if x.CategoryId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 11, x.GetCategoryId())
+	return offset
+}
+
+func (x *UpdateProductReq) fastWriteField12(buf []byte) (offset int) {
+	if len(x.UpdateMask) == 0 {
+		return offset
+	}
+	for i := range x.GetUpdateMask() {
+		offset += fastpb.WriteString(buf[offset:], 12, x.GetUpdateMask()[i])
+	}
+	return offset
+}
+
+func (x *UpdateProductReq) fastWriteField13(buf []byte) (offset int) {
+	if x.Version == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 13, x.GetVersion())
+	return offset
+}
+
+func (x *UpdateProductReq) fastWriteField14(buf []byte) (offset int) {
+	if x.Reason == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 14, x.GetReason())
+	return offset
+}
+
+func (x *UpdateProductResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *UpdateProductResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *UpdateProductResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Version == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetVersion())
+	return offset
+}
+
+func (x *GetProductReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *GetProductReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Id == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetId())
+	return offset
+}
+
+func (x *GetProductResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *GetProductResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Product == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetProduct())
+	return offset
+}
+
+func (x *DeleteProductReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *DeleteProductReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Id == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetId())
+	return offset
+}
+
+func (x *DeleteProductReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Reason == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetReason())
+	return offset
+}
+
+func (x *DeleteProductResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *DeleteProductResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *ListProductsReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	offset += x.fastWriteField10(buf[offset:])
+	offset += x.fastWriteField11(buf[offset:])
+	offset += x.fastWriteField12(buf[offset:])
+	offset += x.fastWriteField13(buf[offset:])
+	return offset
+}
+
+func (x *ListProductsReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Category == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetCategory())
+	return offset
+}
+
+func (x *ListProductsReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Keyword == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetKeyword())
+	return offset
+}
+
+func (x *ListProductsReq) fastWriteField3(buf []byte) (offset int) {
+	if !x.OnSaleOnly {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 3, x.GetOnSaleOnly())
+	return offset
+}
+
+func (x *ListProductsReq) fastWriteField4(buf []byte) (offset int) {
+	if x.Page == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 4, x.GetPage())
+	return offset
+}
+
+func (x *ListProductsReq) fastWriteField5(buf []byte) (offset int) {
+	if x.PageSize == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 5, x.GetPageSize())
+	return offset
+}
+
+func (x *ListProductsReq) fastWriteField6(buf []byte) (offset int) {
+	if x.SortBy == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 6, x.GetSortBy())
+	return offset
+}
+
+func (x *ListProductsReq) fastWriteField7(buf []byte) (offset int) {
+	if !x.Ascending {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 7, x.GetAscending())
+	return offset
+}
+
+func (x *ListProductsReq) fastWriteField8(buf []byte) (offset int) {
+	if x.MinPrice == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 8, x.GetMinPrice())
+	return offset
+}
+
+func (x *ListProductsReq) fastWriteField9(buf []byte) (offset int) {
+	if x.MaxPrice == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 9, x.GetMaxPrice())
+	return offset
+}
+
+func (x *ListProductsReq) fastWriteField10(buf []byte) (offset int) {
+	if x.CategoryId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 10, x.GetCategoryId())
+	return offset
+}
+
+func (x *ListProductsReq) fastWriteField11(buf []byte) (offset int) {
+	if !x.UseCursor {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 11, x.GetUseCursor())
+	return offset
+}
+
+func (x *ListProductsReq) fastWriteField12(buf []byte) (offset int) {
+	if x.Cursor == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 12, x.GetCursor())
+	return offset
+}
+
+func (x *ListProductsReq) fastWriteField13(buf []byte) (offset int) {
+	if !x.SkipTotal {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 13, x.GetSkipTotal())
+	return offset
+}
+
+func (x *ListProductsResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	return offset
+}
+
+func (x *ListProductsResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Products == nil {
+		return offset
+	}
+	for i := range x.GetProducts() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetProducts()[i])
+	}
+	return offset
+}
+
+func (x *ListProductsResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Total == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 2, x.GetTotal())
+	return offset
+}
+
+func (x *ListProductsResp) fastWriteField3(buf []byte) (offset int) {
+	if x.Page == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 3, x.GetPage())
+	return offset
+}
+
+func (x *ListProductsResp) fastWriteField4(buf []byte) (offset int) {
+	if x.PageSize == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 4, x.GetPageSize())
+	return offset
+}
+
+func (x *ListProductsResp) fastWriteField5(buf []byte) (offset int) {
+	if x.TotalPages == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 5, x.GetTotalPages())
+	return offset
+}
+
+func (x *ListProductsResp) fastWriteField6(buf []byte) (offset int) {
+	if !x.HasMore {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 6, x.GetHasMore())
+	return offset
+}
+
+func (x *ListProductsResp) fastWriteField7(buf []byte) (offset int) {
+	if x.NextCursor == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 7, x.GetNextCursor())
+	return offset
+}
+
+func (x *SearchProductsReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	return offset
+}
+
+func (x *SearchProductsReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Keyword == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetKeyword())
+	return offset
+}
+
+func (x *SearchProductsReq) fastWriteField2(buf []byte) (offset int) {
+	if x.CategoryId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetCategoryId())
+	return offset
+}
+
+func (x *SearchProductsReq) fastWriteField3(buf []byte) (offset int) {
+	if x.MinPrice == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetMinPrice())
+	return offset
+}
+
+func (x *SearchProductsReq) fastWriteField4(buf []byte) (offset int) {
+	if x.MaxPrice == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 4, x.GetMaxPrice())
+	return offset
+}
+
+func (x *SearchProductsReq) fastWriteField5(buf []byte) (offset int) {
+	if !x.OnSaleOnly {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 5, x.GetOnSaleOnly())
+	return offset
+}
+
+func (x *SearchProductsReq) fastWriteField6(buf []byte) (offset int) {
+	if x.Attributes == nil {
+		return offset
+	}
+	for k, v := range x.GetAttributes() {
+		offset += fastpb.WriteMapEntry(buf[offset:], 6,
+			func(buf []byte, numTagOrKey, numIdxOrVal int32) int {
+				offset := 0
+				offset += fastpb.WriteString(buf[offset:], numTagOrKey, k)
+				offset += fastpb.WriteString(buf[offset:], numIdxOrVal, v)
+				return offset
+			})
+	}
+	return offset
+}
+
+func (x *SearchProductsReq) fastWriteField7(buf []byte) (offset int) {
+	if x.Page == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 7, x.GetPage())
+	return offset
+}
+
+func (x *SearchProductsReq) fastWriteField8(buf []byte) (offset int) {
+	if x.PageSize == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 8, x.GetPageSize())
+	return offset
+}
+
+func (x *SearchHit) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *SearchHit) fastWriteField1(buf []byte) (offset int) {
+	if x.Product == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetProduct())
+	return offset
+}
+
+func (x *SearchHit) fastWriteField2(buf []byte) (offset int) {
+	if x.Score == 0 {
+		return offset
+	}
+	offset += fastpb.WriteDouble(buf[offset:], 2, x.GetScore())
+	return offset
+}
+
+func (x *CategoryFacet) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *CategoryFacet) fastWriteField1(buf []byte) (offset int) {
+	if x.CategoryId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetCategoryId())
+	return offset
+}
+
+func (x *CategoryFacet) fastWriteField2(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetName())
+	return offset
+}
+
+func (x *CategoryFacet) fastWriteField3(buf []byte) (offset int) {
+	if x.Count == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetCount())
+	return offset
+}
+
+func (x *PriceFacet) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *PriceFacet) fastWriteField1(buf []byte) (offset int) {
+	if x.Min == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetMin())
+	return offset
+}
+
+func (x *PriceFacet) fastWriteField2(buf []byte) (offset int) {
+	if x.Max == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetMax())
+	return offset
+}
+
+func (x *PriceFacet) fastWriteField3(buf []byte) (offset int) {
+	if x.Count == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetCount())
+	return offset
+}
+
+func (x *AttributeValueFacet) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *AttributeValueFacet) fastWriteField1(buf []byte) (offset int) {
+	if x.Value == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetValue())
+	return offset
+}
+
+func (x *AttributeValueFacet) fastWriteField2(buf []byte) (offset int) {
+	if x.Count == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetCount())
+	return offset
+}
+
+func (x *AttributeFacet) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *AttributeFacet) fastWriteField1(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetName())
+	return offset
+}
+
+func (x *AttributeFacet) fastWriteField2(buf []byte) (offset int) {
+	if x.Values == nil {
+		return offset
+	}
+	for i := range x.GetValues() {
+		offset += fastpb.WriteMessage(buf[offset:], 2, x.GetValues()[i])
+	}
+	return offset
+}
+
+func (x *SearchProductsResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	return offset
+}
+
+func (x *SearchProductsResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Hits == nil {
+		return offset
+	}
+	for i := range x.GetHits() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetHits()[i])
+	}
+	return offset
+}
+
+func (x *SearchProductsResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Total == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 2, x.GetTotal())
+	return offset
+}
+
+func (x *SearchProductsResp) fastWriteField3(buf []byte) (offset int) {
+	if x.Page == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 3, x.GetPage())
+	return offset
+}
+
+func (x *SearchProductsResp) fastWriteField4(buf []byte) (offset int) {
+	if x.PageSize == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 4, x.GetPageSize())
+	return offset
+}
+
+func (x *SearchProductsResp) fastWriteField5(buf []byte) (offset int) {
+	if x.CategoryFacets == nil {
+		return offset
+	}
+	for i := range x.GetCategoryFacets() {
+		offset += fastpb.WriteMessage(buf[offset:], 5, x.GetCategoryFacets()[i])
+	}
+	return offset
+}
+
+func (x *SearchProductsResp) fastWriteField6(buf []byte) (offset int) {
+	if x.PriceFacets == nil {
+		return offset
+	}
+	for i := range x.GetPriceFacets() {
+		offset += fastpb.WriteMessage(buf[offset:], 6, x.GetPriceFacets()[i])
+	}
+	return offset
+}
+
+func (x *SearchProductsResp) fastWriteField7(buf []byte) (offset int) {
+	if x.AttributeFacets == nil {
+		return offset
+	}
+	for i := range x.GetAttributeFacets() {
+		offset += fastpb.WriteMessage(buf[offset:], 7, x.GetAttributeFacets()[i])
+	}
+	return offset
+}
+
+func (x *GetCategoriesReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	return offset
+}
+
+func (x *GetCategoriesResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *GetCategoriesResp) fastWriteField1(buf []byte) (offset int) {
+	if len(x.Categories) == 0 {
+		return offset
+	}
+	for i := range x.GetCategories() {
+		offset += fastpb.WriteString(buf[offset:], 1, x.GetCategories()[i])
+	}
+	return offset
+}
+
+func (x *GetCategoriesResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Tree == nil {
+		return offset
+	}
+	for i := range x.GetTree() {
+		offset += fastpb.WriteMessage(buf[offset:], 2, x.GetTree()[i])
+	}
+	return offset
+}
+
+func (x *CreateCategoryReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *CreateCategoryReq) fastWriteField1(buf []byte) (offset int) {
+	if x.ParentId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetParentId())
+	return offset
+}
+
+func (x *CreateCategoryReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetName())
+	return offset
+}
+
+func (x *CreateCategoryReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Slug == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetSlug())
+	return offset
+}
+
+func (x *CreateCategoryReq) fastWriteField4(buf []byte) (offset int) {
+	if x.Icon == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetIcon())
+	return offset
+}
+
+func (x *CreateCategoryReq) fastWriteField5(buf []byte) (offset int) {
+	if x.SortOrder == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 5, x.GetSortOrder())
+	return offset
+}
+
+func (x *CreateCategoryResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *CreateCategoryResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Category == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetCategory())
+	return offset
+}
+
+func (x *UpdateCategoryReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	return offset
+}
+
+func (x *UpdateCategoryReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Id == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetId())
+	return offset
+}
+
+func (x *UpdateCategoryReq) fastWriteField2(buf []byte) (offset int) {
+	if x.ParentId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetParentId())
+	return offset
+}
+
+func (x *UpdateCategoryReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetName())
+	return offset
+}
+
+func (x *UpdateCategoryReq) fastWriteField4(buf []byte) (offset int) {
+	if x.Slug == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetSlug())
+	return offset
+}
+
+func (x *UpdateCategoryReq) fastWriteField5(buf []byte) (offset int) {
+	if x.Icon == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetIcon())
+	return offset
+}
+
+func (x *UpdateCategoryReq) fastWriteField6(buf []byte) (offset int) {
+	if x.SortOrder == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 6, x.GetSortOrder())
+	return offset
+}
+
+func (x *UpdateCategoryResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *UpdateCategoryResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Category == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetCategory())
+	return offset
+}
+
+func (x *DeleteCategoryReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *DeleteCategoryReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Id == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetId())
+	return offset
+}
+
+func (x *DeleteCategoryResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *DeleteCategoryResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *SetProductOptionsReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *SetProductOptionsReq) fastWriteField1(buf []byte) (offset int) {
+	if x.ProductId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetProductId())
+	return offset
+}
+
+func (x *SetProductOptionsReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Options == nil {
+		return offset
+	}
+	for i := range x.GetOptions() {
+		offset += fastpb.WriteMessage(buf[offset:], 2, x.GetOptions()[i])
+	}
+	return offset
+}
+
+func (x *SetProductOptionsResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *SetProductOptionsResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *SaveSkusReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *SaveSkusReq) fastWriteField1(buf []byte) (offset int) {
+	if x.ProductId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetProductId())
+	return offset
+}
+
+func (x *SaveSkusReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Skus == nil {
+		return offset
+	}
+	for i := range x.GetSkus() {
+		offset += fastpb.WriteMessage(buf[offset:], 2, x.GetSkus()[i])
+	}
+	return offset
+}
+
+func (x *SaveSkusResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *SaveSkusResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Skus == nil {
+		return offset
+	}
+	for i := range x.GetSkus() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetSkus()[i])
+	}
+	return offset
+}
+
+func (x *DeleteSkuReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *DeleteSkuReq) fastWriteField1(buf []byte) (offset int) {
+	if x.ProductId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetProductId())
+	return offset
+}
+
+func (x *DeleteSkuReq) fastWriteField2(buf []byte) (offset int) {
+	if x.SkuId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetSkuId())
+	return offset
+}
+
+func (x *DeleteSkuResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *DeleteSkuResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *FieldChange) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *FieldChange) fastWriteField1(buf []byte) (offset int) {
+	if x.Field == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetField())
+	return offset
+}
+
+func (x *FieldChange) fastWriteField2(buf []byte) (offset int) {
+	if x.Before == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetBefore())
+	return offset
+}
+
+func (x *FieldChange) fastWriteField3(buf []byte) (offset int) {
+	if x.After == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetAfter())
+	return offset
+}
+
+func (x *ProductRevision) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	return offset
+}
+
+func (x *ProductRevision) fastWriteField1(buf []byte) (offset int) {
+	if x.Id == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetId())
+	return offset
+}
+
+func (x *ProductRevision) fastWriteField2(buf []byte) (offset int) {
+	if x.ProductId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetProductId())
+	return offset
+}
+
+func (x *ProductRevision) fastWriteField3(buf []byte) (offset int) {
+	if x.Action == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetAction())
+	return offset
+}
+
+func (x *ProductRevision) fastWriteField4(buf []byte) (offset int) {
+	if x.OperatorId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 4, x.GetOperatorId())
+	return offset
+}
+
+func (x *ProductRevision) fastWriteField5(buf []byte) (offset int) {
+	if x.Reason == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetReason())
+	return offset
+}
+
+func (x *ProductRevision) fastWriteField6(buf []byte) (offset int) {
+	if x.SkuId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 6, x.GetSkuId())
+	return offset
+}
+
+func (x *ProductRevision) fastWriteField7(buf []byte) (offset int) {
+	if x.Changes == nil {
+		return offset
+	}
+	for i := range x.GetChanges() {
+		offset += fastpb.WriteMessage(buf[offset:], 7, x.GetChanges()[i])
+	}
+	return offset
+}
+
+func (x *ProductRevision) fastWriteField8(buf []byte) (offset int) {
+	if x.CreateTime == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 8, x.GetCreateTime())
+	return offset
+}
+
+func (x *ListProductRevisionsReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *ListProductRevisionsReq) fastWriteField1(buf []byte) (offset int) {
+	if x.ProductId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetProductId())
+	return offset
+}
+
+func (x *ListProductRevisionsReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Page == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 2, x.GetPage())
+	return offset
+}
+
+func (x *ListProductRevisionsReq) fastWriteField3(buf []byte) (offset int) {
+	if x.PageSize == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 3, x.GetPageSize())
+	return offset
+}
+
+func (x *ListProductRevisionsResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ListProductRevisionsResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Revisions == nil {
+		return offset
+	}
+	for i := range x.GetRevisions() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetRevisions()[i])
+	}
+	return offset
+}
+
+func (x *ListProductRevisionsResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Total == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 2, x.GetTotal())
+	return offset
+}
+
+func (x *RestoreProductReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *RestoreProductReq) fastWriteField1(buf []byte) (offset int) {
+	if x.ProductId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetProductId())
+	return offset
+}
+
+func (x *RestoreProductReq) fastWriteField2(buf []byte) (offset int) {
+	if x.RevisionId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetRevisionId())
+	return offset
+}
+
+func (x *RestoreProductReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Reason == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetReason())
+	return offset
+}
+
+func (x *RestoreProductResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *RestoreProductResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *RestoreProductResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Version == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetVersion())
+	return offset
+}
+
+func (x *ProductRecord) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	offset += x.fastWriteField10(buf[offset:])
+	offset += x.fastWriteField11(buf[offset:])
+	return offset
+}
+
+func (x *ProductRecord) fastWriteField1(buf []byte) (offset int) {
+	if x.ExternalCode == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetExternalCode())
+	return offset
+}
+
+func (x *ProductRecord) fastWriteField2(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetName())
+	return offset
+}
+
+func (x *ProductRecord) fastWriteField3(buf []byte) (offset int) {
+	if x.Description == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetDescription())
+	return offset
+}
+
+func (x *ProductRecord) fastWriteField4(buf []byte) (offset int) {
+	if x.Price == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 4, x.GetPrice())
+	return offset
+}
+
+func (x *ProductRecord) fastWriteField5(buf []byte) (offset int) {
+	if x.Stock == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 5, x.GetStock())
+	return offset
+}
+
+func (x *ProductRecord) fastWriteField6(buf []byte) (offset int) {
+	if x.ImageUrl == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 6, x.GetImageUrl())
+	return offset
+}
+
+func (x *ProductRecord) fastWriteField7(buf []byte) (offset int) {
+	if len(x.Gallery) == 0 {
+		return offset
+	}
+	for i := range x.GetGallery() {
+		offset += fastpb.WriteString(buf[offset:], 7, x.GetGallery()[i])
+	}
+	return offset
+}
+
+func (x *ProductRecord) fastWriteField8(buf []byte) (offset int) {
+	if x.Category == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 8, x.GetCategory())
+	return offset
+}
+
+func (x *ProductRecord) fastWriteField9(buf []byte) (offset int) {
+	if !x.IsOnSale {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 9, x.GetIsOnSale())
+	return offset
+}
+
+func (x *ProductRecord) fastWriteField10(buf []byte) (offset int) {
+	if x.Attributes == nil {
+		return offset
+	}
+	for k, v := range x.GetAttributes() {
+		offset += fastpb.WriteMapEntry(buf[offset:], 10,
+			func(buf []byte, numTagOrKey, numIdxOrVal int32) int {
+				offset := 0
+				offset += fastpb.WriteString(buf[offset:], numTagOrKey, k)
+				offset += fastpb.WriteString(buf[offset:], numIdxOrVal, v)
+				return offset
+			})
+	}
+	return offset
+}
+
+func (x *ProductRecord) fastWriteField11(buf []byte) (offset int) {
+	if x.ProductId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 11, x.GetProductId())
+	return offset
+}
+
+func (x *BulkImportProductsReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *BulkImportProductsReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Mode == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetMode())
+	return offset
+}
+
+func (x *BulkImportProductsReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Reason == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetReason())
+	return offset
+}
+
+func (x *BulkImportProductsReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Records == nil {
+		return offset
+	}
+	for i := range x.GetRecords() {
+		offset += fastpb.WriteMessage(buf[offset:], 3, x.GetRecords()[i])
+	}
+	return offset
+}
+
+func (x *ImportRowError) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *ImportRowError) fastWriteField1(buf []byte) (offset int) {
+	if x.Row == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.GetRow())
+	return offset
+}
+
+func (x *ImportRowError) fastWriteField2(buf []byte) (offset int) {
+	if x.ExternalCode == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetExternalCode())
+	return offset
+}
+
+func (x *ImportRowError) fastWriteField3(buf []byte) (offset int) {
+	if x.Message == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetMessage())
+	return offset
+}
+
+func (x *BulkImportProductsResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *BulkImportProductsResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Total == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.GetTotal())
+	return offset
+}
+
+func (x *BulkImportProductsResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Created == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 2, x.GetCreated())
+	return offset
+}
+
+func (x *BulkImportProductsResp) fastWriteField3(buf []byte) (offset int) {
+	if x.Updated == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 3, x.GetUpdated())
+	return offset
+}
+
+func (x *BulkImportProductsResp) fastWriteField4(buf []byte) (offset int) {
+	if x.Errors == nil {
+		return offset
+	}
+	for i := range x.GetErrors() {
+		offset += fastpb.WriteMessage(buf[offset:], 4, x.GetErrors()[i])
+	}
+	return offset
+}
+
+func (x *BulkImportProductsResp) fastWriteField5(buf []byte) (offset int) {
+	if !x.Committed {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 5, x.GetCommitted())
+	return offset
+}
+
+func (x *ExportProductsReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ExportProductsReq) fastWriteField1(buf []byte) (offset int) {
+	if x.CategoryId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetCategoryId())
+	return offset
+}
+
+func (x *ExportProductsReq) fastWriteField2(buf []byte) (offset int) {
+	if x.BatchSize == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 2, x.GetBatchSize())
+	return offset
+}
+
+func (x *ExportProductsResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ExportProductsResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Records == nil {
+		return offset
+	}
+	for i := range x.GetRecords() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetRecords()[i])
+	}
+	return offset
+}
+
+func (x *UpdateStockReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *UpdateStockReq) fastWriteField1(buf []byte) (offset int) {
+	if x.ProductId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetProductId())
+	return offset
+}
+
+func (x *UpdateStockReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Quantity == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 2, x.GetQuantity())
+	return offset
+}
+
+func (x *UpdateStockReq) fastWriteField3(buf []byte) (offset int) {
+	if x.SkuId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetSkuId())
+	return offset
+}
+
+func (x *UpdateStockReq) fastWriteField4(buf []byte) (offset int) {
+	if x.Reason == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetReason())
+	return offset
+}
+
+func (x *UpdateStockResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *UpdateStockResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *UpdateStockResp) fastWriteField2(buf []byte) (offset int) {
+	if x.CurrentStock == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 2, x.GetCurrentStock())
+	return offset
+}
+
+func (x *StockItem) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *StockItem) fastWriteField1(buf []byte) (offset int) {
+	if x.ProductId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetProductId())
+	return offset
+}
+
+func (x *StockItem) fastWriteField2(buf []byte) (offset int) {
+	if x.Quantity == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 2, x.GetQuantity())
+	return offset
+}
+
+func (x *StockItem) fastWriteField3(buf []byte) (offset int) {
+	if x.SkuId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetSkuId())
+	return offset
+}
+
+func (x *StockItem) fastWriteField4(buf []byte) (offset int) {
+	if x.Attributes == nil {
+		return offset
+	}
+	for k, v := range x.GetAttributes() {
+		offset += fastpb.WriteMapEntry(buf[offset:], 4,
+			func(buf []byte, numTagOrKey, numIdxOrVal int32) int {
+				offset := 0
+				offset += fastpb.WriteString(buf[offset:], numTagOrKey, k)
+				offset += fastpb.WriteString(buf[offset:], numIdxOrVal, v)
+				return offset
+			})
+	}
+	return offset
+}
+
+func (x *ReserveStockReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *ReserveStockReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *ReserveStockReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Items == nil {
+		return offset
+	}
+	for i := range x.GetItems() {
+		offset += fastpb.WriteMessage(buf[offset:], 2, x.GetItems()[i])
+	}
+	return offset
+}
+
+func (x *ReserveStockReq) fastWriteField3(buf []byte) (offset int) {
+	if x.TtlSeconds == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetTtlSeconds())
+	return offset
+}
+
+func (x *ReserveStockResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *ReserveStockResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *ReserveStockResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Status == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 2, int32(x.GetStatus()))
+	return offset
+}
+
+func (x *ReserveStockResp) fastWriteField3(buf []byte) (offset int) {
+	if x.ExpireTime == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetExpireTime())
+	return offset
+}
+
+func (x *ConfirmReservationReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ConfirmReservationReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *ConfirmReservationResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ConfirmReservationResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *ReleaseReservationReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ReleaseReservationReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *ReleaseReservationResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ReleaseReservationResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *Product) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
+	n += x.sizeField10()
+	n += x.sizeField11()
+	n += x.sizeField12()
+	n += x.sizeField13()
+	n += x.sizeField14()
+	n += x.sizeField15()
+	n += x.sizeField16()
+	n += x.sizeField17()
+	n += x.sizeField18()
+	n += x.sizeField19()
+	return n
+}
+
+func (x *Product) sizeField1() (n int) {
+	if x.Id == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetId())
+	return n
+}
+
+func (x *Product) sizeField2() (n int) {
+	if x.Name == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetName())
+	return n
+}
+
+func (x *Product) sizeField3() (n int) {
+	if x.Description == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetDescription())
+	return n
+}
+
+func (x *Product) sizeField4() (n int) {
+	if x.Price == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(4, x.GetPrice())
+	return n
+}
+
+func (x *Product) sizeField5() (n int) {
+	if x.Stock == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(5, x.GetStock())
+	return n
+}
+
+func (x *Product) sizeField6() (n int) {
+	if x.ImageUrl == "" {
+		return n
+	}
+	n += fastpb.SizeString(6, x.GetImageUrl())
+	return n
+}
+
+func (x *Product) sizeField7() (n int) {
+	if len(x.Gallery) == 0 {
+		return n
+	}
+	for i := range x.GetGallery() {
+		n += fastpb.SizeString(7, x.GetGallery()[i])
+	}
+	return n
+}
+
+func (x *Product) sizeField8() (n int) {
+	if x.Category == "" {
+		return n
+	}
+	n += fastpb.SizeString(8, x.GetCategory())
+	return n
+}
+
+func (x *Product) sizeField9() (n int) {
+	if !x.IsOnSale {
+		return n
+	}
+	n += fastpb.SizeBool(9, x.GetIsOnSale())
+	return n
+}
+
+func (x *Product) sizeField10() (n int) {
+	if x.CreateTime == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(10, x.GetCreateTime())
+	return n
+}
+
+func (x *Product) sizeField11() (n int) {
+	if x.UpdateTime == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(11, x.GetUpdateTime())
+	return n
+}
+
+func (x *Product) sizeField12() (n int) {
+	if x.Attributes == nil {
+		return n
+	}
+	for k, v := range x.GetAttributes() {
+		n += fastpb.SizeMapEntry(12,
+			func(numTagOrKey, numIdxOrVal int32) int {
+				n := 0
+				n += fastpb.SizeString(numTagOrKey, k)
+				n += fastpb.SizeString(numIdxOrVal, v)
+				return n
+			})
+	}
+	return n
+}
+
+func (x *Product) sizeField13() (n int) {
+	if x.Rating == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(13, x.GetRating())
+	return n
+}
+
+func (x *Product) sizeField14() (n int) {
+	if x.SalesCount == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(14, x.GetSalesCount())
+	return n
+}
+
+func (x *Product) sizeField15() (n int) {
+	if x.Options == nil {
+		return n
+	}
+	for i := range x.GetOptions() {
+		n += fastpb.SizeMessage(15, x.GetOptions()[i])
+	}
+	return n
+}
+
+func (x *Product) sizeField16() (n int) {
+	if x.Skus == nil {
+		return n
+	}
+	for i := range x.GetSkus() {
+		n += fastpb.SizeMessage(16, x.GetSkus()[i])
+	}
+	return n
+}
+
+func (x *Product) sizeField17() (n int) {
+	if x.CategoryId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(17, x.GetCategoryId())
+	return n
+}
+
+func (x *Product) sizeField18() (n int) {
+	if x.Version == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(18, x.GetVersion())
+	return n
+}
+
+func (x *Product) sizeField19() (n int) {
+	if x.ExternalCode == "" {
+		return n
+	}
+	n += fastpb.SizeString(19, x.GetExternalCode())
+	return n
+}
+
+func (x *Category) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	return n
+}
+
+func (x *Category) sizeField1() (n int) {
+	if x.Id == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetId())
+	return n
+}
+
+func (x *Category) sizeField2() (n int) {
+	if x.ParentId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetParentId())
+	return n
+}
+
+func (x *Category) sizeField3() (n int) {
+	if x.Name == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetName())
+	return n
+}
+
+func (x *Category) sizeField4() (n int) {
+	if x.Slug == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetSlug())
+	return n
+}
+
+func (x *Category) sizeField5() (n int) {
+	if x.Icon == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.GetIcon())
+	return n
+}
+
+func (x *Category) sizeField6() (n int) {
+	if x.SortOrder == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(6, x.GetSortOrder())
+	return n
+}
+
+func (x *Category) sizeField7() (n int) {
+	if x.Children == nil {
+		return n
+	}
+	for i := range x.GetChildren() {
+		n += fastpb.SizeMessage(7, x.GetChildren()[i])
+	}
+	return n
+}
+
+func (x *ProductOption) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *ProductOption) sizeField1() (n int) {
+	if x.Name == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetName())
+	return n
+}
+
+func (x *ProductOption) sizeField2() (n int) {
+	if len(x.Values) == 0 {
+		return n
+	}
+	for i := range x.GetValues() {
+		n += fastpb.SizeString(2, x.GetValues()[i])
+	}
+	return n
+}
+
+func (x *Sku) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	return n
+}
+
+func (x *Sku) sizeField1() (n int) {
+	if x.Id == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetId())
+	return n
+}
+
+func (x *Sku) sizeField2() (n int) {
+	if x.ProductId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetProductId())
+	return n
+}
+
+func (x *Sku) sizeField3() (n int) {
+	if x.Attributes == nil {
+		return n
+	}
+	for k, v := range x.GetAttributes() {
+		n += fastpb.SizeMapEntry(3,
+			func(numTagOrKey, numIdxOrVal int32) int {
+				n := 0
+				n += fastpb.SizeString(numTagOrKey, k)
+				n += fastpb.SizeString(numIdxOrVal, v)
+				return n
+			})
+	}
+	return n
+}
+
+func (x *Sku) sizeField4() (n int) {
+	if x.Price == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(4, x.GetPrice())
+	return n
+}
+
+func (x *Sku) sizeField5() (n int) {
+	if x.Stock == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(5, x.GetStock())
+	return n
+}
+
+func (x *Sku) sizeField6() (n int) {
+	if x.Barcode == "" {
+		return n
+	}
+	n += fastpb.SizeString(6, x.GetBarcode())
+	return n
+}
+
+func (x *Sku) sizeField7() (n int) {
+	if !x.IsOnSale {
+		return n
+	}
+	n += fastpb.SizeBool(7, x.GetIsOnSale())
+	return n
+}
+
+func (x *Sku) sizeField8() (n int) {
+	if x.SalesCount == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(8, x.GetSalesCount())
+	return n
+}
+
+func (x *CreateProductReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
+	n += x.sizeField10()
+	n += x.sizeField11()
+	return n
+}
+
+func (x *CreateProductReq) sizeField1() (n int) {
+	if x.Name == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetName())
+	return n
+}
+
+func (x *CreateProductReq) sizeField2() (n int) {
+	if x.Description == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetDescription())
+	return n
+}
+
+func (x *CreateProductReq) sizeField3() (n int) {
+	if x.Price == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.GetPrice())
+	return n
+}
+
+func (x *CreateProductReq) sizeField4() (n int) {
+	if x.Stock == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(4, x.GetStock())
+	return n
+}
+
+func (x *CreateProductReq) sizeField5() (n int) {
+	if x.ImageUrl == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.GetImageUrl())
+	return n
+}
+
+func (x *CreateProductReq) sizeField6() (n int) {
+	if len(x.Gallery) == 0 {
+		return n
+	}
+	for i := range x.GetGallery() {
+		n += fastpb.SizeString(6, x.GetGallery()[i])
+	}
+	return n
+}
+
+func (x *CreateProductReq) sizeField7() (n int) {
+	if x.Category == "" {
+		return n
+	}
+	n += fastpb.SizeString(7, x.GetCategory())
+	return n
+}
+
+func (x *CreateProductReq) sizeField8() (n int) {
+	if !x.IsOnSale {
+		return n
+	}
+	n += fastpb.SizeBool(8, x.GetIsOnSale())
+	return n
+}
+
+func (x *CreateProductReq) sizeField9() (n int) {
+	if x.Attributes == nil {
+		return n
+	}
+	for k, v := range x.GetAttributes() {
+		n += fastpb.SizeMapEntry(9,
+			func(numTagOrKey, numIdxOrVal int32) int {
+				n := 0
+				n += fastpb.SizeString(numTagOrKey, k)
+				n += fastpb.SizeString(numIdxOrVal, v)
+				return n
+			})
+	}
+	return n
+}
+
+func (x *CreateProductReq) sizeField10() (n int) {
+	if x.CategoryId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(10, x.GetCategoryId())
+	return n
+}
+
+func (x *CreateProductReq) sizeField11() (n int) {
+	if x.Reason == "" {
+		return n
+	}
+	n += fastpb.SizeString(11, x.GetReason())
+	return n
+}
+
+func (x *CreateProductResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *CreateProductResp) sizeField1() (n int) {
+	if x.ProductId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetProductId())
+	return n
+}
+
+func (x *UpdateProductReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
+	n += x.sizeField10()
+	n += x.sizeField11()
+	n += x.sizeField12()
+	n += x.sizeField13()
+	n += x.sizeField14()
+	return n
+}
+
+func (x *UpdateProductReq) sizeField1() (n int) {
+	if x.Id == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetId())
+	return n
+}
+
+func (x *UpdateProductReq) sizeField2() (n int) {
+	if x.Name == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetName())
+	return n
+}
+
+func (x *UpdateProductReq) sizeField3() (n int) {
+	if x.Description == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetDescription())
+	return n
+}
+
+func (x *UpdateProductReq) sizeField4() (n int) {
+	if x.Price == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(4, x.GetPrice())
+	return n
+}
+
+func (x *UpdateProductReq) sizeField5() (n int) {
+	if x.Stock == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(5, x.GetStock())
+	return n
+}
+
+func (x *UpdateProductReq) sizeField6() (n int) {
+	if x.ImageUrl == "" {
+		return n
+	}
+	n += fastpb.SizeString(6, x.GetImageUrl())
+	return n
+}
+
+func (x *UpdateProductReq) sizeField7() (n int) {
+	if len(x.Gallery) == 0 {
+		return n
+	}
+	for i := range x.GetGallery() {
+		n += fastpb.SizeString(7, x.GetGallery()[i])
+	}
+	return n
+}
+
+func (x *UpdateProductReq) sizeField8() (n int) {
+	if x.Category == "" {
+		return n
+	}
+	n += fastpb.SizeString(8, x.GetCategory())
+	return n
+}
+
+func (x *UpdateProductReq) sizeField9() (n int) {
+	if !x.IsOnSale {
+		return n
+	}
+	n += fastpb.SizeBool(9, x.GetIsOnSale())
+	return n
+}
+
+func (x *UpdateProductReq) sizeField10() (n int) {
+	if x.Attributes == nil {
+		return n
+	}
+	for k, v := range x.GetAttributes() {
+		n += fastpb.SizeMapEntry(10,
+			func(numTagOrKey, numIdxOrVal int32) int {
+				n := 0
+				n += fastpb.SizeString(numTagOrKey, k)
+				n += fastpb.SizeString(numIdxOrVal, v)
+				return n
+			})
+	}
+	return n
+}
+
+func (x *UpdateProductReq) sizeField11() (n int) {
+	if x.CategoryId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(11, x.GetCategoryId())
+	return n
+}
+
+func (x *UpdateProductReq) sizeField12() (n int) {
+	if len(x.UpdateMask) == 0 {
+		return n
+	}
+	for i := range x.GetUpdateMask() {
+		n += fastpb.SizeString(12, x.GetUpdateMask()[i])
+	}
+	return n
+}
+
+func (x *UpdateProductReq) sizeField13() (n int) {
+	if x.Version == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(13, x.GetVersion())
+	return n
+}
+
+func (x *UpdateProductReq) sizeField14() (n int) {
+	if x.Reason == "" {
+		return n
+	}
+	n += fastpb.SizeString(14, x.GetReason())
+	return n
+}
+
+func (x *UpdateProductResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *UpdateProductResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
+func (x *UpdateProductResp) sizeField2() (n int) {
+	if x.Version == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetVersion())
+	return n
+}
+
+func (x *GetProductReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *GetProductReq) sizeField1() (n int) {
+	if x.Id == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetId())
+	return n
+}
+
+func (x *GetProductResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *GetProductResp) sizeField1() (n int) {
+	if x.Product == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetProduct())
+	return n
+}
+
+func (x *DeleteProductReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *DeleteProductReq) sizeField1() (n int) {
+	if x.Id == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetId())
+	return n
+}
+
+func (x *DeleteProductReq) sizeField2() (n int) {
+	if x.Reason == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetReason())
+	return n
+}
+
+func (x *DeleteProductResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *DeleteProductResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
+func (x *ListProductsReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
+	n += x.sizeField10()
+	n += x.sizeField11()
+	n += x.sizeField12()
+	n += x.sizeField13()
+	return n
+}
+
+func (x *ListProductsReq) sizeField1() (n int) {
+	if x.Category == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetCategory())
+	return n
+}
+
+func (x *ListProductsReq) sizeField2() (n int) {
+	if x.Keyword == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetKeyword())
+	return n
+}
+
+func (x *ListProductsReq) sizeField3() (n int) {
+	if !x.OnSaleOnly {
+		return n
+	}
+	n += fastpb.SizeBool(3, x.GetOnSaleOnly())
+	return n
+}
+
+func (x *ListProductsReq) sizeField4() (n int) {
+	if x.Page == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(4, x.GetPage())
+	return n
+}
+
+func (x *ListProductsReq) sizeField5() (n int) {
+	if x.PageSize == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(5, x.GetPageSize())
+	return n
+}
+
+func (x *ListProductsReq) sizeField6() (n int) {
+	if x.SortBy == "" {
+		return n
+	}
+	n += fastpb.SizeString(6, x.GetSortBy())
+	return n
+}
+
+func (x *ListProductsReq) sizeField7() (n int) {
+	if !x.Ascending {
+		return n
+	}
+	n += fastpb.SizeBool(7, x.GetAscending())
+	return n
+}
+
+func (x *ListProductsReq) sizeField8() (n int) {
+	if x.MinPrice == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(8, x.GetMinPrice())
+	return n
+}
+
+func (x *ListProductsReq) sizeField9() (n int) {
+	if x.MaxPrice == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(9, x.GetMaxPrice())
+	return n
+}
+
+func (x *ListProductsReq) sizeField10() (n int) {
+	if x.CategoryId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(10, x.GetCategoryId())
+	return n
+}
+
+func (x *ListProductsReq) sizeField11() (n int) {
+	if !x.UseCursor {
+		return n
+	}
+	n += fastpb.SizeBool(11, x.GetUseCursor())
+	return n
+}
+
+func (x *ListProductsReq) sizeField12() (n int) {
+	if x.Cursor == "" {
+		return n
+	}
+	n += fastpb.SizeString(12, x.GetCursor())
+	return n
+}
+
+func (x *ListProductsReq) sizeField13() (n int) {
+	if !x.SkipTotal {
+		return n
+	}
+	n += fastpb.SizeBool(13, x.GetSkipTotal())
+	return n
+}
+
+func (x *ListProductsResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	return n
+}
+
+func (x *ListProductsResp) sizeField1() (n int) {
+	if x.Products == nil {
+		return n
+	}
+	for i := range x.GetProducts() {
+		n += fastpb.SizeMessage(1, x.GetProducts()[i])
+	}
+	return n
+}
+
+func (x *ListProductsResp) sizeField2() (n int) {
+	if x.Total == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(2, x.GetTotal())
+	return n
+}
+
+func (x *ListProductsResp) sizeField3() (n int) {
+	if x.Page == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(3, x.GetPage())
+	return n
+}
+
+func (x *ListProductsResp) sizeField4() (n int) {
+	if x.PageSize == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(4, x.GetPageSize())
+	return n
+}
+
+func (x *ListProductsResp) sizeField5() (n int) {
+	if x.TotalPages == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(5, x.GetTotalPages())
+	return n
+}
+
+func (x *ListProductsResp) sizeField6() (n int) {
+	if !x.HasMore {
+		return n
+	}
+	n += fastpb.SizeBool(6, x.GetHasMore())
+	return n
+}
+
+func (x *ListProductsResp) sizeField7() (n int) {
+	if x.NextCursor == "" {
+		return n
+	}
+	n += fastpb.SizeString(7, x.GetNextCursor())
+	return n
+}
+
+func (x *SearchProductsReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	return n
+}
+
+func (x *SearchProductsReq) sizeField1() (n int) {
+	if x.Keyword == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetKeyword())
+	return n
+}
+
+func (x *SearchProductsReq) sizeField2() (n int) {
+	if x.CategoryId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetCategoryId())
+	return n
+}
+
+func (x *SearchProductsReq) sizeField3() (n int) {
+	if x.MinPrice == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.GetMinPrice())
+	return n
+}
+
+func (x *SearchProductsReq) sizeField4() (n int) {
+	if x.MaxPrice == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(4, x.GetMaxPrice())
+	return n
+}
+
+func (x *SearchProductsReq) sizeField5() (n int) {
+	if !x.OnSaleOnly {
+		return n
+	}
+	n += fastpb.SizeBool(5, x.GetOnSaleOnly())
+	return n
+}
+
+func (x *SearchProductsReq) sizeField6() (n int) {
+	if x.Attributes == nil {
+		return n
+	}
+	for k, v := range x.GetAttributes() {
+		n += fastpb.SizeMapEntry(6,
+			func(numTagOrKey, numIdxOrVal int32) int {
+				n := 0
+				n += fastpb.SizeString(numTagOrKey, k)
+				n += fastpb.SizeString(numIdxOrVal, v)
+				return n
+			})
+	}
+	return n
+}
+
+func (x *SearchProductsReq) sizeField7() (n int) {
+	if x.Page == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(7, x.GetPage())
+	return n
+}
+
+func (x *SearchProductsReq) sizeField8() (n int) {
+	if x.PageSize == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(8, x.GetPageSize())
+	return n
+}
+
+func (x *SearchHit) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *SearchHit) sizeField1() (n int) {
+	if x.Product == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetProduct())
+	return n
+}
+
+func (x *SearchHit) sizeField2() (n int) {
+	if x.Score == 0 {
+		return n
+	}
+	n += fastpb.SizeDouble(2, x.GetScore())
+	return n
+}
+
+func (x *CategoryFacet) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *CategoryFacet) sizeField1() (n int) {
+	if x.CategoryId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetCategoryId())
+	return n
+}
+
+func (x *CategoryFacet) sizeField2() (n int) {
+	if x.Name == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetName())
+	return n
+}
+
+func (x *CategoryFacet) sizeField3() (n int) {
+	if x.Count == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.GetCount())
+	return n
+}
+
+func (x *PriceFacet) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *PriceFacet) sizeField1() (n int) {
+	if x.Min == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetMin())
+	return n
+}
+
+func (x *PriceFacet) sizeField2() (n int) {
+	if x.Max == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetMax())
+	return n
+}
+
+func (x *PriceFacet) sizeField3() (n int) {
+	if x.Count == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.GetCount())
+	return n
+}
+
+func (x *AttributeValueFacet) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *AttributeValueFacet) sizeField1() (n int) {
+	if x.Value == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetValue())
+	return n
+}
+
+func (x *AttributeValueFacet) sizeField2() (n int) {
+	if x.Count == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetCount())
+	return n
+}
+
+func (x *AttributeFacet) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *AttributeFacet) sizeField1() (n int) {
+	if x.Name == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetName())
+	return n
+}
+
+func (x *AttributeFacet) sizeField2() (n int) {
+	if x.Values == nil {
+		return n
+	}
+	for i := range x.GetValues() {
+		n += fastpb.SizeMessage(2, x.GetValues()[i])
+	}
+	return n
+}
+
+func (x *SearchProductsResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	return n
+}
+
+func (x *SearchProductsResp) sizeField1() (n int) {
+	if x.Hits == nil {
+		return n
+	}
+	for i := range x.GetHits() {
+		n += fastpb.SizeMessage(1, x.GetHits()[i])
+	}
+	return n
+}
+
+func (x *SearchProductsResp) sizeField2() (n int) {
+	if x.Total == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(2, x.GetTotal())
+	return n
+}
+
+func (x *SearchProductsResp) sizeField3() (n int) {
+	if x.Page == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(3, x.GetPage())
+	return n
+}
+
+func (x *SearchProductsResp) sizeField4() (n int) {
+	if x.PageSize == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(4, x.GetPageSize())
+	return n
+}
+
+func (x *SearchProductsResp) sizeField5() (n int) {
+	if x.CategoryFacets == nil {
+		return n
+	}
+	for i := range x.GetCategoryFacets() {
+		n += fastpb.SizeMessage(5, x.GetCategoryFacets()[i])
+	}
+	return n
+}
+
+func (x *SearchProductsResp) sizeField6() (n int) {
+	if x.PriceFacets == nil {
+		return n
+	}
+	for i := range x.GetPriceFacets() {
+		n += fastpb.SizeMessage(6, x.GetPriceFacets()[i])
+	}
+	return n
+}
+
+func (x *SearchProductsResp) sizeField7() (n int) {
+	if x.AttributeFacets == nil {
+		return n
+	}
+	for i := range x.GetAttributeFacets() {
+		n += fastpb.SizeMessage(7, x.GetAttributeFacets()[i])
+	}
+	return n
+}
+
+func (x *GetCategoriesReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	return n
+}
+
+func (x *GetCategoriesResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *GetCategoriesResp) sizeField1() (n int) {
+	if len(x.Categories) == 0 {
+		return n
+	}
+	for i := range x.GetCategories() {
+		n += fastpb.SizeString(1, x.GetCategories()[i])
+	}
+	return n
+}
+
+func (x *GetCategoriesResp) sizeField2() (n int) {
+	if x.Tree == nil {
+		return n
+	}
+	for i := range x.GetTree() {
+		n += fastpb.SizeMessage(2, x.GetTree()[i])
+	}
+	return n
+}
+
+func (x *CreateCategoryReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	return n
+}
+
+func (x *CreateCategoryReq) sizeField1() (n int) {
+	if x.ParentId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetParentId())
+	return n
+}
+
+func (x *CreateCategoryReq) sizeField2() (n int) {
+	if x.Name == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetName())
+	return n
+}
+
+func (x *CreateCategoryReq) sizeField3() (n int) {
+	if x.Slug == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetSlug())
+	return n
+}
+
+func (x *CreateCategoryReq) sizeField4() (n int) {
+	if x.Icon == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetIcon())
+	return n
+}
+
+func (x *CreateCategoryReq) sizeField5() (n int) {
+	if x.SortOrder == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(5, x.GetSortOrder())
+	return n
+}
+
+func (x *CreateCategoryResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *CreateCategoryResp) sizeField1() (n int) {
+	if x.Category == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetCategory())
+	return n
+}
+
+func (x *UpdateCategoryReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	return n
+}
+
+func (x *UpdateCategoryReq) sizeField1() (n int) {
+	if x.Id == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetId())
+	return n
+}
+
+func (x *UpdateCategoryReq) sizeField2() (n int) {
+	if x.ParentId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetParentId())
+	return n
+}
+
+func (x *UpdateCategoryReq) sizeField3() (n int) {
+	if x.Name == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetName())
+	return n
+}
+
+func (x *UpdateCategoryReq) sizeField4() (n int) {
+	if x.Slug == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetSlug())
+	return n
+}
+
+func (x *UpdateCategoryReq) sizeField5() (n int) {
+	if x.Icon == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.GetIcon())
+	return n
+}
+
+func (x *UpdateCategoryReq) sizeField6() (n int) {
+	if x.SortOrder == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(6, x.GetSortOrder())
+	return n
+}
+
+func (x *UpdateCategoryResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *UpdateCategoryResp) sizeField1() (n int) {
+	if x.Category == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetCategory())
+	return n
+}
+
+func (x *DeleteCategoryReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *DeleteCategoryReq) sizeField1() (n int) {
+	if x.Id == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetId())
+	return n
+}
+
+func (x *DeleteCategoryResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *DeleteCategoryResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
+func (x *SetProductOptionsReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *SetProductOptionsReq) sizeField1() (n int) {
+	if x.ProductId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetProductId())
+	return n
+}
+
+func (x *SetProductOptionsReq) sizeField2() (n int) {
+	if x.Options == nil {
+		return n
+	}
+	for i := range x.GetOptions() {
+		n += fastpb.SizeMessage(2, x.GetOptions()[i])
+	}
+	return n
+}
+
+func (x *SetProductOptionsResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *SetProductOptionsResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
+func (x *SaveSkusReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *SaveSkusReq) sizeField1() (n int) {
+	if x.ProductId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetProductId())
+	return n
+}
+
+func (x *SaveSkusReq) sizeField2() (n int) {
+	if x.Skus == nil {
+		return n
+	}
+	for i := range x.GetSkus() {
+		n += fastpb.SizeMessage(2, x.GetSkus()[i])
+	}
+	return n
+}
+
+func (x *SaveSkusResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *SaveSkusResp) sizeField1() (n int) {
+	if x.Skus == nil {
+		return n
+	}
+	for i := range x.GetSkus() {
+		n += fastpb.SizeMessage(1, x.GetSkus()[i])
+	}
+	return n
+}
+
+func (x *DeleteSkuReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *DeleteSkuReq) sizeField1() (n int) {
+	if x.ProductId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetProductId())
+	return n
+}
+
+func (x *DeleteSkuReq) sizeField2() (n int) {
+	if x.SkuId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetSkuId())
+	return n
+}
+
+func (x *DeleteSkuResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *DeleteSkuResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
+func (x *FieldChange) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *FieldChange) sizeField1() (n int) {
+	if x.Field == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetField())
+	return n
+}
+
+func (x *FieldChange) sizeField2() (n int) {
+	if x.Before == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetBefore())
+	return n
+}
+
+func (x *FieldChange) sizeField3() (n int) {
+	if x.After == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetAfter())
+	return n
+}
+
+func (x *ProductRevision) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	return n
+}
+
+func (x *ProductRevision) sizeField1() (n int) {
+	if x.Id == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetId())
+	return n
+}
+
+func (x *ProductRevision) sizeField2() (n int) {
+	if x.ProductId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetProductId())
+	return n
+}
+
+func (x *ProductRevision) sizeField3() (n int) {
+	if x.Action == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetAction())
+	return n
+}
+
+func (x *ProductRevision) sizeField4() (n int) {
+	if x.OperatorId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(4, x.GetOperatorId())
+	return n
+}
+
+func (x *ProductRevision) sizeField5() (n int) {
+	if x.Reason == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.GetReason())
+	return n
+}
+
+func (x *ProductRevision) sizeField6() (n int) {
+	if x.SkuId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(6, x.GetSkuId())
+	return n
+}
+
+func (x *ProductRevision) sizeField7() (n int) {
+	if x.Changes == nil {
+		return n
+	}
+	for i := range x.GetChanges() {
+		n += fastpb.SizeMessage(7, x.GetChanges()[i])
+	}
+	return n
+}
+
+func (x *ProductRevision) sizeField8() (n int) {
+	if x.CreateTime == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(8, x.GetCreateTime())
+	return n
+}
+
+func (x *ListProductRevisionsReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *ListProductRevisionsReq) sizeField1() (n int) {
+	if x.ProductId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetProductId())
+	return n
+}
+
+func (x *ListProductRevisionsReq) sizeField2() (n int) {
+	if x.Page == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(2, x.GetPage())
+	return n
+}
+
+func (x *ListProductRevisionsReq) sizeField3() (n int) {
+	if x.PageSize == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(3, x.GetPageSize())
+	return n
+}
+
+func (x *ListProductRevisionsResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *ListProductRevisionsResp) sizeField1() (n int) {
+	if x.Revisions == nil {
+		return n
+	}
+	for i := range x.GetRevisions() {
+		n += fastpb.SizeMessage(1, x.GetRevisions()[i])
+	}
+	return n
+}
+
+func (x *ListProductRevisionsResp) sizeField2() (n int) {
+	if x.Total == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(2, x.GetTotal())
+	return n
+}
+
+func (x *RestoreProductReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *RestoreProductReq) sizeField1() (n int) {
+	if x.ProductId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetProductId())
+	return n
+}
+
+func (x *RestoreProductReq) sizeField2() (n int) {
+	if x.RevisionId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetRevisionId())
+	return n
+}
+
+func (x *RestoreProductReq) sizeField3() (n int) {
+	if x.Reason == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetReason())
+	return n
+}
+
+func (x *RestoreProductResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *RestoreProductResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
+func (x *RestoreProductResp) sizeField2() (n int) {
+	if x.Version == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetVersion())
+	return n
+}
+
+func (x *ProductRecord) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
+	n += x.sizeField10()
+	n += x.sizeField11()
+	return n
+}
+
+func (x *ProductRecord) sizeField1() (n int) {
+	if x.ExternalCode == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetExternalCode())
+	return n
+}
+
+func (x *ProductRecord) sizeField2() (n int) {
+	if x.Name == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetName())
+	return n
+}
+
+func (x *ProductRecord) sizeField3() (n int) {
+	if x.Description == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetDescription())
+	return n
+}
+
+func (x *ProductRecord) sizeField4() (n int) {
+	if x.Price == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(4, x.GetPrice())
+	return n
+}
+
+func (x *ProductRecord) sizeField5() (n int) {
+	if x.Stock == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(5, x.GetStock())
+	return n
+}
+
+func (x *ProductRecord) sizeField6() (n int) {
+	if x.ImageUrl == "" {
+		return n
+	}
+	n += fastpb.SizeString(6, x.GetImageUrl())
+	return n
+}
+
+func (x *ProductRecord) sizeField7() (n int) {
+	if len(x.Gallery) == 0 {
+		return n
+	}
+	for i := range x.GetGallery() {
+		n += fastpb.SizeString(7, x.GetGallery()[i])
+	}
+	return n
+}
+
+func (x *ProductRecord) sizeField8() (n int) {
+	if x.Category == "" {
+		return n
+	}
+	n += fastpb.SizeString(8, x.GetCategory())
+	return n
+}
+
+func (x *ProductRecord) sizeField9() (n int) {
+	if !x.IsOnSale {
+		return n
+	}
+	n += fastpb.SizeBool(9, x.GetIsOnSale())
+	return n
+}
+
+func (x *ProductRecord) sizeField10() (n int) {
+	if x.Attributes == nil {
+		return n
+	}
+	for k, v := range x.GetAttributes() {
+		n += fastpb.SizeMapEntry(10,
+			func(numTagOrKey, numIdxOrVal int32) int {
+				n := 0
+				n += fastpb.SizeString(numTagOrKey, k)
+				n += fastpb.SizeString(numIdxOrVal, v)
+				return n
+			})
+	}
+	return n
+}
+
+func (x *ProductRecord) sizeField11() (n int) {
+	if x.ProductId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(11, x.GetProductId())
+	return n
+}
+
+func (x *BulkImportProductsReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *BulkImportProductsReq) sizeField1() (n int) {
+	if x.Mode == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetMode())
+	return n
+}
+
+func (x *BulkImportProductsReq) sizeField2() (n int) {
+	if x.Reason == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetReason())
+	return n
+}
+
+func (x *BulkImportProductsReq) sizeField3() (n int) {
+	if x.Records == nil {
+		return n
+	}
+	for i := range x.GetRecords() {
+		n += fastpb.SizeMessage(3, x.GetRecords()[i])
+	}
+	return n
+}
+
+func (x *ImportRowError) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *ImportRowError) sizeField1() (n int) {
+	if x.Row == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.GetRow())
+	return n
+}
+
+func (x *ImportRowError) sizeField2() (n int) {
+	if x.ExternalCode == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetExternalCode())
+	return n
+}
+
+func (x *ImportRowError) sizeField3() (n int) {
+	if x.Message == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetMessage())
+	return n
+}
+
+func (x *BulkImportProductsResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	return n
+}
+
+func (x *BulkImportProductsResp) sizeField1() (n int) {
+	if x.Total == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.GetTotal())
+	return n
+}
+
+func (x *BulkImportProductsResp) sizeField2() (n int) {
+	if x.Created == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(2, x.GetCreated())
+	return n
+}
+
+func (x *BulkImportProductsResp) sizeField3() (n int) {
+	if x.Updated == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(3, x.GetUpdated())
+	return n
+}
+
+func (x *BulkImportProductsResp) sizeField4() (n int) {
+	if x.Errors == nil {
+		return n
+	}
+	for i := range x.GetErrors() {
+		n += fastpb.SizeMessage(4, x.GetErrors()[i])
+	}
+	return n
+}
+
+func (x *BulkImportProductsResp) sizeField5() (n int) {
+	if !x.Committed {
+		return n
+	}
+	n += fastpb.SizeBool(5, x.GetCommitted())
+	return n
+}
+
+func (x *ExportProductsReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *ExportProductsReq) sizeField1() (n int) {
+	if x.CategoryId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetCategoryId())
+	return n
+}
+
+func (x *ExportProductsReq) sizeField2() (n int) {
+	if x.BatchSize == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(2, x.GetBatchSize())
+	return n
+}
+
+func (x *ExportProductsResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ExportProductsResp) sizeField1() (n int) {
+	if x.Records == nil {
+		return n
+	}
+	for i := range x.GetRecords() {
+		n += fastpb.SizeMessage(1, x.GetRecords()[i])
+	}
+	return n
+}
+
+func (x *UpdateStockReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *UpdateStockReq) sizeField1() (n int) {
+	if x.ProductId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetProductId())
+	return n
+}
+
+func (x *UpdateStockReq) sizeField2() (n int) {
+	if x.Quantity == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(2, x.GetQuantity())
+	return n
+}
+
+func (x *UpdateStockReq) sizeField3() (n int) {
+	if x.SkuId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.GetSkuId())
+	return n
+}
+
+func (x *UpdateStockReq) sizeField4() (n int) {
+	if x.Reason == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetReason())
+	return n
+}
+
+func (x *UpdateStockResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *UpdateStockResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
+func (x *UpdateStockResp) sizeField2() (n int) {
+	if x.CurrentStock == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(2, x.GetCurrentStock())
+	return n
+}
+
+func (x *StockItem) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *StockItem) sizeField1() (n int) {
+	if x.ProductId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetProductId())
+	return n
+}
+
+func (x *StockItem) sizeField2() (n int) {
+	if x.Quantity == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(2, x.GetQuantity())
+	return n
+}
+
+func (x *StockItem) sizeField3() (n int) {
+	if x.SkuId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.GetSkuId())
+	return n
+}
+
+func (x *StockItem) sizeField4() (n int) {
+	if x.Attributes == nil {
+		return n
+	}
+	for k, v := range x.GetAttributes() {
+		n += fastpb.SizeMapEntry(4,
+			func(numTagOrKey, numIdxOrVal int32) int {
+				n := 0
+				n += fastpb.SizeString(numTagOrKey, k)
+				n += fastpb.SizeString(numIdxOrVal, v)
+				return n
+			})
+	}
+	return n
+}
+
+func (x *ReserveStockReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *ReserveStockReq) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *ReserveStockReq) sizeField2() (n int) {
+	if x.Items == nil {
+		return n
+	}
+	for i := range x.GetItems() {
+		n += fastpb.SizeMessage(2, x.GetItems()[i])
+	}
+	return n
+}
+
+func (x *ReserveStockReq) sizeField3() (n int) {
+	if x.TtlSeconds == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.GetTtlSeconds())
+	return n
+}
+
+func (x *ReserveStockResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *ReserveStockResp) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *ReserveStockResp) sizeField2() (n int) {
+	if x.Status == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(2, int32(x.GetStatus()))
+	return n
+}
+
+func (x *ReserveStockResp) sizeField3() (n int) {
+	if x.ExpireTime == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.GetExpireTime())
+	return n
+}
+
+func (x *ConfirmReservationReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ConfirmReservationReq) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *ConfirmReservationResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ConfirmReservationResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
+func (x *ReleaseReservationReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ReleaseReservationReq) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *ReleaseReservationResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ReleaseReservationResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
+var fieldIDToName_Product = map[int32]string{
+	1:  "Id",
+	2:  "Name",
+	3:  "Description",
+	4:  "Price",
+	5:  "Stock",
+	6:  "ImageUrl",
+	7:  "Gallery",
+	8:  "Category",
+	9:  "IsOnSale",
+	10: "CreateTime",
+	11: "UpdateTime",
+	12: "Attributes",
+	13: "Rating",
+	14: "SalesCount",
+	15: "Options",
+	16: "Skus",
+	17: "CategoryId",
+	18: "Version",
+	19: "ExternalCode",
+}
+
+var fieldIDToName_Category = map[int32]string{
+	1: "Id",
+	2: "ParentId",
+	3: "Name",
+	4: "Slug",
+	5: "Icon",
+	6: "SortOrder",
+	7: "Children",
+}
+
+var fieldIDToName_ProductOption = map[int32]string{
+	1: "Name",
+	2: "Values",
+}
+
+var fieldIDToName_Sku = map[int32]string{
+	1: "Id",
+	2: "ProductId",
+	3: "Attributes",
+	4: "Price",
+	5: "Stock",
+	6: "Barcode",
+	7: "IsOnSale",
+	8: "SalesCount",
+}
+
+var fieldIDToName_CreateProductReq = map[int32]string{
+	1:  "Name",
+	2:  "Description",
+	3:  "Price",
+	4:  "Stock",
+	5:  "ImageUrl",
+	6:  "Gallery",
+	7:  "Category",
+	8:  "IsOnSale",
+	9:  "Attributes",
+	10: "CategoryId",
+	11: "Reason",
+}
+
+var fieldIDToName_CreateProductResp = map[int32]string{
+	1: "ProductId",
+}
+
+var fieldIDToName_UpdateProductReq = map[int32]string{
+	1:  "Id",
+	2:  "Name",
+	3:  "Description",
+	4:  "Price",
+	5:  "Stock",
+	6:  "ImageUrl",
+	7:  "Gallery",
+	8:  "Category",
+	9:  "IsOnSale",
+	10: "Attributes",
+	11: "CategoryId",
+	12: "UpdateMask",
+	13: "Version",
+	14: "Reason",
+}
+
+var fieldIDToName_UpdateProductResp = map[int32]string{
+	1: "Success",
+	2: "Version",
+}
+
+var fieldIDToName_GetProductReq = map[int32]string{
+	1: "Id",
+}
+
+var fieldIDToName_GetProductResp = map[int32]string{
+	1: "Product",
+}
+
+var fieldIDToName_DeleteProductReq = map[int32]string{
+	1: "Id",
+	2: "Reason",
+}
+
+var fieldIDToName_DeleteProductResp = map[int32]string{
+	1: "Success",
+}
+
+var fieldIDToName_ListProductsReq = map[int32]string{
+	1:  "Category",
+	2:  "Keyword",
+	3:  "OnSaleOnly",
+	4:  "Page",
+	5:  "PageSize",
+	6:  "SortBy",
+	7:  "Ascending",
+	8:  "MinPrice",
+	9:  "MaxPrice",
+	10: "CategoryId",
+	11: "UseCursor",
+	12: "Cursor",
+	13: "SkipTotal",
+}
+
+var fieldIDToName_ListProductsResp = map[int32]string{
+	1: "Products",
+	2: "Total",
+	3: "Page",
+	4: "PageSize",
+	5: "TotalPages",
+	6: "HasMore",
+	7: "NextCursor",
+}
+
+var fieldIDToName_SearchProductsReq = map[int32]string{
+	1: "Keyword",
+	2: "CategoryId",
+	3: "MinPrice",
+	4: "MaxPrice",
+	5: "OnSaleOnly",
+	6: "Attributes",
+	7: "Page",
+	8: "PageSize",
+}
+
+var fieldIDToName_SearchHit = map[int32]string{
+	1: "Product",
+	2: "Score",
+}
+
+var fieldIDToName_CategoryFacet = map[int32]string{
+	1: "CategoryId",
+	2: "Name",
+	3: "Count",
+}
+
+var fieldIDToName_PriceFacet = map[int32]string{
+	1: "Min",
+	2: "Max",
+	3: "Count",
+}
+
+var fieldIDToName_AttributeValueFacet = map[int32]string{
+	1: "Value",
+	2: "Count",
+}
+
+var fieldIDToName_AttributeFacet = map[int32]string{
+	1: "Name",
+	2: "Values",
+}
+
+var fieldIDToName_SearchProductsResp = map[int32]string{
+	1: "Hits",
+	2: "Total",
+	3: "Page",
+	4: "PageSize",
+	5: "CategoryFacets",
+	6: "PriceFacets",
+	7: "AttributeFacets",
+}
+
+var fieldIDToName_GetCategoriesReq = map[int32]string{}
+
+var fieldIDToName_GetCategoriesResp = map[int32]string{
+	1: "Categories",
+	2: "Tree",
+}
+
+var fieldIDToName_CreateCategoryReq = map[int32]string{
+	1: "ParentId",
+	2: "Name",
+	3: "Slug",
+	4: "Icon",
+	5: "SortOrder",
+}
+
+var fieldIDToName_CreateCategoryResp = map[int32]string{
+	1: "Category",
+}
+
+var fieldIDToName_UpdateCategoryReq = map[int32]string{
+	1: "Id",
+	2: "ParentId",
+	3: "Name",
+	4: "Slug",
+	5: "Icon",
+	6: "SortOrder",
+}
+
+var fieldIDToName_UpdateCategoryResp = map[int32]string{
+	1: "Category",
+}
+
+var fieldIDToName_DeleteCategoryReq = map[int32]string{
+	1: "Id",
+}
+
+var fieldIDToName_DeleteCategoryResp = map[int32]string{
+	1: "Success",
+}
+
+var fieldIDToName_SetProductOptionsReq = map[int32]string{
+	1: "ProductId",
+	2: "Options",
+}
+
+var fieldIDToName_SetProductOptionsResp = map[int32]string{
+	1: "Success",
+}
+
+var fieldIDToName_SaveSkusReq = map[int32]string{
+	1: "ProductId",
+	2: "Skus",
+}
+
+var fieldIDToName_SaveSkusResp = map[int32]string{
+	1: "Skus",
+}
+
+var fieldIDToName_DeleteSkuReq = map[int32]string{
+	1: "ProductId",
+	2: "SkuId",
+}
+
+var fieldIDToName_DeleteSkuResp = map[int32]string{
+	1: "Success",
+}
+
+var fieldIDToName_FieldChange = map[int32]string{
+	1: "Field",
+	2: "Before",
+	3: "After",
+}
+
+var fieldIDToName_ProductRevision = map[int32]string{
+	1: "Id",
+	2: "ProductId",
+	3: "Action",
+	4: "OperatorId",
+	5: "Reason",
+	6: "SkuId",
+	7: "Changes",
+	8: "CreateTime",
+}
+
+var fieldIDToName_ListProductRevisionsReq = map[int32]string{
+	1: "ProductId",
+	2: "Page",
+	3: "PageSize",
+}
+
+var fieldIDToName_ListProductRevisionsResp = map[int32]string{
+	1: "Revisions",
+	2: "Total",
+}
+
+var fieldIDToName_RestoreProductReq = map[int32]string{
+	1: "ProductId",
+	2: "RevisionId",
+	3: "Reason",
+}
+
+var fieldIDToName_RestoreProductResp = map[int32]string{
+	1: "Success",
+	2: "Version",
+}
+
+var fieldIDToName_ProductRecord = map[int32]string{
+	1:  "ExternalCode",
+	2:  "Name",
+	3:  "Description",
+	4:  "Price",
+	5:  "Stock",
+	6:  "ImageUrl",
+	7:  "Gallery",
+	8:  "Category",
+	9:  "IsOnSale",
+	10: "Attributes",
+	11: "ProductId",
+}
+
+var fieldIDToName_BulkImportProductsReq = map[int32]string{
+	1: "Mode",
+	2: "Reason",
+	3: "Records",
+}
+
+var fieldIDToName_ImportRowError = map[int32]string{
+	1: "Row",
+	2: "ExternalCode",
+	3: "Message",
+}
+
+var fieldIDToName_BulkImportProductsResp = map[int32]string{
+	1: "Total",
+	2: "Created",
+	3: "Updated",
+	4: "Errors",
+	5: "Committed",
+}
+
+var fieldIDToName_ExportProductsReq = map[int32]string{
+	1: "CategoryId",
+	2: "BatchSize",
+}
+
+var fieldIDToName_ExportProductsResp = map[int32]string{
+	1: "Records",
+}
+
+var fieldIDToName_UpdateStockReq = map[int32]string{
+	1: "ProductId",
+	2: "Quantity",
+	3: "SkuId",
+	4: "Reason",
+}
+
+var fieldIDToName_UpdateStockResp = map[int32]string{
+	1: "Success",
+	2: "CurrentStock",
+}
+
+var fieldIDToName_StockItem = map[int32]string{
+	1: "ProductId",
+	2: "Quantity",
+	3: "SkuId",
+	4: "Attributes",
+}
+
+var fieldIDToName_ReserveStockReq = map[int32]string{
+	1: "Token",
+	2: "Items",
+	3: "TtlSeconds",
+}
+
+var fieldIDToName_ReserveStockResp = map[int32]string{
+	1: "Token",
+	2: "Status",
+	3: "ExpireTime",
+}
+
+var fieldIDToName_ConfirmReservationReq = map[int32]string{
+	1: "Token",
+}
+
+var fieldIDToName_ConfirmReservationResp = map[int32]string{
+	1: "Success",
+}
+
+var fieldIDToName_ReleaseReservationReq = map[int32]string{
+	1: "Token",
+}
+
+var fieldIDToName_ReleaseReservationResp = map[int32]string{
+	1: "Success",
+}
+
+var _ = api.File_api_proto
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"net"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/cloudwego/kitex/pkg/transmeta"
+	"github.com/cloudwego/kitex/server"
+	kitexlogrus "github.com/kitex-contrib/obs-opentelemetry/logging/logrus"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
+	"zqzqsb.com/gomall/common/serversuite"
+	"zqzqsb/gomall/app/cart/biz/dal"
+	"zqzqsb/gomall/app/cart/conf"
+	"zqzqsb/gomall/app/cart/infra/rpc"
+	"zqzqsb/gomall/app/cart/kitex_gen/cart/cartservice"
+)
+
+func main() {
+	dal.Init()
+	// JWT 中间件校验吊销状态时使用 dal 初始化的 Redis 客户端，需在 dal.Init 之后创建
+	hertzEngine = initHertz()
+	rpc.InitClient()
+
+	opts := kitexInit()
+
+	svr := cartservice.NewServer(new(CartServiceImpl), opts...)
+
+	err := svr.Run()
+	if err != nil {
+		klog.Error(err.Error())
+	}
+}
+
+func kitexInit() (opts []server.Option) {
+	opts = append(opts, server.
+		WithTransHandlerFactory(&mixTransHandlerFactory{nil}))
+
+	// address
+	addr, err := net.ResolveTCPAddr("tcp", conf.GetConf().Kitex.Address)
+	if err != nil {
+		panic(err)
+	}
+	opts = append(opts, server.WithServiceAddr(addr))
+
+	// 通过 Consul 注册服务，服务退出时自动注销
+	opts = append(opts, server.WithSuite(&serversuite.CommonServerSuite{
+		CurrentServiceName: conf.GetConf().Kitex.Service,
+		RegistryAddr:       conf.GetConf().Registry.Address(),
+		ServiceAddr:        conf.GetConf().Kitex.Address,
+		Weight:             conf.GetConf().Registry.Weight,
+		Env:                conf.GetEnv(),
+		Version:            conf.GetConf().Registry.Version,
+	}))
+
+	// service info
+	opts = append(opts, server.WithServerBasicInfo(&rpcinfo.EndpointBasicInfo{
+		ServiceName: conf.GetConf().Kitex.Service,
+	}))
+
+	// 接收 gRPC 调用方透传的用户身份
+	opts = append(opts, server.WithMetaHandler(transmeta.ServerHTTP2Handler))
+
+	// klog
+	logger := kitexlogrus.NewLogger()
+	klog.SetLogger(logger)
+	klog.SetLevel(conf.LogLevel())
+	asyncWriter := &zapcore.BufferedWriteSyncer{
+		WS: zapcore.AddSync(&lumberjack.Logger{
+			Filename:   conf.GetConf().Kitex.LogFileName,
+			MaxSize:    conf.GetConf().Kitex.LogMaxSize,
+			MaxBackups: conf.GetConf().Kitex.LogMaxBackups,
+			MaxAge:     conf.GetConf().Kitex.LogMaxAge,
+		}),
+		FlushInterval: time.Minute,
+	}
+	klog.SetOutput(asyncWriter)
+	server.RegisterShutdownHook(func() {
+		asyncWriter.Sync()
+	})
+	return
+}
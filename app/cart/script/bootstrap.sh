@@ -0,0 +1,4 @@
+#! /usr/bin/env bash
+CURDIR=$(cd $(dirname $0); pwd)
+echo "$CURDIR/bin/cart"
+exec "$CURDIR/bin/cart"
@@ -0,0 +1,9 @@
+package dal
+
+import (
+	"zqzqsb/gomall/app/gateway/biz/dal/redis"
+)
+
+func Init() {
+	redis.Init()
+}
@@ -0,0 +1,158 @@
+// Code generated by hertz generator.
+
+package cart
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"zqzqsb/gomall/app/gateway/biz/utils"
+	"zqzqsb/gomall/app/gateway/infra/rpc"
+	cart "zqzqsb/gomall/app/gateway/kitex_gen/cart"
+)
+
+// AddToCart .
+// @router /cart/items [POST]
+func AddToCart(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req cart.AddToCartReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用购物车服务添加购物车项
+	resp, err := rpc.CartClient.AddToCart(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// UpdateCartItem .
+// @router /cart/items/{cart_item_id} [PUT]
+func UpdateCartItem(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req cart.UpdateCartItemReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取购物车项ID
+	idStr := c.Param("cart_item_id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid cart item ID")
+		return
+	}
+	req.CartItemId = id
+
+	// 调用购物车服务更新购物车项
+	resp, err := rpc.CartClient.UpdateCartItem(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RemoveFromCart .
+// @router /cart/items/{cart_item_id} [DELETE]
+func RemoveFromCart(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req cart.RemoveFromCartReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取购物车项ID
+	idStr := c.Param("cart_item_id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid cart item ID")
+		return
+	}
+	req.CartItemId = id
+
+	// 调用购物车服务删除购物车项
+	resp, err := rpc.CartClient.RemoveFromCart(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetCart .
+// @router /cart [GET]
+func GetCart(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req cart.GetCartReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用购物车服务获取购物车
+	resp, err := rpc.CartClient.GetCart(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ClearCart .
+// @router /cart [DELETE]
+func ClearCart(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req cart.ClearCartReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用购物车服务清空购物车
+	resp, err := rpc.CartClient.ClearCart(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// SelectCartItems .
+// @router /cart/select [POST]
+func SelectCartItems(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req cart.SelectCartItemsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用购物车服务选择结算项
+	resp, err := rpc.CartClient.SelectCartItems(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
@@ -0,0 +1,197 @@
+// Code generated by hertz generator.
+
+package order
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"zqzqsb/gomall/app/gateway/biz/utils"
+	"zqzqsb/gomall/app/gateway/infra/rpc"
+	order "zqzqsb/gomall/app/gateway/kitex_gen/order"
+)
+
+// CreateOrder .
+// @router /orders [POST]
+func CreateOrder(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.CreateOrderReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用订单服务创建订单
+	resp, err := rpc.OrderClient.CreateOrder(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetOrder .
+// @router /orders/{order_id} [GET]
+func GetOrder(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.GetOrderReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取订单ID
+	idStr := c.Param("order_id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid order ID")
+		return
+	}
+	req.OrderId = id
+
+	// 调用订单服务获取订单详情
+	resp, err := rpc.OrderClient.GetOrder(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ListUserOrders .
+// @router /user/orders [GET]
+func ListUserOrders(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.ListUserOrdersReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用订单服务获取用户订单列表
+	resp, err := rpc.OrderClient.ListUserOrders(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// CancelOrder .
+// @router /orders/{order_id}/cancel [POST]
+func CancelOrder(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.CancelOrderReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取订单ID
+	idStr := c.Param("order_id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid order ID")
+		return
+	}
+	req.OrderId = id
+
+	// 调用订单服务取消订单
+	resp, err := rpc.OrderClient.CancelOrder(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ConfirmReceipt .
+// @router /orders/{order_id}/confirm [POST]
+func ConfirmReceipt(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.ConfirmReceiptReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取订单ID
+	idStr := c.Param("order_id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid order ID")
+		return
+	}
+	req.OrderId = id
+
+	// 调用订单服务确认收货
+	resp, err := rpc.OrderClient.ConfirmReceipt(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ShipOrder .
+// @router /admin/orders/{order_id}/ship [POST]
+func ShipOrder(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.ShipOrderReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取订单ID
+	idStr := c.Param("order_id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid order ID")
+		return
+	}
+	req.OrderId = id
+
+	// 调用订单服务订单发货
+	resp, err := rpc.OrderClient.ShipOrder(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ListOrders .
+// @router /admin/orders [GET]
+func ListOrders(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.ListOrdersReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用订单服务获取全部订单列表
+	resp, err := rpc.OrderClient.ListOrders(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
@@ -0,0 +1,188 @@
+// Code generated by hertz generator.
+
+package pay
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"zqzqsb/gomall/app/gateway/biz/utils"
+	"zqzqsb/gomall/app/gateway/infra/rpc"
+	pay "zqzqsb/gomall/app/gateway/kitex_gen/pay"
+)
+
+// CreatePayment .
+// @router /payments [POST]
+func CreatePayment(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req pay.CreatePaymentReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用支付服务创建支付单
+	resp, err := rpc.PaymentClient.CreatePayment(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// QueryPayment .
+// @router /payments/{payment_id} [GET]
+func QueryPayment(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req pay.QueryPaymentReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取支付ID
+	idStr := c.Param("payment_id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid payment ID")
+		return
+	}
+	req.Identifier = &pay.QueryPaymentReq_PaymentId{PaymentId: id}
+
+	// 调用支付服务查询支付单
+	resp, err := rpc.PaymentClient.QueryPayment(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// CancelPayment .
+// @router /payments/{payment_id}/cancel [POST]
+func CancelPayment(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req pay.CancelPaymentReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取支付ID
+	idStr := c.Param("payment_id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid payment ID")
+		return
+	}
+	req.Identifier = &pay.CancelPaymentReq_PaymentId{PaymentId: id}
+
+	// 调用支付服务取消支付单
+	resp, err := rpc.PaymentClient.CancelPayment(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// HandlePaymentCallback .
+// @router /payments/callback [POST]
+func HandlePaymentCallback(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req pay.PaymentCallbackReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用支付服务处理渠道回调
+	resp, err := rpc.PaymentClient.HandlePaymentCallback(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RefundPayment .
+// @router /payments/{payment_id}/refund [POST]
+func RefundPayment(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req pay.RefundReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取支付ID
+	idStr := c.Param("payment_id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid payment ID")
+		return
+	}
+	req.Identifier = &pay.RefundReq_PaymentId{PaymentId: id}
+
+	// 调用支付服务发起退款
+	resp, err := rpc.PaymentClient.RefundPayment(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetUserPayments .
+// @router /user/payments [GET]
+func GetUserPayments(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req pay.GetUserPaymentsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用支付服务获取用户支付列表
+	resp, err := rpc.PaymentClient.GetUserPayments(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// CreatePaymentFromCart .
+// @router /payments/from-cart [POST]
+func CreatePaymentFromCart(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req pay.CreatePaymentFromCartReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用支付服务根据购物车创建支付单
+	resp, err := rpc.PaymentClient.CreatePaymentFromCart(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
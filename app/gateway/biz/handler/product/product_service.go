@@ -0,0 +1,461 @@
+// Code generated by hertz generator.
+
+package product
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"zqzqsb/gomall/app/gateway/biz/utils"
+	"zqzqsb/gomall/app/gateway/infra/rpc"
+	product "zqzqsb/gomall/app/gateway/kitex_gen/product"
+)
+
+// CreateProduct .
+// @router /admin/products [POST]
+func CreateProduct(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.CreateProductReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用商品服务创建商品
+	resp, err := rpc.ProductClient.CreateProduct(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// UpdateProduct .
+// @router /admin/products/{id} [PUT]
+func UpdateProduct(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.UpdateProductReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取商品ID
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid product ID")
+		return
+	}
+	req.Id = id
+
+	// 调用商品服务更新商品
+	resp, err := rpc.ProductClient.UpdateProduct(ctx, &req)
+	if err != nil {
+		// 商品已被其他请求修改时返回 409，由调用方重新读取后再提交
+		if bizErr, ok := kerrors.FromBizStatusError(err); ok && bizErr.BizStatusCode() == consts.StatusConflict {
+			c.String(consts.StatusConflict, bizErr.BizMessage())
+			return
+		}
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetProduct .
+// @router /products/{id} [GET]
+func GetProduct(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.GetProductReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取商品ID
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid product ID")
+		return
+	}
+	req.Id = id
+
+	// 调用商品服务获取商品详情
+	resp, err := rpc.ProductClient.GetProduct(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// DeleteProduct .
+// @router /admin/products/{id} [DELETE]
+func DeleteProduct(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.DeleteProductReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取商品ID
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid product ID")
+		return
+	}
+	req.Id = id
+
+	// 调用商品服务删除商品
+	resp, err := rpc.ProductClient.DeleteProduct(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ListProducts .
+// @router /products [GET]
+func ListProducts(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.ListProductsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+	// 游标分页参数
+	req.Cursor = c.Query("cursor")
+	req.UseCursor, _ = strconv.ParseBool(c.Query("use_cursor"))
+	req.SkipTotal, _ = strconv.ParseBool(c.Query("skip_total"))
+
+	// 调用商品服务获取商品列表
+	resp, err := rpc.ProductClient.ListProducts(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetCategories .
+// @router /products/categories [GET]
+func GetCategories(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.GetCategoriesReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用商品服务获取商品分类
+	resp, err := rpc.ProductClient.GetCategories(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// SetProductOptions .
+// @router /admin/products/{id}/options [PUT]
+func SetProductOptions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.SetProductOptionsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取商品ID
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid product ID")
+		return
+	}
+	req.ProductId = id
+
+	// 调用商品服务设置商品规格轴
+	resp, err := rpc.ProductClient.SetProductOptions(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// SaveSkus .
+// @router /admin/products/{id}/skus [PUT]
+func SaveSkus(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.SaveSkusReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取商品ID
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid product ID")
+		return
+	}
+	req.ProductId = id
+
+	// 调用商品服务新增或更新商品 SKU
+	resp, err := rpc.ProductClient.SaveSkus(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// DeleteSku .
+// @router /admin/products/{id}/skus/{sku_id} [DELETE]
+func DeleteSku(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.DeleteSkuReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取商品ID
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid product ID")
+		return
+	}
+	req.ProductId = id
+
+	skuID, err := strconv.ParseInt(c.Param("sku_id"), 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid sku ID")
+		return
+	}
+	req.SkuId = skuID
+
+	// 调用商品服务删除商品 SKU
+	resp, err := rpc.ProductClient.DeleteSku(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// CreateCategory .
+// @router /admin/categories [POST]
+func CreateCategory(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.CreateCategoryReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用商品服务创建分类
+	resp, err := rpc.ProductClient.CreateCategory(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// UpdateCategory .
+// @router /admin/categories/{id} [PUT]
+func UpdateCategory(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.UpdateCategoryReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取分类ID
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid category ID")
+		return
+	}
+	req.Id = id
+
+	// 调用商品服务更新分类
+	resp, err := rpc.ProductClient.UpdateCategory(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// DeleteCategory .
+// @router /admin/categories/{id} [DELETE]
+func DeleteCategory(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.DeleteCategoryReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取分类ID
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid category ID")
+		return
+	}
+	req.Id = id
+
+	// 调用商品服务删除分类
+	resp, err := rpc.ProductClient.DeleteCategory(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// SearchProducts .
+// @router /products/search [GET]
+func SearchProducts(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.SearchProductsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+	bindSearchQuery(c, &req)
+
+	// 调用商品服务搜索商品
+	resp, err := rpc.ProductClient.SearchProducts(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// bindSearchQuery 从查询参数读取搜索条件，属性筛选使用 attr.<属性名>=<属性值> 的形式
+func bindSearchQuery(c *app.RequestContext, req *product.SearchProductsReq) {
+	req.Keyword = c.Query("keyword")
+	req.CategoryId, _ = strconv.ParseInt(c.Query("category_id"), 10, 64)
+	req.MinPrice, _ = strconv.ParseInt(c.Query("min_price"), 10, 64)
+	req.MaxPrice, _ = strconv.ParseInt(c.Query("max_price"), 10, 64)
+	req.OnSaleOnly, _ = strconv.ParseBool(c.Query("on_sale_only"))
+	page, _ := strconv.ParseInt(c.Query("page"), 10, 32)
+	pageSize, _ := strconv.ParseInt(c.Query("page_size"), 10, 32)
+	req.Page, req.PageSize = int32(page), int32(pageSize)
+	c.QueryArgs().VisitAll(func(key, value []byte) {
+		if name, ok := strings.CutPrefix(string(key), "attr."); ok && name != "" {
+			if req.Attributes == nil {
+				req.Attributes = make(map[string]string)
+			}
+			req.Attributes[name] = string(value)
+		}
+	})
+}
+
+// ListProductRevisions .
+// @router /admin/products/{id}/revisions [GET]
+func ListProductRevisions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.ListProductRevisionsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取商品ID
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid product ID")
+		return
+	}
+	req.ProductId = id
+	page, _ := strconv.ParseInt(c.Query("page"), 10, 32)
+	pageSize, _ := strconv.ParseInt(c.Query("page_size"), 10, 32)
+	req.Page, req.PageSize = int32(page), int32(pageSize)
+
+	// 调用商品服务查询商品变更历史
+	resp, err := rpc.ProductClient.ListProductRevisions(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RestoreProduct .
+// @router /admin/products/{id}/restore [POST]
+func RestoreProduct(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.RestoreProductReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取商品ID
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid product ID")
+		return
+	}
+	req.ProductId = id
+
+	// 调用商品服务恢复商品
+	resp, err := rpc.ProductClient.RestoreProduct(ctx, &req)
+	if err != nil {
+		utils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
@@ -0,0 +1,91 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/app/server"
+	"github.com/cloudwego/hertz/pkg/common/ut"
+	"github.com/cloudwego/kitex/client/callopt"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"zqzqsb.com/gomall/common/identity"
+	mw "zqzqsb/gomall/app/gateway/biz/router/middleware"
+	"zqzqsb/gomall/app/gateway/infra/rpc"
+	product "zqzqsb/gomall/app/gateway/kitex_gen/product"
+	"zqzqsb/gomall/app/gateway/kitex_gen/product/productservice"
+)
+
+// fakeProductClient 只实现 UpdateProduct，记录网关透传的用户身份
+type fakeProductClient struct {
+	productservice.Client
+	err    error
+	userID int64
+	req    *product.UpdateProductReq
+}
+
+func (f *fakeProductClient) UpdateProduct(ctx context.Context, req *product.UpdateProductReq, _ ...callopt.Option) (*product.UpdateProductResp, error) {
+	f.userID, _ = identity.GetUserID(ctx)
+	f.req = req
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &product.UpdateProductResp{}, nil
+}
+
+func TestUpdateProduct(t *testing.T) {
+	tests := []struct {
+		name       string
+		path       string
+		err        error
+		wantStatus int
+		wantBody   string
+	}{
+		{"ok", "/admin/products/7", nil, http.StatusOK, ""},
+		{"invalid id", "/admin/products/abc", nil, http.StatusBadRequest, "Invalid product ID"},
+		{"version conflict", "/admin/products/7", kerrors.NewBizStatusError(http.StatusConflict, "version conflict"), http.StatusConflict, "version conflict"},
+		{"biz error", "/admin/products/7", kerrors.NewBizStatusError(1, "permission denied"), http.StatusInternalServerError, "permission denied"},
+		{"unavailable", "/admin/products/7", kerrors.ErrNoMoreInstance, http.StatusServiceUnavailable, ""},
+		{"other error", "/admin/products/7", errors.New("boom"), http.StatusInternalServerError, "boom"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeProductClient{err: tt.err}
+			old := rpc.ProductClient
+			rpc.ProductClient = client
+			defer func() { rpc.ProductClient = old }()
+
+			h := server.New()
+			// 代替 JWT 中间件写入解析出的身份
+			setIdentity := func(ctx context.Context, c *app.RequestContext) {
+				c.Set(mw.IdentityKey, int64(42))
+				c.Next(ctx)
+			}
+			h.PUT("/admin/products/:id", setIdentity, mw.IdentityMiddleware(), UpdateProduct)
+
+			w := ut.PerformRequest(h.Engine, http.MethodPut, tt.path, &ut.Body{},
+				ut.Header{Key: "Content-Type", Value: "application/json"})
+			resp := w.Result()
+			if got := resp.StatusCode(); got != tt.wantStatus {
+				t.Fatalf("got status %d, want %d, body %s", got, tt.wantStatus, resp.Body())
+			}
+			if tt.wantBody != "" && string(resp.Body()) != tt.wantBody {
+				t.Errorf("got body %q, want %q", resp.Body(), tt.wantBody)
+			}
+			if tt.wantStatus == http.StatusBadRequest {
+				if client.req != nil {
+					t.Error("expect request rejected before calling product service")
+				}
+				return
+			}
+			if client.userID != 42 {
+				t.Errorf("forwarded user %d, want 42", client.userID)
+			}
+			if client.req.Id != 7 {
+				t.Errorf("got product id %d, want 7", client.req.Id)
+			}
+		})
+	}
+}
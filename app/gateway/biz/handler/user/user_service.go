@@ -0,0 +1,779 @@
+// Code generated by hertz generator.
+
+package user
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/hertz-contrib/jwt"
+	"zqzqsb.com/gomall/common/clientsuite"
+	bizutils "zqzqsb/gomall/app/gateway/biz/utils"
+	"zqzqsb/gomall/app/gateway/infra/rpc"
+	user "zqzqsb/gomall/app/gateway/kitex_gen/user"
+)
+
+// Register .
+// @router /register [POST]
+func Register(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.RegisterReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用用户服务进行注册
+	resp, err := rpc.UserClient.Register(ctx, &req)
+	if err != nil {
+		bizutils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// Login .
+// @router /login [POST]
+func Login(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.LoginReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := rpc.UserClient.Login(ctx, &req)
+	if err != nil {
+		sendLoginFailed(c, err)
+		return
+	}
+	// 开启二次验证时不下发令牌 cookie，客户端需要携带 mfa_token 调用 /login/mfa
+	if resp.MfaRequired {
+		c.JSON(consts.StatusOK, mfaResponse(resp))
+		return
+	}
+
+	setTokenCookies(c, resp.Token)
+	c.JSON(consts.StatusOK, tokenResponse(resp.Token))
+}
+
+// VerifyMFA .
+// @router /login/mfa [POST]
+func VerifyMFA(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.VerifyMFAReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := rpc.UserClient.VerifyMFA(ctx, &req)
+	if err != nil {
+		sendLoginFailed(c, err)
+		return
+	}
+
+	setTokenCookies(c, resp.Token)
+	c.JSON(consts.StatusOK, tokenResponse(resp.Token))
+}
+
+// RefreshToken .
+// @router /refresh [POST]
+func RefreshToken(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.RefreshTokenReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+	// 未在请求体中携带时从 cookie 中读取刷新令牌
+	if req.RefreshToken == "" {
+		req.RefreshToken = string(c.Cookie(refreshCookieName))
+	}
+
+	resp, err := rpc.UserClient.RefreshToken(ctx, &req)
+	if err != nil {
+		sendUnauthorized(c, "", err)
+		return
+	}
+
+	setTokenCookies(c, resp.Token)
+	c.JSON(consts.StatusOK, tokenResponse(resp.Token))
+}
+
+// Logout .
+// @router /logout [POST]
+func Logout(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.LogoutReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+	// 访问令牌已由 JWT 中间件校验，刷新令牌未在请求体中携带时从 cookie 中读取
+	req.AccessToken = jwt.GetToken(ctx, c)
+	if req.RefreshToken == "" {
+		req.RefreshToken = string(c.Cookie(refreshCookieName))
+	}
+
+	resp, err := rpc.UserClient.Logout(ctx, &req)
+	if err != nil {
+		bizutils.SendRPCError(c, err)
+		return
+	}
+
+	clearTokenCookies(c)
+	c.JSON(consts.StatusOK, resp)
+}
+
+// Hello .
+// @router /hello [GET]
+func Hello(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.HelloReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := rpc.UserClient.Hello(ctx, &req)
+	if err != nil {
+		bizutils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetProfile .
+// @router /user/profile [GET]
+func GetProfile(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.GetProfileReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用用户服务查询用户资料
+	resp, err := rpc.UserClient.GetProfile(ctx, &req)
+	if err != nil {
+		bizutils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// UpdateProfile .
+// @router /user/profile [PUT]
+func UpdateProfile(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.UpdateProfileReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用用户服务更新用户资料
+	resp, err := rpc.UserClient.UpdateProfile(ctx, &req)
+	if err != nil {
+		bizutils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ListAddresses .
+// @router /user/addresses [GET]
+func ListAddresses(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.ListAddressesReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用用户服务查询收货地址列表
+	resp, err := rpc.UserClient.ListAddresses(ctx, &req)
+	if err != nil {
+		bizutils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetAddress .
+// @router /user/addresses/{address_id} [GET]
+func GetAddress(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.GetAddressReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取收货地址ID
+	id, err := strconv.ParseInt(c.Param("address_id"), 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid address ID")
+		return
+	}
+	req.AddressId = id
+
+	// 调用用户服务查询收货地址
+	resp, err := rpc.UserClient.GetAddress(ctx, &req)
+	if err != nil {
+		bizutils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// CreateAddress .
+// @router /user/addresses [POST]
+func CreateAddress(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.CreateAddressReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用用户服务新增收货地址
+	resp, err := rpc.UserClient.CreateAddress(ctx, &req)
+	if err != nil {
+		bizutils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// UpdateAddress .
+// @router /user/addresses/{address_id} [PUT]
+func UpdateAddress(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.UpdateAddressReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取收货地址ID
+	id, err := strconv.ParseInt(c.Param("address_id"), 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid address ID")
+		return
+	}
+	req.AddressId = id
+
+	// 调用用户服务修改收货地址
+	resp, err := rpc.UserClient.UpdateAddress(ctx, &req)
+	if err != nil {
+		bizutils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// DeleteAddress .
+// @router /user/addresses/{address_id} [DELETE]
+func DeleteAddress(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.DeleteAddressReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取收货地址ID
+	id, err := strconv.ParseInt(c.Param("address_id"), 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid address ID")
+		return
+	}
+	req.AddressId = id
+
+	// 调用用户服务删除收货地址
+	resp, err := rpc.UserClient.DeleteAddress(ctx, &req)
+	if err != nil {
+		bizutils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// SetDefaultAddress .
+// @router /user/addresses/{address_id}/default [POST]
+func SetDefaultAddress(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.SetDefaultAddressReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取收货地址ID
+	id, err := strconv.ParseInt(c.Param("address_id"), 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid address ID")
+		return
+	}
+	req.AddressId = id
+
+	// 调用用户服务设置默认收货地址
+	resp, err := rpc.UserClient.SetDefaultAddress(ctx, &req)
+	if err != nil {
+		bizutils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// SendVerificationEmail .
+// @router /email/verification [POST]
+func SendVerificationEmail(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.SendVerificationEmailReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用用户服务发送邮箱验证邮件
+	resp, err := rpc.UserClient.SendVerificationEmail(ctx, &req)
+	if err != nil {
+		bizutils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// VerifyEmail .
+// @router /email/verify [POST]
+func VerifyEmail(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.VerifyEmailReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用用户服务验证邮箱
+	resp, err := rpc.UserClient.VerifyEmail(ctx, &req)
+	if err != nil {
+		bizutils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RequestPasswordReset .
+// @router /password/forgot [POST]
+func RequestPasswordReset(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.RequestPasswordResetReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用用户服务发送重置密码邮件
+	resp, err := rpc.UserClient.RequestPasswordReset(ctx, &req)
+	if err != nil {
+		bizutils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ResetPassword .
+// @router /password/reset [POST]
+func ResetPassword(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.ResetPasswordReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用用户服务重置密码
+	resp, err := rpc.UserClient.ResetPassword(ctx, &req)
+	if err != nil {
+		bizutils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// sendUnauthorized 登录和刷新令牌失败时返回 401，用户服务不可用时仍返回 503
+// sendThrottled 用户服务对连续失败的账号或 IP 限流时返回 429，返回 false 表示不是限流错误
+func sendThrottled(c *app.RequestContext, prefix string, err error) bool {
+	bizErr, ok := kerrors.FromBizStatusError(err)
+	if !ok || bizErr.BizStatusCode() != consts.StatusTooManyRequests {
+		return false
+	}
+	c.Header("Retry-After", bizErr.BizExtra()["retry_after"])
+	c.JSON(consts.StatusTooManyRequests, utils.H{
+		"code":    consts.StatusTooManyRequests,
+		"message": prefix + bizErr.BizMessage(),
+	})
+	return true
+}
+
+func sendLoginFailed(c *app.RequestContext, err error) {
+	if !sendThrottled(c, "Login failed: ", err) {
+		sendUnauthorized(c, "Login failed: ", err)
+	}
+}
+
+func sendUnauthorized(c *app.RequestContext, prefix string, err error) {
+	if clientsuite.Degradable(err) {
+		bizutils.SendRPCError(c, err)
+		return
+	}
+	c.JSON(consts.StatusUnauthorized, utils.H{
+		"code":    consts.StatusUnauthorized,
+		"message": prefix + bizutils.ErrorMessage(err),
+	})
+}
+
+// GetMFAStatus .
+// @router /user/mfa [GET]
+func GetMFAStatus(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.GetMFAStatusReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用用户服务查询二次验证状态
+	resp, err := rpc.UserClient.GetMFAStatus(ctx, &req)
+	if err != nil {
+		bizutils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// EnrollMFA .
+// @router /user/mfa/enroll [POST]
+func EnrollMFA(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.EnrollMFAReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用用户服务生成待绑定的密钥
+	resp, err := rpc.UserClient.EnrollMFA(ctx, &req)
+	if err != nil {
+		bizutils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ActivateMFA .
+// @router /user/mfa/activate [POST]
+func ActivateMFA(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.ActivateMFAReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用用户服务确认绑定
+	resp, err := rpc.UserClient.ActivateMFA(ctx, &req)
+	if err != nil {
+		bizutils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// DisableMFA .
+// @router /user/mfa/disable [POST]
+func DisableMFA(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.DisableMFAReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用用户服务关闭二次验证
+	resp, err := rpc.UserClient.DisableMFA(ctx, &req)
+	if err != nil {
+		if !sendThrottled(c, "", err) {
+			bizutils.SendRPCError(c, err)
+		}
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RegenerateRecoveryCodes .
+// @router /user/mfa/recovery_codes [POST]
+func RegenerateRecoveryCodes(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.RegenerateRecoveryCodesReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用用户服务重新生成恢复码
+	resp, err := rpc.UserClient.RegenerateRecoveryCodes(ctx, &req)
+	if err != nil {
+		if !sendThrottled(c, "", err) {
+			bizutils.SendRPCError(c, err)
+		}
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ListPolicies .
+// @router /admin/policies [GET]
+func ListPolicies(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.ListPoliciesReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+	req.Subject = c.Query("subject")
+
+	// 调用用户服务查询权限策略，是否为管理员由用户服务校验
+	resp, err := rpc.UserClient.ListPolicies(ctx, &req)
+	if err != nil {
+		bizutils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// AddPolicy .
+// @router /admin/policies [POST]
+func AddPolicy(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.AddPolicyReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用用户服务添加权限策略，是否为管理员由用户服务校验
+	resp, err := rpc.UserClient.AddPolicy(ctx, &req)
+	if err != nil {
+		bizutils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RemovePolicy .
+// @router /admin/policies [DELETE]
+func RemovePolicy(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.RemovePolicyReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用用户服务删除权限策略，是否为管理员由用户服务校验
+	resp, err := rpc.UserClient.RemovePolicy(ctx, &req)
+	if err != nil {
+		bizutils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ListRoles .
+// @router /admin/roles [GET]
+func ListRoles(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.ListRolesReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+	req.Subject = c.Query("subject")
+
+	// 调用用户服务查询角色授予关系，是否为管理员由用户服务校验
+	resp, err := rpc.UserClient.ListRoles(ctx, &req)
+	if err != nil {
+		bizutils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// AssignRole .
+// @router /admin/roles [POST]
+func AssignRole(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.AssignRoleReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用用户服务授予角色，是否为管理员由用户服务校验
+	resp, err := rpc.UserClient.AssignRole(ctx, &req)
+	if err != nil {
+		bizutils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RevokeRole .
+// @router /admin/roles [DELETE]
+func RevokeRole(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.RevokeRoleReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用用户服务撤销角色，是否为管理员由用户服务校验
+	resp, err := rpc.UserClient.RevokeRole(ctx, &req)
+	if err != nil {
+		bizutils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ListBlacklist .
+// @router /admin/blacklist [GET]
+func ListBlacklist(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.ListBlacklistReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用用户服务查询黑名单，是否为管理员由用户服务校验
+	resp, err := rpc.UserClient.ListBlacklist(ctx, &req)
+	if err != nil {
+		bizutils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// AddToBlacklist .
+// @router /admin/blacklist [POST]
+func AddToBlacklist(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.AddToBlacklistReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用用户服务将用户加入黑名单，是否为管理员由用户服务校验
+	resp, err := rpc.UserClient.AddToBlacklist(ctx, &req)
+	if err != nil {
+		bizutils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RemoveFromBlacklist .
+// @router /admin/blacklist/:user_id [DELETE]
+func RemoveFromBlacklist(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.RemoveFromBlacklistReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+	id, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid user ID")
+		return
+	}
+	req.UserId = id
+
+	// 调用用户服务将用户移出黑名单，是否为管理员由用户服务校验
+	resp, err := rpc.UserClient.RemoveFromBlacklist(ctx, &req)
+	if err != nil {
+		bizutils.SendRPCError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
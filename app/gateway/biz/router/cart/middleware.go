@@ -0,0 +1,56 @@
+// Code generated by hertz generator.
+
+package cart
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+	mw "zqzqsb/gomall/app/gateway/biz/router/middleware"
+)
+
+func rootMw() []app.HandlerFunc {
+	// 购物车接口全部需要登录，网关校验 JWT 后将用户身份透传给购物车服务
+	return []app.HandlerFunc{
+		mw.JwtMiddleware.MiddlewareFunc(),
+		mw.IdentityMiddleware(),
+	}
+}
+
+func _clearcartMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getcartMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _cartMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _addtocartMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _itemsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _removefromcartMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updatecartitemMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _selectcartitemsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
@@ -0,0 +1,93 @@
+package mw
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+	"github.com/hertz-contrib/jwt"
+	"zqzqsb.com/gomall/common/identity"
+	"zqzqsb.com/gomall/common/jwtauth"
+	"zqzqsb/gomall/app/gateway/biz/dal/redis"
+	"zqzqsb/gomall/app/gateway/conf"
+)
+
+var (
+	JwtMiddleware *jwt.HertzJWTMiddleware // JWT 中间件实例
+	IdentityKey   = "identity"            // 与用户服务保持一致的身份 key
+)
+
+// InitJwt 初始化 JWT 中间件
+// 网关只校验用户服务签发的访问令牌，校验通过后将用户身份透传给下游服务，下游不再重复校验
+func InitJwt() {
+	var err error
+	JwtMiddleware, err = jwt.New(&jwt.HertzJWTMiddleware{
+		Realm:         "gomall",
+		Key:           []byte(conf.GetConf().Jwt.Secret),
+		TokenLookup:   "header: Authorization, cookie: jwt",
+		TokenHeadName: "Bearer",
+		IdentityKey:   IdentityKey,
+		// 从 JWT 载荷中提取用户身份信息
+		IdentityHandler: func(ctx context.Context, c *app.RequestContext) interface{} {
+			claims := jwt.ExtractClaims(ctx, c)
+			// JWT 解析数字通常为 float64
+			f64, ok := claims[IdentityKey].(float64)
+			if !ok {
+				return nil
+			}
+			return int64(f64)
+		},
+		// 拒绝刷新令牌、等待二次验证的令牌和已吊销的访问令牌
+		Authorizator: jwtauth.Authorizator(redis.RedisClient),
+		HTTPStatusMessageFunc: func(e error, ctx context.Context, c *app.RequestContext) string {
+			hlog.CtxErrorf(ctx, "jwt biz err = %+v", e.Error())
+			return e.Error()
+		},
+		Unauthorized: func(ctx context.Context, c *app.RequestContext, code int, message string) {
+			c.JSON(http.StatusUnauthorized, utils.H{
+				"code":    code,
+				"message": message,
+			})
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// IdentityMiddleware 将 JWT 中的用户身份写入元信息，随 RPC 透传到下游服务
+func IdentityMiddleware() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		userID, ok := c.Get(IdentityKey)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, utils.H{
+				"code":    http.StatusUnauthorized,
+				"message": "no identity in token",
+			})
+			return
+		}
+		id, ok := userID.(int64)
+		if !ok || id <= 0 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, utils.H{
+				"code":    http.StatusUnauthorized,
+				"message": "invalid identity in token",
+			})
+			return
+		}
+		c.Next(identity.WithUserID(ctx, id))
+	}
+}
+
+// ClientInfoMiddleware 将客户端 IP 和 User-Agent 写入元信息，随 RPC 透传到下游服务
+// 客户端 IP 由 main 中挂载的 clientip.New 解析，只有可信代理的 X-Forwarded-For 才会被采信
+func ClientInfoMiddleware() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		ctx = identity.WithClientIP(ctx, c.ClientIP())
+		if ua := c.UserAgent(); len(ua) > 0 {
+			ctx = identity.WithUserAgent(ctx, string(ua))
+		}
+		c.Next(ctx)
+	}
+}
@@ -0,0 +1,98 @@
+package mw
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/bytedance/gopkg/cloud/metainfo"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/app/server"
+	"github.com/cloudwego/hertz/pkg/common/ut"
+	"zqzqsb.com/gomall/common/clientip"
+	"zqzqsb.com/gomall/common/identity"
+)
+
+func TestIdentityMiddleware(t *testing.T) {
+	tests := []struct {
+		name       string
+		identity   interface{} // nil 表示 JWT 中间件没有写入身份
+		wantStatus int
+		wantUserID int64
+	}{
+		{"valid", int64(42), http.StatusOK, 42},
+		{"missing", nil, http.StatusUnauthorized, 0},
+		{"zero", int64(0), http.StatusUnauthorized, 0},
+		{"negative", int64(-1), http.StatusUnauthorized, 0},
+		{"wrong type", "42", http.StatusUnauthorized, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var forwarded int64
+			h := server.New()
+			// 代替 JWT 中间件写入解析出的身份
+			setIdentity := func(ctx context.Context, c *app.RequestContext) {
+				if tt.identity != nil {
+					c.Set(IdentityKey, tt.identity)
+				}
+				c.Next(ctx)
+			}
+			h.GET("/me", setIdentity, IdentityMiddleware(), func(ctx context.Context, c *app.RequestContext) {
+				forwarded, _ = identity.GetUserID(ctx)
+				c.Status(http.StatusOK)
+			})
+
+			w := ut.PerformRequest(h.Engine, http.MethodGet, "/me", nil)
+			if got := w.Result().StatusCode(); got != tt.wantStatus {
+				t.Errorf("got status %d, want %d", got, tt.wantStatus)
+			}
+			if forwarded != tt.wantUserID {
+				t.Errorf("forwarded user %d, want %d", forwarded, tt.wantUserID)
+			}
+		})
+	}
+}
+
+func TestClientInfoMiddleware(t *testing.T) {
+	var ip, ua string
+	h := server.New()
+	h.GET("/ping", ClientInfoMiddleware(), func(ctx context.Context, c *app.RequestContext) {
+		ip, _ = metainfo.GetPersistentValue(ctx, identity.ClientIPKey)
+		ua, _ = metainfo.GetPersistentValue(ctx, identity.UserAgentKey)
+	})
+	ut.PerformRequest(h.Engine, http.MethodGet, "/ping", nil, ut.Header{Key: "User-Agent", Value: "gomall-test"})
+	if ip == "" {
+		t.Error("expect client ip forwarded")
+	}
+	if ua != "gomall-test" {
+		t.Errorf("got user agent %q, want gomall-test", ua)
+	}
+}
+
+func TestClientInfoMiddlewareIgnoresForgedForwardedFor(t *testing.T) {
+	clientIP, err := clientip.New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var direct, forged string
+	h := server.New()
+	// ut.PerformRequest 不经过 Engine 的 context 池，代替 main 中的 SetClientIPFunc 挂载
+	setClientIP := func(ctx context.Context, c *app.RequestContext) {
+		c.SetClientIPFunc(clientIP)
+		c.Next(ctx)
+	}
+	h.GET("/ping", setClientIP, ClientInfoMiddleware(), func(ctx context.Context, c *app.RequestContext) {
+		ip, _ := metainfo.GetPersistentValue(ctx, identity.ClientIPKey)
+		if c.Request.Header.Get("X-Forwarded-For") == "" {
+			direct = ip
+		} else {
+			forged = ip
+		}
+	})
+	ut.PerformRequest(h.Engine, http.MethodGet, "/ping", nil)
+	ut.PerformRequest(h.Engine, http.MethodGet, "/ping", nil,
+		ut.Header{Key: "X-Forwarded-For", Value: "1.2.3.4"}, ut.Header{Key: "X-Real-IP", Value: "1.2.3.4"})
+	if direct == "" || forged != direct {
+		t.Errorf("forged X-Forwarded-For changed client ip from %q to %q", direct, forged)
+	}
+}
@@ -0,0 +1,27 @@
+package mw
+
+import (
+	"sync"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"zqzqsb.com/gomall/common/ratelimit"
+	"zqzqsb/gomall/app/gateway/biz/dal/redis"
+	"zqzqsb/gomall/app/gateway/conf"
+)
+
+var (
+	authRateLimit     app.HandlerFunc
+	authRateLimitOnce sync.Once
+)
+
+// AuthRateLimit /login、/register、/refresh 共用的分布式令牌桶，
+// 与用户服务使用同一个 Redis 和 key 前缀，经网关和直接访问用户服务的请求共享同一份配额
+func AuthRateLimit() app.HandlerFunc {
+	authRateLimitOnce.Do(initAuthRateLimit)
+	return authRateLimit
+}
+
+func initAuthRateLimit() {
+	bucket := ratelimit.NewRedisTokenBucket(redis.RedisClient, "ratelimit:auth", conf.GetConf().RateLimit.Auth)
+	authRateLimit = ratelimit.NewRedisHertzMiddleware(bucket, ratelimit.KeyByIdentityOrIP(IdentityKey))
+}
@@ -0,0 +1,65 @@
+// Code generated by hertz generator.
+
+package pay
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+	mw "zqzqsb/gomall/app/gateway/biz/router/middleware"
+)
+
+// authMw 需要登录的接口，用户身份从 JWT 中获取
+func authMw() []app.HandlerFunc {
+	return []app.HandlerFunc{
+		mw.JwtMiddleware.MiddlewareFunc(),
+		mw.IdentityMiddleware(),
+	}
+}
+
+func rootMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createpaymentMw() []app.HandlerFunc {
+	return authMw()
+}
+
+func _paymentsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _handlepaymentcallbackMw() []app.HandlerFunc {
+	// 渠道回调不携带用户身份，依赖回调签名校验
+	return nil
+}
+
+func _createpaymentfromcartMw() []app.HandlerFunc {
+	return authMw()
+}
+
+func _querypaymentMw() []app.HandlerFunc {
+	return authMw()
+}
+
+func _payment_idMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _cancelpaymentMw() []app.HandlerFunc {
+	return authMw()
+}
+
+func _refundpaymentMw() []app.HandlerFunc {
+	return authMw()
+}
+
+func _userMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getuserpaymentsMw() []app.HandlerFunc {
+	return authMw()
+}
@@ -0,0 +1,126 @@
+// Code generated by hertz generator.
+
+package product
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+	mw "zqzqsb/gomall/app/gateway/biz/router/middleware"
+)
+
+func rootMw() []app.HandlerFunc {
+	// 商品浏览接口无需登录
+	return nil
+}
+
+func _productsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listproductsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getcategoriesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _adminMw() []app.HandlerFunc {
+	// 商品管理接口需要登录，网关校验 JWT 后将用户身份透传给商品服务，由商品服务校验管理角色
+	return []app.HandlerFunc{
+		mw.JwtMiddleware.MiddlewareFunc(),
+		mw.IdentityMiddleware(),
+	}
+}
+
+func _products0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createproductMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updateproductMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deleteproductMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _products1Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getproductMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _idMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _setproductoptionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _saveskusMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _skusMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deleteskuMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createcategoryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _categoriesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deletecategoryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updatecategoryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _searchproductsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _restoreproductMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listproductrevisionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
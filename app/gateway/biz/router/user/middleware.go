@@ -0,0 +1,223 @@
+// Code generated by hertz generator.
+
+package user
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+	mw "zqzqsb/gomall/app/gateway/biz/router/middleware"
+)
+
+// authMw 需要登录的接口，网关校验 JWT 后将用户身份透传给用户服务
+func authMw() []app.HandlerFunc {
+	return []app.HandlerFunc{
+		mw.JwtMiddleware.MiddlewareFunc(),
+		mw.IdentityMiddleware(),
+	}
+}
+
+func rootMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _helloMw() []app.HandlerFunc {
+	return authMw()
+}
+
+func _loginMw() []app.HandlerFunc {
+	// 防暴力破解，与用户服务的 /login 使用相同的限流策略
+	return []app.HandlerFunc{mw.AuthRateLimit()}
+}
+
+func _logoutMw() []app.HandlerFunc {
+	return authMw()
+}
+
+func _refreshtokenMw() []app.HandlerFunc {
+	// 访问令牌可能已过期，不校验 JWT，只限制刷新令牌的尝试频率
+	return []app.HandlerFunc{mw.AuthRateLimit()}
+}
+
+func _registerMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.AuthRateLimit()}
+}
+
+func _userMw() []app.HandlerFunc {
+	// 用户资料和收货地址只能操作当前登录用户自己的数据
+	return authMw()
+}
+
+func _listaddressesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _addressesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deleteaddressMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getaddressMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updateaddressMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _address_idMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _setdefaultaddressMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createaddressMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getprofileMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updateprofileMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _emailMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _sendverificationemailMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _verifyemailMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _passwordMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _requestpasswordresetMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _resetpasswordMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _login0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _verifymfaMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getmfastatusMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _mfaMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _enrollmfaMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _activatemfaMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _disablemfaMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _regeneraterecoverycodesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _adminMw() []app.HandlerFunc {
+	// 权限管理接口，用户服务校验当前用户拥有 admin 角色
+	return authMw()
+}
+
+func _listpoliciesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _addpolicyMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _removepolicyMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listrolesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _assignroleMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _revokeroleMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listblacklistMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _addtoblacklistMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _blacklistMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _removefromblacklistMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
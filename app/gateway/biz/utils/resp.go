@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"errors"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/remote/trans/nphttp2/status"
+	"zqzqsb.com/gomall/common/clientsuite"
+)
+
+// SendRPCError 返回下游调用的错误，下游不可用时返回 503，其余错误返回 500
+func SendRPCError(c *app.RequestContext, err error) {
+	if clientsuite.Degradable(err) {
+		c.String(consts.StatusServiceUnavailable, err.Error())
+		return
+	}
+	c.String(consts.StatusInternalServerError, ErrorMessage(err))
+}
+
+// ErrorMessage 提取下游服务返回的错误信息，去掉 Kitex 包装的前缀
+func ErrorMessage(err error) string {
+	var bizErr kerrors.BizStatusErrorIface
+	if errors.As(err, &bizErr) {
+		return bizErr.BizMessage()
+	}
+	if s, ok := status.FromError(err); ok {
+		return s.Message()
+	}
+	return err.Error()
+}
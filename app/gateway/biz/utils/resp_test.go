@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/remote/trans/nphttp2/codes"
+	"github.com/cloudwego/kitex/pkg/remote/trans/nphttp2/status"
+)
+
+func TestSendRPCError(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantBody   string
+	}{
+		{"biz error", kerrors.NewBizStatusError(1001, "stock not enough"), consts.StatusInternalServerError, "stock not enough"},
+		{"wrapped biz error", kerrors.ErrRemoteOrNetwork.WithCause(kerrors.NewBizStatusError(1001, "stock not enough")), consts.StatusInternalServerError, "stock not enough"},
+		{"grpc status", status.Err(codes.NotFound, "product not found"), consts.StatusInternalServerError, "product not found"},
+		{"plain error", errors.New("boom"), consts.StatusInternalServerError, "boom"},
+		{"timeout", kerrors.ErrRPCTimeout, consts.StatusServiceUnavailable, kerrors.ErrRPCTimeout.Error()},
+		{"circuit break", kerrors.ErrCircuitBreak.WithCause(errors.New("open")), consts.StatusServiceUnavailable, ""},
+		{"no instance", kerrors.ErrNoMoreInstance, consts.StatusServiceUnavailable, kerrors.ErrNoMoreInstance.Error()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := app.NewContext(0)
+			SendRPCError(c, tt.err)
+			if got := c.Response.StatusCode(); got != tt.wantStatus {
+				t.Errorf("got status %d, want %d", got, tt.wantStatus)
+			}
+			if tt.wantBody != "" && string(c.Response.Body()) != tt.wantBody {
+				t.Errorf("got body %q, want %q", c.Response.Body(), tt.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package conf
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/kr/pretty"
+	"gopkg.in/validator.v2"
+	"gopkg.in/yaml.v2"
+	"zqzqsb.com/gomall/common/clientsuite"
+	"zqzqsb.com/gomall/common/ratelimit"
+)
+
+var (
+	conf *Config
+	once sync.Once
+)
+
+type Config struct {
+	Env            string
+	Hertz          Hertz             `yaml:"hertz"`
+	Redis          Redis             `yaml:"redis"`
+	Registry       Registry          `yaml:"registry"`
+	Jwt            Jwt               `yaml:"jwt"`
+	RateLimit      RateLimit         `yaml:"rate_limit"`
+	UserService    DownstreamService `yaml:"user_service"`
+	ProductService DownstreamService `yaml:"product_service"`
+	CartService    DownstreamService `yaml:"cart_service"`
+	OrderService   DownstreamService `yaml:"order_service"`
+	PaymentService DownstreamService `yaml:"payment_service"`
+}
+
+type Hertz struct {
+	Service       string   `yaml:"service"`
+	Address       string   `yaml:"address"`
+	AllowOrigins  []string `yaml:"allow_origins"` // 允许跨域访问的前端地址
+	LogLevel      string   `yaml:"log_level"`
+	LogFileName   string   `yaml:"log_file_name"`
+	LogMaxSize    int      `yaml:"log_max_size"`
+	LogMaxBackups int      `yaml:"log_max_backups"`
+	LogMaxAge     int      `yaml:"log_max_age"`
+	// TrustedProxies 网关前的可信代理（IP 或 CIDR），只有来自这些地址的 X-Forwarded-For 才被采信
+	TrustedProxies []string `yaml:"trusted_proxies"`
+}
+
+// Redis 与用户服务共用，网关只读取令牌黑名单和令牌版本
+type Redis struct {
+	Address  string `yaml:"address"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	DB       int    `yaml:"db"`
+}
+
+type Registry struct {
+	RegistryAddress []string `yaml:"registry_address"`
+	Username        string   `yaml:"username"`
+	Password        string   `yaml:"password"`
+}
+
+// Address 首个注册中心地址，未配置时返回空
+func (r Registry) Address() string {
+	if len(r.RegistryAddress) == 0 {
+		return ""
+	}
+	return r.RegistryAddress[0]
+}
+
+// Jwt 与用户服务签发 JWT 时使用的配置保持一致
+type Jwt struct {
+	Secret string `yaml:"secret"`
+}
+
+// RateLimit 限流配置，Auth 为 /login、/register、/refresh 的分布式令牌桶，与用户服务的 auth 规则保持一致
+type RateLimit struct {
+	Auth ratelimit.Rule `yaml:"auth"`
+}
+
+// DownstreamService 下游服务的地址和调用策略，未配置地址时通过 Consul 发现服务
+type DownstreamService struct {
+	Address []string           `yaml:"address"`
+	Policy  clientsuite.Policy `yaml:"policy"`
+}
+
+// GetConf gets configuration instance
+func GetConf() *Config {
+	once.Do(initConf)
+	return conf
+}
+
+func initConf() {
+	prefix := "conf"
+	confFileRelPath := filepath.Join(prefix, filepath.Join(GetEnv(), "conf.yaml"))
+	content, err := ioutil.ReadFile(confFileRelPath)
+	if err != nil {
+		panic(err)
+	}
+	conf = new(Config)
+	err = yaml.Unmarshal(content, conf)
+	if err != nil {
+		hlog.Error("parse yaml error - %v", err)
+		panic(err)
+	}
+	if err := validator.Validate(conf); err != nil {
+		hlog.Error("validate config error - %v", err)
+		panic(err)
+	}
+	conf.Env = GetEnv()
+	pretty.Printf("%+v\n", conf)
+}
+
+func GetEnv() string {
+	e := os.Getenv("GO_ENV")
+	if len(e) == 0 {
+		return "test"
+	}
+	return e
+}
+
+func LogLevel() hlog.Level {
+	level := GetConf().Hertz.LogLevel
+	switch level {
+	case "trace":
+		return hlog.LevelTrace
+	case "debug":
+		return hlog.LevelDebug
+	case "info":
+		return hlog.LevelInfo
+	case "notice":
+		return hlog.LevelNotice
+	case "warn":
+		return hlog.LevelWarn
+	case "error":
+		return hlog.LevelError
+	case "fatal":
+		return hlog.LevelFatal
+	default:
+		return hlog.LevelInfo
+	}
+}
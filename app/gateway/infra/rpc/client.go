@@ -0,0 +1,69 @@
+package rpc
+
+import (
+	"sync"
+
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/transmeta"
+	"zqzqsb.com/gomall/common/clientsuite"
+	"zqzqsb/gomall/app/gateway/conf"
+	"zqzqsb/gomall/app/gateway/kitex_gen/cart/cartservice"
+	"zqzqsb/gomall/app/gateway/kitex_gen/order/orderservice"
+	"zqzqsb/gomall/app/gateway/kitex_gen/pay/paymentservice"
+	"zqzqsb/gomall/app/gateway/kitex_gen/product/productservice"
+	"zqzqsb/gomall/app/gateway/kitex_gen/user/userservice"
+)
+
+var (
+	UserClient    userservice.Client
+	ProductClient productservice.Client
+	CartClient    cartservice.Client
+	OrderClient   orderservice.Client
+	PaymentClient paymentservice.Client
+	once          sync.Once
+	err           error
+)
+
+// InitClient 初始化下游服务客户端
+func InitClient() {
+	once.Do(func() {
+		UserClient, err = userservice.NewClient("user", options(conf.GetConf().UserService)...)
+		if err != nil {
+			panic(err)
+		}
+		ProductClient, err = productservice.NewClient("product", options(conf.GetConf().ProductService)...)
+		if err != nil {
+			panic(err)
+		}
+		CartClient, err = cartservice.NewClient("cart", options(conf.GetConf().CartService)...)
+		if err != nil {
+			panic(err)
+		}
+		OrderClient, err = orderservice.NewClient("order", options(conf.GetConf().OrderService)...)
+		if err != nil {
+			panic(err)
+		}
+		PaymentClient, err = paymentservice.NewClient("payment", options(conf.GetConf().PaymentService)...)
+		if err != nil {
+			panic(err)
+		}
+	})
+}
+
+// options 配置了下游地址时直连，否则通过 Consul 发现服务
+func options(svc conf.DownstreamService) []client.Option {
+	opts := []client.Option{
+		// gRPC 传输下需要显式开启元信息透传，用户身份才能带到下游
+		client.WithMetaHandler(transmeta.ClientHTTP2Handler),
+	}
+	suite := clientsuite.CommonClientSuite{
+		CurrentServiceName: conf.GetConf().Hertz.Service,
+		Policy:             svc.Policy,
+	}
+	if len(svc.Address) > 0 {
+		opts = append(opts, client.WithHostPorts(svc.Address...))
+	} else {
+		suite.RegisteryAddr = conf.GetConf().Registry.Address()
+	}
+	return append(opts, suite.Options()...)
+}
@@ -0,0 +1,79 @@
+// Code generated by Kitex v0.9.1. DO NOT EDIT.
+
+package cartservice
+
+import (
+	"context"
+	client "github.com/cloudwego/kitex/client"
+	callopt "github.com/cloudwego/kitex/client/callopt"
+	cart "zqzqsb/gomall/app/gateway/kitex_gen/cart"
+)
+
+// Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
+type Client interface {
+	AddToCart(ctx context.Context, Req *cart.AddToCartReq, callOptions ...callopt.Option) (r *cart.AddToCartResp, err error)
+	UpdateCartItem(ctx context.Context, Req *cart.UpdateCartItemReq, callOptions ...callopt.Option) (r *cart.UpdateCartItemResp, err error)
+	RemoveFromCart(ctx context.Context, Req *cart.RemoveFromCartReq, callOptions ...callopt.Option) (r *cart.RemoveFromCartResp, err error)
+	GetCart(ctx context.Context, Req *cart.GetCartReq, callOptions ...callopt.Option) (r *cart.GetCartResp, err error)
+	ClearCart(ctx context.Context, Req *cart.ClearCartReq, callOptions ...callopt.Option) (r *cart.ClearCartResp, err error)
+	SelectCartItems(ctx context.Context, Req *cart.SelectCartItemsReq, callOptions ...callopt.Option) (r *cart.SelectCartItemsResp, err error)
+}
+
+// NewClient creates a client for the service defined in IDL.
+func NewClient(destService string, opts ...client.Option) (Client, error) {
+	var options []client.Option
+	options = append(options, client.WithDestService(destService))
+
+	options = append(options, opts...)
+
+	kc, err := client.NewClient(serviceInfo(), options...)
+	if err != nil {
+		return nil, err
+	}
+	return &kCartServiceClient{
+		kClient: newServiceClient(kc),
+	}, nil
+}
+
+// MustNewClient creates a client for the service defined in IDL. It panics if any error occurs.
+func MustNewClient(destService string, opts ...client.Option) Client {
+	kc, err := NewClient(destService, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return kc
+}
+
+type kCartServiceClient struct {
+	*kClient
+}
+
+func (p *kCartServiceClient) AddToCart(ctx context.Context, Req *cart.AddToCartReq, callOptions ...callopt.Option) (r *cart.AddToCartResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AddToCart(ctx, Req)
+}
+
+func (p *kCartServiceClient) UpdateCartItem(ctx context.Context, Req *cart.UpdateCartItemReq, callOptions ...callopt.Option) (r *cart.UpdateCartItemResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateCartItem(ctx, Req)
+}
+
+func (p *kCartServiceClient) RemoveFromCart(ctx context.Context, Req *cart.RemoveFromCartReq, callOptions ...callopt.Option) (r *cart.RemoveFromCartResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RemoveFromCart(ctx, Req)
+}
+
+func (p *kCartServiceClient) GetCart(ctx context.Context, Req *cart.GetCartReq, callOptions ...callopt.Option) (r *cart.GetCartResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetCart(ctx, Req)
+}
+
+func (p *kCartServiceClient) ClearCart(ctx context.Context, Req *cart.ClearCartReq, callOptions ...callopt.Option) (r *cart.ClearCartResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ClearCart(ctx, Req)
+}
+
+func (p *kCartServiceClient) SelectCartItems(ctx context.Context, Req *cart.SelectCartItemsReq, callOptions ...callopt.Option) (r *cart.SelectCartItemsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SelectCartItems(ctx, Req)
+}
@@ -0,0 +1,2762 @@
+// Code generated by Fastpb v0.0.2. DO NOT EDIT.
+
+package order
+
+import (
+	fmt "fmt"
+	fastpb "github.com/cloudwego/fastpb"
+	api "zqzqsb/gomall/app/gateway/kitex_gen/api"
+)
+
+var (
+	_ = fmt.Errorf
+	_ = fastpb.Skip
+)
+
+func (x *ShippingAddress) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ShippingAddress[number], err)
+}
+
+func (x *ShippingAddress) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.AddressId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ShippingAddress) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ShippingAddress) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Phone, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ShippingAddress) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Province, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ShippingAddress) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.City, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ShippingAddress) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.District, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ShippingAddress) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.Detail, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ShippingAddress) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.ZipCode, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *OrderItem) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_OrderItem[number], err)
+}
+
+func (x *OrderItem) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Id, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *OrderItem) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.ProductId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *OrderItem) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.ProductName, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *OrderItem) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.ProductImage, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *OrderItem) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Price, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *OrderItem) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.Quantity, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *OrderItem) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.Subtotal, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *OrderItem) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	if x.SelectedAttributes == nil {
+		x.SelectedAttributes = make(map[string]string)
+	}
+	var key string
+	var value string
+	offset, err = fastpb.ReadMapEntry(buf, _type,
+		func(buf []byte, _type int8) (offset int, err error) {
+			key, offset, err = fastpb.ReadString(buf, _type)
+			return offset, err
+		},
+		func(buf []byte, _type int8) (offset int, err error) {
+			value, offset, err = fastpb.ReadString(buf, _type)
+			return offset, err
+		})
+	if err != nil {
+		return offset, err
+	}
+	x.SelectedAttributes[key] = value
+	return offset, nil
+}
+
+func (x *Order) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 9:
+		offset, err = x.fastReadField9(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 10:
+		offset, err = x.fastReadField10(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 11:
+		offset, err = x.fastReadField11(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 12:
+		offset, err = x.fastReadField12(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 13:
+		offset, err = x.fastReadField13(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 14:
+		offset, err = x.fastReadField14(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 15:
+		offset, err = x.fastReadField15(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 16:
+		offset, err = x.fastReadField16(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 17:
+		offset, err = x.fastReadField17(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 18:
+		offset, err = x.fastReadField18(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 19:
+		offset, err = x.fastReadField19(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_Order[number], err)
+}
+
+func (x *Order) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Id, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Order) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.OrderNo, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Order) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Order) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	var v int32
+	v, offset, err = fastpb.ReadInt32(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.Status = OrderStatus(v)
+	return offset, nil
+}
+
+func (x *Order) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.TotalAmount, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Order) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	var v OrderItem
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Items = append(x.Items, &v)
+	return offset, nil
+}
+
+func (x *Order) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	var v ShippingAddress
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.ShippingAddress = &v
+	return offset, nil
+}
+
+func (x *Order) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.Remark, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Order) fastReadField9(buf []byte, _type int8) (offset int, err error) {
+	x.PaymentId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Order) fastReadField10(buf []byte, _type int8) (offset int, err error) {
+	x.Carrier, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Order) fastReadField11(buf []byte, _type int8) (offset int, err error) {
+	x.TrackingNo, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Order) fastReadField12(buf []byte, _type int8) (offset int, err error) {
+	x.CancelReason, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Order) fastReadField13(buf []byte, _type int8) (offset int, err error) {
+	x.CreateTime, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Order) fastReadField14(buf []byte, _type int8) (offset int, err error) {
+	x.UpdateTime, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Order) fastReadField15(buf []byte, _type int8) (offset int, err error) {
+	x.ExpireTime, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Order) fastReadField16(buf []byte, _type int8) (offset int, err error) {
+	x.PayTime, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Order) fastReadField17(buf []byte, _type int8) (offset int, err error) {
+	x.ShipTime, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Order) fastReadField18(buf []byte, _type int8) (offset int, err error) {
+	x.CompleteTime, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Order) fastReadField19(buf []byte, _type int8) (offset int, err error) {
+	x.CancelTime, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *CreateOrderReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CreateOrderReq[number], err)
+}
+
+func (x *CreateOrderReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	offset, err = fastpb.ReadList(buf, _type,
+		func(buf []byte, _type int8) (n int, err error) {
+			var v int64
+			v, offset, err = fastpb.ReadInt64(buf, _type)
+			if err != nil {
+				return offset, err
+			}
+			x.CartItemIds = append(x.CartItemIds, v)
+			return offset, err
+		})
+	return offset, err
+}
+
+func (x *CreateOrderReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v ShippingAddress
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.ShippingAddress = &v
+	return offset, nil
+}
+
+func (x *CreateOrderReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Remark, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateOrderResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CreateOrderResp[number], err)
+}
+
+func (x *CreateOrderResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Order
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Order = &v
+	return offset, nil
+}
+
+func (x *GetOrderReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetOrderReq[number], err)
+}
+
+func (x *GetOrderReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *GetOrderResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetOrderResp[number], err)
+}
+
+func (x *GetOrderResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Order
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Order = &v
+	return offset, nil
+}
+
+func (x *ListUserOrdersReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListUserOrdersReq[number], err)
+}
+
+func (x *ListUserOrdersReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Page, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ListUserOrdersReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.PageSize, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ListUserOrdersReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	var v int32
+	v, offset, err = fastpb.ReadInt32(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.Status = OrderStatus(v)
+	return offset, nil
+}
+
+func (x *ListOrdersResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListOrdersResp[number], err)
+}
+
+func (x *ListOrdersResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Order
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Orders = append(x.Orders, &v)
+	return offset, nil
+}
+
+func (x *ListOrdersResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Total, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ListOrdersResp) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Page, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ListOrdersResp) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.PageSize, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *CancelOrderReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CancelOrderReq[number], err)
+}
+
+func (x *CancelOrderReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *CancelOrderReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Reason, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CancelOrderResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CancelOrderResp[number], err)
+}
+
+func (x *CancelOrderResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *ConfirmReceiptReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ConfirmReceiptReq[number], err)
+}
+
+func (x *ConfirmReceiptReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ConfirmReceiptResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ConfirmReceiptResp[number], err)
+}
+
+func (x *ConfirmReceiptResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *MarkOrderPaidReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_MarkOrderPaidReq[number], err)
+}
+
+func (x *MarkOrderPaidReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *MarkOrderPaidReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.PaymentId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *MarkOrderPaidReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Amount, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *MarkOrderPaidResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_MarkOrderPaidResp[number], err)
+}
+
+func (x *MarkOrderPaidResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *ShipOrderReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ShipOrderReq[number], err)
+}
+
+func (x *ShipOrderReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ShipOrderReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Carrier, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ShipOrderReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.TrackingNo, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ShipOrderResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ShipOrderResp[number], err)
+}
+
+func (x *ShipOrderResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *ListOrdersReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListOrdersReq[number], err)
+}
+
+func (x *ListOrdersReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Page, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ListOrdersReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.PageSize, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ListOrdersReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	var v int32
+	v, offset, err = fastpb.ReadInt32(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.Status = OrderStatus(v)
+	return offset, nil
+}
+
+func (x *ListOrdersReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *MarkOrderRefundedReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_MarkOrderRefundedReq[number], err)
+}
+
+func (x *MarkOrderRefundedReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *MarkOrderRefundedReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.PaymentId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *MarkOrderRefundedReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Amount, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *MarkOrderRefundedResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_MarkOrderRefundedResp[number], err)
+}
+
+func (x *MarkOrderRefundedResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *ShippingAddress) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	return offset
+}
+
+func (x *ShippingAddress) fastWriteField1(buf []byte) (offset int) {
+	if x.AddressId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetAddressId())
+	return offset
+}
+
+func (x *ShippingAddress) fastWriteField2(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetName())
+	return offset
+}
+
+func (x *ShippingAddress) fastWriteField3(buf []byte) (offset int) {
+	if x.Phone == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetPhone())
+	return offset
+}
+
+func (x *ShippingAddress) fastWriteField4(buf []byte) (offset int) {
+	if x.Province == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetProvince())
+	return offset
+}
+
+func (x *ShippingAddress) fastWriteField5(buf []byte) (offset int) {
+	if x.City == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetCity())
+	return offset
+}
+
+func (x *ShippingAddress) fastWriteField6(buf []byte) (offset int) {
+	if x.District == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 6, x.GetDistrict())
+	return offset
+}
+
+func (x *ShippingAddress) fastWriteField7(buf []byte) (offset int) {
+	if x.Detail == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 7, x.GetDetail())
+	return offset
+}
+
+func (x *ShippingAddress) fastWriteField8(buf []byte) (offset int) {
+	if x.ZipCode == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 8, x.GetZipCode())
+	return offset
+}
+
+func (x *OrderItem) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	return offset
+}
+
+func (x *OrderItem) fastWriteField1(buf []byte) (offset int) {
+	if x.Id == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetId())
+	return offset
+}
+
+func (x *OrderItem) fastWriteField2(buf []byte) (offset int) {
+	if x.ProductId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetProductId())
+	return offset
+}
+
+func (x *OrderItem) fastWriteField3(buf []byte) (offset int) {
+	if x.ProductName == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetProductName())
+	return offset
+}
+
+func (x *OrderItem) fastWriteField4(buf []byte) (offset int) {
+	if x.ProductImage == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetProductImage())
+	return offset
+}
+
+func (x *OrderItem) fastWriteField5(buf []byte) (offset int) {
+	if x.Price == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 5, x.GetPrice())
+	return offset
+}
+
+func (x *OrderItem) fastWriteField6(buf []byte) (offset int) {
+	if x.Quantity == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 6, x.GetQuantity())
+	return offset
+}
+
+func (x *OrderItem) fastWriteField7(buf []byte) (offset int) {
+	if x.Subtotal == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 7, x.GetSubtotal())
+	return offset
+}
+
+func (x *OrderItem) fastWriteField8(buf []byte) (offset int) {
+	if x.SelectedAttributes == nil {
+		return offset
+	}
+	for k, v := range x.GetSelectedAttributes() {
+		offset += fastpb.WriteMapEntry(buf[offset:], 8,
+			func(buf []byte, numTagOrKey, numIdxOrVal int32) int {
+				offset := 0
+				offset += fastpb.WriteString(buf[offset:], numTagOrKey, k)
+				offset += fastpb.WriteString(buf[offset:], numIdxOrVal, v)
+				return offset
+			})
+	}
+	return offset
+}
+
+func (x *Order) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	offset += x.fastWriteField10(buf[offset:])
+	offset += x.fastWriteField11(buf[offset:])
+	offset += x.fastWriteField12(buf[offset:])
+	offset += x.fastWriteField13(buf[offset:])
+	offset += x.fastWriteField14(buf[offset:])
+	offset += x.fastWriteField15(buf[offset:])
+	offset += x.fastWriteField16(buf[offset:])
+	offset += x.fastWriteField17(buf[offset:])
+	offset += x.fastWriteField18(buf[offset:])
+	offset += x.fastWriteField19(buf[offset:])
+	return offset
+}
+
+func (x *Order) fastWriteField1(buf []byte) (offset int) {
+	if x.Id == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetId())
+	return offset
+}
+
+func (x *Order) fastWriteField2(buf []byte) (offset int) {
+	if x.OrderNo == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetOrderNo())
+	return offset
+}
+
+func (x *Order) fastWriteField3(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetUserId())
+	return offset
+}
+
+func (x *Order) fastWriteField4(buf []byte) (offset int) {
+	if x.Status == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 4, int32(x.GetStatus()))
+	return offset
+}
+
+func (x *Order) fastWriteField5(buf []byte) (offset int) {
+	if x.TotalAmount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 5, x.GetTotalAmount())
+	return offset
+}
+
+func (x *Order) fastWriteField6(buf []byte) (offset int) {
+	if x.Items == nil {
+		return offset
+	}
+	for i := range x.GetItems() {
+		offset += fastpb.WriteMessage(buf[offset:], 6, x.GetItems()[i])
+	}
+	return offset
+}
+
+func (x *Order) fastWriteField7(buf []byte) (offset int) {
+	if x.ShippingAddress == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 7, x.GetShippingAddress())
+	return offset
+}
+
+func (x *Order) fastWriteField8(buf []byte) (offset int) {
+	if x.Remark == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 8, x.GetRemark())
+	return offset
+}
+
+func (x *Order) fastWriteField9(buf []byte) (offset int) {
+	if x.PaymentId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 9, x.GetPaymentId())
+	return offset
+}
+
+func (x *Order) fastWriteField10(buf []byte) (offset int) {
+	if x.Carrier == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 10, x.GetCarrier())
+	return offset
+}
+
+func (x *Order) fastWriteField11(buf []byte) (offset int) {
+	if x.TrackingNo == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 11, x.GetTrackingNo())
+	return offset
+}
+
+func (x *Order) fastWriteField12(buf []byte) (offset int) {
+	if x.CancelReason == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 12, x.GetCancelReason())
+	return offset
+}
+
+func (x *Order) fastWriteField13(buf []byte) (offset int) {
+	if x.CreateTime == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 13, x.GetCreateTime())
+	return offset
+}
+
+func (x *Order) fastWriteField14(buf []byte) (offset int) {
+	if x.UpdateTime == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 14, x.GetUpdateTime())
+	return offset
+}
+
+func (x *Order) fastWriteField15(buf []byte) (offset int) {
+	if x.ExpireTime == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 15, x.GetExpireTime())
+	return offset
+}
+
+func (x *Order) fastWriteField16(buf []byte) (offset int) {
+	if x.PayTime == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 16, x.GetPayTime())
+	return offset
+}
+
+func (x *Order) fastWriteField17(buf []byte) (offset int) {
+	if x.ShipTime == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 17, x.GetShipTime())
+	return offset
+}
+
+func (x *Order) fastWriteField18(buf []byte) (offset int) {
+	if x.CompleteTime == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 18, x.GetCompleteTime())
+	return offset
+}
+
+func (x *Order) fastWriteField19(buf []byte) (offset int) {
+	if x.CancelTime == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 19, x.GetCancelTime())
+	return offset
+}
+
+func (x *CreateOrderReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *CreateOrderReq) fastWriteField1(buf []byte) (offset int) {
+	if len(x.CartItemIds) == 0 {
+		return offset
+	}
+	offset += fastpb.WriteListPacked(buf[offset:], 1, len(x.GetCartItemIds()),
+		func(buf []byte, numTagOrKey, numIdxOrVal int32) int {
+			offset := 0
+			offset += fastpb.WriteInt64(buf[offset:], numTagOrKey, x.GetCartItemIds()[numIdxOrVal])
+			return offset
+		})
+	return offset
+}
+
+func (x *CreateOrderReq) fastWriteField2(buf []byte) (offset int) {
+	if x.ShippingAddress == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetShippingAddress())
+	return offset
+}
+
+func (x *CreateOrderReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Remark == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetRemark())
+	return offset
+}
+
+func (x *CreateOrderResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *CreateOrderResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Order == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetOrder())
+	return offset
+}
+
+func (x *GetOrderReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *GetOrderReq) fastWriteField1(buf []byte) (offset int) {
+	if x.OrderId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetOrderId())
+	return offset
+}
+
+func (x *GetOrderResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *GetOrderResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Order == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetOrder())
+	return offset
+}
+
+func (x *ListUserOrdersReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *ListUserOrdersReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Page == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.GetPage())
+	return offset
+}
+
+func (x *ListUserOrdersReq) fastWriteField2(buf []byte) (offset int) {
+	if x.PageSize == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 2, x.GetPageSize())
+	return offset
+}
+
+func (x *ListUserOrdersReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Status == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 3, int32(x.GetStatus()))
+	return offset
+}
+
+func (x *ListOrdersResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *ListOrdersResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Orders == nil {
+		return offset
+	}
+	for i := range x.GetOrders() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetOrders()[i])
+	}
+	return offset
+}
+
+func (x *ListOrdersResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Total == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 2, x.GetTotal())
+	return offset
+}
+
+func (x *ListOrdersResp) fastWriteField3(buf []byte) (offset int) {
+	if x.Page == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 3, x.GetPage())
+	return offset
+}
+
+func (x *ListOrdersResp) fastWriteField4(buf []byte) (offset int) {
+	if x.PageSize == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 4, x.GetPageSize())
+	return offset
+}
+
+func (x *CancelOrderReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *CancelOrderReq) fastWriteField1(buf []byte) (offset int) {
+	if x.OrderId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetOrderId())
+	return offset
+}
+
+func (x *CancelOrderReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Reason == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetReason())
+	return offset
+}
+
+func (x *CancelOrderResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *CancelOrderResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *ConfirmReceiptReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ConfirmReceiptReq) fastWriteField1(buf []byte) (offset int) {
+	if x.OrderId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetOrderId())
+	return offset
+}
+
+func (x *ConfirmReceiptResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ConfirmReceiptResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *MarkOrderPaidReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *MarkOrderPaidReq) fastWriteField1(buf []byte) (offset int) {
+	if x.OrderId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetOrderId())
+	return offset
+}
+
+func (x *MarkOrderPaidReq) fastWriteField2(buf []byte) (offset int) {
+	if x.PaymentId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetPaymentId())
+	return offset
+}
+
+func (x *MarkOrderPaidReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Amount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetAmount())
+	return offset
+}
+
+func (x *MarkOrderPaidResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *MarkOrderPaidResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *ShipOrderReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *ShipOrderReq) fastWriteField1(buf []byte) (offset int) {
+	if x.OrderId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetOrderId())
+	return offset
+}
+
+func (x *ShipOrderReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Carrier == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetCarrier())
+	return offset
+}
+
+func (x *ShipOrderReq) fastWriteField3(buf []byte) (offset int) {
+	if x.TrackingNo == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetTrackingNo())
+	return offset
+}
+
+func (x *ShipOrderResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ShipOrderResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *ListOrdersReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *ListOrdersReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Page == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.GetPage())
+	return offset
+}
+
+func (x *ListOrdersReq) fastWriteField2(buf []byte) (offset int) {
+	if x.PageSize == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 2, x.GetPageSize())
+	return offset
+}
+
+func (x *ListOrdersReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Status == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 3, int32(x.GetStatus()))
+	return offset
+}
+
+func (x *ListOrdersReq) fastWriteField4(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 4, x.GetUserId())
+	return offset
+}
+
+func (x *MarkOrderRefundedReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *MarkOrderRefundedReq) fastWriteField1(buf []byte) (offset int) {
+	if x.OrderId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetOrderId())
+	return offset
+}
+
+func (x *MarkOrderRefundedReq) fastWriteField2(buf []byte) (offset int) {
+	if x.PaymentId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetPaymentId())
+	return offset
+}
+
+func (x *MarkOrderRefundedReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Amount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetAmount())
+	return offset
+}
+
+func (x *MarkOrderRefundedResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *MarkOrderRefundedResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *ShippingAddress) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	return n
+}
+
+func (x *ShippingAddress) sizeField1() (n int) {
+	if x.AddressId == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetAddressId())
+	return n
+}
+
+func (x *ShippingAddress) sizeField2() (n int) {
+	if x.Name == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetName())
+	return n
+}
+
+func (x *ShippingAddress) sizeField3() (n int) {
+	if x.Phone == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetPhone())
+	return n
+}
+
+func (x *ShippingAddress) sizeField4() (n int) {
+	if x.Province == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetProvince())
+	return n
+}
+
+func (x *ShippingAddress) sizeField5() (n int) {
+	if x.City == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.GetCity())
+	return n
+}
+
+func (x *ShippingAddress) sizeField6() (n int) {
+	if x.District == "" {
+		return n
+	}
+	n += fastpb.SizeString(6, x.GetDistrict())
+	return n
+}
+
+func (x *ShippingAddress) sizeField7() (n int) {
+	if x.Detail == "" {
+		return n
+	}
+	n += fastpb.SizeString(7, x.GetDetail())
+	return n
+}
+
+func (x *ShippingAddress) sizeField8() (n int) {
+	if x.ZipCode == "" {
+		return n
+	}
+	n += fastpb.SizeString(8, x.GetZipCode())
+	return n
+}
+
+func (x *OrderItem) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	return n
+}
+
+func (x *OrderItem) sizeField1() (n int) {
+	if x.Id == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetId())
+	return n
+}
+
+func (x *OrderItem) sizeField2() (n int) {
+	if x.ProductId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetProductId())
+	return n
+}
+
+func (x *OrderItem) sizeField3() (n int) {
+	if x.ProductName == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetProductName())
+	return n
+}
+
+func (x *OrderItem) sizeField4() (n int) {
+	if x.ProductImage == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetProductImage())
+	return n
+}
+
+func (x *OrderItem) sizeField5() (n int) {
+	if x.Price == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(5, x.GetPrice())
+	return n
+}
+
+func (x *OrderItem) sizeField6() (n int) {
+	if x.Quantity == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(6, x.GetQuantity())
+	return n
+}
+
+func (x *OrderItem) sizeField7() (n int) {
+	if x.Subtotal == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(7, x.GetSubtotal())
+	return n
+}
+
+func (x *OrderItem) sizeField8() (n int) {
+	if x.SelectedAttributes == nil {
+		return n
+	}
+	for k, v := range x.GetSelectedAttributes() {
+		n += fastpb.SizeMapEntry(8,
+			func(numTagOrKey, numIdxOrVal int32) int {
+				n := 0
+				n += fastpb.SizeString(numTagOrKey, k)
+				n += fastpb.SizeString(numIdxOrVal, v)
+				return n
+			})
+	}
+	return n
+}
+
+func (x *Order) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
+	n += x.sizeField10()
+	n += x.sizeField11()
+	n += x.sizeField12()
+	n += x.sizeField13()
+	n += x.sizeField14()
+	n += x.sizeField15()
+	n += x.sizeField16()
+	n += x.sizeField17()
+	n += x.sizeField18()
+	n += x.sizeField19()
+	return n
+}
+
+func (x *Order) sizeField1() (n int) {
+	if x.Id == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetId())
+	return n
+}
+
+func (x *Order) sizeField2() (n int) {
+	if x.OrderNo == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetOrderNo())
+	return n
+}
+
+func (x *Order) sizeField3() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.GetUserId())
+	return n
+}
+
+func (x *Order) sizeField4() (n int) {
+	if x.Status == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(4, int32(x.GetStatus()))
+	return n
+}
+
+func (x *Order) sizeField5() (n int) {
+	if x.TotalAmount == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(5, x.GetTotalAmount())
+	return n
+}
+
+func (x *Order) sizeField6() (n int) {
+	if x.Items == nil {
+		return n
+	}
+	for i := range x.GetItems() {
+		n += fastpb.SizeMessage(6, x.GetItems()[i])
+	}
+	return n
+}
+
+func (x *Order) sizeField7() (n int) {
+	if x.ShippingAddress == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(7, x.GetShippingAddress())
+	return n
+}
+
+func (x *Order) sizeField8() (n int) {
+	if x.Remark == "" {
+		return n
+	}
+	n += fastpb.SizeString(8, x.GetRemark())
+	return n
+}
+
+func (x *Order) sizeField9() (n int) {
+	if x.PaymentId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(9, x.GetPaymentId())
+	return n
+}
+
+func (x *Order) sizeField10() (n int) {
+	if x.Carrier == "" {
+		return n
+	}
+	n += fastpb.SizeString(10, x.GetCarrier())
+	return n
+}
+
+func (x *Order) sizeField11() (n int) {
+	if x.TrackingNo == "" {
+		return n
+	}
+	n += fastpb.SizeString(11, x.GetTrackingNo())
+	return n
+}
+
+func (x *Order) sizeField12() (n int) {
+	if x.CancelReason == "" {
+		return n
+	}
+	n += fastpb.SizeString(12, x.GetCancelReason())
+	return n
+}
+
+func (x *Order) sizeField13() (n int) {
+	if x.CreateTime == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(13, x.GetCreateTime())
+	return n
+}
+
+func (x *Order) sizeField14() (n int) {
+	if x.UpdateTime == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(14, x.GetUpdateTime())
+	return n
+}
+
+func (x *Order) sizeField15() (n int) {
+	if x.ExpireTime == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(15, x.GetExpireTime())
+	return n
+}
+
+func (x *Order) sizeField16() (n int) {
+	if x.PayTime == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(16, x.GetPayTime())
+	return n
+}
+
+func (x *Order) sizeField17() (n int) {
+	if x.ShipTime == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(17, x.GetShipTime())
+	return n
+}
+
+func (x *Order) sizeField18() (n int) {
+	if x.CompleteTime == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(18, x.GetCompleteTime())
+	return n
+}
+
+func (x *Order) sizeField19() (n int) {
+	if x.CancelTime == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(19, x.GetCancelTime())
+	return n
+}
+
+func (x *CreateOrderReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *CreateOrderReq) sizeField1() (n int) {
+	if len(x.CartItemIds) == 0 {
+		return n
+	}
+	n += fastpb.SizeListPacked(1, len(x.GetCartItemIds()),
+		func(numTagOrKey, numIdxOrVal int32) int {
+			n := 0
+			n += fastpb.SizeInt64(numTagOrKey, x.GetCartItemIds()[numIdxOrVal])
+			return n
+		})
+	return n
+}
+
+func (x *CreateOrderReq) sizeField2() (n int) {
+	if x.ShippingAddress == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(2, x.GetShippingAddress())
+	return n
+}
+
+func (x *CreateOrderReq) sizeField3() (n int) {
+	if x.Remark == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetRemark())
+	return n
+}
+
+func (x *CreateOrderResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *CreateOrderResp) sizeField1() (n int) {
+	if x.Order == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetOrder())
+	return n
+}
+
+func (x *GetOrderReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *GetOrderReq) sizeField1() (n int) {
+	if x.OrderId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetOrderId())
+	return n
+}
+
+func (x *GetOrderResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *GetOrderResp) sizeField1() (n int) {
+	if x.Order == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetOrder())
+	return n
+}
+
+func (x *ListUserOrdersReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *ListUserOrdersReq) sizeField1() (n int) {
+	if x.Page == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.GetPage())
+	return n
+}
+
+func (x *ListUserOrdersReq) sizeField2() (n int) {
+	if x.PageSize == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(2, x.GetPageSize())
+	return n
+}
+
+func (x *ListUserOrdersReq) sizeField3() (n int) {
+	if x.Status == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(3, int32(x.GetStatus()))
+	return n
+}
+
+func (x *ListOrdersResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *ListOrdersResp) sizeField1() (n int) {
+	if x.Orders == nil {
+		return n
+	}
+	for i := range x.GetOrders() {
+		n += fastpb.SizeMessage(1, x.GetOrders()[i])
+	}
+	return n
+}
+
+func (x *ListOrdersResp) sizeField2() (n int) {
+	if x.Total == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(2, x.GetTotal())
+	return n
+}
+
+func (x *ListOrdersResp) sizeField3() (n int) {
+	if x.Page == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(3, x.GetPage())
+	return n
+}
+
+func (x *ListOrdersResp) sizeField4() (n int) {
+	if x.PageSize == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(4, x.GetPageSize())
+	return n
+}
+
+func (x *CancelOrderReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *CancelOrderReq) sizeField1() (n int) {
+	if x.OrderId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetOrderId())
+	return n
+}
+
+func (x *CancelOrderReq) sizeField2() (n int) {
+	if x.Reason == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetReason())
+	return n
+}
+
+func (x *CancelOrderResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *CancelOrderResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
+func (x *ConfirmReceiptReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ConfirmReceiptReq) sizeField1() (n int) {
+	if x.OrderId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetOrderId())
+	return n
+}
+
+func (x *ConfirmReceiptResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ConfirmReceiptResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
+func (x *MarkOrderPaidReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *MarkOrderPaidReq) sizeField1() (n int) {
+	if x.OrderId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetOrderId())
+	return n
+}
+
+func (x *MarkOrderPaidReq) sizeField2() (n int) {
+	if x.PaymentId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetPaymentId())
+	return n
+}
+
+func (x *MarkOrderPaidReq) sizeField3() (n int) {
+	if x.Amount == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.GetAmount())
+	return n
+}
+
+func (x *MarkOrderPaidResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *MarkOrderPaidResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
+func (x *ShipOrderReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *ShipOrderReq) sizeField1() (n int) {
+	if x.OrderId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetOrderId())
+	return n
+}
+
+func (x *ShipOrderReq) sizeField2() (n int) {
+	if x.Carrier == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetCarrier())
+	return n
+}
+
+func (x *ShipOrderReq) sizeField3() (n int) {
+	if x.TrackingNo == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetTrackingNo())
+	return n
+}
+
+func (x *ShipOrderResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ShipOrderResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
+func (x *ListOrdersReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *ListOrdersReq) sizeField1() (n int) {
+	if x.Page == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.GetPage())
+	return n
+}
+
+func (x *ListOrdersReq) sizeField2() (n int) {
+	if x.PageSize == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(2, x.GetPageSize())
+	return n
+}
+
+func (x *ListOrdersReq) sizeField3() (n int) {
+	if x.Status == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(3, int32(x.GetStatus()))
+	return n
+}
+
+func (x *ListOrdersReq) sizeField4() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(4, x.GetUserId())
+	return n
+}
+
+func (x *MarkOrderRefundedReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *MarkOrderRefundedReq) sizeField1() (n int) {
+	if x.OrderId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetOrderId())
+	return n
+}
+
+func (x *MarkOrderRefundedReq) sizeField2() (n int) {
+	if x.PaymentId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetPaymentId())
+	return n
+}
+
+func (x *MarkOrderRefundedReq) sizeField3() (n int) {
+	if x.Amount == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.GetAmount())
+	return n
+}
+
+func (x *MarkOrderRefundedResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *MarkOrderRefundedResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
+var fieldIDToName_ShippingAddress = map[int32]string{
+	1: "AddressId",
+	2: "Name",
+	3: "Phone",
+	4: "Province",
+	5: "City",
+	6: "District",
+	7: "Detail",
+	8: "ZipCode",
+}
+
+var fieldIDToName_OrderItem = map[int32]string{
+	1: "Id",
+	2: "ProductId",
+	3: "ProductName",
+	4: "ProductImage",
+	5: "Price",
+	6: "Quantity",
+	7: "Subtotal",
+	8: "SelectedAttributes",
+}
+
+var fieldIDToName_Order = map[int32]string{
+	1:  "Id",
+	2:  "OrderNo",
+	3:  "UserId",
+	4:  "Status",
+	5:  "TotalAmount",
+	6:  "Items",
+	7:  "ShippingAddress",
+	8:  "Remark",
+	9:  "PaymentId",
+	10: "Carrier",
+	11: "TrackingNo",
+	12: "CancelReason",
+	13: "CreateTime",
+	14: "UpdateTime",
+	15: "ExpireTime",
+	16: "PayTime",
+	17: "ShipTime",
+	18: "CompleteTime",
+	19: "CancelTime",
+}
+
+var fieldIDToName_CreateOrderReq = map[int32]string{
+	1: "CartItemIds",
+	2: "ShippingAddress",
+	3: "Remark",
+}
+
+var fieldIDToName_CreateOrderResp = map[int32]string{
+	1: "Order",
+}
+
+var fieldIDToName_GetOrderReq = map[int32]string{
+	1: "OrderId",
+}
+
+var fieldIDToName_GetOrderResp = map[int32]string{
+	1: "Order",
+}
+
+var fieldIDToName_ListUserOrdersReq = map[int32]string{
+	1: "Page",
+	2: "PageSize",
+	3: "Status",
+}
+
+var fieldIDToName_ListOrdersResp = map[int32]string{
+	1: "Orders",
+	2: "Total",
+	3: "Page",
+	4: "PageSize",
+}
+
+var fieldIDToName_CancelOrderReq = map[int32]string{
+	1: "OrderId",
+	2: "Reason",
+}
+
+var fieldIDToName_CancelOrderResp = map[int32]string{
+	1: "Success",
+}
+
+var fieldIDToName_ConfirmReceiptReq = map[int32]string{
+	1: "OrderId",
+}
+
+var fieldIDToName_ConfirmReceiptResp = map[int32]string{
+	1: "Success",
+}
+
+var fieldIDToName_MarkOrderPaidReq = map[int32]string{
+	1: "OrderId",
+	2: "PaymentId",
+	3: "Amount",
+}
+
+var fieldIDToName_MarkOrderPaidResp = map[int32]string{
+	1: "Success",
+}
+
+var fieldIDToName_ShipOrderReq = map[int32]string{
+	1: "OrderId",
+	2: "Carrier",
+	3: "TrackingNo",
+}
+
+var fieldIDToName_ShipOrderResp = map[int32]string{
+	1: "Success",
+}
+
+var fieldIDToName_ListOrdersReq = map[int32]string{
+	1: "Page",
+	2: "PageSize",
+	3: "Status",
+	4: "UserId",
+}
+
+var fieldIDToName_MarkOrderRefundedReq = map[int32]string{
+	1: "OrderId",
+	2: "PaymentId",
+	3: "Amount",
+}
+
+var fieldIDToName_MarkOrderRefundedResp = map[int32]string{
+	1: "Success",
+}
+
+var _ = api.File_api_proto
@@ -0,0 +1,24 @@
+// Code generated by Kitex v0.9.1. DO NOT EDIT.
+package paymentservice
+
+import (
+	server "github.com/cloudwego/kitex/server"
+	pay "zqzqsb/gomall/app/gateway/kitex_gen/pay"
+)
+
+// NewServer creates a server.Server with the given handler and options.
+func NewServer(handler pay.PaymentService, opts ...server.Option) server.Server {
+	var options []server.Option
+
+	options = append(options, opts...)
+
+	svr := server.NewServer(options...)
+	if err := svr.RegisterService(serviceInfo(), handler); err != nil {
+		panic(err)
+	}
+	return svr
+}
+
+func RegisterService(svr server.Server, handler pay.PaymentService, opts ...server.RegisterOption) error {
+	return svr.RegisterService(serviceInfo(), handler, opts...)
+}
@@ -0,0 +1,3711 @@
+// Code generated by Kitex v0.9.1. DO NOT EDIT.
+
+package productservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	client "github.com/cloudwego/kitex/client"
+	kitex "github.com/cloudwego/kitex/pkg/serviceinfo"
+	streaming "github.com/cloudwego/kitex/pkg/streaming"
+	proto "google.golang.org/protobuf/proto"
+	product "zqzqsb/gomall/app/gateway/kitex_gen/product"
+)
+
+var errInvalidMessageType = errors.New("invalid message type for service method handler")
+
+var serviceMethods = map[string]kitex.MethodInfo{
+	"CreateProduct": kitex.NewMethodInfo(
+		createProductHandler,
+		newCreateProductArgs,
+		newCreateProductResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"UpdateProduct": kitex.NewMethodInfo(
+		updateProductHandler,
+		newUpdateProductArgs,
+		newUpdateProductResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"GetProduct": kitex.NewMethodInfo(
+		getProductHandler,
+		newGetProductArgs,
+		newGetProductResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"DeleteProduct": kitex.NewMethodInfo(
+		deleteProductHandler,
+		newDeleteProductArgs,
+		newDeleteProductResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"SetProductOptions": kitex.NewMethodInfo(
+		setProductOptionsHandler,
+		newSetProductOptionsArgs,
+		newSetProductOptionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"SaveSkus": kitex.NewMethodInfo(
+		saveSkusHandler,
+		newSaveSkusArgs,
+		newSaveSkusResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"DeleteSku": kitex.NewMethodInfo(
+		deleteSkuHandler,
+		newDeleteSkuArgs,
+		newDeleteSkuResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListProductRevisions": kitex.NewMethodInfo(
+		listProductRevisionsHandler,
+		newListProductRevisionsArgs,
+		newListProductRevisionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RestoreProduct": kitex.NewMethodInfo(
+		restoreProductHandler,
+		newRestoreProductArgs,
+		newRestoreProductResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListProducts": kitex.NewMethodInfo(
+		listProductsHandler,
+		newListProductsArgs,
+		newListProductsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"GetCategories": kitex.NewMethodInfo(
+		getCategoriesHandler,
+		newGetCategoriesArgs,
+		newGetCategoriesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"SearchProducts": kitex.NewMethodInfo(
+		searchProductsHandler,
+		newSearchProductsArgs,
+		newSearchProductsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"CreateCategory": kitex.NewMethodInfo(
+		createCategoryHandler,
+		newCreateCategoryArgs,
+		newCreateCategoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"UpdateCategory": kitex.NewMethodInfo(
+		updateCategoryHandler,
+		newUpdateCategoryArgs,
+		newUpdateCategoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"DeleteCategory": kitex.NewMethodInfo(
+		deleteCategoryHandler,
+		newDeleteCategoryArgs,
+		newDeleteCategoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"UpdateStock": kitex.NewMethodInfo(
+		updateStockHandler,
+		newUpdateStockArgs,
+		newUpdateStockResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ReserveStock": kitex.NewMethodInfo(
+		reserveStockHandler,
+		newReserveStockArgs,
+		newReserveStockResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ConfirmReservation": kitex.NewMethodInfo(
+		confirmReservationHandler,
+		newConfirmReservationArgs,
+		newConfirmReservationResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ReleaseReservation": kitex.NewMethodInfo(
+		releaseReservationHandler,
+		newReleaseReservationArgs,
+		newReleaseReservationResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"BulkImportProducts": kitex.NewMethodInfo(
+		bulkImportProductsHandler,
+		newBulkImportProductsArgs,
+		newBulkImportProductsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingClient),
+	),
+	"ExportProducts": kitex.NewMethodInfo(
+		exportProductsHandler,
+		newExportProductsArgs,
+		newExportProductsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingServer),
+	),
+}
+
+var (
+	productServiceServiceInfo                = NewServiceInfo()
+	productServiceServiceInfoForClient       = NewServiceInfoForClient()
+	productServiceServiceInfoForStreamClient = NewServiceInfoForStreamClient()
+)
+
+// for server
+func serviceInfo() *kitex.ServiceInfo {
+	return productServiceServiceInfo
+}
+
+// for client
+func serviceInfoForStreamClient() *kitex.ServiceInfo {
+	return productServiceServiceInfoForStreamClient
+}
+
+// for stream client
+func serviceInfoForClient() *kitex.ServiceInfo {
+	return productServiceServiceInfoForClient
+}
+
+// NewServiceInfo creates a new ServiceInfo containing all methods
+func NewServiceInfo() *kitex.ServiceInfo {
+	return newServiceInfo(true, true, true)
+}
+
+// NewServiceInfo creates a new ServiceInfo containing non-streaming methods
+func NewServiceInfoForClient() *kitex.ServiceInfo {
+	return newServiceInfo(false, false, true)
+}
+func NewServiceInfoForStreamClient() *kitex.ServiceInfo {
+	return newServiceInfo(true, true, false)
+}
+
+func newServiceInfo(hasStreaming bool, keepStreamingMethods bool, keepNonStreamingMethods bool) *kitex.ServiceInfo {
+	serviceName := "ProductService"
+	handlerType := (*product.ProductService)(nil)
+	methods := map[string]kitex.MethodInfo{}
+	for name, m := range serviceMethods {
+		if m.IsStreaming() && !keepStreamingMethods {
+			continue
+		}
+		if !m.IsStreaming() && !keepNonStreamingMethods {
+			continue
+		}
+		methods[name] = m
+	}
+	extra := map[string]interface{}{
+		"PackageName": "product",
+	}
+	if hasStreaming {
+		extra["streaming"] = hasStreaming
+	}
+	svcInfo := &kitex.ServiceInfo{
+		ServiceName:     serviceName,
+		HandlerType:     handlerType,
+		Methods:         methods,
+		PayloadCodec:    kitex.Protobuf,
+		KiteXGenVersion: "v0.9.1",
+		Extra:           extra,
+	}
+	return svcInfo
+}
+
+func createProductHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.CreateProductReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).CreateProduct(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CreateProductArgs:
+		success, err := handler.(product.ProductService).CreateProduct(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CreateProductResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCreateProductArgs() interface{} {
+	return &CreateProductArgs{}
+}
+
+func newCreateProductResult() interface{} {
+	return &CreateProductResult{}
+}
+
+type CreateProductArgs struct {
+	Req *product.CreateProductReq
+}
+
+func (p *CreateProductArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.CreateProductReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *CreateProductArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *CreateProductArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *CreateProductArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CreateProductArgs) Unmarshal(in []byte) error {
+	msg := new(product.CreateProductReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CreateProductArgs_Req_DEFAULT *product.CreateProductReq
+
+func (p *CreateProductArgs) GetReq() *product.CreateProductReq {
+	if !p.IsSetReq() {
+		return CreateProductArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CreateProductArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CreateProductArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CreateProductResult struct {
+	Success *product.CreateProductResp
+}
+
+var CreateProductResult_Success_DEFAULT *product.CreateProductResp
+
+func (p *CreateProductResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.CreateProductResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *CreateProductResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *CreateProductResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *CreateProductResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CreateProductResult) Unmarshal(in []byte) error {
+	msg := new(product.CreateProductResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CreateProductResult) GetSuccess() *product.CreateProductResp {
+	if !p.IsSetSuccess() {
+		return CreateProductResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CreateProductResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.CreateProductResp)
+}
+
+func (p *CreateProductResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CreateProductResult) GetResult() interface{} {
+	return p.Success
+}
+
+func updateProductHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.UpdateProductReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).UpdateProduct(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UpdateProductArgs:
+		success, err := handler.(product.ProductService).UpdateProduct(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UpdateProductResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUpdateProductArgs() interface{} {
+	return &UpdateProductArgs{}
+}
+
+func newUpdateProductResult() interface{} {
+	return &UpdateProductResult{}
+}
+
+type UpdateProductArgs struct {
+	Req *product.UpdateProductReq
+}
+
+func (p *UpdateProductArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.UpdateProductReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UpdateProductArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UpdateProductArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UpdateProductArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UpdateProductArgs) Unmarshal(in []byte) error {
+	msg := new(product.UpdateProductReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UpdateProductArgs_Req_DEFAULT *product.UpdateProductReq
+
+func (p *UpdateProductArgs) GetReq() *product.UpdateProductReq {
+	if !p.IsSetReq() {
+		return UpdateProductArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UpdateProductArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UpdateProductArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UpdateProductResult struct {
+	Success *product.UpdateProductResp
+}
+
+var UpdateProductResult_Success_DEFAULT *product.UpdateProductResp
+
+func (p *UpdateProductResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.UpdateProductResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UpdateProductResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UpdateProductResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UpdateProductResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UpdateProductResult) Unmarshal(in []byte) error {
+	msg := new(product.UpdateProductResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UpdateProductResult) GetSuccess() *product.UpdateProductResp {
+	if !p.IsSetSuccess() {
+		return UpdateProductResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UpdateProductResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.UpdateProductResp)
+}
+
+func (p *UpdateProductResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UpdateProductResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getProductHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.GetProductReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).GetProduct(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetProductArgs:
+		success, err := handler.(product.ProductService).GetProduct(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetProductResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetProductArgs() interface{} {
+	return &GetProductArgs{}
+}
+
+func newGetProductResult() interface{} {
+	return &GetProductResult{}
+}
+
+type GetProductArgs struct {
+	Req *product.GetProductReq
+}
+
+func (p *GetProductArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.GetProductReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetProductArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetProductArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetProductArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetProductArgs) Unmarshal(in []byte) error {
+	msg := new(product.GetProductReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetProductArgs_Req_DEFAULT *product.GetProductReq
+
+func (p *GetProductArgs) GetReq() *product.GetProductReq {
+	if !p.IsSetReq() {
+		return GetProductArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetProductArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetProductArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetProductResult struct {
+	Success *product.GetProductResp
+}
+
+var GetProductResult_Success_DEFAULT *product.GetProductResp
+
+func (p *GetProductResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.GetProductResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetProductResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetProductResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetProductResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetProductResult) Unmarshal(in []byte) error {
+	msg := new(product.GetProductResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetProductResult) GetSuccess() *product.GetProductResp {
+	if !p.IsSetSuccess() {
+		return GetProductResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetProductResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.GetProductResp)
+}
+
+func (p *GetProductResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetProductResult) GetResult() interface{} {
+	return p.Success
+}
+
+func deleteProductHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.DeleteProductReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).DeleteProduct(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *DeleteProductArgs:
+		success, err := handler.(product.ProductService).DeleteProduct(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DeleteProductResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newDeleteProductArgs() interface{} {
+	return &DeleteProductArgs{}
+}
+
+func newDeleteProductResult() interface{} {
+	return &DeleteProductResult{}
+}
+
+type DeleteProductArgs struct {
+	Req *product.DeleteProductReq
+}
+
+func (p *DeleteProductArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.DeleteProductReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *DeleteProductArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *DeleteProductArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *DeleteProductArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *DeleteProductArgs) Unmarshal(in []byte) error {
+	msg := new(product.DeleteProductReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var DeleteProductArgs_Req_DEFAULT *product.DeleteProductReq
+
+func (p *DeleteProductArgs) GetReq() *product.DeleteProductReq {
+	if !p.IsSetReq() {
+		return DeleteProductArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *DeleteProductArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DeleteProductArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type DeleteProductResult struct {
+	Success *product.DeleteProductResp
+}
+
+var DeleteProductResult_Success_DEFAULT *product.DeleteProductResp
+
+func (p *DeleteProductResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.DeleteProductResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *DeleteProductResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *DeleteProductResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *DeleteProductResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *DeleteProductResult) Unmarshal(in []byte) error {
+	msg := new(product.DeleteProductResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DeleteProductResult) GetSuccess() *product.DeleteProductResp {
+	if !p.IsSetSuccess() {
+		return DeleteProductResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DeleteProductResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.DeleteProductResp)
+}
+
+func (p *DeleteProductResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DeleteProductResult) GetResult() interface{} {
+	return p.Success
+}
+
+func setProductOptionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.SetProductOptionsReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).SetProductOptions(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *SetProductOptionsArgs:
+		success, err := handler.(product.ProductService).SetProductOptions(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SetProductOptionsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newSetProductOptionsArgs() interface{} {
+	return &SetProductOptionsArgs{}
+}
+
+func newSetProductOptionsResult() interface{} {
+	return &SetProductOptionsResult{}
+}
+
+type SetProductOptionsArgs struct {
+	Req *product.SetProductOptionsReq
+}
+
+func (p *SetProductOptionsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.SetProductOptionsReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *SetProductOptionsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *SetProductOptionsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *SetProductOptionsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SetProductOptionsArgs) Unmarshal(in []byte) error {
+	msg := new(product.SetProductOptionsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SetProductOptionsArgs_Req_DEFAULT *product.SetProductOptionsReq
+
+func (p *SetProductOptionsArgs) GetReq() *product.SetProductOptionsReq {
+	if !p.IsSetReq() {
+		return SetProductOptionsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SetProductOptionsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SetProductOptionsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type SetProductOptionsResult struct {
+	Success *product.SetProductOptionsResp
+}
+
+var SetProductOptionsResult_Success_DEFAULT *product.SetProductOptionsResp
+
+func (p *SetProductOptionsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.SetProductOptionsResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *SetProductOptionsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *SetProductOptionsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *SetProductOptionsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SetProductOptionsResult) Unmarshal(in []byte) error {
+	msg := new(product.SetProductOptionsResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SetProductOptionsResult) GetSuccess() *product.SetProductOptionsResp {
+	if !p.IsSetSuccess() {
+		return SetProductOptionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SetProductOptionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.SetProductOptionsResp)
+}
+
+func (p *SetProductOptionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SetProductOptionsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func saveSkusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.SaveSkusReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).SaveSkus(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *SaveSkusArgs:
+		success, err := handler.(product.ProductService).SaveSkus(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SaveSkusResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newSaveSkusArgs() interface{} {
+	return &SaveSkusArgs{}
+}
+
+func newSaveSkusResult() interface{} {
+	return &SaveSkusResult{}
+}
+
+type SaveSkusArgs struct {
+	Req *product.SaveSkusReq
+}
+
+func (p *SaveSkusArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.SaveSkusReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *SaveSkusArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *SaveSkusArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *SaveSkusArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SaveSkusArgs) Unmarshal(in []byte) error {
+	msg := new(product.SaveSkusReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SaveSkusArgs_Req_DEFAULT *product.SaveSkusReq
+
+func (p *SaveSkusArgs) GetReq() *product.SaveSkusReq {
+	if !p.IsSetReq() {
+		return SaveSkusArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SaveSkusArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SaveSkusArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type SaveSkusResult struct {
+	Success *product.SaveSkusResp
+}
+
+var SaveSkusResult_Success_DEFAULT *product.SaveSkusResp
+
+func (p *SaveSkusResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.SaveSkusResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *SaveSkusResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *SaveSkusResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *SaveSkusResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SaveSkusResult) Unmarshal(in []byte) error {
+	msg := new(product.SaveSkusResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SaveSkusResult) GetSuccess() *product.SaveSkusResp {
+	if !p.IsSetSuccess() {
+		return SaveSkusResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SaveSkusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.SaveSkusResp)
+}
+
+func (p *SaveSkusResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SaveSkusResult) GetResult() interface{} {
+	return p.Success
+}
+
+func deleteSkuHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.DeleteSkuReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).DeleteSku(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *DeleteSkuArgs:
+		success, err := handler.(product.ProductService).DeleteSku(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DeleteSkuResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newDeleteSkuArgs() interface{} {
+	return &DeleteSkuArgs{}
+}
+
+func newDeleteSkuResult() interface{} {
+	return &DeleteSkuResult{}
+}
+
+type DeleteSkuArgs struct {
+	Req *product.DeleteSkuReq
+}
+
+func (p *DeleteSkuArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.DeleteSkuReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *DeleteSkuArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *DeleteSkuArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *DeleteSkuArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *DeleteSkuArgs) Unmarshal(in []byte) error {
+	msg := new(product.DeleteSkuReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var DeleteSkuArgs_Req_DEFAULT *product.DeleteSkuReq
+
+func (p *DeleteSkuArgs) GetReq() *product.DeleteSkuReq {
+	if !p.IsSetReq() {
+		return DeleteSkuArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *DeleteSkuArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DeleteSkuArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type DeleteSkuResult struct {
+	Success *product.DeleteSkuResp
+}
+
+var DeleteSkuResult_Success_DEFAULT *product.DeleteSkuResp
+
+func (p *DeleteSkuResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.DeleteSkuResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *DeleteSkuResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *DeleteSkuResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *DeleteSkuResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *DeleteSkuResult) Unmarshal(in []byte) error {
+	msg := new(product.DeleteSkuResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DeleteSkuResult) GetSuccess() *product.DeleteSkuResp {
+	if !p.IsSetSuccess() {
+		return DeleteSkuResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DeleteSkuResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.DeleteSkuResp)
+}
+
+func (p *DeleteSkuResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DeleteSkuResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listProductRevisionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.ListProductRevisionsReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).ListProductRevisions(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListProductRevisionsArgs:
+		success, err := handler.(product.ProductService).ListProductRevisions(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListProductRevisionsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListProductRevisionsArgs() interface{} {
+	return &ListProductRevisionsArgs{}
+}
+
+func newListProductRevisionsResult() interface{} {
+	return &ListProductRevisionsResult{}
+}
+
+type ListProductRevisionsArgs struct {
+	Req *product.ListProductRevisionsReq
+}
+
+func (p *ListProductRevisionsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.ListProductRevisionsReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListProductRevisionsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListProductRevisionsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListProductRevisionsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListProductRevisionsArgs) Unmarshal(in []byte) error {
+	msg := new(product.ListProductRevisionsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListProductRevisionsArgs_Req_DEFAULT *product.ListProductRevisionsReq
+
+func (p *ListProductRevisionsArgs) GetReq() *product.ListProductRevisionsReq {
+	if !p.IsSetReq() {
+		return ListProductRevisionsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListProductRevisionsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListProductRevisionsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListProductRevisionsResult struct {
+	Success *product.ListProductRevisionsResp
+}
+
+var ListProductRevisionsResult_Success_DEFAULT *product.ListProductRevisionsResp
+
+func (p *ListProductRevisionsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.ListProductRevisionsResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListProductRevisionsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListProductRevisionsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListProductRevisionsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListProductRevisionsResult) Unmarshal(in []byte) error {
+	msg := new(product.ListProductRevisionsResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListProductRevisionsResult) GetSuccess() *product.ListProductRevisionsResp {
+	if !p.IsSetSuccess() {
+		return ListProductRevisionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListProductRevisionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.ListProductRevisionsResp)
+}
+
+func (p *ListProductRevisionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListProductRevisionsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func restoreProductHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.RestoreProductReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).RestoreProduct(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *RestoreProductArgs:
+		success, err := handler.(product.ProductService).RestoreProduct(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RestoreProductResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newRestoreProductArgs() interface{} {
+	return &RestoreProductArgs{}
+}
+
+func newRestoreProductResult() interface{} {
+	return &RestoreProductResult{}
+}
+
+type RestoreProductArgs struct {
+	Req *product.RestoreProductReq
+}
+
+func (p *RestoreProductArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.RestoreProductReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RestoreProductArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RestoreProductArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RestoreProductArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RestoreProductArgs) Unmarshal(in []byte) error {
+	msg := new(product.RestoreProductReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RestoreProductArgs_Req_DEFAULT *product.RestoreProductReq
+
+func (p *RestoreProductArgs) GetReq() *product.RestoreProductReq {
+	if !p.IsSetReq() {
+		return RestoreProductArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RestoreProductArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RestoreProductArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RestoreProductResult struct {
+	Success *product.RestoreProductResp
+}
+
+var RestoreProductResult_Success_DEFAULT *product.RestoreProductResp
+
+func (p *RestoreProductResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.RestoreProductResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RestoreProductResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RestoreProductResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RestoreProductResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RestoreProductResult) Unmarshal(in []byte) error {
+	msg := new(product.RestoreProductResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RestoreProductResult) GetSuccess() *product.RestoreProductResp {
+	if !p.IsSetSuccess() {
+		return RestoreProductResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RestoreProductResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.RestoreProductResp)
+}
+
+func (p *RestoreProductResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RestoreProductResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listProductsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.ListProductsReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).ListProducts(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListProductsArgs:
+		success, err := handler.(product.ProductService).ListProducts(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListProductsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListProductsArgs() interface{} {
+	return &ListProductsArgs{}
+}
+
+func newListProductsResult() interface{} {
+	return &ListProductsResult{}
+}
+
+type ListProductsArgs struct {
+	Req *product.ListProductsReq
+}
+
+func (p *ListProductsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.ListProductsReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListProductsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListProductsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListProductsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListProductsArgs) Unmarshal(in []byte) error {
+	msg := new(product.ListProductsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListProductsArgs_Req_DEFAULT *product.ListProductsReq
+
+func (p *ListProductsArgs) GetReq() *product.ListProductsReq {
+	if !p.IsSetReq() {
+		return ListProductsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListProductsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListProductsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListProductsResult struct {
+	Success *product.ListProductsResp
+}
+
+var ListProductsResult_Success_DEFAULT *product.ListProductsResp
+
+func (p *ListProductsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.ListProductsResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListProductsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListProductsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListProductsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListProductsResult) Unmarshal(in []byte) error {
+	msg := new(product.ListProductsResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListProductsResult) GetSuccess() *product.ListProductsResp {
+	if !p.IsSetSuccess() {
+		return ListProductsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListProductsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.ListProductsResp)
+}
+
+func (p *ListProductsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListProductsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getCategoriesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.GetCategoriesReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).GetCategories(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetCategoriesArgs:
+		success, err := handler.(product.ProductService).GetCategories(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetCategoriesResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetCategoriesArgs() interface{} {
+	return &GetCategoriesArgs{}
+}
+
+func newGetCategoriesResult() interface{} {
+	return &GetCategoriesResult{}
+}
+
+type GetCategoriesArgs struct {
+	Req *product.GetCategoriesReq
+}
+
+func (p *GetCategoriesArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.GetCategoriesReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetCategoriesArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetCategoriesArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetCategoriesArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetCategoriesArgs) Unmarshal(in []byte) error {
+	msg := new(product.GetCategoriesReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetCategoriesArgs_Req_DEFAULT *product.GetCategoriesReq
+
+func (p *GetCategoriesArgs) GetReq() *product.GetCategoriesReq {
+	if !p.IsSetReq() {
+		return GetCategoriesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetCategoriesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetCategoriesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetCategoriesResult struct {
+	Success *product.GetCategoriesResp
+}
+
+var GetCategoriesResult_Success_DEFAULT *product.GetCategoriesResp
+
+func (p *GetCategoriesResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.GetCategoriesResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetCategoriesResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetCategoriesResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetCategoriesResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetCategoriesResult) Unmarshal(in []byte) error {
+	msg := new(product.GetCategoriesResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetCategoriesResult) GetSuccess() *product.GetCategoriesResp {
+	if !p.IsSetSuccess() {
+		return GetCategoriesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetCategoriesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.GetCategoriesResp)
+}
+
+func (p *GetCategoriesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetCategoriesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func searchProductsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.SearchProductsReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).SearchProducts(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *SearchProductsArgs:
+		success, err := handler.(product.ProductService).SearchProducts(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SearchProductsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newSearchProductsArgs() interface{} {
+	return &SearchProductsArgs{}
+}
+
+func newSearchProductsResult() interface{} {
+	return &SearchProductsResult{}
+}
+
+type SearchProductsArgs struct {
+	Req *product.SearchProductsReq
+}
+
+func (p *SearchProductsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.SearchProductsReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *SearchProductsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *SearchProductsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *SearchProductsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SearchProductsArgs) Unmarshal(in []byte) error {
+	msg := new(product.SearchProductsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SearchProductsArgs_Req_DEFAULT *product.SearchProductsReq
+
+func (p *SearchProductsArgs) GetReq() *product.SearchProductsReq {
+	if !p.IsSetReq() {
+		return SearchProductsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SearchProductsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SearchProductsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type SearchProductsResult struct {
+	Success *product.SearchProductsResp
+}
+
+var SearchProductsResult_Success_DEFAULT *product.SearchProductsResp
+
+func (p *SearchProductsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.SearchProductsResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *SearchProductsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *SearchProductsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *SearchProductsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SearchProductsResult) Unmarshal(in []byte) error {
+	msg := new(product.SearchProductsResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SearchProductsResult) GetSuccess() *product.SearchProductsResp {
+	if !p.IsSetSuccess() {
+		return SearchProductsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SearchProductsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.SearchProductsResp)
+}
+
+func (p *SearchProductsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SearchProductsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func createCategoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.CreateCategoryReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).CreateCategory(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CreateCategoryArgs:
+		success, err := handler.(product.ProductService).CreateCategory(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CreateCategoryResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCreateCategoryArgs() interface{} {
+	return &CreateCategoryArgs{}
+}
+
+func newCreateCategoryResult() interface{} {
+	return &CreateCategoryResult{}
+}
+
+type CreateCategoryArgs struct {
+	Req *product.CreateCategoryReq
+}
+
+func (p *CreateCategoryArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.CreateCategoryReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *CreateCategoryArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *CreateCategoryArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *CreateCategoryArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CreateCategoryArgs) Unmarshal(in []byte) error {
+	msg := new(product.CreateCategoryReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CreateCategoryArgs_Req_DEFAULT *product.CreateCategoryReq
+
+func (p *CreateCategoryArgs) GetReq() *product.CreateCategoryReq {
+	if !p.IsSetReq() {
+		return CreateCategoryArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CreateCategoryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CreateCategoryArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CreateCategoryResult struct {
+	Success *product.CreateCategoryResp
+}
+
+var CreateCategoryResult_Success_DEFAULT *product.CreateCategoryResp
+
+func (p *CreateCategoryResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.CreateCategoryResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *CreateCategoryResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *CreateCategoryResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *CreateCategoryResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CreateCategoryResult) Unmarshal(in []byte) error {
+	msg := new(product.CreateCategoryResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CreateCategoryResult) GetSuccess() *product.CreateCategoryResp {
+	if !p.IsSetSuccess() {
+		return CreateCategoryResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CreateCategoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.CreateCategoryResp)
+}
+
+func (p *CreateCategoryResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CreateCategoryResult) GetResult() interface{} {
+	return p.Success
+}
+
+func updateCategoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.UpdateCategoryReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).UpdateCategory(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UpdateCategoryArgs:
+		success, err := handler.(product.ProductService).UpdateCategory(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UpdateCategoryResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUpdateCategoryArgs() interface{} {
+	return &UpdateCategoryArgs{}
+}
+
+func newUpdateCategoryResult() interface{} {
+	return &UpdateCategoryResult{}
+}
+
+type UpdateCategoryArgs struct {
+	Req *product.UpdateCategoryReq
+}
+
+func (p *UpdateCategoryArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.UpdateCategoryReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UpdateCategoryArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UpdateCategoryArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UpdateCategoryArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UpdateCategoryArgs) Unmarshal(in []byte) error {
+	msg := new(product.UpdateCategoryReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UpdateCategoryArgs_Req_DEFAULT *product.UpdateCategoryReq
+
+func (p *UpdateCategoryArgs) GetReq() *product.UpdateCategoryReq {
+	if !p.IsSetReq() {
+		return UpdateCategoryArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UpdateCategoryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UpdateCategoryArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UpdateCategoryResult struct {
+	Success *product.UpdateCategoryResp
+}
+
+var UpdateCategoryResult_Success_DEFAULT *product.UpdateCategoryResp
+
+func (p *UpdateCategoryResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.UpdateCategoryResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UpdateCategoryResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UpdateCategoryResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UpdateCategoryResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UpdateCategoryResult) Unmarshal(in []byte) error {
+	msg := new(product.UpdateCategoryResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UpdateCategoryResult) GetSuccess() *product.UpdateCategoryResp {
+	if !p.IsSetSuccess() {
+		return UpdateCategoryResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UpdateCategoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.UpdateCategoryResp)
+}
+
+func (p *UpdateCategoryResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UpdateCategoryResult) GetResult() interface{} {
+	return p.Success
+}
+
+func deleteCategoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.DeleteCategoryReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).DeleteCategory(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *DeleteCategoryArgs:
+		success, err := handler.(product.ProductService).DeleteCategory(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DeleteCategoryResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newDeleteCategoryArgs() interface{} {
+	return &DeleteCategoryArgs{}
+}
+
+func newDeleteCategoryResult() interface{} {
+	return &DeleteCategoryResult{}
+}
+
+type DeleteCategoryArgs struct {
+	Req *product.DeleteCategoryReq
+}
+
+func (p *DeleteCategoryArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.DeleteCategoryReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *DeleteCategoryArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *DeleteCategoryArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *DeleteCategoryArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *DeleteCategoryArgs) Unmarshal(in []byte) error {
+	msg := new(product.DeleteCategoryReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var DeleteCategoryArgs_Req_DEFAULT *product.DeleteCategoryReq
+
+func (p *DeleteCategoryArgs) GetReq() *product.DeleteCategoryReq {
+	if !p.IsSetReq() {
+		return DeleteCategoryArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *DeleteCategoryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DeleteCategoryArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type DeleteCategoryResult struct {
+	Success *product.DeleteCategoryResp
+}
+
+var DeleteCategoryResult_Success_DEFAULT *product.DeleteCategoryResp
+
+func (p *DeleteCategoryResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.DeleteCategoryResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *DeleteCategoryResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *DeleteCategoryResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *DeleteCategoryResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *DeleteCategoryResult) Unmarshal(in []byte) error {
+	msg := new(product.DeleteCategoryResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DeleteCategoryResult) GetSuccess() *product.DeleteCategoryResp {
+	if !p.IsSetSuccess() {
+		return DeleteCategoryResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DeleteCategoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.DeleteCategoryResp)
+}
+
+func (p *DeleteCategoryResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DeleteCategoryResult) GetResult() interface{} {
+	return p.Success
+}
+
+func updateStockHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.UpdateStockReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).UpdateStock(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UpdateStockArgs:
+		success, err := handler.(product.ProductService).UpdateStock(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UpdateStockResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUpdateStockArgs() interface{} {
+	return &UpdateStockArgs{}
+}
+
+func newUpdateStockResult() interface{} {
+	return &UpdateStockResult{}
+}
+
+type UpdateStockArgs struct {
+	Req *product.UpdateStockReq
+}
+
+func (p *UpdateStockArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.UpdateStockReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UpdateStockArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UpdateStockArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UpdateStockArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UpdateStockArgs) Unmarshal(in []byte) error {
+	msg := new(product.UpdateStockReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UpdateStockArgs_Req_DEFAULT *product.UpdateStockReq
+
+func (p *UpdateStockArgs) GetReq() *product.UpdateStockReq {
+	if !p.IsSetReq() {
+		return UpdateStockArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UpdateStockArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UpdateStockArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UpdateStockResult struct {
+	Success *product.UpdateStockResp
+}
+
+var UpdateStockResult_Success_DEFAULT *product.UpdateStockResp
+
+func (p *UpdateStockResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.UpdateStockResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UpdateStockResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UpdateStockResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UpdateStockResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UpdateStockResult) Unmarshal(in []byte) error {
+	msg := new(product.UpdateStockResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UpdateStockResult) GetSuccess() *product.UpdateStockResp {
+	if !p.IsSetSuccess() {
+		return UpdateStockResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UpdateStockResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.UpdateStockResp)
+}
+
+func (p *UpdateStockResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UpdateStockResult) GetResult() interface{} {
+	return p.Success
+}
+
+func reserveStockHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.ReserveStockReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).ReserveStock(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ReserveStockArgs:
+		success, err := handler.(product.ProductService).ReserveStock(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ReserveStockResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newReserveStockArgs() interface{} {
+	return &ReserveStockArgs{}
+}
+
+func newReserveStockResult() interface{} {
+	return &ReserveStockResult{}
+}
+
+type ReserveStockArgs struct {
+	Req *product.ReserveStockReq
+}
+
+func (p *ReserveStockArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.ReserveStockReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ReserveStockArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ReserveStockArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ReserveStockArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ReserveStockArgs) Unmarshal(in []byte) error {
+	msg := new(product.ReserveStockReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ReserveStockArgs_Req_DEFAULT *product.ReserveStockReq
+
+func (p *ReserveStockArgs) GetReq() *product.ReserveStockReq {
+	if !p.IsSetReq() {
+		return ReserveStockArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ReserveStockArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ReserveStockArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ReserveStockResult struct {
+	Success *product.ReserveStockResp
+}
+
+var ReserveStockResult_Success_DEFAULT *product.ReserveStockResp
+
+func (p *ReserveStockResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.ReserveStockResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ReserveStockResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ReserveStockResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ReserveStockResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ReserveStockResult) Unmarshal(in []byte) error {
+	msg := new(product.ReserveStockResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ReserveStockResult) GetSuccess() *product.ReserveStockResp {
+	if !p.IsSetSuccess() {
+		return ReserveStockResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ReserveStockResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.ReserveStockResp)
+}
+
+func (p *ReserveStockResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ReserveStockResult) GetResult() interface{} {
+	return p.Success
+}
+
+func confirmReservationHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.ConfirmReservationReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).ConfirmReservation(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ConfirmReservationArgs:
+		success, err := handler.(product.ProductService).ConfirmReservation(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ConfirmReservationResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newConfirmReservationArgs() interface{} {
+	return &ConfirmReservationArgs{}
+}
+
+func newConfirmReservationResult() interface{} {
+	return &ConfirmReservationResult{}
+}
+
+type ConfirmReservationArgs struct {
+	Req *product.ConfirmReservationReq
+}
+
+func (p *ConfirmReservationArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.ConfirmReservationReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ConfirmReservationArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ConfirmReservationArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ConfirmReservationArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ConfirmReservationArgs) Unmarshal(in []byte) error {
+	msg := new(product.ConfirmReservationReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ConfirmReservationArgs_Req_DEFAULT *product.ConfirmReservationReq
+
+func (p *ConfirmReservationArgs) GetReq() *product.ConfirmReservationReq {
+	if !p.IsSetReq() {
+		return ConfirmReservationArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ConfirmReservationArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ConfirmReservationArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ConfirmReservationResult struct {
+	Success *product.ConfirmReservationResp
+}
+
+var ConfirmReservationResult_Success_DEFAULT *product.ConfirmReservationResp
+
+func (p *ConfirmReservationResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.ConfirmReservationResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ConfirmReservationResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ConfirmReservationResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ConfirmReservationResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ConfirmReservationResult) Unmarshal(in []byte) error {
+	msg := new(product.ConfirmReservationResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ConfirmReservationResult) GetSuccess() *product.ConfirmReservationResp {
+	if !p.IsSetSuccess() {
+		return ConfirmReservationResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ConfirmReservationResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.ConfirmReservationResp)
+}
+
+func (p *ConfirmReservationResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ConfirmReservationResult) GetResult() interface{} {
+	return p.Success
+}
+
+func releaseReservationHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.ReleaseReservationReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).ReleaseReservation(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ReleaseReservationArgs:
+		success, err := handler.(product.ProductService).ReleaseReservation(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ReleaseReservationResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newReleaseReservationArgs() interface{} {
+	return &ReleaseReservationArgs{}
+}
+
+func newReleaseReservationResult() interface{} {
+	return &ReleaseReservationResult{}
+}
+
+type ReleaseReservationArgs struct {
+	Req *product.ReleaseReservationReq
+}
+
+func (p *ReleaseReservationArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.ReleaseReservationReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ReleaseReservationArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ReleaseReservationArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ReleaseReservationArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ReleaseReservationArgs) Unmarshal(in []byte) error {
+	msg := new(product.ReleaseReservationReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ReleaseReservationArgs_Req_DEFAULT *product.ReleaseReservationReq
+
+func (p *ReleaseReservationArgs) GetReq() *product.ReleaseReservationReq {
+	if !p.IsSetReq() {
+		return ReleaseReservationArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ReleaseReservationArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ReleaseReservationArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ReleaseReservationResult struct {
+	Success *product.ReleaseReservationResp
+}
+
+var ReleaseReservationResult_Success_DEFAULT *product.ReleaseReservationResp
+
+func (p *ReleaseReservationResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.ReleaseReservationResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ReleaseReservationResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ReleaseReservationResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ReleaseReservationResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ReleaseReservationResult) Unmarshal(in []byte) error {
+	msg := new(product.ReleaseReservationResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ReleaseReservationResult) GetSuccess() *product.ReleaseReservationResp {
+	if !p.IsSetSuccess() {
+		return ReleaseReservationResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ReleaseReservationResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.ReleaseReservationResp)
+}
+
+func (p *ReleaseReservationResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ReleaseReservationResult) GetResult() interface{} {
+	return p.Success
+}
+
+func bulkImportProductsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	streamingArgs, ok := arg.(*streaming.Args)
+	if !ok {
+		return errInvalidMessageType
+	}
+	st := streamingArgs.Stream
+	stream := &productServiceBulkImportProductsServer{st}
+	return handler.(product.ProductService).BulkImportProducts(stream)
+}
+
+type productServiceBulkImportProductsClient struct {
+	streaming.Stream
+}
+
+func (x *productServiceBulkImportProductsClient) DoFinish(err error) {
+	if finisher, ok := x.Stream.(streaming.WithDoFinish); ok {
+		finisher.DoFinish(err)
+	} else {
+		panic(fmt.Sprintf("streaming.WithDoFinish is not implemented by %T", x.Stream))
+	}
+}
+func (x *productServiceBulkImportProductsClient) Send(m *product.BulkImportProductsReq) error {
+	return x.Stream.SendMsg(m)
+}
+func (x *productServiceBulkImportProductsClient) CloseAndRecv() (*product.BulkImportProductsResp, error) {
+	if err := x.Stream.Close(); err != nil {
+		return nil, err
+	}
+	m := new(product.BulkImportProductsResp)
+	return m, x.Stream.RecvMsg(m)
+}
+
+type productServiceBulkImportProductsServer struct {
+	streaming.Stream
+}
+
+func (x *productServiceBulkImportProductsServer) SendAndClose(m *product.BulkImportProductsResp) error {
+	return x.Stream.SendMsg(m)
+}
+
+func (x *productServiceBulkImportProductsServer) Recv() (*product.BulkImportProductsReq, error) {
+	m := new(product.BulkImportProductsReq)
+	return m, x.Stream.RecvMsg(m)
+}
+
+func newBulkImportProductsArgs() interface{} {
+	return &BulkImportProductsArgs{}
+}
+
+func newBulkImportProductsResult() interface{} {
+	return &BulkImportProductsResult{}
+}
+
+type BulkImportProductsArgs struct {
+	Req *product.BulkImportProductsReq
+}
+
+func (p *BulkImportProductsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.BulkImportProductsReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *BulkImportProductsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *BulkImportProductsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *BulkImportProductsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *BulkImportProductsArgs) Unmarshal(in []byte) error {
+	msg := new(product.BulkImportProductsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var BulkImportProductsArgs_Req_DEFAULT *product.BulkImportProductsReq
+
+func (p *BulkImportProductsArgs) GetReq() *product.BulkImportProductsReq {
+	if !p.IsSetReq() {
+		return BulkImportProductsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *BulkImportProductsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *BulkImportProductsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type BulkImportProductsResult struct {
+	Success *product.BulkImportProductsResp
+}
+
+var BulkImportProductsResult_Success_DEFAULT *product.BulkImportProductsResp
+
+func (p *BulkImportProductsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.BulkImportProductsResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *BulkImportProductsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *BulkImportProductsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *BulkImportProductsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *BulkImportProductsResult) Unmarshal(in []byte) error {
+	msg := new(product.BulkImportProductsResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *BulkImportProductsResult) GetSuccess() *product.BulkImportProductsResp {
+	if !p.IsSetSuccess() {
+		return BulkImportProductsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *BulkImportProductsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.BulkImportProductsResp)
+}
+
+func (p *BulkImportProductsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *BulkImportProductsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func exportProductsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	streamingArgs, ok := arg.(*streaming.Args)
+	if !ok {
+		return errInvalidMessageType
+	}
+	st := streamingArgs.Stream
+	stream := &productServiceExportProductsServer{st}
+	req := new(product.ExportProductsReq)
+	if err := st.RecvMsg(req); err != nil {
+		return err
+	}
+	return handler.(product.ProductService).ExportProducts(req, stream)
+}
+
+type productServiceExportProductsClient struct {
+	streaming.Stream
+}
+
+func (x *productServiceExportProductsClient) DoFinish(err error) {
+	if finisher, ok := x.Stream.(streaming.WithDoFinish); ok {
+		finisher.DoFinish(err)
+	} else {
+		panic(fmt.Sprintf("streaming.WithDoFinish is not implemented by %T", x.Stream))
+	}
+}
+func (x *productServiceExportProductsClient) Recv() (*product.ExportProductsResp, error) {
+	m := new(product.ExportProductsResp)
+	return m, x.Stream.RecvMsg(m)
+}
+
+type productServiceExportProductsServer struct {
+	streaming.Stream
+}
+
+func (x *productServiceExportProductsServer) Send(m *product.ExportProductsResp) error {
+	return x.Stream.SendMsg(m)
+}
+
+func newExportProductsArgs() interface{} {
+	return &ExportProductsArgs{}
+}
+
+func newExportProductsResult() interface{} {
+	return &ExportProductsResult{}
+}
+
+type ExportProductsArgs struct {
+	Req *product.ExportProductsReq
+}
+
+func (p *ExportProductsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.ExportProductsReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ExportProductsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ExportProductsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ExportProductsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ExportProductsArgs) Unmarshal(in []byte) error {
+	msg := new(product.ExportProductsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ExportProductsArgs_Req_DEFAULT *product.ExportProductsReq
+
+func (p *ExportProductsArgs) GetReq() *product.ExportProductsReq {
+	if !p.IsSetReq() {
+		return ExportProductsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ExportProductsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ExportProductsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ExportProductsResult struct {
+	Success *product.ExportProductsResp
+}
+
+var ExportProductsResult_Success_DEFAULT *product.ExportProductsResp
+
+func (p *ExportProductsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.ExportProductsResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ExportProductsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ExportProductsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ExportProductsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ExportProductsResult) Unmarshal(in []byte) error {
+	msg := new(product.ExportProductsResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ExportProductsResult) GetSuccess() *product.ExportProductsResp {
+	if !p.IsSetSuccess() {
+		return ExportProductsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ExportProductsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.ExportProductsResp)
+}
+
+func (p *ExportProductsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ExportProductsResult) GetResult() interface{} {
+	return p.Success
+}
+
+type kClient struct {
+	c client.Client
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c: c,
+	}
+}
+
+func (p *kClient) CreateProduct(ctx context.Context, Req *product.CreateProductReq) (r *product.CreateProductResp, err error) {
+	var _args CreateProductArgs
+	_args.Req = Req
+	var _result CreateProductResult
+	if err = p.c.Call(ctx, "CreateProduct", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateProduct(ctx context.Context, Req *product.UpdateProductReq) (r *product.UpdateProductResp, err error) {
+	var _args UpdateProductArgs
+	_args.Req = Req
+	var _result UpdateProductResult
+	if err = p.c.Call(ctx, "UpdateProduct", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetProduct(ctx context.Context, Req *product.GetProductReq) (r *product.GetProductResp, err error) {
+	var _args GetProductArgs
+	_args.Req = Req
+	var _result GetProductResult
+	if err = p.c.Call(ctx, "GetProduct", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteProduct(ctx context.Context, Req *product.DeleteProductReq) (r *product.DeleteProductResp, err error) {
+	var _args DeleteProductArgs
+	_args.Req = Req
+	var _result DeleteProductResult
+	if err = p.c.Call(ctx, "DeleteProduct", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SetProductOptions(ctx context.Context, Req *product.SetProductOptionsReq) (r *product.SetProductOptionsResp, err error) {
+	var _args SetProductOptionsArgs
+	_args.Req = Req
+	var _result SetProductOptionsResult
+	if err = p.c.Call(ctx, "SetProductOptions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SaveSkus(ctx context.Context, Req *product.SaveSkusReq) (r *product.SaveSkusResp, err error) {
+	var _args SaveSkusArgs
+	_args.Req = Req
+	var _result SaveSkusResult
+	if err = p.c.Call(ctx, "SaveSkus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteSku(ctx context.Context, Req *product.DeleteSkuReq) (r *product.DeleteSkuResp, err error) {
+	var _args DeleteSkuArgs
+	_args.Req = Req
+	var _result DeleteSkuResult
+	if err = p.c.Call(ctx, "DeleteSku", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListProductRevisions(ctx context.Context, Req *product.ListProductRevisionsReq) (r *product.ListProductRevisionsResp, err error) {
+	var _args ListProductRevisionsArgs
+	_args.Req = Req
+	var _result ListProductRevisionsResult
+	if err = p.c.Call(ctx, "ListProductRevisions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RestoreProduct(ctx context.Context, Req *product.RestoreProductReq) (r *product.RestoreProductResp, err error) {
+	var _args RestoreProductArgs
+	_args.Req = Req
+	var _result RestoreProductResult
+	if err = p.c.Call(ctx, "RestoreProduct", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListProducts(ctx context.Context, Req *product.ListProductsReq) (r *product.ListProductsResp, err error) {
+	var _args ListProductsArgs
+	_args.Req = Req
+	var _result ListProductsResult
+	if err = p.c.Call(ctx, "ListProducts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetCategories(ctx context.Context, Req *product.GetCategoriesReq) (r *product.GetCategoriesResp, err error) {
+	var _args GetCategoriesArgs
+	_args.Req = Req
+	var _result GetCategoriesResult
+	if err = p.c.Call(ctx, "GetCategories", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SearchProducts(ctx context.Context, Req *product.SearchProductsReq) (r *product.SearchProductsResp, err error) {
+	var _args SearchProductsArgs
+	_args.Req = Req
+	var _result SearchProductsResult
+	if err = p.c.Call(ctx, "SearchProducts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateCategory(ctx context.Context, Req *product.CreateCategoryReq) (r *product.CreateCategoryResp, err error) {
+	var _args CreateCategoryArgs
+	_args.Req = Req
+	var _result CreateCategoryResult
+	if err = p.c.Call(ctx, "CreateCategory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateCategory(ctx context.Context, Req *product.UpdateCategoryReq) (r *product.UpdateCategoryResp, err error) {
+	var _args UpdateCategoryArgs
+	_args.Req = Req
+	var _result UpdateCategoryResult
+	if err = p.c.Call(ctx, "UpdateCategory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteCategory(ctx context.Context, Req *product.DeleteCategoryReq) (r *product.DeleteCategoryResp, err error) {
+	var _args DeleteCategoryArgs
+	_args.Req = Req
+	var _result DeleteCategoryResult
+	if err = p.c.Call(ctx, "DeleteCategory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateStock(ctx context.Context, Req *product.UpdateStockReq) (r *product.UpdateStockResp, err error) {
+	var _args UpdateStockArgs
+	_args.Req = Req
+	var _result UpdateStockResult
+	if err = p.c.Call(ctx, "UpdateStock", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ReserveStock(ctx context.Context, Req *product.ReserveStockReq) (r *product.ReserveStockResp, err error) {
+	var _args ReserveStockArgs
+	_args.Req = Req
+	var _result ReserveStockResult
+	if err = p.c.Call(ctx, "ReserveStock", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ConfirmReservation(ctx context.Context, Req *product.ConfirmReservationReq) (r *product.ConfirmReservationResp, err error) {
+	var _args ConfirmReservationArgs
+	_args.Req = Req
+	var _result ConfirmReservationResult
+	if err = p.c.Call(ctx, "ConfirmReservation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ReleaseReservation(ctx context.Context, Req *product.ReleaseReservationReq) (r *product.ReleaseReservationResp, err error) {
+	var _args ReleaseReservationArgs
+	_args.Req = Req
+	var _result ReleaseReservationResult
+	if err = p.c.Call(ctx, "ReleaseReservation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) BulkImportProducts(ctx context.Context) (ProductService_BulkImportProductsClient, error) {
+	streamClient, ok := p.c.(client.Streaming)
+	if !ok {
+		return nil, fmt.Errorf("client not support streaming")
+	}
+	res := new(streaming.Result)
+	err := streamClient.Stream(ctx, "BulkImportProducts", nil, res)
+	if err != nil {
+		return nil, err
+	}
+	stream := &productServiceBulkImportProductsClient{res.Stream}
+	return stream, nil
+}
+
+func (p *kClient) ExportProducts(ctx context.Context, req *product.ExportProductsReq) (ProductService_ExportProductsClient, error) {
+	streamClient, ok := p.c.(client.Streaming)
+	if !ok {
+		return nil, fmt.Errorf("client not support streaming")
+	}
+	res := new(streaming.Result)
+	err := streamClient.Stream(ctx, "ExportProducts", nil, res)
+	if err != nil {
+		return nil, err
+	}
+	stream := &productServiceExportProductsClient{res.Stream}
+
+	if err := stream.Stream.SendMsg(req); err != nil {
+		return nil, err
+	}
+	if err := stream.Stream.Close(); err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
@@ -0,0 +1,5760 @@
+// Code generated by Fastpb v0.0.2. DO NOT EDIT.
+
+package user
+
+import (
+	fmt "fmt"
+	fastpb "github.com/cloudwego/fastpb"
+	api "zqzqsb/gomall/app/gateway/kitex_gen/api"
+)
+
+var (
+	_ = fmt.Errorf
+	_ = fastpb.Skip
+)
+
+func (x *RegisterReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RegisterReq[number], err)
+}
+
+func (x *RegisterReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Email, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RegisterReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Password, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RegisterReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.PasswordConfirm, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RegisterResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RegisterResp[number], err)
+}
+
+func (x *RegisterResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *LoginReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_LoginReq[number], err)
+}
+
+func (x *LoginReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Email, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *LoginReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Password, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *LoginResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_LoginResp[number], err)
+}
+
+func (x *LoginResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *LoginResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v TokenPair
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Token = &v
+	return offset, nil
+}
+
+func (x *LoginResp) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.MfaRequired, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *LoginResp) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.MfaToken, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *LoginResp) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.MfaExpire, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *TokenPair) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_TokenPair[number], err)
+}
+
+func (x *TokenPair) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.AccessToken, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *TokenPair) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.AccessExpire, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *TokenPair) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.RefreshToken, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *TokenPair) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.RefreshExpire, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *RefreshTokenReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RefreshTokenReq[number], err)
+}
+
+func (x *RefreshTokenReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.RefreshToken, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RefreshTokenResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RefreshTokenResp[number], err)
+}
+
+func (x *RefreshTokenResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *RefreshTokenResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v TokenPair
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Token = &v
+	return offset, nil
+}
+
+func (x *LogoutReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_LogoutReq[number], err)
+}
+
+func (x *LogoutReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.AccessToken, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *LogoutReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.RefreshToken, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *LogoutReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.AllDevices, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *LogoutResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_LogoutResp[number], err)
+}
+
+func (x *LogoutResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *HelloReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_HelloReq[number], err)
+}
+
+func (x *HelloReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.X, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *HelloResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_HelloResp[number], err)
+}
+
+func (x *HelloResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.RespBody, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *HelloResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Profile) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_Profile[number], err)
+}
+
+func (x *Profile) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Profile) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Email, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Profile) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Nickname, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Profile) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.AvatarUrl, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Profile) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Phone, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetProfileReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+}
+
+func (x *GetProfileResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetProfileResp[number], err)
+}
+
+func (x *GetProfileResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Profile
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Profile = &v
+	return offset, nil
+}
+
+func (x *UpdateProfileReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UpdateProfileReq[number], err)
+}
+
+func (x *UpdateProfileReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Nickname, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateProfileReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.AvatarUrl, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateProfileReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Phone, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateProfileResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UpdateProfileResp[number], err)
+}
+
+func (x *UpdateProfileResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Profile
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Profile = &v
+	return offset, nil
+}
+
+func (x *Address) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 9:
+		offset, err = x.fastReadField9(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 10:
+		offset, err = x.fastReadField10(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_Address[number], err)
+}
+
+func (x *Address) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Id, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Address) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Address) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Address) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Phone, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Address) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Province, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Address) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.City, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Address) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.District, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Address) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.Detail, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Address) fastReadField9(buf []byte, _type int8) (offset int, err error) {
+	x.ZipCode, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Address) fastReadField10(buf []byte, _type int8) (offset int, err error) {
+	x.IsDefault, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *ListAddressesReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+}
+
+func (x *ListAddressesResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListAddressesResp[number], err)
+}
+
+func (x *ListAddressesResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Address
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Addresses = append(x.Addresses, &v)
+	return offset, nil
+}
+
+func (x *GetAddressReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetAddressReq[number], err)
+}
+
+func (x *GetAddressReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.AddressId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *GetAddressResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetAddressResp[number], err)
+}
+
+func (x *GetAddressResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Address
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Address = &v
+	return offset, nil
+}
+
+func (x *CreateAddressReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CreateAddressReq[number], err)
+}
+
+func (x *CreateAddressReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateAddressReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Phone, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateAddressReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Province, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateAddressReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.City, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateAddressReq) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.District, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateAddressReq) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.Detail, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateAddressReq) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.ZipCode, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreateAddressReq) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.IsDefault, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *CreateAddressResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CreateAddressResp[number], err)
+}
+
+func (x *CreateAddressResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Address
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Address = &v
+	return offset, nil
+}
+
+func (x *UpdateAddressReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UpdateAddressReq[number], err)
+}
+
+func (x *UpdateAddressReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.AddressId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateAddressReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateAddressReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Phone, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateAddressReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Province, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateAddressReq) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.City, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateAddressReq) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.District, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateAddressReq) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.Detail, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateAddressReq) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.ZipCode, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateAddressResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UpdateAddressResp[number], err)
+}
+
+func (x *UpdateAddressResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Address
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Address = &v
+	return offset, nil
+}
+
+func (x *DeleteAddressReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_DeleteAddressReq[number], err)
+}
+
+func (x *DeleteAddressReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.AddressId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *DeleteAddressResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_DeleteAddressResp[number], err)
+}
+
+func (x *DeleteAddressResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *SetDefaultAddressReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_SetDefaultAddressReq[number], err)
+}
+
+func (x *SetDefaultAddressReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.AddressId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *SetDefaultAddressResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_SetDefaultAddressResp[number], err)
+}
+
+func (x *SetDefaultAddressResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Address
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Address = &v
+	return offset, nil
+}
+
+func (x *SendVerificationEmailReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_SendVerificationEmailReq[number], err)
+}
+
+func (x *SendVerificationEmailReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Email, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *SendVerificationEmailResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_SendVerificationEmailResp[number], err)
+}
+
+func (x *SendVerificationEmailResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *VerifyEmailReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_VerifyEmailReq[number], err)
+}
+
+func (x *VerifyEmailReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *VerifyEmailResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_VerifyEmailResp[number], err)
+}
+
+func (x *VerifyEmailResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *RequestPasswordResetReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RequestPasswordResetReq[number], err)
+}
+
+func (x *RequestPasswordResetReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Email, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RequestPasswordResetResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RequestPasswordResetResp[number], err)
+}
+
+func (x *RequestPasswordResetResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *ResetPasswordReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ResetPasswordReq[number], err)
+}
+
+func (x *ResetPasswordReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ResetPasswordReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Password, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ResetPasswordReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.PasswordConfirm, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ResetPasswordResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ResetPasswordResp[number], err)
+}
+
+func (x *ResetPasswordResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *VerifyMFAReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_VerifyMFAReq[number], err)
+}
+
+func (x *VerifyMFAReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.MfaToken, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *VerifyMFAReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Code, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *VerifyMFAResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_VerifyMFAResp[number], err)
+}
+
+func (x *VerifyMFAResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *VerifyMFAResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v TokenPair
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Token = &v
+	return offset, nil
+}
+
+func (x *GetMFAStatusReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+}
+
+func (x *GetMFAStatusResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetMFAStatusResp[number], err)
+}
+
+func (x *GetMFAStatusResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Enabled, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *GetMFAStatusResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.RecoveryCodesRemaining, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *EnrollMFAReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+}
+
+func (x *EnrollMFAResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_EnrollMFAResp[number], err)
+}
+
+func (x *EnrollMFAResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Secret, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *EnrollMFAResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.OtpauthUri, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *EnrollMFAResp) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Expire, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *ActivateMFAReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ActivateMFAReq[number], err)
+}
+
+func (x *ActivateMFAReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Code, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ActivateMFAResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ActivateMFAResp[number], err)
+}
+
+func (x *ActivateMFAResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v string
+	v, offset, err = fastpb.ReadString(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.RecoveryCodes = append(x.RecoveryCodes, v)
+	return offset, err
+}
+
+func (x *DisableMFAReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_DisableMFAReq[number], err)
+}
+
+func (x *DisableMFAReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Code, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *DisableMFAResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_DisableMFAResp[number], err)
+}
+
+func (x *DisableMFAResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *RegenerateRecoveryCodesReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RegenerateRecoveryCodesReq[number], err)
+}
+
+func (x *RegenerateRecoveryCodesReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Code, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RegenerateRecoveryCodesResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RegenerateRecoveryCodesResp[number], err)
+}
+
+func (x *RegenerateRecoveryCodesResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v string
+	v, offset, err = fastpb.ReadString(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.RecoveryCodes = append(x.RecoveryCodes, v)
+	return offset, err
+}
+
+func (x *Policy) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_Policy[number], err)
+}
+
+func (x *Policy) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Subject, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Policy) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Object, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Policy) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Action, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Policy) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Effect, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RoleBinding) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RoleBinding[number], err)
+}
+
+func (x *RoleBinding) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Subject, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RoleBinding) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Role, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListPoliciesReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListPoliciesReq[number], err)
+}
+
+func (x *ListPoliciesReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Subject, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListPoliciesResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListPoliciesResp[number], err)
+}
+
+func (x *ListPoliciesResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Policy
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Policies = append(x.Policies, &v)
+	return offset, nil
+}
+
+func (x *AddPolicyReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_AddPolicyReq[number], err)
+}
+
+func (x *AddPolicyReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Policy
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Policy = &v
+	return offset, nil
+}
+
+func (x *AddPolicyResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_AddPolicyResp[number], err)
+}
+
+func (x *AddPolicyResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *RemovePolicyReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RemovePolicyReq[number], err)
+}
+
+func (x *RemovePolicyReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Policy
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Policy = &v
+	return offset, nil
+}
+
+func (x *RemovePolicyResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RemovePolicyResp[number], err)
+}
+
+func (x *RemovePolicyResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *ListRolesReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListRolesReq[number], err)
+}
+
+func (x *ListRolesReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Subject, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListRolesResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListRolesResp[number], err)
+}
+
+func (x *ListRolesResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v RoleBinding
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Bindings = append(x.Bindings, &v)
+	return offset, nil
+}
+
+func (x *AssignRoleReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_AssignRoleReq[number], err)
+}
+
+func (x *AssignRoleReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v RoleBinding
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Binding = &v
+	return offset, nil
+}
+
+func (x *AssignRoleResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_AssignRoleResp[number], err)
+}
+
+func (x *AssignRoleResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *RevokeRoleReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RevokeRoleReq[number], err)
+}
+
+func (x *RevokeRoleReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v RoleBinding
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Binding = &v
+	return offset, nil
+}
+
+func (x *RevokeRoleResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RevokeRoleResp[number], err)
+}
+
+func (x *RevokeRoleResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *ListBlacklistReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+}
+
+func (x *ListBlacklistResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListBlacklistResp[number], err)
+}
+
+func (x *ListBlacklistResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	offset, err = fastpb.ReadList(buf, _type,
+		func(buf []byte, _type int8) (n int, err error) {
+			var v int64
+			v, offset, err = fastpb.ReadInt64(buf, _type)
+			if err != nil {
+				return offset, err
+			}
+			x.UserIds = append(x.UserIds, v)
+			return offset, err
+		})
+	return offset, err
+}
+
+func (x *AddToBlacklistReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_AddToBlacklistReq[number], err)
+}
+
+func (x *AddToBlacklistReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *AddToBlacklistResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_AddToBlacklistResp[number], err)
+}
+
+func (x *AddToBlacklistResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *RemoveFromBlacklistReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RemoveFromBlacklistReq[number], err)
+}
+
+func (x *RemoveFromBlacklistReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *RemoveFromBlacklistResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RemoveFromBlacklistResp[number], err)
+}
+
+func (x *RemoveFromBlacklistResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *RegisterReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *RegisterReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Email == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetEmail())
+	return offset
+}
+
+func (x *RegisterReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Password == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetPassword())
+	return offset
+}
+
+func (x *RegisterReq) fastWriteField3(buf []byte) (offset int) {
+	if x.PasswordConfirm == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetPasswordConfirm())
+	return offset
+}
+
+func (x *RegisterResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *RegisterResp) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *LoginReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *LoginReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Email == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetEmail())
+	return offset
+}
+
+func (x *LoginReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Password == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetPassword())
+	return offset
+}
+
+func (x *LoginResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *LoginResp) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *LoginResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Token == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetToken())
+	return offset
+}
+
+func (x *LoginResp) fastWriteField3(buf []byte) (offset int) {
+	if !x.MfaRequired {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 3, x.GetMfaRequired())
+	return offset
+}
+
+func (x *LoginResp) fastWriteField4(buf []byte) (offset int) {
+	if x.MfaToken == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetMfaToken())
+	return offset
+}
+
+func (x *LoginResp) fastWriteField5(buf []byte) (offset int) {
+	if x.MfaExpire == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 5, x.GetMfaExpire())
+	return offset
+}
+
+func (x *TokenPair) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *TokenPair) fastWriteField1(buf []byte) (offset int) {
+	if x.AccessToken == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetAccessToken())
+	return offset
+}
+
+func (x *TokenPair) fastWriteField2(buf []byte) (offset int) {
+	if x.AccessExpire == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetAccessExpire())
+	return offset
+}
+
+func (x *TokenPair) fastWriteField3(buf []byte) (offset int) {
+	if x.RefreshToken == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetRefreshToken())
+	return offset
+}
+
+func (x *TokenPair) fastWriteField4(buf []byte) (offset int) {
+	if x.RefreshExpire == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 4, x.GetRefreshExpire())
+	return offset
+}
+
+func (x *RefreshTokenReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *RefreshTokenReq) fastWriteField1(buf []byte) (offset int) {
+	if x.RefreshToken == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetRefreshToken())
+	return offset
+}
+
+func (x *RefreshTokenResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *RefreshTokenResp) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *RefreshTokenResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Token == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetToken())
+	return offset
+}
+
+func (x *LogoutReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *LogoutReq) fastWriteField1(buf []byte) (offset int) {
+	if x.AccessToken == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetAccessToken())
+	return offset
+}
+
+func (x *LogoutReq) fastWriteField2(buf []byte) (offset int) {
+	if x.RefreshToken == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetRefreshToken())
+	return offset
+}
+
+func (x *LogoutReq) fastWriteField3(buf []byte) (offset int) {
+	if !x.AllDevices {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 3, x.GetAllDevices())
+	return offset
+}
+
+func (x *LogoutResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *LogoutResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *HelloReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *HelloReq) fastWriteField1(buf []byte) (offset int) {
+	if x.X == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetX())
+	return offset
+}
+
+func (x *HelloResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *HelloResp) fastWriteField1(buf []byte) (offset int) {
+	if x.RespBody == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetRespBody())
+	return offset
+}
+
+func (x *HelloResp) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *Profile) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *Profile) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *Profile) fastWriteField2(buf []byte) (offset int) {
+	if x.Email == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetEmail())
+	return offset
+}
+
+func (x *Profile) fastWriteField3(buf []byte) (offset int) {
+	if x.Nickname == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetNickname())
+	return offset
+}
+
+func (x *Profile) fastWriteField4(buf []byte) (offset int) {
+	if x.AvatarUrl == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetAvatarUrl())
+	return offset
+}
+
+func (x *Profile) fastWriteField5(buf []byte) (offset int) {
+	if x.Phone == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetPhone())
+	return offset
+}
+
+func (x *GetProfileReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	return offset
+}
+
+func (x *GetProfileResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *GetProfileResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Profile == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetProfile())
+	return offset
+}
+
+func (x *UpdateProfileReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *UpdateProfileReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Nickname == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetNickname())
+	return offset
+}
+
+func (x *UpdateProfileReq) fastWriteField2(buf []byte) (offset int) {
+	if x.AvatarUrl == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetAvatarUrl())
+	return offset
+}
+
+func (x *UpdateProfileReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Phone == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetPhone())
+	return offset
+}
+
+func (x *UpdateProfileResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *UpdateProfileResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Profile == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetProfile())
+	return offset
+}
+
+func (x *Address) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	offset += x.fastWriteField10(buf[offset:])
+	return offset
+}
+
+func (x *Address) fastWriteField1(buf []byte) (offset int) {
+	if x.Id == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetId())
+	return offset
+}
+
+func (x *Address) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *Address) fastWriteField3(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetName())
+	return offset
+}
+
+func (x *Address) fastWriteField4(buf []byte) (offset int) {
+	if x.Phone == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetPhone())
+	return offset
+}
+
+func (x *Address) fastWriteField5(buf []byte) (offset int) {
+	if x.Province == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetProvince())
+	return offset
+}
+
+func (x *Address) fastWriteField6(buf []byte) (offset int) {
+	if x.City == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 6, x.GetCity())
+	return offset
+}
+
+func (x *Address) fastWriteField7(buf []byte) (offset int) {
+	if x.District == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 7, x.GetDistrict())
+	return offset
+}
+
+func (x *Address) fastWriteField8(buf []byte) (offset int) {
+	if x.Detail == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 8, x.GetDetail())
+	return offset
+}
+
+func (x *Address) fastWriteField9(buf []byte) (offset int) {
+	if x.ZipCode == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 9, x.GetZipCode())
+	return offset
+}
+
+func (x *Address) fastWriteField10(buf []byte) (offset int) {
+	if !x.IsDefault {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 10, x.GetIsDefault())
+	return offset
+}
+
+func (x *ListAddressesReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	return offset
+}
+
+func (x *ListAddressesResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ListAddressesResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Addresses == nil {
+		return offset
+	}
+	for i := range x.GetAddresses() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetAddresses()[i])
+	}
+	return offset
+}
+
+func (x *GetAddressReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *GetAddressReq) fastWriteField1(buf []byte) (offset int) {
+	if x.AddressId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetAddressId())
+	return offset
+}
+
+func (x *GetAddressResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *GetAddressResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Address == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetAddress())
+	return offset
+}
+
+func (x *CreateAddressReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	return offset
+}
+
+func (x *CreateAddressReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetName())
+	return offset
+}
+
+func (x *CreateAddressReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Phone == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetPhone())
+	return offset
+}
+
+func (x *CreateAddressReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Province == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetProvince())
+	return offset
+}
+
+func (x *CreateAddressReq) fastWriteField4(buf []byte) (offset int) {
+	if x.City == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetCity())
+	return offset
+}
+
+func (x *CreateAddressReq) fastWriteField5(buf []byte) (offset int) {
+	if x.District == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetDistrict())
+	return offset
+}
+
+func (x *CreateAddressReq) fastWriteField6(buf []byte) (offset int) {
+	if x.Detail == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 6, x.GetDetail())
+	return offset
+}
+
+func (x *CreateAddressReq) fastWriteField7(buf []byte) (offset int) {
+	if x.ZipCode == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 7, x.GetZipCode())
+	return offset
+}
+
+func (x *CreateAddressReq) fastWriteField8(buf []byte) (offset int) {
+	if !x.IsDefault {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 8, x.GetIsDefault())
+	return offset
+}
+
+func (x *CreateAddressResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *CreateAddressResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Address == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetAddress())
+	return offset
+}
+
+func (x *UpdateAddressReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	return offset
+}
+
+func (x *UpdateAddressReq) fastWriteField1(buf []byte) (offset int) {
+	if x.AddressId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetAddressId())
+	return offset
+}
+
+func (x *UpdateAddressReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetName())
+	return offset
+}
+
+func (x *UpdateAddressReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Phone == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetPhone())
+	return offset
+}
+
+func (x *UpdateAddressReq) fastWriteField4(buf []byte) (offset int) {
+	if x.Province == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetProvince())
+	return offset
+}
+
+func (x *UpdateAddressReq) fastWriteField5(buf []byte) (offset int) {
+	if x.City == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetCity())
+	return offset
+}
+
+func (x *UpdateAddressReq) fastWriteField6(buf []byte) (offset int) {
+	if x.District == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 6, x.GetDistrict())
+	return offset
+}
+
+func (x *UpdateAddressReq) fastWriteField7(buf []byte) (offset int) {
+	if x.Detail == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 7, x.GetDetail())
+	return offset
+}
+
+func (x *UpdateAddressReq) fastWriteField8(buf []byte) (offset int) {
+	if x.ZipCode == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 8, x.GetZipCode())
+	return offset
+}
+
+func (x *UpdateAddressResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *UpdateAddressResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Address == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetAddress())
+	return offset
+}
+
+func (x *DeleteAddressReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *DeleteAddressReq) fastWriteField1(buf []byte) (offset int) {
+	if x.AddressId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetAddressId())
+	return offset
+}
+
+func (x *DeleteAddressResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *DeleteAddressResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *SetDefaultAddressReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *SetDefaultAddressReq) fastWriteField1(buf []byte) (offset int) {
+	if x.AddressId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetAddressId())
+	return offset
+}
+
+func (x *SetDefaultAddressResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *SetDefaultAddressResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Address == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetAddress())
+	return offset
+}
+
+func (x *SendVerificationEmailReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *SendVerificationEmailReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Email == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetEmail())
+	return offset
+}
+
+func (x *SendVerificationEmailResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *SendVerificationEmailResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *VerifyEmailReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *VerifyEmailReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *VerifyEmailResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *VerifyEmailResp) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *RequestPasswordResetReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *RequestPasswordResetReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Email == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetEmail())
+	return offset
+}
+
+func (x *RequestPasswordResetResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *RequestPasswordResetResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *ResetPasswordReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *ResetPasswordReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetToken())
+	return offset
+}
+
+func (x *ResetPasswordReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Password == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetPassword())
+	return offset
+}
+
+func (x *ResetPasswordReq) fastWriteField3(buf []byte) (offset int) {
+	if x.PasswordConfirm == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetPasswordConfirm())
+	return offset
+}
+
+func (x *ResetPasswordResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ResetPasswordResp) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *VerifyMFAReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *VerifyMFAReq) fastWriteField1(buf []byte) (offset int) {
+	if x.MfaToken == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetMfaToken())
+	return offset
+}
+
+func (x *VerifyMFAReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Code == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetCode())
+	return offset
+}
+
+func (x *VerifyMFAResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *VerifyMFAResp) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *VerifyMFAResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Token == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetToken())
+	return offset
+}
+
+func (x *GetMFAStatusReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	return offset
+}
+
+func (x *GetMFAStatusResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *GetMFAStatusResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Enabled {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetEnabled())
+	return offset
+}
+
+func (x *GetMFAStatusResp) fastWriteField2(buf []byte) (offset int) {
+	if x.RecoveryCodesRemaining == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetRecoveryCodesRemaining())
+	return offset
+}
+
+func (x *EnrollMFAReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	return offset
+}
+
+func (x *EnrollMFAResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *EnrollMFAResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Secret == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetSecret())
+	return offset
+}
+
+func (x *EnrollMFAResp) fastWriteField2(buf []byte) (offset int) {
+	if x.OtpauthUri == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetOtpauthUri())
+	return offset
+}
+
+func (x *EnrollMFAResp) fastWriteField3(buf []byte) (offset int) {
+	if x.Expire == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetExpire())
+	return offset
+}
+
+func (x *ActivateMFAReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ActivateMFAReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Code == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetCode())
+	return offset
+}
+
+func (x *ActivateMFAResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ActivateMFAResp) fastWriteField1(buf []byte) (offset int) {
+	if len(x.RecoveryCodes) == 0 {
+		return offset
+	}
+	for i := range x.GetRecoveryCodes() {
+		offset += fastpb.WriteString(buf[offset:], 1, x.GetRecoveryCodes()[i])
+	}
+	return offset
+}
+
+func (x *DisableMFAReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *DisableMFAReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Code == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetCode())
+	return offset
+}
+
+func (x *DisableMFAResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *DisableMFAResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *RegenerateRecoveryCodesReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *RegenerateRecoveryCodesReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Code == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetCode())
+	return offset
+}
+
+func (x *RegenerateRecoveryCodesResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *RegenerateRecoveryCodesResp) fastWriteField1(buf []byte) (offset int) {
+	if len(x.RecoveryCodes) == 0 {
+		return offset
+	}
+	for i := range x.GetRecoveryCodes() {
+		offset += fastpb.WriteString(buf[offset:], 1, x.GetRecoveryCodes()[i])
+	}
+	return offset
+}
+
+func (x *Policy) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *Policy) fastWriteField1(buf []byte) (offset int) {
+	if x.Subject == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetSubject())
+	return offset
+}
+
+func (x *Policy) fastWriteField2(buf []byte) (offset int) {
+	if x.Object == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetObject())
+	return offset
+}
+
+func (x *Policy) fastWriteField3(buf []byte) (offset int) {
+	if x.Action == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetAction())
+	return offset
+}
+
+func (x *Policy) fastWriteField4(buf []byte) (offset int) {
+	if x.Effect == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetEffect())
+	return offset
+}
+
+func (x *RoleBinding) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *RoleBinding) fastWriteField1(buf []byte) (offset int) {
+	if x.Subject == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetSubject())
+	return offset
+}
+
+func (x *RoleBinding) fastWriteField2(buf []byte) (offset int) {
+	if x.Role == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetRole())
+	return offset
+}
+
+func (x *ListPoliciesReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ListPoliciesReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Subject == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetSubject())
+	return offset
+}
+
+func (x *ListPoliciesResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ListPoliciesResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Policies == nil {
+		return offset
+	}
+	for i := range x.GetPolicies() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetPolicies()[i])
+	}
+	return offset
+}
+
+func (x *AddPolicyReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *AddPolicyReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Policy == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetPolicy())
+	return offset
+}
+
+func (x *AddPolicyResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *AddPolicyResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *RemovePolicyReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *RemovePolicyReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Policy == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetPolicy())
+	return offset
+}
+
+func (x *RemovePolicyResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *RemovePolicyResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *ListRolesReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ListRolesReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Subject == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetSubject())
+	return offset
+}
+
+func (x *ListRolesResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ListRolesResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Bindings == nil {
+		return offset
+	}
+	for i := range x.GetBindings() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBindings()[i])
+	}
+	return offset
+}
+
+func (x *AssignRoleReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *AssignRoleReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Binding == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBinding())
+	return offset
+}
+
+func (x *AssignRoleResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *AssignRoleResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *RevokeRoleReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *RevokeRoleReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Binding == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetBinding())
+	return offset
+}
+
+func (x *RevokeRoleResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *RevokeRoleResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *ListBlacklistReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	return offset
+}
+
+func (x *ListBlacklistResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ListBlacklistResp) fastWriteField1(buf []byte) (offset int) {
+	if len(x.UserIds) == 0 {
+		return offset
+	}
+	offset += fastpb.WriteListPacked(buf[offset:], 1, len(x.GetUserIds()),
+		func(buf []byte, numTagOrKey, numIdxOrVal int32) int {
+			offset := 0
+			offset += fastpb.WriteInt64(buf[offset:], numTagOrKey, x.GetUserIds()[numIdxOrVal])
+			return offset
+		})
+	return offset
+}
+
+func (x *AddToBlacklistReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *AddToBlacklistReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *AddToBlacklistResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *AddToBlacklistResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *RemoveFromBlacklistReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *RemoveFromBlacklistReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *RemoveFromBlacklistResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *RemoveFromBlacklistResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *RegisterReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *RegisterReq) sizeField1() (n int) {
+	if x.Email == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetEmail())
+	return n
+}
+
+func (x *RegisterReq) sizeField2() (n int) {
+	if x.Password == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetPassword())
+	return n
+}
+
+func (x *RegisterReq) sizeField3() (n int) {
+	if x.PasswordConfirm == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetPasswordConfirm())
+	return n
+}
+
+func (x *RegisterResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *RegisterResp) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.GetUserId())
+	return n
+}
+
+func (x *LoginReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *LoginReq) sizeField1() (n int) {
+	if x.Email == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetEmail())
+	return n
+}
+
+func (x *LoginReq) sizeField2() (n int) {
+	if x.Password == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetPassword())
+	return n
+}
+
+func (x *LoginResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	return n
+}
+
+func (x *LoginResp) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.GetUserId())
+	return n
+}
+
+func (x *LoginResp) sizeField2() (n int) {
+	if x.Token == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(2, x.GetToken())
+	return n
+}
+
+func (x *LoginResp) sizeField3() (n int) {
+	if !x.MfaRequired {
+		return n
+	}
+	n += fastpb.SizeBool(3, x.GetMfaRequired())
+	return n
+}
+
+func (x *LoginResp) sizeField4() (n int) {
+	if x.MfaToken == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetMfaToken())
+	return n
+}
+
+func (x *LoginResp) sizeField5() (n int) {
+	if x.MfaExpire == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(5, x.GetMfaExpire())
+	return n
+}
+
+func (x *TokenPair) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *TokenPair) sizeField1() (n int) {
+	if x.AccessToken == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetAccessToken())
+	return n
+}
+
+func (x *TokenPair) sizeField2() (n int) {
+	if x.AccessExpire == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetAccessExpire())
+	return n
+}
+
+func (x *TokenPair) sizeField3() (n int) {
+	if x.RefreshToken == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetRefreshToken())
+	return n
+}
+
+func (x *TokenPair) sizeField4() (n int) {
+	if x.RefreshExpire == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(4, x.GetRefreshExpire())
+	return n
+}
+
+func (x *RefreshTokenReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *RefreshTokenReq) sizeField1() (n int) {
+	if x.RefreshToken == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetRefreshToken())
+	return n
+}
+
+func (x *RefreshTokenResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *RefreshTokenResp) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetUserId())
+	return n
+}
+
+func (x *RefreshTokenResp) sizeField2() (n int) {
+	if x.Token == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(2, x.GetToken())
+	return n
+}
+
+func (x *LogoutReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *LogoutReq) sizeField1() (n int) {
+	if x.AccessToken == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetAccessToken())
+	return n
+}
+
+func (x *LogoutReq) sizeField2() (n int) {
+	if x.RefreshToken == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetRefreshToken())
+	return n
+}
+
+func (x *LogoutReq) sizeField3() (n int) {
+	if !x.AllDevices {
+		return n
+	}
+	n += fastpb.SizeBool(3, x.GetAllDevices())
+	return n
+}
+
+func (x *LogoutResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *LogoutResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
+func (x *HelloReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *HelloReq) sizeField1() (n int) {
+	if x.X == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetX())
+	return n
+}
+
+func (x *HelloResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *HelloResp) sizeField1() (n int) {
+	if x.RespBody == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetRespBody())
+	return n
+}
+
+func (x *HelloResp) sizeField2() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetUserId())
+	return n
+}
+
+func (x *Profile) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	return n
+}
+
+func (x *Profile) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetUserId())
+	return n
+}
+
+func (x *Profile) sizeField2() (n int) {
+	if x.Email == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetEmail())
+	return n
+}
+
+func (x *Profile) sizeField3() (n int) {
+	if x.Nickname == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetNickname())
+	return n
+}
+
+func (x *Profile) sizeField4() (n int) {
+	if x.AvatarUrl == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetAvatarUrl())
+	return n
+}
+
+func (x *Profile) sizeField5() (n int) {
+	if x.Phone == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.GetPhone())
+	return n
+}
+
+func (x *GetProfileReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	return n
+}
+
+func (x *GetProfileResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *GetProfileResp) sizeField1() (n int) {
+	if x.Profile == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetProfile())
+	return n
+}
+
+func (x *UpdateProfileReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *UpdateProfileReq) sizeField1() (n int) {
+	if x.Nickname == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetNickname())
+	return n
+}
+
+func (x *UpdateProfileReq) sizeField2() (n int) {
+	if x.AvatarUrl == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetAvatarUrl())
+	return n
+}
+
+func (x *UpdateProfileReq) sizeField3() (n int) {
+	if x.Phone == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetPhone())
+	return n
+}
+
+func (x *UpdateProfileResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *UpdateProfileResp) sizeField1() (n int) {
+	if x.Profile == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetProfile())
+	return n
+}
+
+func (x *Address) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
+	n += x.sizeField10()
+	return n
+}
+
+func (x *Address) sizeField1() (n int) {
+	if x.Id == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetId())
+	return n
+}
+
+func (x *Address) sizeField2() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetUserId())
+	return n
+}
+
+func (x *Address) sizeField3() (n int) {
+	if x.Name == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetName())
+	return n
+}
+
+func (x *Address) sizeField4() (n int) {
+	if x.Phone == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetPhone())
+	return n
+}
+
+func (x *Address) sizeField5() (n int) {
+	if x.Province == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.GetProvince())
+	return n
+}
+
+func (x *Address) sizeField6() (n int) {
+	if x.City == "" {
+		return n
+	}
+	n += fastpb.SizeString(6, x.GetCity())
+	return n
+}
+
+func (x *Address) sizeField7() (n int) {
+	if x.District == "" {
+		return n
+	}
+	n += fastpb.SizeString(7, x.GetDistrict())
+	return n
+}
+
+func (x *Address) sizeField8() (n int) {
+	if x.Detail == "" {
+		return n
+	}
+	n += fastpb.SizeString(8, x.GetDetail())
+	return n
+}
+
+func (x *Address) sizeField9() (n int) {
+	if x.ZipCode == "" {
+		return n
+	}
+	n += fastpb.SizeString(9, x.GetZipCode())
+	return n
+}
+
+func (x *Address) sizeField10() (n int) {
+	if !x.IsDefault {
+		return n
+	}
+	n += fastpb.SizeBool(10, x.GetIsDefault())
+	return n
+}
+
+func (x *ListAddressesReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	return n
+}
+
+func (x *ListAddressesResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ListAddressesResp) sizeField1() (n int) {
+	if x.Addresses == nil {
+		return n
+	}
+	for i := range x.GetAddresses() {
+		n += fastpb.SizeMessage(1, x.GetAddresses()[i])
+	}
+	return n
+}
+
+func (x *GetAddressReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *GetAddressReq) sizeField1() (n int) {
+	if x.AddressId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetAddressId())
+	return n
+}
+
+func (x *GetAddressResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *GetAddressResp) sizeField1() (n int) {
+	if x.Address == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetAddress())
+	return n
+}
+
+func (x *CreateAddressReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	return n
+}
+
+func (x *CreateAddressReq) sizeField1() (n int) {
+	if x.Name == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetName())
+	return n
+}
+
+func (x *CreateAddressReq) sizeField2() (n int) {
+	if x.Phone == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetPhone())
+	return n
+}
+
+func (x *CreateAddressReq) sizeField3() (n int) {
+	if x.Province == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetProvince())
+	return n
+}
+
+func (x *CreateAddressReq) sizeField4() (n int) {
+	if x.City == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetCity())
+	return n
+}
+
+func (x *CreateAddressReq) sizeField5() (n int) {
+	if x.District == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.GetDistrict())
+	return n
+}
+
+func (x *CreateAddressReq) sizeField6() (n int) {
+	if x.Detail == "" {
+		return n
+	}
+	n += fastpb.SizeString(6, x.GetDetail())
+	return n
+}
+
+func (x *CreateAddressReq) sizeField7() (n int) {
+	if x.ZipCode == "" {
+		return n
+	}
+	n += fastpb.SizeString(7, x.GetZipCode())
+	return n
+}
+
+func (x *CreateAddressReq) sizeField8() (n int) {
+	if !x.IsDefault {
+		return n
+	}
+	n += fastpb.SizeBool(8, x.GetIsDefault())
+	return n
+}
+
+func (x *CreateAddressResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *CreateAddressResp) sizeField1() (n int) {
+	if x.Address == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetAddress())
+	return n
+}
+
+func (x *UpdateAddressReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	return n
+}
+
+func (x *UpdateAddressReq) sizeField1() (n int) {
+	if x.AddressId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetAddressId())
+	return n
+}
+
+func (x *UpdateAddressReq) sizeField2() (n int) {
+	if x.Name == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetName())
+	return n
+}
+
+func (x *UpdateAddressReq) sizeField3() (n int) {
+	if x.Phone == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetPhone())
+	return n
+}
+
+func (x *UpdateAddressReq) sizeField4() (n int) {
+	if x.Province == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetProvince())
+	return n
+}
+
+func (x *UpdateAddressReq) sizeField5() (n int) {
+	if x.City == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.GetCity())
+	return n
+}
+
+func (x *UpdateAddressReq) sizeField6() (n int) {
+	if x.District == "" {
+		return n
+	}
+	n += fastpb.SizeString(6, x.GetDistrict())
+	return n
+}
+
+func (x *UpdateAddressReq) sizeField7() (n int) {
+	if x.Detail == "" {
+		return n
+	}
+	n += fastpb.SizeString(7, x.GetDetail())
+	return n
+}
+
+func (x *UpdateAddressReq) sizeField8() (n int) {
+	if x.ZipCode == "" {
+		return n
+	}
+	n += fastpb.SizeString(8, x.GetZipCode())
+	return n
+}
+
+func (x *UpdateAddressResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *UpdateAddressResp) sizeField1() (n int) {
+	if x.Address == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetAddress())
+	return n
+}
+
+func (x *DeleteAddressReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *DeleteAddressReq) sizeField1() (n int) {
+	if x.AddressId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetAddressId())
+	return n
+}
+
+func (x *DeleteAddressResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *DeleteAddressResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
+func (x *SetDefaultAddressReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *SetDefaultAddressReq) sizeField1() (n int) {
+	if x.AddressId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetAddressId())
+	return n
+}
+
+func (x *SetDefaultAddressResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *SetDefaultAddressResp) sizeField1() (n int) {
+	if x.Address == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetAddress())
+	return n
+}
+
+func (x *SendVerificationEmailReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *SendVerificationEmailReq) sizeField1() (n int) {
+	if x.Email == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetEmail())
+	return n
+}
+
+func (x *SendVerificationEmailResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *SendVerificationEmailResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
+func (x *VerifyEmailReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *VerifyEmailReq) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *VerifyEmailResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *VerifyEmailResp) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetUserId())
+	return n
+}
+
+func (x *RequestPasswordResetReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *RequestPasswordResetReq) sizeField1() (n int) {
+	if x.Email == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetEmail())
+	return n
+}
+
+func (x *RequestPasswordResetResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *RequestPasswordResetResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
+func (x *ResetPasswordReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *ResetPasswordReq) sizeField1() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetToken())
+	return n
+}
+
+func (x *ResetPasswordReq) sizeField2() (n int) {
+	if x.Password == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetPassword())
+	return n
+}
+
+func (x *ResetPasswordReq) sizeField3() (n int) {
+	if x.PasswordConfirm == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetPasswordConfirm())
+	return n
+}
+
+func (x *ResetPasswordResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ResetPasswordResp) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetUserId())
+	return n
+}
+
+func (x *VerifyMFAReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *VerifyMFAReq) sizeField1() (n int) {
+	if x.MfaToken == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetMfaToken())
+	return n
+}
+
+func (x *VerifyMFAReq) sizeField2() (n int) {
+	if x.Code == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetCode())
+	return n
+}
+
+func (x *VerifyMFAResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *VerifyMFAResp) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetUserId())
+	return n
+}
+
+func (x *VerifyMFAResp) sizeField2() (n int) {
+	if x.Token == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(2, x.GetToken())
+	return n
+}
+
+func (x *GetMFAStatusReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	return n
+}
+
+func (x *GetMFAStatusResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *GetMFAStatusResp) sizeField1() (n int) {
+	if !x.Enabled {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetEnabled())
+	return n
+}
+
+func (x *GetMFAStatusResp) sizeField2() (n int) {
+	if x.RecoveryCodesRemaining == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetRecoveryCodesRemaining())
+	return n
+}
+
+func (x *EnrollMFAReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	return n
+}
+
+func (x *EnrollMFAResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *EnrollMFAResp) sizeField1() (n int) {
+	if x.Secret == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetSecret())
+	return n
+}
+
+func (x *EnrollMFAResp) sizeField2() (n int) {
+	if x.OtpauthUri == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetOtpauthUri())
+	return n
+}
+
+func (x *EnrollMFAResp) sizeField3() (n int) {
+	if x.Expire == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.GetExpire())
+	return n
+}
+
+func (x *ActivateMFAReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ActivateMFAReq) sizeField1() (n int) {
+	if x.Code == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetCode())
+	return n
+}
+
+func (x *ActivateMFAResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ActivateMFAResp) sizeField1() (n int) {
+	if len(x.RecoveryCodes) == 0 {
+		return n
+	}
+	for i := range x.GetRecoveryCodes() {
+		n += fastpb.SizeString(1, x.GetRecoveryCodes()[i])
+	}
+	return n
+}
+
+func (x *DisableMFAReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *DisableMFAReq) sizeField1() (n int) {
+	if x.Code == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetCode())
+	return n
+}
+
+func (x *DisableMFAResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *DisableMFAResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
+func (x *RegenerateRecoveryCodesReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *RegenerateRecoveryCodesReq) sizeField1() (n int) {
+	if x.Code == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetCode())
+	return n
+}
+
+func (x *RegenerateRecoveryCodesResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *RegenerateRecoveryCodesResp) sizeField1() (n int) {
+	if len(x.RecoveryCodes) == 0 {
+		return n
+	}
+	for i := range x.GetRecoveryCodes() {
+		n += fastpb.SizeString(1, x.GetRecoveryCodes()[i])
+	}
+	return n
+}
+
+func (x *Policy) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *Policy) sizeField1() (n int) {
+	if x.Subject == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetSubject())
+	return n
+}
+
+func (x *Policy) sizeField2() (n int) {
+	if x.Object == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetObject())
+	return n
+}
+
+func (x *Policy) sizeField3() (n int) {
+	if x.Action == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetAction())
+	return n
+}
+
+func (x *Policy) sizeField4() (n int) {
+	if x.Effect == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetEffect())
+	return n
+}
+
+func (x *RoleBinding) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *RoleBinding) sizeField1() (n int) {
+	if x.Subject == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetSubject())
+	return n
+}
+
+func (x *RoleBinding) sizeField2() (n int) {
+	if x.Role == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetRole())
+	return n
+}
+
+func (x *ListPoliciesReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ListPoliciesReq) sizeField1() (n int) {
+	if x.Subject == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetSubject())
+	return n
+}
+
+func (x *ListPoliciesResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ListPoliciesResp) sizeField1() (n int) {
+	if x.Policies == nil {
+		return n
+	}
+	for i := range x.GetPolicies() {
+		n += fastpb.SizeMessage(1, x.GetPolicies()[i])
+	}
+	return n
+}
+
+func (x *AddPolicyReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *AddPolicyReq) sizeField1() (n int) {
+	if x.Policy == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetPolicy())
+	return n
+}
+
+func (x *AddPolicyResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *AddPolicyResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
+func (x *RemovePolicyReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *RemovePolicyReq) sizeField1() (n int) {
+	if x.Policy == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetPolicy())
+	return n
+}
+
+func (x *RemovePolicyResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *RemovePolicyResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
+func (x *ListRolesReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ListRolesReq) sizeField1() (n int) {
+	if x.Subject == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetSubject())
+	return n
+}
+
+func (x *ListRolesResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ListRolesResp) sizeField1() (n int) {
+	if x.Bindings == nil {
+		return n
+	}
+	for i := range x.GetBindings() {
+		n += fastpb.SizeMessage(1, x.GetBindings()[i])
+	}
+	return n
+}
+
+func (x *AssignRoleReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *AssignRoleReq) sizeField1() (n int) {
+	if x.Binding == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBinding())
+	return n
+}
+
+func (x *AssignRoleResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *AssignRoleResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
+func (x *RevokeRoleReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *RevokeRoleReq) sizeField1() (n int) {
+	if x.Binding == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetBinding())
+	return n
+}
+
+func (x *RevokeRoleResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *RevokeRoleResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
+func (x *ListBlacklistReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	return n
+}
+
+func (x *ListBlacklistResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ListBlacklistResp) sizeField1() (n int) {
+	if len(x.UserIds) == 0 {
+		return n
+	}
+	n += fastpb.SizeListPacked(1, len(x.GetUserIds()),
+		func(numTagOrKey, numIdxOrVal int32) int {
+			n := 0
+			n += fastpb.SizeInt64(numTagOrKey, x.GetUserIds()[numIdxOrVal])
+			return n
+		})
+	return n
+}
+
+func (x *AddToBlacklistReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *AddToBlacklistReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetUserId())
+	return n
+}
+
+func (x *AddToBlacklistResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *AddToBlacklistResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
+func (x *RemoveFromBlacklistReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *RemoveFromBlacklistReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetUserId())
+	return n
+}
+
+func (x *RemoveFromBlacklistResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *RemoveFromBlacklistResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
+var fieldIDToName_RegisterReq = map[int32]string{
+	1: "Email",
+	2: "Password",
+	3: "PasswordConfirm",
+}
+
+var fieldIDToName_RegisterResp = map[int32]string{
+	1: "UserId",
+}
+
+var fieldIDToName_LoginReq = map[int32]string{
+	1: "Email",
+	2: "Password",
+}
+
+var fieldIDToName_LoginResp = map[int32]string{
+	1: "UserId",
+	2: "Token",
+	3: "MfaRequired",
+	4: "MfaToken",
+	5: "MfaExpire",
+}
+
+var fieldIDToName_TokenPair = map[int32]string{
+	1: "AccessToken",
+	2: "AccessExpire",
+	3: "RefreshToken",
+	4: "RefreshExpire",
+}
+
+var fieldIDToName_RefreshTokenReq = map[int32]string{
+	1: "RefreshToken",
+}
+
+var fieldIDToName_RefreshTokenResp = map[int32]string{
+	1: "UserId",
+	2: "Token",
+}
+
+var fieldIDToName_LogoutReq = map[int32]string{
+	1: "AccessToken",
+	2: "RefreshToken",
+	3: "AllDevices",
+}
+
+var fieldIDToName_LogoutResp = map[int32]string{
+	1: "Success",
+}
+
+var fieldIDToName_HelloReq = map[int32]string{
+	1: "X",
+}
+
+var fieldIDToName_HelloResp = map[int32]string{
+	1: "RespBody",
+	2: "UserId",
+}
+
+var fieldIDToName_Profile = map[int32]string{
+	1: "UserId",
+	2: "Email",
+	3: "Nickname",
+	4: "AvatarUrl",
+	5: "Phone",
+}
+
+var fieldIDToName_GetProfileReq = map[int32]string{}
+
+var fieldIDToName_GetProfileResp = map[int32]string{
+	1: "Profile",
+}
+
+var fieldIDToName_UpdateProfileReq = map[int32]string{
+	1: "Nickname",
+	2: "AvatarUrl",
+	3: "Phone",
+}
+
+var fieldIDToName_UpdateProfileResp = map[int32]string{
+	1: "Profile",
+}
+
+var fieldIDToName_Address = map[int32]string{
+	1:  "Id",
+	2:  "UserId",
+	3:  "Name",
+	4:  "Phone",
+	5:  "Province",
+	6:  "City",
+	7:  "District",
+	8:  "Detail",
+	9:  "ZipCode",
+	10: "IsDefault",
+}
+
+var fieldIDToName_ListAddressesReq = map[int32]string{}
+
+var fieldIDToName_ListAddressesResp = map[int32]string{
+	1: "Addresses",
+}
+
+var fieldIDToName_GetAddressReq = map[int32]string{
+	1: "AddressId",
+}
+
+var fieldIDToName_GetAddressResp = map[int32]string{
+	1: "Address",
+}
+
+var fieldIDToName_CreateAddressReq = map[int32]string{
+	1: "Name",
+	2: "Phone",
+	3: "Province",
+	4: "City",
+	5: "District",
+	6: "Detail",
+	7: "ZipCode",
+	8: "IsDefault",
+}
+
+var fieldIDToName_CreateAddressResp = map[int32]string{
+	1: "Address",
+}
+
+var fieldIDToName_UpdateAddressReq = map[int32]string{
+	1: "AddressId",
+	2: "Name",
+	3: "Phone",
+	4: "Province",
+	5: "City",
+	6: "District",
+	7: "Detail",
+	8: "ZipCode",
+}
+
+var fieldIDToName_UpdateAddressResp = map[int32]string{
+	1: "Address",
+}
+
+var fieldIDToName_DeleteAddressReq = map[int32]string{
+	1: "AddressId",
+}
+
+var fieldIDToName_DeleteAddressResp = map[int32]string{
+	1: "Success",
+}
+
+var fieldIDToName_SetDefaultAddressReq = map[int32]string{
+	1: "AddressId",
+}
+
+var fieldIDToName_SetDefaultAddressResp = map[int32]string{
+	1: "Address",
+}
+
+var fieldIDToName_SendVerificationEmailReq = map[int32]string{
+	1: "Email",
+}
+
+var fieldIDToName_SendVerificationEmailResp = map[int32]string{
+	1: "Success",
+}
+
+var fieldIDToName_VerifyEmailReq = map[int32]string{
+	1: "Token",
+}
+
+var fieldIDToName_VerifyEmailResp = map[int32]string{
+	1: "UserId",
+}
+
+var fieldIDToName_RequestPasswordResetReq = map[int32]string{
+	1: "Email",
+}
+
+var fieldIDToName_RequestPasswordResetResp = map[int32]string{
+	1: "Success",
+}
+
+var fieldIDToName_ResetPasswordReq = map[int32]string{
+	1: "Token",
+	2: "Password",
+	3: "PasswordConfirm",
+}
+
+var fieldIDToName_ResetPasswordResp = map[int32]string{
+	1: "UserId",
+}
+
+var fieldIDToName_VerifyMFAReq = map[int32]string{
+	1: "MfaToken",
+	2: "Code",
+}
+
+var fieldIDToName_VerifyMFAResp = map[int32]string{
+	1: "UserId",
+	2: "Token",
+}
+
+var fieldIDToName_GetMFAStatusReq = map[int32]string{}
+
+var fieldIDToName_GetMFAStatusResp = map[int32]string{
+	1: "Enabled",
+	2: "RecoveryCodesRemaining",
+}
+
+var fieldIDToName_EnrollMFAReq = map[int32]string{}
+
+var fieldIDToName_EnrollMFAResp = map[int32]string{
+	1: "Secret",
+	2: "OtpauthUri",
+	3: "Expire",
+}
+
+var fieldIDToName_ActivateMFAReq = map[int32]string{
+	1: "Code",
+}
+
+var fieldIDToName_ActivateMFAResp = map[int32]string{
+	1: "RecoveryCodes",
+}
+
+var fieldIDToName_DisableMFAReq = map[int32]string{
+	1: "Code",
+}
+
+var fieldIDToName_DisableMFAResp = map[int32]string{
+	1: "Success",
+}
+
+var fieldIDToName_RegenerateRecoveryCodesReq = map[int32]string{
+	1: "Code",
+}
+
+var fieldIDToName_RegenerateRecoveryCodesResp = map[int32]string{
+	1: "RecoveryCodes",
+}
+
+var fieldIDToName_Policy = map[int32]string{
+	1: "Subject",
+	2: "Object",
+	3: "Action",
+	4: "Effect",
+}
+
+var fieldIDToName_RoleBinding = map[int32]string{
+	1: "Subject",
+	2: "Role",
+}
+
+var fieldIDToName_ListPoliciesReq = map[int32]string{
+	1: "Subject",
+}
+
+var fieldIDToName_ListPoliciesResp = map[int32]string{
+	1: "Policies",
+}
+
+var fieldIDToName_AddPolicyReq = map[int32]string{
+	1: "Policy",
+}
+
+var fieldIDToName_AddPolicyResp = map[int32]string{
+	1: "Success",
+}
+
+var fieldIDToName_RemovePolicyReq = map[int32]string{
+	1: "Policy",
+}
+
+var fieldIDToName_RemovePolicyResp = map[int32]string{
+	1: "Success",
+}
+
+var fieldIDToName_ListRolesReq = map[int32]string{
+	1: "Subject",
+}
+
+var fieldIDToName_ListRolesResp = map[int32]string{
+	1: "Bindings",
+}
+
+var fieldIDToName_AssignRoleReq = map[int32]string{
+	1: "Binding",
+}
+
+var fieldIDToName_AssignRoleResp = map[int32]string{
+	1: "Success",
+}
+
+var fieldIDToName_RevokeRoleReq = map[int32]string{
+	1: "Binding",
+}
+
+var fieldIDToName_RevokeRoleResp = map[int32]string{
+	1: "Success",
+}
+
+var fieldIDToName_ListBlacklistReq = map[int32]string{}
+
+var fieldIDToName_ListBlacklistResp = map[int32]string{
+	1: "UserIds",
+}
+
+var fieldIDToName_AddToBlacklistReq = map[int32]string{
+	1: "UserId",
+}
+
+var fieldIDToName_AddToBlacklistResp = map[int32]string{
+	1: "Success",
+}
+
+var fieldIDToName_RemoveFromBlacklistReq = map[int32]string{
+	1: "UserId",
+}
+
+var fieldIDToName_RemoveFromBlacklistResp = map[int32]string{
+	1: "Success",
+}
+
+var _ = api.File_api_proto
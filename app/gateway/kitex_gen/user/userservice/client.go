@@ -0,0 +1,235 @@
+// Code generated by Kitex v0.9.1. DO NOT EDIT.
+
+package userservice
+
+import (
+	"context"
+	client "github.com/cloudwego/kitex/client"
+	callopt "github.com/cloudwego/kitex/client/callopt"
+	user "zqzqsb/gomall/app/gateway/kitex_gen/user"
+)
+
+// Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
+type Client interface {
+	Register(ctx context.Context, Req *user.RegisterReq, callOptions ...callopt.Option) (r *user.RegisterResp, err error)
+	Login(ctx context.Context, Req *user.LoginReq, callOptions ...callopt.Option) (r *user.LoginResp, err error)
+	VerifyMFA(ctx context.Context, Req *user.VerifyMFAReq, callOptions ...callopt.Option) (r *user.VerifyMFAResp, err error)
+	RefreshToken(ctx context.Context, Req *user.RefreshTokenReq, callOptions ...callopt.Option) (r *user.RefreshTokenResp, err error)
+	Logout(ctx context.Context, Req *user.LogoutReq, callOptions ...callopt.Option) (r *user.LogoutResp, err error)
+	Hello(ctx context.Context, Req *user.HelloReq, callOptions ...callopt.Option) (r *user.HelloResp, err error)
+	SendVerificationEmail(ctx context.Context, Req *user.SendVerificationEmailReq, callOptions ...callopt.Option) (r *user.SendVerificationEmailResp, err error)
+	VerifyEmail(ctx context.Context, Req *user.VerifyEmailReq, callOptions ...callopt.Option) (r *user.VerifyEmailResp, err error)
+	RequestPasswordReset(ctx context.Context, Req *user.RequestPasswordResetReq, callOptions ...callopt.Option) (r *user.RequestPasswordResetResp, err error)
+	ResetPassword(ctx context.Context, Req *user.ResetPasswordReq, callOptions ...callopt.Option) (r *user.ResetPasswordResp, err error)
+	GetProfile(ctx context.Context, Req *user.GetProfileReq, callOptions ...callopt.Option) (r *user.GetProfileResp, err error)
+	UpdateProfile(ctx context.Context, Req *user.UpdateProfileReq, callOptions ...callopt.Option) (r *user.UpdateProfileResp, err error)
+	ListAddresses(ctx context.Context, Req *user.ListAddressesReq, callOptions ...callopt.Option) (r *user.ListAddressesResp, err error)
+	GetAddress(ctx context.Context, Req *user.GetAddressReq, callOptions ...callopt.Option) (r *user.GetAddressResp, err error)
+	CreateAddress(ctx context.Context, Req *user.CreateAddressReq, callOptions ...callopt.Option) (r *user.CreateAddressResp, err error)
+	UpdateAddress(ctx context.Context, Req *user.UpdateAddressReq, callOptions ...callopt.Option) (r *user.UpdateAddressResp, err error)
+	DeleteAddress(ctx context.Context, Req *user.DeleteAddressReq, callOptions ...callopt.Option) (r *user.DeleteAddressResp, err error)
+	SetDefaultAddress(ctx context.Context, Req *user.SetDefaultAddressReq, callOptions ...callopt.Option) (r *user.SetDefaultAddressResp, err error)
+	GetMFAStatus(ctx context.Context, Req *user.GetMFAStatusReq, callOptions ...callopt.Option) (r *user.GetMFAStatusResp, err error)
+	EnrollMFA(ctx context.Context, Req *user.EnrollMFAReq, callOptions ...callopt.Option) (r *user.EnrollMFAResp, err error)
+	ActivateMFA(ctx context.Context, Req *user.ActivateMFAReq, callOptions ...callopt.Option) (r *user.ActivateMFAResp, err error)
+	DisableMFA(ctx context.Context, Req *user.DisableMFAReq, callOptions ...callopt.Option) (r *user.DisableMFAResp, err error)
+	RegenerateRecoveryCodes(ctx context.Context, Req *user.RegenerateRecoveryCodesReq, callOptions ...callopt.Option) (r *user.RegenerateRecoveryCodesResp, err error)
+	ListPolicies(ctx context.Context, Req *user.ListPoliciesReq, callOptions ...callopt.Option) (r *user.ListPoliciesResp, err error)
+	AddPolicy(ctx context.Context, Req *user.AddPolicyReq, callOptions ...callopt.Option) (r *user.AddPolicyResp, err error)
+	RemovePolicy(ctx context.Context, Req *user.RemovePolicyReq, callOptions ...callopt.Option) (r *user.RemovePolicyResp, err error)
+	ListRoles(ctx context.Context, Req *user.ListRolesReq, callOptions ...callopt.Option) (r *user.ListRolesResp, err error)
+	AssignRole(ctx context.Context, Req *user.AssignRoleReq, callOptions ...callopt.Option) (r *user.AssignRoleResp, err error)
+	RevokeRole(ctx context.Context, Req *user.RevokeRoleReq, callOptions ...callopt.Option) (r *user.RevokeRoleResp, err error)
+	ListBlacklist(ctx context.Context, Req *user.ListBlacklistReq, callOptions ...callopt.Option) (r *user.ListBlacklistResp, err error)
+	AddToBlacklist(ctx context.Context, Req *user.AddToBlacklistReq, callOptions ...callopt.Option) (r *user.AddToBlacklistResp, err error)
+	RemoveFromBlacklist(ctx context.Context, Req *user.RemoveFromBlacklistReq, callOptions ...callopt.Option) (r *user.RemoveFromBlacklistResp, err error)
+}
+
+// NewClient creates a client for the service defined in IDL.
+func NewClient(destService string, opts ...client.Option) (Client, error) {
+	var options []client.Option
+	options = append(options, client.WithDestService(destService))
+
+	options = append(options, opts...)
+
+	kc, err := client.NewClient(serviceInfo(), options...)
+	if err != nil {
+		return nil, err
+	}
+	return &kUserServiceClient{
+		kClient: newServiceClient(kc),
+	}, nil
+}
+
+// MustNewClient creates a client for the service defined in IDL. It panics if any error occurs.
+func MustNewClient(destService string, opts ...client.Option) Client {
+	kc, err := NewClient(destService, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return kc
+}
+
+type kUserServiceClient struct {
+	*kClient
+}
+
+func (p *kUserServiceClient) Register(ctx context.Context, Req *user.RegisterReq, callOptions ...callopt.Option) (r *user.RegisterResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Register(ctx, Req)
+}
+
+func (p *kUserServiceClient) Login(ctx context.Context, Req *user.LoginReq, callOptions ...callopt.Option) (r *user.LoginResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Login(ctx, Req)
+}
+
+func (p *kUserServiceClient) VerifyMFA(ctx context.Context, Req *user.VerifyMFAReq, callOptions ...callopt.Option) (r *user.VerifyMFAResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.VerifyMFA(ctx, Req)
+}
+
+func (p *kUserServiceClient) RefreshToken(ctx context.Context, Req *user.RefreshTokenReq, callOptions ...callopt.Option) (r *user.RefreshTokenResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RefreshToken(ctx, Req)
+}
+
+func (p *kUserServiceClient) Logout(ctx context.Context, Req *user.LogoutReq, callOptions ...callopt.Option) (r *user.LogoutResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Logout(ctx, Req)
+}
+
+func (p *kUserServiceClient) Hello(ctx context.Context, Req *user.HelloReq, callOptions ...callopt.Option) (r *user.HelloResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Hello(ctx, Req)
+}
+
+func (p *kUserServiceClient) SendVerificationEmail(ctx context.Context, Req *user.SendVerificationEmailReq, callOptions ...callopt.Option) (r *user.SendVerificationEmailResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SendVerificationEmail(ctx, Req)
+}
+
+func (p *kUserServiceClient) VerifyEmail(ctx context.Context, Req *user.VerifyEmailReq, callOptions ...callopt.Option) (r *user.VerifyEmailResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.VerifyEmail(ctx, Req)
+}
+
+func (p *kUserServiceClient) RequestPasswordReset(ctx context.Context, Req *user.RequestPasswordResetReq, callOptions ...callopt.Option) (r *user.RequestPasswordResetResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RequestPasswordReset(ctx, Req)
+}
+
+func (p *kUserServiceClient) ResetPassword(ctx context.Context, Req *user.ResetPasswordReq, callOptions ...callopt.Option) (r *user.ResetPasswordResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ResetPassword(ctx, Req)
+}
+
+func (p *kUserServiceClient) GetProfile(ctx context.Context, Req *user.GetProfileReq, callOptions ...callopt.Option) (r *user.GetProfileResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetProfile(ctx, Req)
+}
+
+func (p *kUserServiceClient) UpdateProfile(ctx context.Context, Req *user.UpdateProfileReq, callOptions ...callopt.Option) (r *user.UpdateProfileResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateProfile(ctx, Req)
+}
+
+func (p *kUserServiceClient) ListAddresses(ctx context.Context, Req *user.ListAddressesReq, callOptions ...callopt.Option) (r *user.ListAddressesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListAddresses(ctx, Req)
+}
+
+func (p *kUserServiceClient) GetAddress(ctx context.Context, Req *user.GetAddressReq, callOptions ...callopt.Option) (r *user.GetAddressResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetAddress(ctx, Req)
+}
+
+func (p *kUserServiceClient) CreateAddress(ctx context.Context, Req *user.CreateAddressReq, callOptions ...callopt.Option) (r *user.CreateAddressResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateAddress(ctx, Req)
+}
+
+func (p *kUserServiceClient) UpdateAddress(ctx context.Context, Req *user.UpdateAddressReq, callOptions ...callopt.Option) (r *user.UpdateAddressResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateAddress(ctx, Req)
+}
+
+func (p *kUserServiceClient) DeleteAddress(ctx context.Context, Req *user.DeleteAddressReq, callOptions ...callopt.Option) (r *user.DeleteAddressResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteAddress(ctx, Req)
+}
+
+func (p *kUserServiceClient) SetDefaultAddress(ctx context.Context, Req *user.SetDefaultAddressReq, callOptions ...callopt.Option) (r *user.SetDefaultAddressResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SetDefaultAddress(ctx, Req)
+}
+
+func (p *kUserServiceClient) GetMFAStatus(ctx context.Context, Req *user.GetMFAStatusReq, callOptions ...callopt.Option) (r *user.GetMFAStatusResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetMFAStatus(ctx, Req)
+}
+
+func (p *kUserServiceClient) EnrollMFA(ctx context.Context, Req *user.EnrollMFAReq, callOptions ...callopt.Option) (r *user.EnrollMFAResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.EnrollMFA(ctx, Req)
+}
+
+func (p *kUserServiceClient) ActivateMFA(ctx context.Context, Req *user.ActivateMFAReq, callOptions ...callopt.Option) (r *user.ActivateMFAResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ActivateMFA(ctx, Req)
+}
+
+func (p *kUserServiceClient) DisableMFA(ctx context.Context, Req *user.DisableMFAReq, callOptions ...callopt.Option) (r *user.DisableMFAResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DisableMFA(ctx, Req)
+}
+
+func (p *kUserServiceClient) RegenerateRecoveryCodes(ctx context.Context, Req *user.RegenerateRecoveryCodesReq, callOptions ...callopt.Option) (r *user.RegenerateRecoveryCodesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RegenerateRecoveryCodes(ctx, Req)
+}
+
+func (p *kUserServiceClient) ListPolicies(ctx context.Context, Req *user.ListPoliciesReq, callOptions ...callopt.Option) (r *user.ListPoliciesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListPolicies(ctx, Req)
+}
+
+func (p *kUserServiceClient) AddPolicy(ctx context.Context, Req *user.AddPolicyReq, callOptions ...callopt.Option) (r *user.AddPolicyResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AddPolicy(ctx, Req)
+}
+
+func (p *kUserServiceClient) RemovePolicy(ctx context.Context, Req *user.RemovePolicyReq, callOptions ...callopt.Option) (r *user.RemovePolicyResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RemovePolicy(ctx, Req)
+}
+
+func (p *kUserServiceClient) ListRoles(ctx context.Context, Req *user.ListRolesReq, callOptions ...callopt.Option) (r *user.ListRolesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListRoles(ctx, Req)
+}
+
+func (p *kUserServiceClient) AssignRole(ctx context.Context, Req *user.AssignRoleReq, callOptions ...callopt.Option) (r *user.AssignRoleResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AssignRole(ctx, Req)
+}
+
+func (p *kUserServiceClient) RevokeRole(ctx context.Context, Req *user.RevokeRoleReq, callOptions ...callopt.Option) (r *user.RevokeRoleResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RevokeRole(ctx, Req)
+}
+
+func (p *kUserServiceClient) ListBlacklist(ctx context.Context, Req *user.ListBlacklistReq, callOptions ...callopt.Option) (r *user.ListBlacklistResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListBlacklist(ctx, Req)
+}
+
+func (p *kUserServiceClient) AddToBlacklist(ctx context.Context, Req *user.AddToBlacklistReq, callOptions ...callopt.Option) (r *user.AddToBlacklistResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AddToBlacklist(ctx, Req)
+}
+
+func (p *kUserServiceClient) RemoveFromBlacklist(ctx context.Context, Req *user.RemoveFromBlacklistReq, callOptions ...callopt.Option) (r *user.RemoveFromBlacklistResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RemoveFromBlacklist(ctx, Req)
+}
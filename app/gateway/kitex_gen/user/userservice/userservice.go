@@ -0,0 +1,5532 @@
+// Code generated by Kitex v0.9.1. DO NOT EDIT.
+
+package userservice
+
+import (
+	"context"
+	"errors"
+	client "github.com/cloudwego/kitex/client"
+	kitex "github.com/cloudwego/kitex/pkg/serviceinfo"
+	streaming "github.com/cloudwego/kitex/pkg/streaming"
+	proto "google.golang.org/protobuf/proto"
+	user "zqzqsb/gomall/app/gateway/kitex_gen/user"
+)
+
+var errInvalidMessageType = errors.New("invalid message type for service method handler")
+
+var serviceMethods = map[string]kitex.MethodInfo{
+	"Register": kitex.NewMethodInfo(
+		registerHandler,
+		newRegisterArgs,
+		newRegisterResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"Login": kitex.NewMethodInfo(
+		loginHandler,
+		newLoginArgs,
+		newLoginResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"VerifyMFA": kitex.NewMethodInfo(
+		verifyMFAHandler,
+		newVerifyMFAArgs,
+		newVerifyMFAResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RefreshToken": kitex.NewMethodInfo(
+		refreshTokenHandler,
+		newRefreshTokenArgs,
+		newRefreshTokenResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"Logout": kitex.NewMethodInfo(
+		logoutHandler,
+		newLogoutArgs,
+		newLogoutResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"Hello": kitex.NewMethodInfo(
+		helloHandler,
+		newHelloArgs,
+		newHelloResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"SendVerificationEmail": kitex.NewMethodInfo(
+		sendVerificationEmailHandler,
+		newSendVerificationEmailArgs,
+		newSendVerificationEmailResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"VerifyEmail": kitex.NewMethodInfo(
+		verifyEmailHandler,
+		newVerifyEmailArgs,
+		newVerifyEmailResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RequestPasswordReset": kitex.NewMethodInfo(
+		requestPasswordResetHandler,
+		newRequestPasswordResetArgs,
+		newRequestPasswordResetResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ResetPassword": kitex.NewMethodInfo(
+		resetPasswordHandler,
+		newResetPasswordArgs,
+		newResetPasswordResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"GetProfile": kitex.NewMethodInfo(
+		getProfileHandler,
+		newGetProfileArgs,
+		newGetProfileResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"UpdateProfile": kitex.NewMethodInfo(
+		updateProfileHandler,
+		newUpdateProfileArgs,
+		newUpdateProfileResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListAddresses": kitex.NewMethodInfo(
+		listAddressesHandler,
+		newListAddressesArgs,
+		newListAddressesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"GetAddress": kitex.NewMethodInfo(
+		getAddressHandler,
+		newGetAddressArgs,
+		newGetAddressResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"CreateAddress": kitex.NewMethodInfo(
+		createAddressHandler,
+		newCreateAddressArgs,
+		newCreateAddressResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"UpdateAddress": kitex.NewMethodInfo(
+		updateAddressHandler,
+		newUpdateAddressArgs,
+		newUpdateAddressResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"DeleteAddress": kitex.NewMethodInfo(
+		deleteAddressHandler,
+		newDeleteAddressArgs,
+		newDeleteAddressResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"SetDefaultAddress": kitex.NewMethodInfo(
+		setDefaultAddressHandler,
+		newSetDefaultAddressArgs,
+		newSetDefaultAddressResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"GetMFAStatus": kitex.NewMethodInfo(
+		getMFAStatusHandler,
+		newGetMFAStatusArgs,
+		newGetMFAStatusResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"EnrollMFA": kitex.NewMethodInfo(
+		enrollMFAHandler,
+		newEnrollMFAArgs,
+		newEnrollMFAResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ActivateMFA": kitex.NewMethodInfo(
+		activateMFAHandler,
+		newActivateMFAArgs,
+		newActivateMFAResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"DisableMFA": kitex.NewMethodInfo(
+		disableMFAHandler,
+		newDisableMFAArgs,
+		newDisableMFAResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RegenerateRecoveryCodes": kitex.NewMethodInfo(
+		regenerateRecoveryCodesHandler,
+		newRegenerateRecoveryCodesArgs,
+		newRegenerateRecoveryCodesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListPolicies": kitex.NewMethodInfo(
+		listPoliciesHandler,
+		newListPoliciesArgs,
+		newListPoliciesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"AddPolicy": kitex.NewMethodInfo(
+		addPolicyHandler,
+		newAddPolicyArgs,
+		newAddPolicyResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RemovePolicy": kitex.NewMethodInfo(
+		removePolicyHandler,
+		newRemovePolicyArgs,
+		newRemovePolicyResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListRoles": kitex.NewMethodInfo(
+		listRolesHandler,
+		newListRolesArgs,
+		newListRolesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"AssignRole": kitex.NewMethodInfo(
+		assignRoleHandler,
+		newAssignRoleArgs,
+		newAssignRoleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RevokeRole": kitex.NewMethodInfo(
+		revokeRoleHandler,
+		newRevokeRoleArgs,
+		newRevokeRoleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListBlacklist": kitex.NewMethodInfo(
+		listBlacklistHandler,
+		newListBlacklistArgs,
+		newListBlacklistResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"AddToBlacklist": kitex.NewMethodInfo(
+		addToBlacklistHandler,
+		newAddToBlacklistArgs,
+		newAddToBlacklistResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RemoveFromBlacklist": kitex.NewMethodInfo(
+		removeFromBlacklistHandler,
+		newRemoveFromBlacklistArgs,
+		newRemoveFromBlacklistResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+}
+
+var (
+	userServiceServiceInfo                = NewServiceInfo()
+	userServiceServiceInfoForClient       = NewServiceInfoForClient()
+	userServiceServiceInfoForStreamClient = NewServiceInfoForStreamClient()
+)
+
+// for server
+func serviceInfo() *kitex.ServiceInfo {
+	return userServiceServiceInfo
+}
+
+// for client
+func serviceInfoForStreamClient() *kitex.ServiceInfo {
+	return userServiceServiceInfoForStreamClient
+}
+
+// for stream client
+func serviceInfoForClient() *kitex.ServiceInfo {
+	return userServiceServiceInfoForClient
+}
+
+// NewServiceInfo creates a new ServiceInfo containing all methods
+func NewServiceInfo() *kitex.ServiceInfo {
+	return newServiceInfo(false, true, true)
+}
+
+// NewServiceInfo creates a new ServiceInfo containing non-streaming methods
+func NewServiceInfoForClient() *kitex.ServiceInfo {
+	return newServiceInfo(false, false, true)
+}
+func NewServiceInfoForStreamClient() *kitex.ServiceInfo {
+	return newServiceInfo(true, true, false)
+}
+
+func newServiceInfo(hasStreaming bool, keepStreamingMethods bool, keepNonStreamingMethods bool) *kitex.ServiceInfo {
+	serviceName := "UserService"
+	handlerType := (*user.UserService)(nil)
+	methods := map[string]kitex.MethodInfo{}
+	for name, m := range serviceMethods {
+		if m.IsStreaming() && !keepStreamingMethods {
+			continue
+		}
+		if !m.IsStreaming() && !keepNonStreamingMethods {
+			continue
+		}
+		methods[name] = m
+	}
+	extra := map[string]interface{}{
+		"PackageName": "user",
+	}
+	if hasStreaming {
+		extra["streaming"] = hasStreaming
+	}
+	svcInfo := &kitex.ServiceInfo{
+		ServiceName:     serviceName,
+		HandlerType:     handlerType,
+		Methods:         methods,
+		PayloadCodec:    kitex.Protobuf,
+		KiteXGenVersion: "v0.9.1",
+		Extra:           extra,
+	}
+	return svcInfo
+}
+
+func registerHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.RegisterReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).Register(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *RegisterArgs:
+		success, err := handler.(user.UserService).Register(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RegisterResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newRegisterArgs() interface{} {
+	return &RegisterArgs{}
+}
+
+func newRegisterResult() interface{} {
+	return &RegisterResult{}
+}
+
+type RegisterArgs struct {
+	Req *user.RegisterReq
+}
+
+func (p *RegisterArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.RegisterReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RegisterArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RegisterArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RegisterArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RegisterArgs) Unmarshal(in []byte) error {
+	msg := new(user.RegisterReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RegisterArgs_Req_DEFAULT *user.RegisterReq
+
+func (p *RegisterArgs) GetReq() *user.RegisterReq {
+	if !p.IsSetReq() {
+		return RegisterArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RegisterArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RegisterArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RegisterResult struct {
+	Success *user.RegisterResp
+}
+
+var RegisterResult_Success_DEFAULT *user.RegisterResp
+
+func (p *RegisterResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.RegisterResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RegisterResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RegisterResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RegisterResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RegisterResult) Unmarshal(in []byte) error {
+	msg := new(user.RegisterResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RegisterResult) GetSuccess() *user.RegisterResp {
+	if !p.IsSetSuccess() {
+		return RegisterResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RegisterResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.RegisterResp)
+}
+
+func (p *RegisterResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RegisterResult) GetResult() interface{} {
+	return p.Success
+}
+
+func loginHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.LoginReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).Login(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *LoginArgs:
+		success, err := handler.(user.UserService).Login(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*LoginResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newLoginArgs() interface{} {
+	return &LoginArgs{}
+}
+
+func newLoginResult() interface{} {
+	return &LoginResult{}
+}
+
+type LoginArgs struct {
+	Req *user.LoginReq
+}
+
+func (p *LoginArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.LoginReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *LoginArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *LoginArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *LoginArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *LoginArgs) Unmarshal(in []byte) error {
+	msg := new(user.LoginReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var LoginArgs_Req_DEFAULT *user.LoginReq
+
+func (p *LoginArgs) GetReq() *user.LoginReq {
+	if !p.IsSetReq() {
+		return LoginArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *LoginArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *LoginArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type LoginResult struct {
+	Success *user.LoginResp
+}
+
+var LoginResult_Success_DEFAULT *user.LoginResp
+
+func (p *LoginResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.LoginResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *LoginResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *LoginResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *LoginResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *LoginResult) Unmarshal(in []byte) error {
+	msg := new(user.LoginResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *LoginResult) GetSuccess() *user.LoginResp {
+	if !p.IsSetSuccess() {
+		return LoginResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *LoginResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.LoginResp)
+}
+
+func (p *LoginResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *LoginResult) GetResult() interface{} {
+	return p.Success
+}
+
+func verifyMFAHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.VerifyMFAReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).VerifyMFA(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *VerifyMFAArgs:
+		success, err := handler.(user.UserService).VerifyMFA(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*VerifyMFAResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newVerifyMFAArgs() interface{} {
+	return &VerifyMFAArgs{}
+}
+
+func newVerifyMFAResult() interface{} {
+	return &VerifyMFAResult{}
+}
+
+type VerifyMFAArgs struct {
+	Req *user.VerifyMFAReq
+}
+
+func (p *VerifyMFAArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.VerifyMFAReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *VerifyMFAArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *VerifyMFAArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *VerifyMFAArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *VerifyMFAArgs) Unmarshal(in []byte) error {
+	msg := new(user.VerifyMFAReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var VerifyMFAArgs_Req_DEFAULT *user.VerifyMFAReq
+
+func (p *VerifyMFAArgs) GetReq() *user.VerifyMFAReq {
+	if !p.IsSetReq() {
+		return VerifyMFAArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *VerifyMFAArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VerifyMFAArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type VerifyMFAResult struct {
+	Success *user.VerifyMFAResp
+}
+
+var VerifyMFAResult_Success_DEFAULT *user.VerifyMFAResp
+
+func (p *VerifyMFAResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.VerifyMFAResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *VerifyMFAResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *VerifyMFAResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *VerifyMFAResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *VerifyMFAResult) Unmarshal(in []byte) error {
+	msg := new(user.VerifyMFAResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *VerifyMFAResult) GetSuccess() *user.VerifyMFAResp {
+	if !p.IsSetSuccess() {
+		return VerifyMFAResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *VerifyMFAResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.VerifyMFAResp)
+}
+
+func (p *VerifyMFAResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VerifyMFAResult) GetResult() interface{} {
+	return p.Success
+}
+
+func refreshTokenHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.RefreshTokenReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).RefreshToken(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *RefreshTokenArgs:
+		success, err := handler.(user.UserService).RefreshToken(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RefreshTokenResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newRefreshTokenArgs() interface{} {
+	return &RefreshTokenArgs{}
+}
+
+func newRefreshTokenResult() interface{} {
+	return &RefreshTokenResult{}
+}
+
+type RefreshTokenArgs struct {
+	Req *user.RefreshTokenReq
+}
+
+func (p *RefreshTokenArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.RefreshTokenReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RefreshTokenArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RefreshTokenArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RefreshTokenArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RefreshTokenArgs) Unmarshal(in []byte) error {
+	msg := new(user.RefreshTokenReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RefreshTokenArgs_Req_DEFAULT *user.RefreshTokenReq
+
+func (p *RefreshTokenArgs) GetReq() *user.RefreshTokenReq {
+	if !p.IsSetReq() {
+		return RefreshTokenArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RefreshTokenArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RefreshTokenArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RefreshTokenResult struct {
+	Success *user.RefreshTokenResp
+}
+
+var RefreshTokenResult_Success_DEFAULT *user.RefreshTokenResp
+
+func (p *RefreshTokenResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.RefreshTokenResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RefreshTokenResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RefreshTokenResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RefreshTokenResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RefreshTokenResult) Unmarshal(in []byte) error {
+	msg := new(user.RefreshTokenResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RefreshTokenResult) GetSuccess() *user.RefreshTokenResp {
+	if !p.IsSetSuccess() {
+		return RefreshTokenResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RefreshTokenResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.RefreshTokenResp)
+}
+
+func (p *RefreshTokenResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RefreshTokenResult) GetResult() interface{} {
+	return p.Success
+}
+
+func logoutHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.LogoutReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).Logout(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *LogoutArgs:
+		success, err := handler.(user.UserService).Logout(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*LogoutResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newLogoutArgs() interface{} {
+	return &LogoutArgs{}
+}
+
+func newLogoutResult() interface{} {
+	return &LogoutResult{}
+}
+
+type LogoutArgs struct {
+	Req *user.LogoutReq
+}
+
+func (p *LogoutArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.LogoutReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *LogoutArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *LogoutArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *LogoutArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *LogoutArgs) Unmarshal(in []byte) error {
+	msg := new(user.LogoutReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var LogoutArgs_Req_DEFAULT *user.LogoutReq
+
+func (p *LogoutArgs) GetReq() *user.LogoutReq {
+	if !p.IsSetReq() {
+		return LogoutArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *LogoutArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *LogoutArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type LogoutResult struct {
+	Success *user.LogoutResp
+}
+
+var LogoutResult_Success_DEFAULT *user.LogoutResp
+
+func (p *LogoutResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.LogoutResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *LogoutResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *LogoutResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *LogoutResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *LogoutResult) Unmarshal(in []byte) error {
+	msg := new(user.LogoutResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *LogoutResult) GetSuccess() *user.LogoutResp {
+	if !p.IsSetSuccess() {
+		return LogoutResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *LogoutResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.LogoutResp)
+}
+
+func (p *LogoutResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *LogoutResult) GetResult() interface{} {
+	return p.Success
+}
+
+func helloHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.HelloReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).Hello(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *HelloArgs:
+		success, err := handler.(user.UserService).Hello(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*HelloResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newHelloArgs() interface{} {
+	return &HelloArgs{}
+}
+
+func newHelloResult() interface{} {
+	return &HelloResult{}
+}
+
+type HelloArgs struct {
+	Req *user.HelloReq
+}
+
+func (p *HelloArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.HelloReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *HelloArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *HelloArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *HelloArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *HelloArgs) Unmarshal(in []byte) error {
+	msg := new(user.HelloReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var HelloArgs_Req_DEFAULT *user.HelloReq
+
+func (p *HelloArgs) GetReq() *user.HelloReq {
+	if !p.IsSetReq() {
+		return HelloArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *HelloArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *HelloArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type HelloResult struct {
+	Success *user.HelloResp
+}
+
+var HelloResult_Success_DEFAULT *user.HelloResp
+
+func (p *HelloResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.HelloResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *HelloResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *HelloResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *HelloResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *HelloResult) Unmarshal(in []byte) error {
+	msg := new(user.HelloResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *HelloResult) GetSuccess() *user.HelloResp {
+	if !p.IsSetSuccess() {
+		return HelloResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *HelloResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.HelloResp)
+}
+
+func (p *HelloResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *HelloResult) GetResult() interface{} {
+	return p.Success
+}
+
+func sendVerificationEmailHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.SendVerificationEmailReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).SendVerificationEmail(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *SendVerificationEmailArgs:
+		success, err := handler.(user.UserService).SendVerificationEmail(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SendVerificationEmailResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newSendVerificationEmailArgs() interface{} {
+	return &SendVerificationEmailArgs{}
+}
+
+func newSendVerificationEmailResult() interface{} {
+	return &SendVerificationEmailResult{}
+}
+
+type SendVerificationEmailArgs struct {
+	Req *user.SendVerificationEmailReq
+}
+
+func (p *SendVerificationEmailArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.SendVerificationEmailReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *SendVerificationEmailArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *SendVerificationEmailArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *SendVerificationEmailArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SendVerificationEmailArgs) Unmarshal(in []byte) error {
+	msg := new(user.SendVerificationEmailReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SendVerificationEmailArgs_Req_DEFAULT *user.SendVerificationEmailReq
+
+func (p *SendVerificationEmailArgs) GetReq() *user.SendVerificationEmailReq {
+	if !p.IsSetReq() {
+		return SendVerificationEmailArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SendVerificationEmailArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SendVerificationEmailArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type SendVerificationEmailResult struct {
+	Success *user.SendVerificationEmailResp
+}
+
+var SendVerificationEmailResult_Success_DEFAULT *user.SendVerificationEmailResp
+
+func (p *SendVerificationEmailResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.SendVerificationEmailResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *SendVerificationEmailResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *SendVerificationEmailResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *SendVerificationEmailResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SendVerificationEmailResult) Unmarshal(in []byte) error {
+	msg := new(user.SendVerificationEmailResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SendVerificationEmailResult) GetSuccess() *user.SendVerificationEmailResp {
+	if !p.IsSetSuccess() {
+		return SendVerificationEmailResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SendVerificationEmailResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.SendVerificationEmailResp)
+}
+
+func (p *SendVerificationEmailResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SendVerificationEmailResult) GetResult() interface{} {
+	return p.Success
+}
+
+func verifyEmailHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.VerifyEmailReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).VerifyEmail(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *VerifyEmailArgs:
+		success, err := handler.(user.UserService).VerifyEmail(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*VerifyEmailResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newVerifyEmailArgs() interface{} {
+	return &VerifyEmailArgs{}
+}
+
+func newVerifyEmailResult() interface{} {
+	return &VerifyEmailResult{}
+}
+
+type VerifyEmailArgs struct {
+	Req *user.VerifyEmailReq
+}
+
+func (p *VerifyEmailArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.VerifyEmailReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *VerifyEmailArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *VerifyEmailArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *VerifyEmailArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *VerifyEmailArgs) Unmarshal(in []byte) error {
+	msg := new(user.VerifyEmailReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var VerifyEmailArgs_Req_DEFAULT *user.VerifyEmailReq
+
+func (p *VerifyEmailArgs) GetReq() *user.VerifyEmailReq {
+	if !p.IsSetReq() {
+		return VerifyEmailArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *VerifyEmailArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VerifyEmailArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type VerifyEmailResult struct {
+	Success *user.VerifyEmailResp
+}
+
+var VerifyEmailResult_Success_DEFAULT *user.VerifyEmailResp
+
+func (p *VerifyEmailResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.VerifyEmailResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *VerifyEmailResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *VerifyEmailResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *VerifyEmailResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *VerifyEmailResult) Unmarshal(in []byte) error {
+	msg := new(user.VerifyEmailResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *VerifyEmailResult) GetSuccess() *user.VerifyEmailResp {
+	if !p.IsSetSuccess() {
+		return VerifyEmailResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *VerifyEmailResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.VerifyEmailResp)
+}
+
+func (p *VerifyEmailResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VerifyEmailResult) GetResult() interface{} {
+	return p.Success
+}
+
+func requestPasswordResetHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.RequestPasswordResetReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).RequestPasswordReset(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *RequestPasswordResetArgs:
+		success, err := handler.(user.UserService).RequestPasswordReset(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RequestPasswordResetResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newRequestPasswordResetArgs() interface{} {
+	return &RequestPasswordResetArgs{}
+}
+
+func newRequestPasswordResetResult() interface{} {
+	return &RequestPasswordResetResult{}
+}
+
+type RequestPasswordResetArgs struct {
+	Req *user.RequestPasswordResetReq
+}
+
+func (p *RequestPasswordResetArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.RequestPasswordResetReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RequestPasswordResetArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RequestPasswordResetArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RequestPasswordResetArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RequestPasswordResetArgs) Unmarshal(in []byte) error {
+	msg := new(user.RequestPasswordResetReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RequestPasswordResetArgs_Req_DEFAULT *user.RequestPasswordResetReq
+
+func (p *RequestPasswordResetArgs) GetReq() *user.RequestPasswordResetReq {
+	if !p.IsSetReq() {
+		return RequestPasswordResetArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RequestPasswordResetArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RequestPasswordResetArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RequestPasswordResetResult struct {
+	Success *user.RequestPasswordResetResp
+}
+
+var RequestPasswordResetResult_Success_DEFAULT *user.RequestPasswordResetResp
+
+func (p *RequestPasswordResetResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.RequestPasswordResetResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RequestPasswordResetResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RequestPasswordResetResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RequestPasswordResetResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RequestPasswordResetResult) Unmarshal(in []byte) error {
+	msg := new(user.RequestPasswordResetResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RequestPasswordResetResult) GetSuccess() *user.RequestPasswordResetResp {
+	if !p.IsSetSuccess() {
+		return RequestPasswordResetResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RequestPasswordResetResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.RequestPasswordResetResp)
+}
+
+func (p *RequestPasswordResetResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RequestPasswordResetResult) GetResult() interface{} {
+	return p.Success
+}
+
+func resetPasswordHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.ResetPasswordReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).ResetPassword(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ResetPasswordArgs:
+		success, err := handler.(user.UserService).ResetPassword(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ResetPasswordResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newResetPasswordArgs() interface{} {
+	return &ResetPasswordArgs{}
+}
+
+func newResetPasswordResult() interface{} {
+	return &ResetPasswordResult{}
+}
+
+type ResetPasswordArgs struct {
+	Req *user.ResetPasswordReq
+}
+
+func (p *ResetPasswordArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.ResetPasswordReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ResetPasswordArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ResetPasswordArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ResetPasswordArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ResetPasswordArgs) Unmarshal(in []byte) error {
+	msg := new(user.ResetPasswordReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ResetPasswordArgs_Req_DEFAULT *user.ResetPasswordReq
+
+func (p *ResetPasswordArgs) GetReq() *user.ResetPasswordReq {
+	if !p.IsSetReq() {
+		return ResetPasswordArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ResetPasswordArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ResetPasswordArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ResetPasswordResult struct {
+	Success *user.ResetPasswordResp
+}
+
+var ResetPasswordResult_Success_DEFAULT *user.ResetPasswordResp
+
+func (p *ResetPasswordResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.ResetPasswordResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ResetPasswordResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ResetPasswordResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ResetPasswordResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ResetPasswordResult) Unmarshal(in []byte) error {
+	msg := new(user.ResetPasswordResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ResetPasswordResult) GetSuccess() *user.ResetPasswordResp {
+	if !p.IsSetSuccess() {
+		return ResetPasswordResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ResetPasswordResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.ResetPasswordResp)
+}
+
+func (p *ResetPasswordResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ResetPasswordResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getProfileHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.GetProfileReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).GetProfile(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetProfileArgs:
+		success, err := handler.(user.UserService).GetProfile(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetProfileResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetProfileArgs() interface{} {
+	return &GetProfileArgs{}
+}
+
+func newGetProfileResult() interface{} {
+	return &GetProfileResult{}
+}
+
+type GetProfileArgs struct {
+	Req *user.GetProfileReq
+}
+
+func (p *GetProfileArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.GetProfileReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetProfileArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetProfileArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetProfileArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetProfileArgs) Unmarshal(in []byte) error {
+	msg := new(user.GetProfileReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetProfileArgs_Req_DEFAULT *user.GetProfileReq
+
+func (p *GetProfileArgs) GetReq() *user.GetProfileReq {
+	if !p.IsSetReq() {
+		return GetProfileArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetProfileArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetProfileArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetProfileResult struct {
+	Success *user.GetProfileResp
+}
+
+var GetProfileResult_Success_DEFAULT *user.GetProfileResp
+
+func (p *GetProfileResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.GetProfileResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetProfileResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetProfileResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetProfileResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetProfileResult) Unmarshal(in []byte) error {
+	msg := new(user.GetProfileResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetProfileResult) GetSuccess() *user.GetProfileResp {
+	if !p.IsSetSuccess() {
+		return GetProfileResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetProfileResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.GetProfileResp)
+}
+
+func (p *GetProfileResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetProfileResult) GetResult() interface{} {
+	return p.Success
+}
+
+func updateProfileHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.UpdateProfileReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).UpdateProfile(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UpdateProfileArgs:
+		success, err := handler.(user.UserService).UpdateProfile(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UpdateProfileResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUpdateProfileArgs() interface{} {
+	return &UpdateProfileArgs{}
+}
+
+func newUpdateProfileResult() interface{} {
+	return &UpdateProfileResult{}
+}
+
+type UpdateProfileArgs struct {
+	Req *user.UpdateProfileReq
+}
+
+func (p *UpdateProfileArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.UpdateProfileReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UpdateProfileArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UpdateProfileArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UpdateProfileArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UpdateProfileArgs) Unmarshal(in []byte) error {
+	msg := new(user.UpdateProfileReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UpdateProfileArgs_Req_DEFAULT *user.UpdateProfileReq
+
+func (p *UpdateProfileArgs) GetReq() *user.UpdateProfileReq {
+	if !p.IsSetReq() {
+		return UpdateProfileArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UpdateProfileArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UpdateProfileArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UpdateProfileResult struct {
+	Success *user.UpdateProfileResp
+}
+
+var UpdateProfileResult_Success_DEFAULT *user.UpdateProfileResp
+
+func (p *UpdateProfileResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.UpdateProfileResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UpdateProfileResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UpdateProfileResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UpdateProfileResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UpdateProfileResult) Unmarshal(in []byte) error {
+	msg := new(user.UpdateProfileResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UpdateProfileResult) GetSuccess() *user.UpdateProfileResp {
+	if !p.IsSetSuccess() {
+		return UpdateProfileResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UpdateProfileResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.UpdateProfileResp)
+}
+
+func (p *UpdateProfileResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UpdateProfileResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listAddressesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.ListAddressesReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).ListAddresses(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListAddressesArgs:
+		success, err := handler.(user.UserService).ListAddresses(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListAddressesResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListAddressesArgs() interface{} {
+	return &ListAddressesArgs{}
+}
+
+func newListAddressesResult() interface{} {
+	return &ListAddressesResult{}
+}
+
+type ListAddressesArgs struct {
+	Req *user.ListAddressesReq
+}
+
+func (p *ListAddressesArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.ListAddressesReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListAddressesArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListAddressesArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListAddressesArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListAddressesArgs) Unmarshal(in []byte) error {
+	msg := new(user.ListAddressesReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListAddressesArgs_Req_DEFAULT *user.ListAddressesReq
+
+func (p *ListAddressesArgs) GetReq() *user.ListAddressesReq {
+	if !p.IsSetReq() {
+		return ListAddressesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListAddressesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListAddressesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListAddressesResult struct {
+	Success *user.ListAddressesResp
+}
+
+var ListAddressesResult_Success_DEFAULT *user.ListAddressesResp
+
+func (p *ListAddressesResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.ListAddressesResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListAddressesResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListAddressesResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListAddressesResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListAddressesResult) Unmarshal(in []byte) error {
+	msg := new(user.ListAddressesResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListAddressesResult) GetSuccess() *user.ListAddressesResp {
+	if !p.IsSetSuccess() {
+		return ListAddressesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListAddressesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.ListAddressesResp)
+}
+
+func (p *ListAddressesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListAddressesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getAddressHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.GetAddressReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).GetAddress(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetAddressArgs:
+		success, err := handler.(user.UserService).GetAddress(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetAddressResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetAddressArgs() interface{} {
+	return &GetAddressArgs{}
+}
+
+func newGetAddressResult() interface{} {
+	return &GetAddressResult{}
+}
+
+type GetAddressArgs struct {
+	Req *user.GetAddressReq
+}
+
+func (p *GetAddressArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.GetAddressReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetAddressArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetAddressArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetAddressArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetAddressArgs) Unmarshal(in []byte) error {
+	msg := new(user.GetAddressReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetAddressArgs_Req_DEFAULT *user.GetAddressReq
+
+func (p *GetAddressArgs) GetReq() *user.GetAddressReq {
+	if !p.IsSetReq() {
+		return GetAddressArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetAddressArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetAddressArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetAddressResult struct {
+	Success *user.GetAddressResp
+}
+
+var GetAddressResult_Success_DEFAULT *user.GetAddressResp
+
+func (p *GetAddressResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.GetAddressResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetAddressResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetAddressResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetAddressResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetAddressResult) Unmarshal(in []byte) error {
+	msg := new(user.GetAddressResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetAddressResult) GetSuccess() *user.GetAddressResp {
+	if !p.IsSetSuccess() {
+		return GetAddressResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetAddressResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.GetAddressResp)
+}
+
+func (p *GetAddressResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetAddressResult) GetResult() interface{} {
+	return p.Success
+}
+
+func createAddressHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.CreateAddressReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).CreateAddress(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CreateAddressArgs:
+		success, err := handler.(user.UserService).CreateAddress(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CreateAddressResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCreateAddressArgs() interface{} {
+	return &CreateAddressArgs{}
+}
+
+func newCreateAddressResult() interface{} {
+	return &CreateAddressResult{}
+}
+
+type CreateAddressArgs struct {
+	Req *user.CreateAddressReq
+}
+
+func (p *CreateAddressArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.CreateAddressReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *CreateAddressArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *CreateAddressArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *CreateAddressArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CreateAddressArgs) Unmarshal(in []byte) error {
+	msg := new(user.CreateAddressReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CreateAddressArgs_Req_DEFAULT *user.CreateAddressReq
+
+func (p *CreateAddressArgs) GetReq() *user.CreateAddressReq {
+	if !p.IsSetReq() {
+		return CreateAddressArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CreateAddressArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CreateAddressArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CreateAddressResult struct {
+	Success *user.CreateAddressResp
+}
+
+var CreateAddressResult_Success_DEFAULT *user.CreateAddressResp
+
+func (p *CreateAddressResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.CreateAddressResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *CreateAddressResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *CreateAddressResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *CreateAddressResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CreateAddressResult) Unmarshal(in []byte) error {
+	msg := new(user.CreateAddressResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CreateAddressResult) GetSuccess() *user.CreateAddressResp {
+	if !p.IsSetSuccess() {
+		return CreateAddressResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CreateAddressResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.CreateAddressResp)
+}
+
+func (p *CreateAddressResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CreateAddressResult) GetResult() interface{} {
+	return p.Success
+}
+
+func updateAddressHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.UpdateAddressReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).UpdateAddress(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UpdateAddressArgs:
+		success, err := handler.(user.UserService).UpdateAddress(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UpdateAddressResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUpdateAddressArgs() interface{} {
+	return &UpdateAddressArgs{}
+}
+
+func newUpdateAddressResult() interface{} {
+	return &UpdateAddressResult{}
+}
+
+type UpdateAddressArgs struct {
+	Req *user.UpdateAddressReq
+}
+
+func (p *UpdateAddressArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.UpdateAddressReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UpdateAddressArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UpdateAddressArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UpdateAddressArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UpdateAddressArgs) Unmarshal(in []byte) error {
+	msg := new(user.UpdateAddressReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UpdateAddressArgs_Req_DEFAULT *user.UpdateAddressReq
+
+func (p *UpdateAddressArgs) GetReq() *user.UpdateAddressReq {
+	if !p.IsSetReq() {
+		return UpdateAddressArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UpdateAddressArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UpdateAddressArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UpdateAddressResult struct {
+	Success *user.UpdateAddressResp
+}
+
+var UpdateAddressResult_Success_DEFAULT *user.UpdateAddressResp
+
+func (p *UpdateAddressResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.UpdateAddressResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UpdateAddressResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UpdateAddressResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UpdateAddressResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UpdateAddressResult) Unmarshal(in []byte) error {
+	msg := new(user.UpdateAddressResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UpdateAddressResult) GetSuccess() *user.UpdateAddressResp {
+	if !p.IsSetSuccess() {
+		return UpdateAddressResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UpdateAddressResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.UpdateAddressResp)
+}
+
+func (p *UpdateAddressResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UpdateAddressResult) GetResult() interface{} {
+	return p.Success
+}
+
+func deleteAddressHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.DeleteAddressReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).DeleteAddress(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *DeleteAddressArgs:
+		success, err := handler.(user.UserService).DeleteAddress(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DeleteAddressResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newDeleteAddressArgs() interface{} {
+	return &DeleteAddressArgs{}
+}
+
+func newDeleteAddressResult() interface{} {
+	return &DeleteAddressResult{}
+}
+
+type DeleteAddressArgs struct {
+	Req *user.DeleteAddressReq
+}
+
+func (p *DeleteAddressArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.DeleteAddressReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *DeleteAddressArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *DeleteAddressArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *DeleteAddressArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *DeleteAddressArgs) Unmarshal(in []byte) error {
+	msg := new(user.DeleteAddressReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var DeleteAddressArgs_Req_DEFAULT *user.DeleteAddressReq
+
+func (p *DeleteAddressArgs) GetReq() *user.DeleteAddressReq {
+	if !p.IsSetReq() {
+		return DeleteAddressArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *DeleteAddressArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DeleteAddressArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type DeleteAddressResult struct {
+	Success *user.DeleteAddressResp
+}
+
+var DeleteAddressResult_Success_DEFAULT *user.DeleteAddressResp
+
+func (p *DeleteAddressResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.DeleteAddressResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *DeleteAddressResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *DeleteAddressResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *DeleteAddressResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *DeleteAddressResult) Unmarshal(in []byte) error {
+	msg := new(user.DeleteAddressResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DeleteAddressResult) GetSuccess() *user.DeleteAddressResp {
+	if !p.IsSetSuccess() {
+		return DeleteAddressResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DeleteAddressResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.DeleteAddressResp)
+}
+
+func (p *DeleteAddressResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DeleteAddressResult) GetResult() interface{} {
+	return p.Success
+}
+
+func setDefaultAddressHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.SetDefaultAddressReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).SetDefaultAddress(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *SetDefaultAddressArgs:
+		success, err := handler.(user.UserService).SetDefaultAddress(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SetDefaultAddressResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newSetDefaultAddressArgs() interface{} {
+	return &SetDefaultAddressArgs{}
+}
+
+func newSetDefaultAddressResult() interface{} {
+	return &SetDefaultAddressResult{}
+}
+
+type SetDefaultAddressArgs struct {
+	Req *user.SetDefaultAddressReq
+}
+
+func (p *SetDefaultAddressArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.SetDefaultAddressReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *SetDefaultAddressArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *SetDefaultAddressArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *SetDefaultAddressArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SetDefaultAddressArgs) Unmarshal(in []byte) error {
+	msg := new(user.SetDefaultAddressReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SetDefaultAddressArgs_Req_DEFAULT *user.SetDefaultAddressReq
+
+func (p *SetDefaultAddressArgs) GetReq() *user.SetDefaultAddressReq {
+	if !p.IsSetReq() {
+		return SetDefaultAddressArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SetDefaultAddressArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SetDefaultAddressArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type SetDefaultAddressResult struct {
+	Success *user.SetDefaultAddressResp
+}
+
+var SetDefaultAddressResult_Success_DEFAULT *user.SetDefaultAddressResp
+
+func (p *SetDefaultAddressResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.SetDefaultAddressResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *SetDefaultAddressResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *SetDefaultAddressResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *SetDefaultAddressResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SetDefaultAddressResult) Unmarshal(in []byte) error {
+	msg := new(user.SetDefaultAddressResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SetDefaultAddressResult) GetSuccess() *user.SetDefaultAddressResp {
+	if !p.IsSetSuccess() {
+		return SetDefaultAddressResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SetDefaultAddressResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.SetDefaultAddressResp)
+}
+
+func (p *SetDefaultAddressResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SetDefaultAddressResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getMFAStatusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.GetMFAStatusReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).GetMFAStatus(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetMFAStatusArgs:
+		success, err := handler.(user.UserService).GetMFAStatus(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetMFAStatusResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetMFAStatusArgs() interface{} {
+	return &GetMFAStatusArgs{}
+}
+
+func newGetMFAStatusResult() interface{} {
+	return &GetMFAStatusResult{}
+}
+
+type GetMFAStatusArgs struct {
+	Req *user.GetMFAStatusReq
+}
+
+func (p *GetMFAStatusArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.GetMFAStatusReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetMFAStatusArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetMFAStatusArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetMFAStatusArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetMFAStatusArgs) Unmarshal(in []byte) error {
+	msg := new(user.GetMFAStatusReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetMFAStatusArgs_Req_DEFAULT *user.GetMFAStatusReq
+
+func (p *GetMFAStatusArgs) GetReq() *user.GetMFAStatusReq {
+	if !p.IsSetReq() {
+		return GetMFAStatusArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetMFAStatusArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetMFAStatusArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetMFAStatusResult struct {
+	Success *user.GetMFAStatusResp
+}
+
+var GetMFAStatusResult_Success_DEFAULT *user.GetMFAStatusResp
+
+func (p *GetMFAStatusResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.GetMFAStatusResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetMFAStatusResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetMFAStatusResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetMFAStatusResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetMFAStatusResult) Unmarshal(in []byte) error {
+	msg := new(user.GetMFAStatusResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetMFAStatusResult) GetSuccess() *user.GetMFAStatusResp {
+	if !p.IsSetSuccess() {
+		return GetMFAStatusResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetMFAStatusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.GetMFAStatusResp)
+}
+
+func (p *GetMFAStatusResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetMFAStatusResult) GetResult() interface{} {
+	return p.Success
+}
+
+func enrollMFAHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.EnrollMFAReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).EnrollMFA(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *EnrollMFAArgs:
+		success, err := handler.(user.UserService).EnrollMFA(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*EnrollMFAResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newEnrollMFAArgs() interface{} {
+	return &EnrollMFAArgs{}
+}
+
+func newEnrollMFAResult() interface{} {
+	return &EnrollMFAResult{}
+}
+
+type EnrollMFAArgs struct {
+	Req *user.EnrollMFAReq
+}
+
+func (p *EnrollMFAArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.EnrollMFAReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *EnrollMFAArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *EnrollMFAArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *EnrollMFAArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *EnrollMFAArgs) Unmarshal(in []byte) error {
+	msg := new(user.EnrollMFAReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var EnrollMFAArgs_Req_DEFAULT *user.EnrollMFAReq
+
+func (p *EnrollMFAArgs) GetReq() *user.EnrollMFAReq {
+	if !p.IsSetReq() {
+		return EnrollMFAArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *EnrollMFAArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *EnrollMFAArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type EnrollMFAResult struct {
+	Success *user.EnrollMFAResp
+}
+
+var EnrollMFAResult_Success_DEFAULT *user.EnrollMFAResp
+
+func (p *EnrollMFAResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.EnrollMFAResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *EnrollMFAResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *EnrollMFAResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *EnrollMFAResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *EnrollMFAResult) Unmarshal(in []byte) error {
+	msg := new(user.EnrollMFAResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *EnrollMFAResult) GetSuccess() *user.EnrollMFAResp {
+	if !p.IsSetSuccess() {
+		return EnrollMFAResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *EnrollMFAResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.EnrollMFAResp)
+}
+
+func (p *EnrollMFAResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *EnrollMFAResult) GetResult() interface{} {
+	return p.Success
+}
+
+func activateMFAHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.ActivateMFAReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).ActivateMFA(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ActivateMFAArgs:
+		success, err := handler.(user.UserService).ActivateMFA(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ActivateMFAResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newActivateMFAArgs() interface{} {
+	return &ActivateMFAArgs{}
+}
+
+func newActivateMFAResult() interface{} {
+	return &ActivateMFAResult{}
+}
+
+type ActivateMFAArgs struct {
+	Req *user.ActivateMFAReq
+}
+
+func (p *ActivateMFAArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.ActivateMFAReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ActivateMFAArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ActivateMFAArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ActivateMFAArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ActivateMFAArgs) Unmarshal(in []byte) error {
+	msg := new(user.ActivateMFAReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ActivateMFAArgs_Req_DEFAULT *user.ActivateMFAReq
+
+func (p *ActivateMFAArgs) GetReq() *user.ActivateMFAReq {
+	if !p.IsSetReq() {
+		return ActivateMFAArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ActivateMFAArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ActivateMFAArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ActivateMFAResult struct {
+	Success *user.ActivateMFAResp
+}
+
+var ActivateMFAResult_Success_DEFAULT *user.ActivateMFAResp
+
+func (p *ActivateMFAResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.ActivateMFAResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ActivateMFAResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ActivateMFAResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ActivateMFAResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ActivateMFAResult) Unmarshal(in []byte) error {
+	msg := new(user.ActivateMFAResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ActivateMFAResult) GetSuccess() *user.ActivateMFAResp {
+	if !p.IsSetSuccess() {
+		return ActivateMFAResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ActivateMFAResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.ActivateMFAResp)
+}
+
+func (p *ActivateMFAResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ActivateMFAResult) GetResult() interface{} {
+	return p.Success
+}
+
+func disableMFAHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.DisableMFAReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).DisableMFA(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *DisableMFAArgs:
+		success, err := handler.(user.UserService).DisableMFA(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DisableMFAResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newDisableMFAArgs() interface{} {
+	return &DisableMFAArgs{}
+}
+
+func newDisableMFAResult() interface{} {
+	return &DisableMFAResult{}
+}
+
+type DisableMFAArgs struct {
+	Req *user.DisableMFAReq
+}
+
+func (p *DisableMFAArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.DisableMFAReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *DisableMFAArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *DisableMFAArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *DisableMFAArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *DisableMFAArgs) Unmarshal(in []byte) error {
+	msg := new(user.DisableMFAReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var DisableMFAArgs_Req_DEFAULT *user.DisableMFAReq
+
+func (p *DisableMFAArgs) GetReq() *user.DisableMFAReq {
+	if !p.IsSetReq() {
+		return DisableMFAArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *DisableMFAArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DisableMFAArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type DisableMFAResult struct {
+	Success *user.DisableMFAResp
+}
+
+var DisableMFAResult_Success_DEFAULT *user.DisableMFAResp
+
+func (p *DisableMFAResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.DisableMFAResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *DisableMFAResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *DisableMFAResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *DisableMFAResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *DisableMFAResult) Unmarshal(in []byte) error {
+	msg := new(user.DisableMFAResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DisableMFAResult) GetSuccess() *user.DisableMFAResp {
+	if !p.IsSetSuccess() {
+		return DisableMFAResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DisableMFAResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.DisableMFAResp)
+}
+
+func (p *DisableMFAResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DisableMFAResult) GetResult() interface{} {
+	return p.Success
+}
+
+func regenerateRecoveryCodesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.RegenerateRecoveryCodesReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).RegenerateRecoveryCodes(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *RegenerateRecoveryCodesArgs:
+		success, err := handler.(user.UserService).RegenerateRecoveryCodes(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RegenerateRecoveryCodesResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newRegenerateRecoveryCodesArgs() interface{} {
+	return &RegenerateRecoveryCodesArgs{}
+}
+
+func newRegenerateRecoveryCodesResult() interface{} {
+	return &RegenerateRecoveryCodesResult{}
+}
+
+type RegenerateRecoveryCodesArgs struct {
+	Req *user.RegenerateRecoveryCodesReq
+}
+
+func (p *RegenerateRecoveryCodesArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.RegenerateRecoveryCodesReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RegenerateRecoveryCodesArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RegenerateRecoveryCodesArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RegenerateRecoveryCodesArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RegenerateRecoveryCodesArgs) Unmarshal(in []byte) error {
+	msg := new(user.RegenerateRecoveryCodesReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RegenerateRecoveryCodesArgs_Req_DEFAULT *user.RegenerateRecoveryCodesReq
+
+func (p *RegenerateRecoveryCodesArgs) GetReq() *user.RegenerateRecoveryCodesReq {
+	if !p.IsSetReq() {
+		return RegenerateRecoveryCodesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RegenerateRecoveryCodesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RegenerateRecoveryCodesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RegenerateRecoveryCodesResult struct {
+	Success *user.RegenerateRecoveryCodesResp
+}
+
+var RegenerateRecoveryCodesResult_Success_DEFAULT *user.RegenerateRecoveryCodesResp
+
+func (p *RegenerateRecoveryCodesResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.RegenerateRecoveryCodesResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RegenerateRecoveryCodesResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RegenerateRecoveryCodesResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RegenerateRecoveryCodesResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RegenerateRecoveryCodesResult) Unmarshal(in []byte) error {
+	msg := new(user.RegenerateRecoveryCodesResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RegenerateRecoveryCodesResult) GetSuccess() *user.RegenerateRecoveryCodesResp {
+	if !p.IsSetSuccess() {
+		return RegenerateRecoveryCodesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RegenerateRecoveryCodesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.RegenerateRecoveryCodesResp)
+}
+
+func (p *RegenerateRecoveryCodesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RegenerateRecoveryCodesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listPoliciesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.ListPoliciesReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).ListPolicies(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListPoliciesArgs:
+		success, err := handler.(user.UserService).ListPolicies(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListPoliciesResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListPoliciesArgs() interface{} {
+	return &ListPoliciesArgs{}
+}
+
+func newListPoliciesResult() interface{} {
+	return &ListPoliciesResult{}
+}
+
+type ListPoliciesArgs struct {
+	Req *user.ListPoliciesReq
+}
+
+func (p *ListPoliciesArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.ListPoliciesReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListPoliciesArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListPoliciesArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListPoliciesArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListPoliciesArgs) Unmarshal(in []byte) error {
+	msg := new(user.ListPoliciesReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListPoliciesArgs_Req_DEFAULT *user.ListPoliciesReq
+
+func (p *ListPoliciesArgs) GetReq() *user.ListPoliciesReq {
+	if !p.IsSetReq() {
+		return ListPoliciesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListPoliciesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListPoliciesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListPoliciesResult struct {
+	Success *user.ListPoliciesResp
+}
+
+var ListPoliciesResult_Success_DEFAULT *user.ListPoliciesResp
+
+func (p *ListPoliciesResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.ListPoliciesResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListPoliciesResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListPoliciesResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListPoliciesResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListPoliciesResult) Unmarshal(in []byte) error {
+	msg := new(user.ListPoliciesResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListPoliciesResult) GetSuccess() *user.ListPoliciesResp {
+	if !p.IsSetSuccess() {
+		return ListPoliciesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListPoliciesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.ListPoliciesResp)
+}
+
+func (p *ListPoliciesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListPoliciesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func addPolicyHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.AddPolicyReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).AddPolicy(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *AddPolicyArgs:
+		success, err := handler.(user.UserService).AddPolicy(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*AddPolicyResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newAddPolicyArgs() interface{} {
+	return &AddPolicyArgs{}
+}
+
+func newAddPolicyResult() interface{} {
+	return &AddPolicyResult{}
+}
+
+type AddPolicyArgs struct {
+	Req *user.AddPolicyReq
+}
+
+func (p *AddPolicyArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.AddPolicyReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *AddPolicyArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *AddPolicyArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *AddPolicyArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *AddPolicyArgs) Unmarshal(in []byte) error {
+	msg := new(user.AddPolicyReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var AddPolicyArgs_Req_DEFAULT *user.AddPolicyReq
+
+func (p *AddPolicyArgs) GetReq() *user.AddPolicyReq {
+	if !p.IsSetReq() {
+		return AddPolicyArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *AddPolicyArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AddPolicyArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type AddPolicyResult struct {
+	Success *user.AddPolicyResp
+}
+
+var AddPolicyResult_Success_DEFAULT *user.AddPolicyResp
+
+func (p *AddPolicyResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.AddPolicyResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *AddPolicyResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *AddPolicyResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *AddPolicyResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *AddPolicyResult) Unmarshal(in []byte) error {
+	msg := new(user.AddPolicyResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *AddPolicyResult) GetSuccess() *user.AddPolicyResp {
+	if !p.IsSetSuccess() {
+		return AddPolicyResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *AddPolicyResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.AddPolicyResp)
+}
+
+func (p *AddPolicyResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AddPolicyResult) GetResult() interface{} {
+	return p.Success
+}
+
+func removePolicyHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.RemovePolicyReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).RemovePolicy(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *RemovePolicyArgs:
+		success, err := handler.(user.UserService).RemovePolicy(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RemovePolicyResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newRemovePolicyArgs() interface{} {
+	return &RemovePolicyArgs{}
+}
+
+func newRemovePolicyResult() interface{} {
+	return &RemovePolicyResult{}
+}
+
+type RemovePolicyArgs struct {
+	Req *user.RemovePolicyReq
+}
+
+func (p *RemovePolicyArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.RemovePolicyReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RemovePolicyArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RemovePolicyArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RemovePolicyArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RemovePolicyArgs) Unmarshal(in []byte) error {
+	msg := new(user.RemovePolicyReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RemovePolicyArgs_Req_DEFAULT *user.RemovePolicyReq
+
+func (p *RemovePolicyArgs) GetReq() *user.RemovePolicyReq {
+	if !p.IsSetReq() {
+		return RemovePolicyArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RemovePolicyArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RemovePolicyArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RemovePolicyResult struct {
+	Success *user.RemovePolicyResp
+}
+
+var RemovePolicyResult_Success_DEFAULT *user.RemovePolicyResp
+
+func (p *RemovePolicyResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.RemovePolicyResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RemovePolicyResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RemovePolicyResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RemovePolicyResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RemovePolicyResult) Unmarshal(in []byte) error {
+	msg := new(user.RemovePolicyResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RemovePolicyResult) GetSuccess() *user.RemovePolicyResp {
+	if !p.IsSetSuccess() {
+		return RemovePolicyResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RemovePolicyResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.RemovePolicyResp)
+}
+
+func (p *RemovePolicyResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RemovePolicyResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listRolesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.ListRolesReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).ListRoles(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListRolesArgs:
+		success, err := handler.(user.UserService).ListRoles(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListRolesResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListRolesArgs() interface{} {
+	return &ListRolesArgs{}
+}
+
+func newListRolesResult() interface{} {
+	return &ListRolesResult{}
+}
+
+type ListRolesArgs struct {
+	Req *user.ListRolesReq
+}
+
+func (p *ListRolesArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.ListRolesReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListRolesArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListRolesArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListRolesArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListRolesArgs) Unmarshal(in []byte) error {
+	msg := new(user.ListRolesReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListRolesArgs_Req_DEFAULT *user.ListRolesReq
+
+func (p *ListRolesArgs) GetReq() *user.ListRolesReq {
+	if !p.IsSetReq() {
+		return ListRolesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListRolesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListRolesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListRolesResult struct {
+	Success *user.ListRolesResp
+}
+
+var ListRolesResult_Success_DEFAULT *user.ListRolesResp
+
+func (p *ListRolesResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.ListRolesResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListRolesResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListRolesResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListRolesResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListRolesResult) Unmarshal(in []byte) error {
+	msg := new(user.ListRolesResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListRolesResult) GetSuccess() *user.ListRolesResp {
+	if !p.IsSetSuccess() {
+		return ListRolesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListRolesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.ListRolesResp)
+}
+
+func (p *ListRolesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListRolesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func assignRoleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.AssignRoleReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).AssignRole(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *AssignRoleArgs:
+		success, err := handler.(user.UserService).AssignRole(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*AssignRoleResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newAssignRoleArgs() interface{} {
+	return &AssignRoleArgs{}
+}
+
+func newAssignRoleResult() interface{} {
+	return &AssignRoleResult{}
+}
+
+type AssignRoleArgs struct {
+	Req *user.AssignRoleReq
+}
+
+func (p *AssignRoleArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.AssignRoleReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *AssignRoleArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *AssignRoleArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *AssignRoleArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *AssignRoleArgs) Unmarshal(in []byte) error {
+	msg := new(user.AssignRoleReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var AssignRoleArgs_Req_DEFAULT *user.AssignRoleReq
+
+func (p *AssignRoleArgs) GetReq() *user.AssignRoleReq {
+	if !p.IsSetReq() {
+		return AssignRoleArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *AssignRoleArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AssignRoleArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type AssignRoleResult struct {
+	Success *user.AssignRoleResp
+}
+
+var AssignRoleResult_Success_DEFAULT *user.AssignRoleResp
+
+func (p *AssignRoleResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.AssignRoleResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *AssignRoleResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *AssignRoleResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *AssignRoleResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *AssignRoleResult) Unmarshal(in []byte) error {
+	msg := new(user.AssignRoleResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *AssignRoleResult) GetSuccess() *user.AssignRoleResp {
+	if !p.IsSetSuccess() {
+		return AssignRoleResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *AssignRoleResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.AssignRoleResp)
+}
+
+func (p *AssignRoleResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AssignRoleResult) GetResult() interface{} {
+	return p.Success
+}
+
+func revokeRoleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.RevokeRoleReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).RevokeRole(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *RevokeRoleArgs:
+		success, err := handler.(user.UserService).RevokeRole(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RevokeRoleResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newRevokeRoleArgs() interface{} {
+	return &RevokeRoleArgs{}
+}
+
+func newRevokeRoleResult() interface{} {
+	return &RevokeRoleResult{}
+}
+
+type RevokeRoleArgs struct {
+	Req *user.RevokeRoleReq
+}
+
+func (p *RevokeRoleArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.RevokeRoleReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RevokeRoleArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RevokeRoleArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RevokeRoleArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RevokeRoleArgs) Unmarshal(in []byte) error {
+	msg := new(user.RevokeRoleReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RevokeRoleArgs_Req_DEFAULT *user.RevokeRoleReq
+
+func (p *RevokeRoleArgs) GetReq() *user.RevokeRoleReq {
+	if !p.IsSetReq() {
+		return RevokeRoleArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RevokeRoleArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RevokeRoleArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RevokeRoleResult struct {
+	Success *user.RevokeRoleResp
+}
+
+var RevokeRoleResult_Success_DEFAULT *user.RevokeRoleResp
+
+func (p *RevokeRoleResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.RevokeRoleResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RevokeRoleResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RevokeRoleResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RevokeRoleResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RevokeRoleResult) Unmarshal(in []byte) error {
+	msg := new(user.RevokeRoleResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RevokeRoleResult) GetSuccess() *user.RevokeRoleResp {
+	if !p.IsSetSuccess() {
+		return RevokeRoleResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RevokeRoleResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.RevokeRoleResp)
+}
+
+func (p *RevokeRoleResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RevokeRoleResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listBlacklistHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.ListBlacklistReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).ListBlacklist(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListBlacklistArgs:
+		success, err := handler.(user.UserService).ListBlacklist(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListBlacklistResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListBlacklistArgs() interface{} {
+	return &ListBlacklistArgs{}
+}
+
+func newListBlacklistResult() interface{} {
+	return &ListBlacklistResult{}
+}
+
+type ListBlacklistArgs struct {
+	Req *user.ListBlacklistReq
+}
+
+func (p *ListBlacklistArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.ListBlacklistReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListBlacklistArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListBlacklistArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListBlacklistArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListBlacklistArgs) Unmarshal(in []byte) error {
+	msg := new(user.ListBlacklistReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListBlacklistArgs_Req_DEFAULT *user.ListBlacklistReq
+
+func (p *ListBlacklistArgs) GetReq() *user.ListBlacklistReq {
+	if !p.IsSetReq() {
+		return ListBlacklistArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListBlacklistArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListBlacklistArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListBlacklistResult struct {
+	Success *user.ListBlacklistResp
+}
+
+var ListBlacklistResult_Success_DEFAULT *user.ListBlacklistResp
+
+func (p *ListBlacklistResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.ListBlacklistResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListBlacklistResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListBlacklistResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListBlacklistResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListBlacklistResult) Unmarshal(in []byte) error {
+	msg := new(user.ListBlacklistResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListBlacklistResult) GetSuccess() *user.ListBlacklistResp {
+	if !p.IsSetSuccess() {
+		return ListBlacklistResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListBlacklistResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.ListBlacklistResp)
+}
+
+func (p *ListBlacklistResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListBlacklistResult) GetResult() interface{} {
+	return p.Success
+}
+
+func addToBlacklistHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.AddToBlacklistReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).AddToBlacklist(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *AddToBlacklistArgs:
+		success, err := handler.(user.UserService).AddToBlacklist(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*AddToBlacklistResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newAddToBlacklistArgs() interface{} {
+	return &AddToBlacklistArgs{}
+}
+
+func newAddToBlacklistResult() interface{} {
+	return &AddToBlacklistResult{}
+}
+
+type AddToBlacklistArgs struct {
+	Req *user.AddToBlacklistReq
+}
+
+func (p *AddToBlacklistArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.AddToBlacklistReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *AddToBlacklistArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *AddToBlacklistArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *AddToBlacklistArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *AddToBlacklistArgs) Unmarshal(in []byte) error {
+	msg := new(user.AddToBlacklistReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var AddToBlacklistArgs_Req_DEFAULT *user.AddToBlacklistReq
+
+func (p *AddToBlacklistArgs) GetReq() *user.AddToBlacklistReq {
+	if !p.IsSetReq() {
+		return AddToBlacklistArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *AddToBlacklistArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AddToBlacklistArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type AddToBlacklistResult struct {
+	Success *user.AddToBlacklistResp
+}
+
+var AddToBlacklistResult_Success_DEFAULT *user.AddToBlacklistResp
+
+func (p *AddToBlacklistResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.AddToBlacklistResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *AddToBlacklistResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *AddToBlacklistResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *AddToBlacklistResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *AddToBlacklistResult) Unmarshal(in []byte) error {
+	msg := new(user.AddToBlacklistResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *AddToBlacklistResult) GetSuccess() *user.AddToBlacklistResp {
+	if !p.IsSetSuccess() {
+		return AddToBlacklistResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *AddToBlacklistResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.AddToBlacklistResp)
+}
+
+func (p *AddToBlacklistResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AddToBlacklistResult) GetResult() interface{} {
+	return p.Success
+}
+
+func removeFromBlacklistHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.RemoveFromBlacklistReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).RemoveFromBlacklist(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *RemoveFromBlacklistArgs:
+		success, err := handler.(user.UserService).RemoveFromBlacklist(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RemoveFromBlacklistResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newRemoveFromBlacklistArgs() interface{} {
+	return &RemoveFromBlacklistArgs{}
+}
+
+func newRemoveFromBlacklistResult() interface{} {
+	return &RemoveFromBlacklistResult{}
+}
+
+type RemoveFromBlacklistArgs struct {
+	Req *user.RemoveFromBlacklistReq
+}
+
+func (p *RemoveFromBlacklistArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.RemoveFromBlacklistReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RemoveFromBlacklistArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RemoveFromBlacklistArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RemoveFromBlacklistArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RemoveFromBlacklistArgs) Unmarshal(in []byte) error {
+	msg := new(user.RemoveFromBlacklistReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RemoveFromBlacklistArgs_Req_DEFAULT *user.RemoveFromBlacklistReq
+
+func (p *RemoveFromBlacklistArgs) GetReq() *user.RemoveFromBlacklistReq {
+	if !p.IsSetReq() {
+		return RemoveFromBlacklistArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RemoveFromBlacklistArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RemoveFromBlacklistArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RemoveFromBlacklistResult struct {
+	Success *user.RemoveFromBlacklistResp
+}
+
+var RemoveFromBlacklistResult_Success_DEFAULT *user.RemoveFromBlacklistResp
+
+func (p *RemoveFromBlacklistResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.RemoveFromBlacklistResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RemoveFromBlacklistResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RemoveFromBlacklistResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RemoveFromBlacklistResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RemoveFromBlacklistResult) Unmarshal(in []byte) error {
+	msg := new(user.RemoveFromBlacklistResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RemoveFromBlacklistResult) GetSuccess() *user.RemoveFromBlacklistResp {
+	if !p.IsSetSuccess() {
+		return RemoveFromBlacklistResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RemoveFromBlacklistResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.RemoveFromBlacklistResp)
+}
+
+func (p *RemoveFromBlacklistResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RemoveFromBlacklistResult) GetResult() interface{} {
+	return p.Success
+}
+
+type kClient struct {
+	c client.Client
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c: c,
+	}
+}
+
+func (p *kClient) Register(ctx context.Context, Req *user.RegisterReq) (r *user.RegisterResp, err error) {
+	var _args RegisterArgs
+	_args.Req = Req
+	var _result RegisterResult
+	if err = p.c.Call(ctx, "Register", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Login(ctx context.Context, Req *user.LoginReq) (r *user.LoginResp, err error) {
+	var _args LoginArgs
+	_args.Req = Req
+	var _result LoginResult
+	if err = p.c.Call(ctx, "Login", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) VerifyMFA(ctx context.Context, Req *user.VerifyMFAReq) (r *user.VerifyMFAResp, err error) {
+	var _args VerifyMFAArgs
+	_args.Req = Req
+	var _result VerifyMFAResult
+	if err = p.c.Call(ctx, "VerifyMFA", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RefreshToken(ctx context.Context, Req *user.RefreshTokenReq) (r *user.RefreshTokenResp, err error) {
+	var _args RefreshTokenArgs
+	_args.Req = Req
+	var _result RefreshTokenResult
+	if err = p.c.Call(ctx, "RefreshToken", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Logout(ctx context.Context, Req *user.LogoutReq) (r *user.LogoutResp, err error) {
+	var _args LogoutArgs
+	_args.Req = Req
+	var _result LogoutResult
+	if err = p.c.Call(ctx, "Logout", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Hello(ctx context.Context, Req *user.HelloReq) (r *user.HelloResp, err error) {
+	var _args HelloArgs
+	_args.Req = Req
+	var _result HelloResult
+	if err = p.c.Call(ctx, "Hello", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SendVerificationEmail(ctx context.Context, Req *user.SendVerificationEmailReq) (r *user.SendVerificationEmailResp, err error) {
+	var _args SendVerificationEmailArgs
+	_args.Req = Req
+	var _result SendVerificationEmailResult
+	if err = p.c.Call(ctx, "SendVerificationEmail", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) VerifyEmail(ctx context.Context, Req *user.VerifyEmailReq) (r *user.VerifyEmailResp, err error) {
+	var _args VerifyEmailArgs
+	_args.Req = Req
+	var _result VerifyEmailResult
+	if err = p.c.Call(ctx, "VerifyEmail", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RequestPasswordReset(ctx context.Context, Req *user.RequestPasswordResetReq) (r *user.RequestPasswordResetResp, err error) {
+	var _args RequestPasswordResetArgs
+	_args.Req = Req
+	var _result RequestPasswordResetResult
+	if err = p.c.Call(ctx, "RequestPasswordReset", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ResetPassword(ctx context.Context, Req *user.ResetPasswordReq) (r *user.ResetPasswordResp, err error) {
+	var _args ResetPasswordArgs
+	_args.Req = Req
+	var _result ResetPasswordResult
+	if err = p.c.Call(ctx, "ResetPassword", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetProfile(ctx context.Context, Req *user.GetProfileReq) (r *user.GetProfileResp, err error) {
+	var _args GetProfileArgs
+	_args.Req = Req
+	var _result GetProfileResult
+	if err = p.c.Call(ctx, "GetProfile", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateProfile(ctx context.Context, Req *user.UpdateProfileReq) (r *user.UpdateProfileResp, err error) {
+	var _args UpdateProfileArgs
+	_args.Req = Req
+	var _result UpdateProfileResult
+	if err = p.c.Call(ctx, "UpdateProfile", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListAddresses(ctx context.Context, Req *user.ListAddressesReq) (r *user.ListAddressesResp, err error) {
+	var _args ListAddressesArgs
+	_args.Req = Req
+	var _result ListAddressesResult
+	if err = p.c.Call(ctx, "ListAddresses", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetAddress(ctx context.Context, Req *user.GetAddressReq) (r *user.GetAddressResp, err error) {
+	var _args GetAddressArgs
+	_args.Req = Req
+	var _result GetAddressResult
+	if err = p.c.Call(ctx, "GetAddress", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateAddress(ctx context.Context, Req *user.CreateAddressReq) (r *user.CreateAddressResp, err error) {
+	var _args CreateAddressArgs
+	_args.Req = Req
+	var _result CreateAddressResult
+	if err = p.c.Call(ctx, "CreateAddress", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateAddress(ctx context.Context, Req *user.UpdateAddressReq) (r *user.UpdateAddressResp, err error) {
+	var _args UpdateAddressArgs
+	_args.Req = Req
+	var _result UpdateAddressResult
+	if err = p.c.Call(ctx, "UpdateAddress", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteAddress(ctx context.Context, Req *user.DeleteAddressReq) (r *user.DeleteAddressResp, err error) {
+	var _args DeleteAddressArgs
+	_args.Req = Req
+	var _result DeleteAddressResult
+	if err = p.c.Call(ctx, "DeleteAddress", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SetDefaultAddress(ctx context.Context, Req *user.SetDefaultAddressReq) (r *user.SetDefaultAddressResp, err error) {
+	var _args SetDefaultAddressArgs
+	_args.Req = Req
+	var _result SetDefaultAddressResult
+	if err = p.c.Call(ctx, "SetDefaultAddress", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetMFAStatus(ctx context.Context, Req *user.GetMFAStatusReq) (r *user.GetMFAStatusResp, err error) {
+	var _args GetMFAStatusArgs
+	_args.Req = Req
+	var _result GetMFAStatusResult
+	if err = p.c.Call(ctx, "GetMFAStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) EnrollMFA(ctx context.Context, Req *user.EnrollMFAReq) (r *user.EnrollMFAResp, err error) {
+	var _args EnrollMFAArgs
+	_args.Req = Req
+	var _result EnrollMFAResult
+	if err = p.c.Call(ctx, "EnrollMFA", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ActivateMFA(ctx context.Context, Req *user.ActivateMFAReq) (r *user.ActivateMFAResp, err error) {
+	var _args ActivateMFAArgs
+	_args.Req = Req
+	var _result ActivateMFAResult
+	if err = p.c.Call(ctx, "ActivateMFA", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DisableMFA(ctx context.Context, Req *user.DisableMFAReq) (r *user.DisableMFAResp, err error) {
+	var _args DisableMFAArgs
+	_args.Req = Req
+	var _result DisableMFAResult
+	if err = p.c.Call(ctx, "DisableMFA", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RegenerateRecoveryCodes(ctx context.Context, Req *user.RegenerateRecoveryCodesReq) (r *user.RegenerateRecoveryCodesResp, err error) {
+	var _args RegenerateRecoveryCodesArgs
+	_args.Req = Req
+	var _result RegenerateRecoveryCodesResult
+	if err = p.c.Call(ctx, "RegenerateRecoveryCodes", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListPolicies(ctx context.Context, Req *user.ListPoliciesReq) (r *user.ListPoliciesResp, err error) {
+	var _args ListPoliciesArgs
+	_args.Req = Req
+	var _result ListPoliciesResult
+	if err = p.c.Call(ctx, "ListPolicies", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AddPolicy(ctx context.Context, Req *user.AddPolicyReq) (r *user.AddPolicyResp, err error) {
+	var _args AddPolicyArgs
+	_args.Req = Req
+	var _result AddPolicyResult
+	if err = p.c.Call(ctx, "AddPolicy", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RemovePolicy(ctx context.Context, Req *user.RemovePolicyReq) (r *user.RemovePolicyResp, err error) {
+	var _args RemovePolicyArgs
+	_args.Req = Req
+	var _result RemovePolicyResult
+	if err = p.c.Call(ctx, "RemovePolicy", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListRoles(ctx context.Context, Req *user.ListRolesReq) (r *user.ListRolesResp, err error) {
+	var _args ListRolesArgs
+	_args.Req = Req
+	var _result ListRolesResult
+	if err = p.c.Call(ctx, "ListRoles", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AssignRole(ctx context.Context, Req *user.AssignRoleReq) (r *user.AssignRoleResp, err error) {
+	var _args AssignRoleArgs
+	_args.Req = Req
+	var _result AssignRoleResult
+	if err = p.c.Call(ctx, "AssignRole", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RevokeRole(ctx context.Context, Req *user.RevokeRoleReq) (r *user.RevokeRoleResp, err error) {
+	var _args RevokeRoleArgs
+	_args.Req = Req
+	var _result RevokeRoleResult
+	if err = p.c.Call(ctx, "RevokeRole", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListBlacklist(ctx context.Context, Req *user.ListBlacklistReq) (r *user.ListBlacklistResp, err error) {
+	var _args ListBlacklistArgs
+	_args.Req = Req
+	var _result ListBlacklistResult
+	if err = p.c.Call(ctx, "ListBlacklist", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AddToBlacklist(ctx context.Context, Req *user.AddToBlacklistReq) (r *user.AddToBlacklistResp, err error) {
+	var _args AddToBlacklistArgs
+	_args.Req = Req
+	var _result AddToBlacklistResult
+	if err = p.c.Call(ctx, "AddToBlacklist", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RemoveFromBlacklist(ctx context.Context, Req *user.RemoveFromBlacklistReq) (r *user.RemoveFromBlacklistResp, err error) {
+	var _args RemoveFromBlacklistArgs
+	_args.Req = Req
+	var _result RemoveFromBlacklistResult
+	if err = p.c.Call(ctx, "RemoveFromBlacklist", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/app/server"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/hertz-contrib/cors"
+	"gopkg.in/natefinch/lumberjack.v2"
+	"zqzqsb.com/gomall/common/clientip"
+	"zqzqsb/gomall/app/gateway/biz/dal"
+	"zqzqsb/gomall/app/gateway/biz/router"
+	mw "zqzqsb/gomall/app/gateway/biz/router/middleware"
+	"zqzqsb/gomall/app/gateway/conf"
+	"zqzqsb/gomall/app/gateway/infra/rpc"
+)
+
+func main() {
+	logWriter := initLog()
+	dal.Init()
+	rpc.InitClient()
+
+	h := server.New(server.WithHostPorts(conf.GetConf().Hertz.Address))
+	// 只采信可信代理写入的转发头，透传给下游的客户端 IP 用于登录风控和支付下单，不能由客户端伪造
+	clientIP, err := clientip.New(conf.GetConf().Hertz.TrustedProxies)
+	if err != nil {
+		panic(err)
+	}
+	h.SetClientIPFunc(clientIP)
+	h.OnShutdown = append(h.OnShutdown, func(ctx context.Context) {
+		logWriter.Close()
+	})
+
+	// add a ping route to test
+	h.GET("/ping", func(c context.Context, ctx *app.RequestContext) {
+		ctx.JSON(consts.StatusOK, utils.H{"ping": "pong"})
+	})
+
+	// 配置 CORS
+	h.Use(cors.New(cors.Config{
+		AllowOrigins:     conf.GetConf().Hertz.AllowOrigins,
+		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS"},
+		AllowHeaders:     []string{"Origin", "Content-Length", "Content-Type", "Authorization"},
+		AllowCredentials: true,
+		ExposeHeaders:    []string{"Content-Length"},
+		MaxAge:           12 * time.Hour,
+	}))
+	h.Use(mw.ClientInfoMiddleware())
+
+	// JWT 只在网关校验一次，下游服务通过元信息获取用户身份
+	mw.InitJwt()
+
+	router.GeneratedRegister(h)
+	h.Spin()
+}
+
+func initLog() *lumberjack.Logger {
+	hlog.SetLevel(conf.LogLevel())
+	writer := &lumberjack.Logger{
+		Filename:   conf.GetConf().Hertz.LogFileName,
+		MaxSize:    conf.GetConf().Hertz.LogMaxSize,
+		MaxBackups: conf.GetConf().Hertz.LogMaxBackups,
+		MaxAge:     conf.GetConf().Hertz.LogMaxAge,
+	}
+	hlog.SetOutput(writer)
+	return writer
+}
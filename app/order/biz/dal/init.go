@@ -0,0 +1,11 @@
+package dal
+
+import (
+	"zqzqsb/gomall/app/order/biz/dal/mysql"
+	"zqzqsb/gomall/app/order/biz/dal/redis"
+)
+
+func Init() {
+	redis.Init()
+	mysql.Init()
+}
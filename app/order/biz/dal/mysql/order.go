@@ -0,0 +1,104 @@
+package mysql
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"zqzqsb/gomall/app/order/biz/model"
+)
+
+var (
+	ErrOrderNotFound = errors.New("order not found")
+	// ErrStatusChanged 订单状态已被并发修改
+	ErrStatusChanged = errors.New("order status has changed")
+)
+
+// CreateOrder 创建订单及订单项
+func CreateOrder(db *gorm.DB, o *model.Order) (int64, error) {
+	now := time.Now()
+	o.CreatedAt = now
+	o.UpdatedAt = now
+	for _, item := range o.Items {
+		item.CreatedAt = now
+	}
+
+	// 订单和订单项需要同时写入
+	err := db.Transaction(func(tx *gorm.DB) error {
+		return tx.Create(o).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+	return o.ID, nil
+}
+
+// GetOrderByID 根据ID获取订单及订单项
+func GetOrderByID(db *gorm.DB, id int64) (*model.Order, error) {
+	var order model.Order
+	result := db.Preload("Items").First(&order, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, result.Error
+	}
+	return &order, nil
+}
+
+// TransitOrder 流转订单状态
+// 仅当订单当前状态仍为 from 时才更新，避免用户取消、超时取消和支付回调相互覆盖
+func TransitOrder(db *gorm.DB, id int64, from, to int32, updates map[string]interface{}) error {
+	if updates == nil {
+		updates = make(map[string]interface{})
+	}
+	updates["status"] = to
+	updates["updated_at"] = time.Now()
+
+	result := db.Model(&model.Order{}).Where("id = ? AND status = ?", id, from).Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrStatusChanged
+	}
+	return nil
+}
+
+// ListOrders 分页获取订单列表，userID 和 status 为 0 时不过滤
+func ListOrders(db *gorm.DB, userID int64, status int32, page, pageSize int) ([]*model.Order, int64, error) {
+	var orders []*model.Order
+	var count int64
+
+	query := db.Model(&model.Order{})
+	if userID != 0 {
+		query = query.Where("user_id = ?", userID)
+	}
+	if status != 0 {
+		query = query.Where("status = ?", status)
+	}
+
+	if err := query.Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	if err := query.Preload("Items").Order("id DESC").Offset(offset).Limit(pageSize).Find(&orders).Error; err != nil {
+		return nil, 0, err
+	}
+	return orders, count, nil
+}
+
+// ListExpiredOrderIDs 获取处于 status 状态且支付截止时间早于 before 的订单ID
+func ListExpiredOrderIDs(db *gorm.DB, status int32, before time.Time, limit int) ([]int64, error) {
+	var ids []int64
+	err := db.Model(&model.Order{}).
+		Where("status = ? AND expire_time < ?", status, before).
+		Order("expire_time").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
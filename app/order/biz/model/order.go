@@ -0,0 +1,96 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Order 订单模型
+type Order struct {
+	ID              int64           `gorm:"primarykey"`
+	OrderNo         string          `gorm:"type:varchar(64);uniqueIndex;not null"`
+	UserID          int64           `gorm:"not null;index"`
+	Status          int32           `gorm:"not null;index:idx_status_expire"`
+	TotalAmount     int64           `gorm:"not null"` // 单位：分
+	ShippingAddress ShippingAddress `gorm:"embedded;embeddedPrefix:shipping_"`
+	Remark          string          `gorm:"type:varchar(255)"`
+	PaymentID       int64           `gorm:"default:0"`
+	Carrier         string          `gorm:"type:varchar(64)"`
+	TrackingNo      string          `gorm:"type:varchar(64)"`
+	CancelReason    string          `gorm:"type:varchar(255)"`
+	ExpireTime      time.Time       `gorm:"not null;index:idx_status_expire"` // 支付截止时间
+	PayTime         *time.Time
+	ShipTime        *time.Time
+	CompleteTime    *time.Time
+	CancelTime      *time.Time
+	Items           []*OrderItem `gorm:"foreignKey:OrderID"`
+	CreatedAt       time.Time    `gorm:"not null"`
+	UpdatedAt       time.Time    `gorm:"not null"`
+}
+
+// TableName 设置表名
+func (Order) TableName() string {
+	return "orders"
+}
+
+// ShippingAddress 下单时的收货地址快照
+type ShippingAddress struct {
+	AddressID string `gorm:"type:varchar(64)"`
+	Name      string `gorm:"type:varchar(64)"`
+	Phone     string `gorm:"type:varchar(32)"`
+	Province  string `gorm:"type:varchar(64)"`
+	City      string `gorm:"type:varchar(64)"`
+	District  string `gorm:"type:varchar(64)"`
+	Detail    string `gorm:"type:varchar(255)"`
+	ZipCode   string `gorm:"type:varchar(16)"`
+}
+
+// OrderItem 订单项模型，保存下单时的商品快照
+type OrderItem struct {
+	ID                 int64     `gorm:"primarykey"`
+	OrderID            int64     `gorm:"not null;index"`
+	ProductID          int64     `gorm:"not null"`
+	SkuID              int64     `gorm:"not null;default:0"` // 商品有 SKU 时为所选规格对应的 SKU
+	ProductName        string    `gorm:"type:varchar(255)"`
+	ProductImage       string    `gorm:"type:varchar(255)"`
+	Price              int64     `gorm:"not null"` // 下单时单价，单位：分
+	Quantity           int32     `gorm:"not null"`
+	SelectedAttributes string    `gorm:"type:varchar(1024)"` // JSON 格式存储所选属性
+	CreatedAt          time.Time `gorm:"not null"`
+}
+
+// TableName 设置表名
+func (OrderItem) TableName() string {
+	return "order_items"
+}
+
+// Subtotal 订单项小计
+func (i *OrderItem) Subtotal() int64 {
+	return i.Price * int64(i.Quantity)
+}
+
+// GetSelectedAttributes 获取所选属性
+func (i *OrderItem) GetSelectedAttributes() map[string]string {
+	var attributes map[string]string
+	if i.SelectedAttributes != "" {
+		_ = json.Unmarshal([]byte(i.SelectedAttributes), &attributes)
+	}
+	if attributes == nil {
+		attributes = make(map[string]string)
+	}
+	return attributes
+}
+
+// SetSelectedAttributes 设置所选属性
+func (i *OrderItem) SetSelectedAttributes(attributes map[string]string) error {
+	if len(attributes) == 0 {
+		i.SelectedAttributes = ""
+		return nil
+	}
+	data, err := json.Marshal(attributes)
+	if err != nil {
+		return err
+	}
+	i.SelectedAttributes = string(data)
+	return nil
+}
@@ -0,0 +1,46 @@
+package mw
+
+import (
+	"context"
+
+	gormmysql "gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"zqzqsb.com/gomall/common/rbac"
+	"zqzqsb/gomall/app/order/biz/dal/mysql"
+	"zqzqsb/gomall/app/order/biz/dal/redis"
+	"zqzqsb/gomall/app/order/conf"
+)
+
+const (
+	defaultModelFile  = "conf/rbac_model.conf"
+	defaultPolicyFile = "conf/rbac_policy.csv"
+)
+
+// InitCasbin 加载订单管理接口的权限策略，并跟随用户服务发布的角色和黑名单变更重新加载
+func InitCasbin() {
+	cfg := conf.GetConf().Casbin
+	if cfg.ModelFile == "" {
+		cfg.ModelFile = defaultModelFile
+	}
+	if cfg.PolicyFile == "" {
+		cfg.PolicyFile = defaultPolicyFile
+	}
+	// 角色和黑名单读取用户服务的数据库，未单独配置时与本服务共用一个库
+	db := mysql.DB
+	if cfg.DSN != "" {
+		var err error
+		if db, err = gorm.Open(gormmysql.Open(cfg.DSN), &gorm.Config{}); err != nil {
+			panic(err)
+		}
+	}
+	if err := rbac.Init(db, cfg.ModelFile, cfg.PolicyFile); err != nil {
+		panic(err)
+	}
+	// 用户服务在自己的 Redis 上发布策略变更，与令牌吊销状态是同一个实例
+	if err := rbac.Watch(context.Background(), redis.TokenClient, cfg.Channel); err != nil {
+		panic(err)
+	}
+	if cfg.SyncInterval > 0 {
+		go rbac.ReloadPeriodically(context.Background(), cfg.SyncInterval)
+	}
+}
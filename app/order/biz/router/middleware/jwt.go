@@ -0,0 +1,81 @@
+package mw
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+	"github.com/hertz-contrib/jwt"
+	"zqzqsb.com/gomall/common/identity"
+	"zqzqsb.com/gomall/common/jwtauth"
+	"zqzqsb/gomall/app/order/biz/dal/redis"
+	"zqzqsb/gomall/app/order/conf"
+)
+
+var (
+	JwtMiddleware *jwt.HertzJWTMiddleware // JWT 中间件实例
+	IdentityKey   = "identity"            // 与用户服务保持一致的身份 key
+)
+
+// InitJwt 初始化 JWT 中间件
+// 订单服务只校验用户服务签发的 Token，不负责登录
+// 签名校验通过后还要检查令牌类型和吊销状态，登出、重置密码或刷新后旧的访问令牌立即失效
+func InitJwt() {
+	var err error
+	JwtMiddleware, err = jwt.New(&jwt.HertzJWTMiddleware{
+		Realm:         "gomall",
+		Key:           []byte(conf.GetConf().Jwt.Secret),
+		TokenLookup:   "header: Authorization, cookie: jwt",
+		TokenHeadName: "Bearer",
+		IdentityKey:   IdentityKey,
+		// 从 JWT 载荷中提取用户身份信息
+		IdentityHandler: func(ctx context.Context, c *app.RequestContext) interface{} {
+			claims := jwt.ExtractClaims(ctx, c)
+			// JWT 解析数字通常为 float64
+			f64, ok := claims[IdentityKey].(float64)
+			if !ok {
+				return nil
+			}
+			return int64(f64)
+		},
+		Authorizator: jwtauth.Authorizator(redis.TokenClient),
+		HTTPStatusMessageFunc: func(e error, ctx context.Context, c *app.RequestContext) string {
+			hlog.CtxErrorf(ctx, "jwt biz err = %+v", e.Error())
+			return e.Error()
+		},
+		Unauthorized: func(ctx context.Context, c *app.RequestContext, code int, message string) {
+			c.JSON(http.StatusUnauthorized, utils.H{
+				"code":    code,
+				"message": message,
+			})
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// IdentityMiddleware 将 JWT 中的用户身份写入上下文，服务层统一通过上下文获取
+func IdentityMiddleware() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		userID, ok := c.Get(IdentityKey)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, utils.H{
+				"code":    http.StatusUnauthorized,
+				"message": "no identity in token",
+			})
+			return
+		}
+		id, ok := userID.(int64)
+		if !ok || id <= 0 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, utils.H{
+				"code":    http.StatusUnauthorized,
+				"message": "invalid identity in token",
+			})
+			return
+		}
+		c.Next(identity.WithUserID(ctx, id))
+	}
+}
@@ -0,0 +1,81 @@
+// Code generated by hertz generator.
+
+package order
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+	mw "zqzqsb/gomall/app/order/biz/router/middleware"
+)
+
+func rootMw() []app.HandlerFunc {
+	// 订单接口全部需要登录，用户身份从 JWT 中获取，管理员权限由服务层校验
+	return []app.HandlerFunc{
+		mw.JwtMiddleware.MiddlewareFunc(),
+		mw.IdentityMiddleware(),
+	}
+}
+
+func _createorderMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _ordersMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getorderMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _order_idMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _cancelorderMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _confirmreceiptMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _adminMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listordersMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _orders0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _order_id0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _shiporderMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _userMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listuserordersMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
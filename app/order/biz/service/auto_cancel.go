@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"zqzqsb/gomall/app/order/biz/dal/mysql"
+	"zqzqsb/gomall/app/order/conf"
+	order "zqzqsb/gomall/app/order/kitex_gen/order"
+)
+
+const (
+	// defaultCancelScanInterval 未配置扫描间隔时使用的默认值
+	defaultCancelScanInterval = time.Minute
+	// cancelBatchSize 每轮最多取消的订单数
+	cancelBatchSize = 100
+	// expiredCancelReason 超时取消的原因
+	expiredCancelReason = "payment timeout"
+)
+
+// StartAutoCancel 定时取消超时未支付的订单
+// 取消使用带状态条件的更新，多个实例同时扫描也不会重复取消
+func StartAutoCancel(ctx context.Context) {
+	interval := conf.GetConf().Order.CancelScanInterval
+	if interval <= 0 {
+		interval = defaultCancelScanInterval
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if n, err := CancelExpiredOrders(ctx, time.Now()); err != nil {
+					klog.CtxErrorf(ctx, "cancel expired orders failed: %v", err)
+				} else if n > 0 {
+					klog.CtxInfof(ctx, "cancelled %d expired orders", n)
+				}
+			}
+		}
+	}()
+}
+
+// CancelExpiredOrders 取消支付截止时间早于 now 的待支付订单，返回取消的订单数
+func CancelExpiredOrders(ctx context.Context, now time.Time) (int, error) {
+	var cancelled int
+	for {
+		ids, err := mysql.ListExpiredOrderIDs(mysql.DB, int32(order.OrderStatus_ORDER_STATUS_CREATED), now, cancelBatchSize)
+		if err != nil {
+			return cancelled, err
+		}
+
+		var batch int
+		for _, id := range ids {
+			o, err := mysql.GetOrderByID(mysql.DB, id)
+			if err != nil {
+				klog.CtxWarnf(ctx, "get expired order %d failed: %v", id, err)
+				continue
+			}
+			if err = cancelOrder(ctx, o, expiredCancelReason); err != nil {
+				// 扫描后订单可能已被支付或取消
+				if !errors.Is(err, mysql.ErrStatusChanged) {
+					klog.CtxWarnf(ctx, "cancel expired order %s failed: %v", o.OrderNo, err)
+				}
+				continue
+			}
+			batch++
+		}
+		cancelled += batch
+
+		// 本轮没有取消任何订单时退出，避免对无法取消的订单反复重试
+		if len(ids) < cancelBatchSize || batch == 0 {
+			return cancelled, nil
+		}
+	}
+}
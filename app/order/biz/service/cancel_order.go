@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+
+	order "zqzqsb/gomall/app/order/kitex_gen/order"
+)
+
+type CancelOrderService struct {
+	ctx context.Context
+} // NewCancelOrderService new CancelOrderService
+func NewCancelOrderService(ctx context.Context) *CancelOrderService {
+	return &CancelOrderService{ctx: ctx}
+}
+
+// Run cancel an unpaid order of current user
+func (s *CancelOrderService) Run(req *order.CancelOrderReq) (resp *order.CancelOrderResp, err error) {
+	o, err := loadUserOrder(s.ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 重复取消直接返回成功
+	if order.OrderStatus(o.Status) == order.OrderStatus_ORDER_STATUS_CANCELLED {
+		return &order.CancelOrderResp{Success: true}, nil
+	}
+
+	reason := req.Reason
+	if reason == "" {
+		reason = "cancelled by user"
+	}
+	// 只有待支付订单可以取消，已支付订单需走退款流程
+	if err = cancelOrder(s.ctx, o, reason); err != nil {
+		return nil, err
+	}
+
+	return &order.CancelOrderResp{Success: true}, nil
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	order "zqzqsb/gomall/app/order/kitex_gen/order"
+)
+
+type ConfirmReceiptService struct {
+	ctx context.Context
+} // NewConfirmReceiptService new ConfirmReceiptService
+func NewConfirmReceiptService(ctx context.Context) *ConfirmReceiptService {
+	return &ConfirmReceiptService{ctx: ctx}
+}
+
+// Run confirm receipt of a shipped order
+func (s *ConfirmReceiptService) Run(req *order.ConfirmReceiptReq) (resp *order.ConfirmReceiptResp, err error) {
+	o, err := loadUserOrder(s.ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 重复确认直接返回成功
+	if order.OrderStatus(o.Status) == order.OrderStatus_ORDER_STATUS_COMPLETED {
+		return &order.ConfirmReceiptResp{Success: true}, nil
+	}
+
+	if err = transitOrder(o, order.OrderStatus_ORDER_STATUS_COMPLETED, map[string]interface{}{
+		"complete_time": time.Now(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &order.ConfirmReceiptResp{Success: true}, nil
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+	"testing"
+	order "zqzqsb/gomall/app/order/kitex_gen/order"
+)
+
+func TestConfirmReceipt_Run(t *testing.T) {
+	ctx := context.Background()
+	s := NewConfirmReceiptService(ctx)
+	// init req and assert value
+
+	req := &order.ConfirmReceiptReq{}
+	resp, err := s.Run(req)
+	t.Logf("err: %v", err)
+	t.Logf("resp: %v", resp)
+
+	// todo: edit your unit test
+
+}
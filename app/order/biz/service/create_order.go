@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"zqzqsb.com/gomall/common/identity"
+	"zqzqsb/gomall/app/order/biz/dal/mysql"
+	"zqzqsb/gomall/app/order/biz/model"
+	"zqzqsb/gomall/app/order/infra/rpc"
+	cart "zqzqsb/gomall/app/order/kitex_gen/cart"
+	order "zqzqsb/gomall/app/order/kitex_gen/order"
+	product "zqzqsb/gomall/app/order/kitex_gen/product"
+	user "zqzqsb/gomall/app/order/kitex_gen/user"
+)
+
+type CreateOrderService struct {
+	ctx context.Context
+} // NewCreateOrderService new CreateOrderService
+func NewCreateOrderService(ctx context.Context) *CreateOrderService {
+	return &CreateOrderService{ctx: ctx}
+}
+
+// Run create order from selected cart items
+func (s *CreateOrderService) Run(req *order.CreateOrderReq) (resp *order.CreateOrderResp, err error) {
+	userID, err := identity.GetUserID(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 参数验证
+	if len(req.CartItemIds) == 0 {
+		return nil, errors.New("no cart item selected")
+	}
+	addr, err := resolveShippingAddress(s.ctx, req.ShippingAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// 购物车服务校验购物车项归属并返回最新的商品信息，用户身份随元信息透传
+	selected, err := rpc.CartClient.SelectCartItems(s.ctx, &cart.SelectCartItemsReq{
+		CartItemIds: req.CartItemIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.OrderItem, 0, len(selected.SelectedItems))
+	var totalAmount int64
+	for _, ci := range selected.SelectedItems {
+		p := ci.Product
+		if p == nil || !p.IsOnSale {
+			return nil, fmt.Errorf("product %d is not available", ci.ProductId)
+		}
+
+		// 以商品当前价格生成快照，之后商品改价不影响订单金额；有 SKU 的商品按所选规格的 SKU 定价
+		item := &model.OrderItem{
+			ProductID:    p.Id,
+			ProductName:  p.Name,
+			ProductImage: p.ImageUrl,
+			Price:        p.Price,
+			Quantity:     ci.Quantity,
+		}
+		if len(p.Skus) > 0 {
+			sku, err := matchSku(p, ci.SelectedAttributes)
+			if err != nil {
+				return nil, err
+			}
+			item.SkuID = sku.Id
+			item.Price = sku.Price
+		}
+		if err = item.SetSelectedAttributes(ci.SelectedAttributes); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+		totalAmount += item.Subtotal()
+	}
+	if totalAmount <= 0 {
+		return nil, errors.New("invalid order amount")
+	}
+
+	// 以订单号为幂等键预占全部商品库存，任一商品库存不足时整单失败
+	// 预占有效期比支付时限略长，正常情况下由订单取消主动释放
+	orderNo := generateNo("O")
+	timeout := payTimeout()
+	stockItems := make([]*product.StockItem, 0, len(items))
+	for _, item := range items {
+		stockItems = append(stockItems, &product.StockItem{ProductId: item.ProductID, SkuId: item.SkuID, Quantity: item.Quantity})
+	}
+	if _, err = rpc.ProductClient.ReserveStock(s.ctx, &product.ReserveStockReq{
+		Token:      orderNo,
+		Items:      stockItems,
+		TtlSeconds: int64((timeout + reservationMargin) / time.Second),
+	}); err != nil {
+		return nil, err
+	}
+
+	o := &model.Order{
+		OrderNo:     orderNo,
+		UserID:      userID,
+		Status:      int32(order.OrderStatus_ORDER_STATUS_CREATED),
+		TotalAmount: totalAmount,
+		ShippingAddress: model.ShippingAddress{
+			AddressID: addr.AddressId,
+			Name:      addr.Name,
+			Phone:     addr.Phone,
+			Province:  addr.Province,
+			City:      addr.City,
+			District:  addr.District,
+			Detail:    addr.Detail,
+			ZipCode:   addr.ZipCode,
+		},
+		Remark:     req.Remark,
+		ExpireTime: time.Now().Add(timeout),
+		Items:      items,
+	}
+	if _, err = mysql.CreateOrder(mysql.DB, o); err != nil {
+		releaseStock(s.ctx, orderNo)
+		return nil, err
+	}
+
+	// 下单成功后从购物车移除已结算的商品，失败不影响订单
+	for _, id := range req.CartItemIds {
+		if _, rerr := rpc.CartClient.RemoveFromCart(s.ctx, &cart.RemoveFromCartReq{CartItemId: id}); rerr != nil {
+			klog.CtxWarnf(s.ctx, "remove cart item %d after order %s created failed: %v", id, o.OrderNo, rerr)
+		}
+	}
+
+	return &order.CreateOrderResp{Order: toProtoOrder(o)}, nil
+}
+
+// resolveShippingAddress 携带地址ID时以用户服务中保存的地址生成快照，忽略请求中的其余字段，
+// 用户服务只返回属于当前用户的地址；未携带地址ID时使用请求中填写的地址
+func resolveShippingAddress(ctx context.Context, addr *order.ShippingAddress) (*order.ShippingAddress, error) {
+	if addr == nil {
+		return nil, errors.New("shipping address is required")
+	}
+	if addr.AddressId == "" {
+		if addr.Name == "" || addr.Phone == "" || addr.Detail == "" {
+			return nil, errors.New("shipping address is required")
+		}
+		return addr, nil
+	}
+
+	id, err := strconv.ParseInt(addr.AddressId, 10, 64)
+	if err != nil || id <= 0 {
+		return nil, fmt.Errorf("invalid shipping address id %q", addr.AddressId)
+	}
+	resp, err := rpc.UserClient.GetAddress(ctx, &user.GetAddressReq{AddressId: id})
+	if err != nil {
+		return nil, err
+	}
+	a := resp.Address
+	return &order.ShippingAddress{
+		AddressId: addr.AddressId,
+		Name:      a.Name,
+		Phone:     a.Phone,
+		Province:  a.Province,
+		City:      a.City,
+		District:  a.District,
+		Detail:    a.Detail,
+		ZipCode:   a.ZipCode,
+	}, nil
+}
@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+
+	order "zqzqsb/gomall/app/order/kitex_gen/order"
+)
+
+type GetOrderService struct {
+	ctx context.Context
+} // NewGetOrderService new GetOrderService
+func NewGetOrderService(ctx context.Context) *GetOrderService {
+	return &GetOrderService{ctx: ctx}
+}
+
+// Run get order detail of current user
+func (s *GetOrderService) Run(req *order.GetOrderReq) (resp *order.GetOrderResp, err error) {
+	o, err := loadUserOrder(s.ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &order.GetOrderResp{Order: toProtoOrder(o)}, nil
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+
+	"zqzqsb/gomall/app/order/biz/dal/mysql"
+	"zqzqsb/gomall/app/order/biz/utils"
+	order "zqzqsb/gomall/app/order/kitex_gen/order"
+)
+
+type ListOrdersService struct {
+	ctx context.Context
+} // NewListOrdersService new ListOrdersService
+func NewListOrdersService(ctx context.Context) *ListOrdersService {
+	return &ListOrdersService{ctx: ctx}
+}
+
+// Run list all orders for admin
+func (s *ListOrdersService) Run(req *order.ListOrdersReq) (resp *order.ListOrdersResp, err error) {
+	if _, err = utils.RequireAdmin(s.ctx, "GET", "/admin/orders"); err != nil {
+		return nil, err
+	}
+
+	page, pageSize := normalizePage(req.Page, req.PageSize)
+	orders, total, err := mysql.ListOrders(mysql.DB, req.UserId, int32(req.Status), int(page), int(pageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	return &order.ListOrdersResp{
+		Orders:   toProtoOrders(orders),
+		Total:    int32(total),
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
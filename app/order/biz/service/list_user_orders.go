@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+
+	"zqzqsb.com/gomall/common/identity"
+	"zqzqsb/gomall/app/order/biz/dal/mysql"
+	order "zqzqsb/gomall/app/order/kitex_gen/order"
+)
+
+type ListUserOrdersService struct {
+	ctx context.Context
+} // NewListUserOrdersService new ListUserOrdersService
+func NewListUserOrdersService(ctx context.Context) *ListUserOrdersService {
+	return &ListUserOrdersService{ctx: ctx}
+}
+
+// Run list orders of current user
+func (s *ListUserOrdersService) Run(req *order.ListUserOrdersReq) (resp *order.ListOrdersResp, err error) {
+	userID, err := identity.GetUserID(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page, pageSize := normalizePage(req.Page, req.PageSize)
+	orders, total, err := mysql.ListOrders(mysql.DB, userID, int32(req.Status), int(page), int(pageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	return &order.ListOrdersResp{
+		Orders:   toProtoOrders(orders),
+		Total:    int32(total),
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"zqzqsb.com/gomall/common/identity"
+	"zqzqsb/gomall/app/order/biz/dal/mysql"
+	"zqzqsb/gomall/app/order/biz/model"
+	"zqzqsb/gomall/app/order/infra/rpc"
+	order "zqzqsb/gomall/app/order/kitex_gen/order"
+	pay "zqzqsb/gomall/app/order/kitex_gen/pay"
+)
+
+// ErrPaymentNotFound 支付服务中没有该订单所属用户的这笔支付单
+var ErrPaymentNotFound = errors.New("payment not found")
+
+type MarkOrderPaidService struct {
+	ctx context.Context
+} // NewMarkOrderPaidService new MarkOrderPaidService
+func NewMarkOrderPaidService(ctx context.Context) *MarkOrderPaidService {
+	return &MarkOrderPaidService{ctx: ctx}
+}
+
+// Run mark order as paid, called by payment service after payment completed
+func (s *MarkOrderPaidService) Run(req *order.MarkOrderPaidReq) (resp *order.MarkOrderPaidResp, err error) {
+	// 参数验证
+	if req.OrderId <= 0 || req.PaymentId <= 0 {
+		return nil, errors.New("invalid order id or payment id")
+	}
+
+	o, err := mysql.GetOrderByID(mysql.DB, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 支付回调会重复通知，同一笔支付重复标记直接返回成功
+	// 上次确认库存预占可能失败，重复通知时再次确认
+	if order.OrderStatus(o.Status) != order.OrderStatus_ORDER_STATUS_CREATED && o.PaymentID == req.PaymentId {
+		if err = confirmStock(s.ctx, o.OrderNo); err != nil {
+			return nil, err
+		}
+		return &order.MarkOrderPaidResp{Success: true}, nil
+	}
+	if req.Amount != o.TotalAmount {
+		klog.CtxErrorf(s.ctx, "order %s amount mismatch, expect %d, got %d", o.OrderNo, o.TotalAmount, req.Amount)
+		return nil, errors.New("order amount mismatch")
+	}
+	// 调用方只传入支付单ID，需向支付服务核对支付单确实已完成且属于该订单，避免伪造的调用把订单标记为已支付
+	if err = verifyPayment(s.ctx, o, req.PaymentId); err != nil {
+		klog.CtxErrorf(s.ctx, "verify payment %d of order %s failed: %v", req.PaymentId, o.OrderNo, err)
+		return nil, err
+	}
+
+	if err = transitOrder(o, order.OrderStatus_ORDER_STATUS_PAID, map[string]interface{}{
+		"payment_id": req.PaymentId,
+		"pay_time":   time.Now(),
+	}); err != nil {
+		// 订单已超时取消等情况需要人工退款
+		klog.CtxErrorf(s.ctx, "mark order %s paid by payment %d failed: %v", o.OrderNo, req.PaymentId, err)
+		return nil, err
+	}
+
+	// 订单状态先流转，保证与取消订单只有一方成功，再确认库存预占
+	// 确认失败时返回错误，由支付服务重试通知
+	if err = confirmStock(s.ctx, o.OrderNo); err != nil {
+		klog.CtxErrorf(s.ctx, "confirm stock reservation of order %s failed: %v", o.OrderNo, err)
+		return nil, err
+	}
+
+	return &order.MarkOrderPaidResp{Success: true}, nil
+}
+
+// verifyPayment 向支付服务查询支付单，校验支付已完成，且关联的订单和金额与订单一致
+// 支付服务只返回当前用户的支付单，以订单所属用户的身份查询，同时校验了支付单的归属
+func verifyPayment(ctx context.Context, o *model.Order, paymentID int64) error {
+	resp, err := rpc.PaymentClient.QueryPayment(identity.WithUserID(ctx, o.UserID), &pay.QueryPaymentReq{
+		Identifier: &pay.QueryPaymentReq_PaymentId{PaymentId: paymentID},
+	})
+	if err != nil {
+		return err
+	}
+	p := resp.GetPayment()
+	switch {
+	case p == nil || p.Id != paymentID:
+		return ErrPaymentNotFound
+	case p.OrderId != o.ID:
+		return errors.New("payment does not belong to the order")
+	case p.Amount != o.TotalAmount:
+		return errors.New("payment amount mismatch")
+	case p.Status != pay.PaymentStatus_PAYMENT_STATUS_COMPLETED:
+		return fmt.Errorf("payment is %s, not completed", p.Status)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"zqzqsb/gomall/app/order/biz/dal/mysql"
+	order "zqzqsb/gomall/app/order/kitex_gen/order"
+)
+
+// refundedCancelReason 全额退款取消订单的原因
+const refundedCancelReason = "refunded"
+
+// refundableStatuses 全额退款时可以取消的订单状态
+// 不加入 orderTransitions，买家取消和超时取消仍然只能取消待支付订单
+var refundableStatuses = map[order.OrderStatus]bool{
+	order.OrderStatus_ORDER_STATUS_PAID:      true,
+	order.OrderStatus_ORDER_STATUS_SHIPPED:   true,
+	order.OrderStatus_ORDER_STATUS_COMPLETED: true,
+}
+
+type MarkOrderRefundedService struct {
+	ctx context.Context
+} // NewMarkOrderRefundedService new MarkOrderRefundedService
+func NewMarkOrderRefundedService(ctx context.Context) *MarkOrderRefundedService {
+	return &MarkOrderRefundedService{ctx: ctx}
+}
+
+// Run cancel a paid order before its payment is fully refunded, called by payment service
+// 已确认扣减的库存不回补，退回的商品由商家入库后自行调整
+func (s *MarkOrderRefundedService) Run(req *order.MarkOrderRefundedReq) (resp *order.MarkOrderRefundedResp, err error) {
+	// 参数验证
+	if req.OrderId <= 0 || req.PaymentId <= 0 {
+		return nil, errors.New("invalid order id or payment id")
+	}
+
+	o, err := mysql.GetOrderByID(mysql.DB, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 订单已取消时无需流转：渠道退款失败后支付服务会重试，超时取消后才完成的支付也需要退款
+	from := order.OrderStatus(o.Status)
+	if from == order.OrderStatus_ORDER_STATUS_CANCELLED {
+		return &order.MarkOrderRefundedResp{Success: true}, nil
+	}
+	if o.PaymentID != req.PaymentId {
+		return nil, errors.New("payment does not belong to the order")
+	}
+	if !refundableStatuses[from] {
+		return nil, fmt.Errorf("%w: %s -> %s", ErrInvalidTransition, from, order.OrderStatus_ORDER_STATUS_CANCELLED)
+	}
+	if req.Amount != o.TotalAmount {
+		klog.CtxErrorf(s.ctx, "order %s refund amount mismatch, expect %d, got %d", o.OrderNo, o.TotalAmount, req.Amount)
+		return nil, errors.New("order amount mismatch")
+	}
+	// 与标记已支付一样向支付服务核对支付单，避免伪造的调用取消已支付的订单
+	if err = verifyPayment(s.ctx, o, req.PaymentId); err != nil {
+		klog.CtxErrorf(s.ctx, "verify payment %d of order %s failed: %v", req.PaymentId, o.OrderNo, err)
+		return nil, err
+	}
+
+	if err = mysql.TransitOrder(mysql.DB, o.ID, o.Status, int32(order.OrderStatus_ORDER_STATUS_CANCELLED), map[string]interface{}{
+		"cancel_reason": refundedCancelReason,
+		"cancel_time":   time.Now(),
+	}); err != nil {
+		return nil, err
+	}
+
+	klog.CtxInfof(s.ctx, "order %s cancelled for refund of payment %d", o.OrderNo, req.PaymentId)
+
+	return &order.MarkOrderRefundedResp{Success: true}, nil
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+	"testing"
+	order "zqzqsb/gomall/app/order/kitex_gen/order"
+)
+
+func TestMarkOrderRefunded_Run(t *testing.T) {
+	ctx := context.Background()
+	s := NewMarkOrderRefundedService(ctx)
+	// init req and assert value
+
+	req := &order.MarkOrderRefundedReq{}
+	resp, err := s.Run(req)
+	t.Logf("err: %v", err)
+	t.Logf("resp: %v", resp)
+
+	// todo: edit your unit test
+
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"zqzqsb.com/gomall/common/identity"
+	"zqzqsb/gomall/app/order/biz/dal/mysql"
+	"zqzqsb/gomall/app/order/biz/model"
+	"zqzqsb/gomall/app/order/conf"
+	"zqzqsb/gomall/app/order/infra/rpc"
+	order "zqzqsb/gomall/app/order/kitex_gen/order"
+	product "zqzqsb/gomall/app/order/kitex_gen/product"
+)
+
+const (
+	// defaultPayTimeout 未配置支付时限时使用的默认值
+	defaultPayTimeout = 30 * time.Minute
+	// reservationMargin 库存预占比支付时限多保留的时间，保证超时取消先于预占过期
+	reservationMargin = 5 * time.Minute
+)
+
+var (
+	ErrInvalidTransition = errors.New("invalid order status transition")
+	ErrOrderForbidden    = errors.New("order does not belong to current user")
+)
+
+// orderTransitions 订单状态机，key 为当前状态，value 为允许流转到的状态
+//
+//	CREATED -> PAID -> SHIPPED -> COMPLETED
+//	   |
+//	   |-----> CANCELLED
+var orderTransitions = map[order.OrderStatus][]order.OrderStatus{
+	order.OrderStatus_ORDER_STATUS_CREATED: {
+		order.OrderStatus_ORDER_STATUS_PAID,
+		order.OrderStatus_ORDER_STATUS_CANCELLED,
+	},
+	order.OrderStatus_ORDER_STATUS_PAID: {
+		order.OrderStatus_ORDER_STATUS_SHIPPED,
+	},
+	order.OrderStatus_ORDER_STATUS_SHIPPED: {
+		order.OrderStatus_ORDER_STATUS_COMPLETED,
+	},
+}
+
+// canTransit 判断状态流转是否合法
+func canTransit(from, to order.OrderStatus) bool {
+	for _, s := range orderTransitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// transitOrder 按状态机流转订单状态并同步到数据库
+func transitOrder(o *model.Order, to order.OrderStatus, updates map[string]interface{}) error {
+	from := order.OrderStatus(o.Status)
+	if !canTransit(from, to) {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidTransition, from, to)
+	}
+	if err := mysql.TransitOrder(mysql.DB, o.ID, o.Status, int32(to), updates); err != nil {
+		return err
+	}
+	o.Status = int32(to)
+	return nil
+}
+
+// payTimeout 下单后的支付时限
+func payTimeout() time.Duration {
+	timeout := conf.GetConf().Order.PayTimeout
+	if timeout <= 0 {
+		timeout = defaultPayTimeout
+	}
+	return timeout
+}
+
+// cancelOrder 取消待支付订单并释放库存预占，用户主动取消和超时取消共用
+func cancelOrder(ctx context.Context, o *model.Order, reason string) error {
+	now := time.Now()
+	if err := transitOrder(o, order.OrderStatus_ORDER_STATUS_CANCELLED, map[string]interface{}{
+		"cancel_reason": reason,
+		"cancel_time":   now,
+	}); err != nil {
+		return err
+	}
+	o.CancelReason = reason
+	o.CancelTime = &now
+
+	releaseStock(ctx, o.OrderNo)
+	return nil
+}
+
+// releaseStock 释放订单的库存预占，失败时预占会在过期后由商品服务自动释放
+func releaseStock(ctx context.Context, orderNo string) {
+	if _, err := rpc.ProductClient.ReleaseReservation(ctx, &product.ReleaseReservationReq{Token: orderNo}); err != nil {
+		klog.CtxWarnf(ctx, "release stock reservation of order %s failed: %v", orderNo, err)
+	}
+}
+
+// confirmStock 确认订单的库存预占，预占的库存正式扣减
+func confirmStock(ctx context.Context, orderNo string) error {
+	_, err := rpc.ProductClient.ConfirmReservation(ctx, &product.ConfirmReservationReq{Token: orderNo})
+	return err
+}
+
+// generateNo 生成订单号
+func generateNo(prefix string) string {
+	return fmt.Sprintf("%s%s%06d", prefix, time.Now().Format("20060102150405"), rand.Intn(1000000))
+}
+
+// normalizePage 设置分页默认值
+func normalizePage(page, pageSize int32) (int32, int32) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+	return page, pageSize
+}
+
+// loadUserOrder 获取当前用户的订单
+func loadUserOrder(ctx context.Context, orderID int64) (*model.Order, error) {
+	userID, err := identity.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if orderID <= 0 {
+		return nil, errors.New("invalid order id")
+	}
+
+	o, err := mysql.GetOrderByID(mysql.DB, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if o.UserID != userID {
+		return nil, ErrOrderForbidden
+	}
+	return o, nil
+}
+
+// toProtoOrder 将订单模型转换为 IDL 结构
+func toProtoOrder(o *model.Order) *order.Order {
+	items := make([]*order.OrderItem, 0, len(o.Items))
+	for _, item := range o.Items {
+		items = append(items, &order.OrderItem{
+			Id:                 item.ID,
+			ProductId:          item.ProductID,
+			ProductName:        item.ProductName,
+			ProductImage:       item.ProductImage,
+			Price:              item.Price,
+			Quantity:           item.Quantity,
+			Subtotal:           item.Subtotal(),
+			SelectedAttributes: item.GetSelectedAttributes(),
+		})
+	}
+
+	addr := o.ShippingAddress
+	return &order.Order{
+		Id:          o.ID,
+		OrderNo:     o.OrderNo,
+		UserId:      o.UserID,
+		Status:      order.OrderStatus(o.Status),
+		TotalAmount: o.TotalAmount,
+		Items:       items,
+		ShippingAddress: &order.ShippingAddress{
+			AddressId: addr.AddressID,
+			Name:      addr.Name,
+			Phone:     addr.Phone,
+			Province:  addr.Province,
+			City:      addr.City,
+			District:  addr.District,
+			Detail:    addr.Detail,
+			ZipCode:   addr.ZipCode,
+		},
+		Remark:       o.Remark,
+		PaymentId:    o.PaymentID,
+		Carrier:      o.Carrier,
+		TrackingNo:   o.TrackingNo,
+		CancelReason: o.CancelReason,
+		CreateTime:   o.CreatedAt.Unix(),
+		UpdateTime:   o.UpdatedAt.Unix(),
+		ExpireTime:   o.ExpireTime.Unix(),
+		PayTime:      unixOrZero(o.PayTime),
+		ShipTime:     unixOrZero(o.ShipTime),
+		CompleteTime: unixOrZero(o.CompleteTime),
+		CancelTime:   unixOrZero(o.CancelTime),
+	}
+}
+
+func unixOrZero(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+	return t.Unix()
+}
+
+// toProtoOrders 批量转换订单
+func toProtoOrders(orders []*model.Order) []*order.Order {
+	result := make([]*order.Order, 0, len(orders))
+	for _, o := range orders {
+		result = append(result, toProtoOrder(o))
+	}
+	return result
+}
+
+// matchSku 按购物车中选择的规格值找到商品的 SKU，所选值需覆盖 SKU 的全部规格，多余的属性忽略
+func matchSku(p *product.Product, selected map[string]string) (*product.Sku, error) {
+	for _, sku := range p.Skus {
+		matched := true
+		for name, value := range sku.Attributes {
+			if selected[name] != value {
+				matched = false
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+		if !sku.IsOnSale {
+			return nil, fmt.Errorf("product %d sku %d is not available", p.Id, sku.Id)
+		}
+		return sku, nil
+	}
+	return nil, fmt.Errorf("product %d: please select a valid specification", p.Id)
+}
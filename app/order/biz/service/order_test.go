@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cloudwego/kitex/client/callopt"
+	"zqzqsb.com/gomall/common/identity"
+	"zqzqsb/gomall/app/order/biz/model"
+	"zqzqsb/gomall/app/order/infra/rpc"
+	order "zqzqsb/gomall/app/order/kitex_gen/order"
+	pay "zqzqsb/gomall/app/order/kitex_gen/pay"
+	"zqzqsb/gomall/app/order/kitex_gen/pay/paymentservice"
+	product "zqzqsb/gomall/app/order/kitex_gen/product"
+)
+
+func TestCanTransit(t *testing.T) {
+	cases := []struct {
+		from, to order.OrderStatus
+		want     bool
+	}{
+		{order.OrderStatus_ORDER_STATUS_CREATED, order.OrderStatus_ORDER_STATUS_PAID, true},
+		{order.OrderStatus_ORDER_STATUS_CREATED, order.OrderStatus_ORDER_STATUS_CANCELLED, true},
+		{order.OrderStatus_ORDER_STATUS_CREATED, order.OrderStatus_ORDER_STATUS_SHIPPED, false},
+		{order.OrderStatus_ORDER_STATUS_PAID, order.OrderStatus_ORDER_STATUS_SHIPPED, true},
+		{order.OrderStatus_ORDER_STATUS_PAID, order.OrderStatus_ORDER_STATUS_CANCELLED, false},
+		{order.OrderStatus_ORDER_STATUS_SHIPPED, order.OrderStatus_ORDER_STATUS_COMPLETED, true},
+		{order.OrderStatus_ORDER_STATUS_COMPLETED, order.OrderStatus_ORDER_STATUS_CANCELLED, false},
+		{order.OrderStatus_ORDER_STATUS_CANCELLED, order.OrderStatus_ORDER_STATUS_PAID, false},
+	}
+	for _, c := range cases {
+		if got := canTransit(c.from, c.to); got != c.want {
+			t.Errorf("canTransit(%s, %s) = %v, want %v", c.from, c.to, got, c.want)
+		}
+	}
+}
+
+func TestMatchSku(t *testing.T) {
+	p := &product.Product{Id: 1, Skus: []*product.Sku{
+		{Id: 10, Attributes: map[string]string{"color": "red", "size": "M"}, IsOnSale: true},
+		{Id: 11, Attributes: map[string]string{"color": "blue", "size": "M"}, IsOnSale: false},
+	}}
+
+	sku, err := matchSku(p, map[string]string{"size": "M", "color": "red", "gift": "card"})
+	if err != nil || sku.Id != 10 {
+		t.Fatalf("expect sku 10, got %v, %v", sku, err)
+	}
+	if _, err = matchSku(p, map[string]string{"color": "blue", "size": "M"}); err == nil {
+		t.Error("expect error for sku not on sale")
+	}
+	if _, err = matchSku(p, map[string]string{"color": "red"}); err == nil {
+		t.Error("expect error for incomplete specification")
+	}
+}
+
+// fakePaymentClient 只实现 QueryPayment，按支付单ID返回预置的支付单，并记录查询时透传的用户身份
+type fakePaymentClient struct {
+	paymentservice.Client
+	payments map[int64]*pay.Payment
+	userID   int64
+}
+
+func (f *fakePaymentClient) QueryPayment(ctx context.Context, req *pay.QueryPaymentReq, _ ...callopt.Option) (*pay.QueryPaymentResp, error) {
+	f.userID, _ = identity.GetUserID(ctx)
+	p, ok := f.payments[req.GetPaymentId()]
+	if !ok || p.UserId != f.userID {
+		return nil, errors.New("payment not found")
+	}
+	return &pay.QueryPaymentResp{Payment: p}, nil
+}
+
+func TestVerifyPayment(t *testing.T) {
+	completed := pay.PaymentStatus_PAYMENT_STATUS_COMPLETED
+	client := &fakePaymentClient{payments: map[int64]*pay.Payment{
+		1: {Id: 1, UserId: 7, OrderId: 100, Amount: 5000, Status: completed},
+		2: {Id: 2, UserId: 7, OrderId: 101, Amount: 5000, Status: completed},
+		3: {Id: 3, UserId: 7, OrderId: 100, Amount: 4000, Status: completed},
+		4: {Id: 4, UserId: 7, OrderId: 100, Amount: 5000, Status: pay.PaymentStatus_PAYMENT_STATUS_PENDING},
+		5: {Id: 5, UserId: 8, OrderId: 100, Amount: 5000, Status: completed},
+	}}
+	old := rpc.PaymentClient
+	rpc.PaymentClient = client
+	defer func() { rpc.PaymentClient = old }()
+
+	o := &model.Order{ID: 100, UserID: 7, TotalAmount: 5000}
+	cases := []struct {
+		name      string
+		paymentID int64
+		wantErr   bool
+	}{
+		{"completed", 1, false},
+		{"other order", 2, true},
+		{"amount mismatch", 3, true},
+		{"not completed", 4, true},
+		{"other user", 5, true},
+		{"not found", 6, true},
+	}
+	for _, c := range cases {
+		err := verifyPayment(context.Background(), o, c.paymentID)
+		if (err != nil) != c.wantErr {
+			t.Errorf("%s: got error %v, want error %v", c.name, err, c.wantErr)
+		}
+		if client.userID != o.UserID {
+			t.Errorf("%s: queried as user %d, want order owner %d", c.name, client.userID, o.UserID)
+		}
+	}
+}
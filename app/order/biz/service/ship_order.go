@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"zqzqsb/gomall/app/order/biz/dal/mysql"
+	"zqzqsb/gomall/app/order/biz/utils"
+	order "zqzqsb/gomall/app/order/kitex_gen/order"
+)
+
+type ShipOrderService struct {
+	ctx context.Context
+} // NewShipOrderService new ShipOrderService
+func NewShipOrderService(ctx context.Context) *ShipOrderService {
+	return &ShipOrderService{ctx: ctx}
+}
+
+// Run ship a paid order, admin only
+func (s *ShipOrderService) Run(req *order.ShipOrderReq) (resp *order.ShipOrderResp, err error) {
+	adminID, err := utils.RequireAdmin(s.ctx, "POST", fmt.Sprintf("/admin/orders/%d/ship", req.OrderId))
+	if err != nil {
+		return nil, err
+	}
+
+	// 参数验证
+	if req.OrderId <= 0 {
+		return nil, errors.New("invalid order id")
+	}
+	if req.Carrier == "" || req.TrackingNo == "" {
+		return nil, errors.New("carrier and tracking no are required")
+	}
+
+	o, err := mysql.GetOrderByID(mysql.DB, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	if err = transitOrder(o, order.OrderStatus_ORDER_STATUS_SHIPPED, map[string]interface{}{
+		"carrier":     req.Carrier,
+		"tracking_no": req.TrackingNo,
+		"ship_time":   time.Now(),
+	}); err != nil {
+		return nil, err
+	}
+
+	klog.CtxInfof(s.ctx, "order %s shipped by admin %d, %s %s", o.OrderNo, adminID, req.Carrier, req.TrackingNo)
+
+	return &order.ShipOrderResp{Success: true}, nil
+}
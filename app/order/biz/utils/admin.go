@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"zqzqsb.com/gomall/common/identity"
+	"zqzqsb.com/gomall/common/rbac"
+)
+
+// ErrPermissionDenied 当前用户没有订单管理权限
+var ErrPermissionDenied = errors.New("permission denied")
+
+// RequireAdmin 校验调用方身份，并按 /admin 接口的 Casbin 策略校验 act 方法访问 obj 的权限，返回管理员的用户ID
+// 角色和黑名单由用户服务维护，网关转发的 RPC 请求和本服务的 HTTP 请求使用同一套规则
+func RequireAdmin(ctx context.Context, act, obj string) (int64, error) {
+	userID, err := identity.GetUserID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	ok, err := rbac.Enforce(strconv.FormatInt(userID, 10), obj, act)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		klog.CtxWarnf(ctx, "user %d denied: %s %s", userID, act, obj)
+		return 0, ErrPermissionDenied
+	}
+	return userID, nil
+}
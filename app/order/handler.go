@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	order "zqzqsb/gomall/app/order/kitex_gen/order"
+	"zqzqsb/gomall/app/order/biz/service"
+)
+
+// OrderServiceImpl implements the last service interface defined in the IDL.
+type OrderServiceImpl struct{}
+
+// CreateOrder implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) CreateOrder(ctx context.Context, req *order.CreateOrderReq) (resp *order.CreateOrderResp, err error) {
+	resp, err = service.NewCreateOrderService(ctx).Run(req)
+
+	return resp, err
+}
+
+// GetOrder implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) GetOrder(ctx context.Context, req *order.GetOrderReq) (resp *order.GetOrderResp, err error) {
+	resp, err = service.NewGetOrderService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ListUserOrders implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) ListUserOrders(ctx context.Context, req *order.ListUserOrdersReq) (resp *order.ListOrdersResp, err error) {
+	resp, err = service.NewListUserOrdersService(ctx).Run(req)
+
+	return resp, err
+}
+
+// CancelOrder implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) CancelOrder(ctx context.Context, req *order.CancelOrderReq) (resp *order.CancelOrderResp, err error) {
+	resp, err = service.NewCancelOrderService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ConfirmReceipt implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) ConfirmReceipt(ctx context.Context, req *order.ConfirmReceiptReq) (resp *order.ConfirmReceiptResp, err error) {
+	resp, err = service.NewConfirmReceiptService(ctx).Run(req)
+
+	return resp, err
+}
+
+// MarkOrderPaid implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) MarkOrderPaid(ctx context.Context, req *order.MarkOrderPaidReq) (resp *order.MarkOrderPaidResp, err error) {
+	resp, err = service.NewMarkOrderPaidService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ShipOrder implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) ShipOrder(ctx context.Context, req *order.ShipOrderReq) (resp *order.ShipOrderResp, err error) {
+	resp, err = service.NewShipOrderService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ListOrders implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) ListOrders(ctx context.Context, req *order.ListOrdersReq) (resp *order.ListOrdersResp, err error) {
+	resp, err = service.NewListOrdersService(ctx).Run(req)
+
+	return resp, err
+}
+
+// MarkOrderRefunded implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) MarkOrderRefunded(ctx context.Context, req *order.MarkOrderRefundedReq) (resp *order.MarkOrderRefundedResp, err error) {
+	resp, err = service.NewMarkOrderRefundedService(ctx).Run(req)
+
+	return resp, err
+}
@@ -0,0 +1,119 @@
+package rpc
+
+import (
+	"sync"
+
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/transmeta"
+	"zqzqsb.com/gomall/common/clientsuite"
+	"zqzqsb/gomall/app/order/conf"
+	"zqzqsb/gomall/app/order/kitex_gen/cart/cartservice"
+	"zqzqsb/gomall/app/order/kitex_gen/pay/paymentservice"
+	"zqzqsb/gomall/app/order/kitex_gen/product/productservice"
+	"zqzqsb/gomall/app/order/kitex_gen/user/userservice"
+)
+
+var (
+	CartClient    cartservice.Client
+	ProductClient productservice.Client
+	UserClient    userservice.Client
+	PaymentClient paymentservice.Client
+	once          sync.Once
+	err           error
+)
+
+// InitClient 初始化下游服务客户端
+func InitClient() {
+	once.Do(func() {
+		initCartClient()
+		initProductClient()
+		initUserClient()
+		initPaymentClient()
+	})
+}
+
+func initCartClient() {
+	opts := []client.Option{
+		// gRPC 传输下需要显式开启元信息透传，用户身份才能带到下游
+		client.WithMetaHandler(transmeta.ClientHTTP2Handler),
+	}
+	policy := conf.GetConf().CartService.Policy
+	policy.RegisterFallback("SelectCartItems", unavailableFallback(ErrCartUnavailable))
+	suite := clientsuite.CommonClientSuite{
+		CurrentServiceName: conf.GetConf().Kitex.Service,
+		Policy:             policy,
+	}
+	opts = append(opts, target(&suite, conf.GetConf().CartService.Address)...)
+	opts = append(opts, suite.Options()...)
+
+	CartClient, err = cartservice.NewClient("cart", opts...)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func initProductClient() {
+	opts := []client.Option{
+		client.WithMetaHandler(transmeta.ClientHTTP2Handler),
+	}
+	policy := conf.GetConf().ProductService.Policy
+	policy.RegisterFallback("ReserveStock", unavailableFallback(ErrProductUnavailable))
+	suite := clientsuite.CommonClientSuite{
+		CurrentServiceName: conf.GetConf().Kitex.Service,
+		Policy:             policy,
+	}
+	opts = append(opts, target(&suite, conf.GetConf().ProductService.Address)...)
+	opts = append(opts, suite.Options()...)
+
+	ProductClient, err = productservice.NewClient("product", opts...)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func initUserClient() {
+	opts := []client.Option{
+		client.WithMetaHandler(transmeta.ClientHTTP2Handler),
+	}
+	policy := conf.GetConf().UserService.Policy
+	policy.RegisterFallback("GetAddress", unavailableFallback(ErrUserUnavailable))
+	suite := clientsuite.CommonClientSuite{
+		CurrentServiceName: conf.GetConf().Kitex.Service,
+		Policy:             policy,
+	}
+	opts = append(opts, target(&suite, conf.GetConf().UserService.Address)...)
+	opts = append(opts, suite.Options()...)
+
+	UserClient, err = userservice.NewClient("user", opts...)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func initPaymentClient() {
+	opts := []client.Option{
+		client.WithMetaHandler(transmeta.ClientHTTP2Handler),
+	}
+	policy := conf.GetConf().PaymentService.Policy
+	policy.RegisterFallback("QueryPayment", unavailableFallback(ErrPaymentUnavailable))
+	suite := clientsuite.CommonClientSuite{
+		CurrentServiceName: conf.GetConf().Kitex.Service,
+		Policy:             policy,
+	}
+	opts = append(opts, target(&suite, conf.GetConf().PaymentService.Address)...)
+	opts = append(opts, suite.Options()...)
+
+	PaymentClient, err = paymentservice.NewClient("payment", opts...)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// target 配置了下游地址时直连，否则通过 Consul 发现服务
+func target(suite *clientsuite.CommonClientSuite, addrs []string) []client.Option {
+	if len(addrs) > 0 {
+		return []client.Option{client.WithHostPorts(addrs...)}
+	}
+	suite.RegisteryAddr = conf.GetConf().Registry.Address()
+	return nil
+}
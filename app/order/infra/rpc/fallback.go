@@ -0,0 +1,27 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+var (
+	// ErrCartUnavailable 购物车服务不可用，下单无法读取已选商品
+	ErrCartUnavailable = errors.New("cart service is unavailable, please try again later")
+	// ErrProductUnavailable 商品服务不可用，下单无法预占库存
+	ErrProductUnavailable = errors.New("product service is unavailable, please try again later")
+	// ErrUserUnavailable 用户服务不可用，下单无法解析收货地址
+	ErrUserUnavailable = errors.New("user service is unavailable, please try again later")
+	// ErrPaymentUnavailable 支付服务不可用，无法核对支付单，由支付服务重试通知
+	ErrPaymentUnavailable = errors.New("payment service is unavailable, please try again later")
+)
+
+// unavailableFallback 下游不可用时快速失败，以明确的错误代替超时或熔断错误返回给调用方
+func unavailableFallback(fbErr error) func(ctx context.Context, req, resp interface{}, err error) (interface{}, error) {
+	return func(ctx context.Context, req, resp interface{}, err error) (interface{}, error) {
+		klog.CtxWarnf(ctx, "downstream unavailable, degrade with %q: %v", fbErr, err)
+		return nil, fbErr
+	}
+}
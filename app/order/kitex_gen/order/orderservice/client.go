@@ -0,0 +1,97 @@
+// Code generated by Kitex v0.9.1. DO NOT EDIT.
+
+package orderservice
+
+import (
+	"context"
+	client "github.com/cloudwego/kitex/client"
+	callopt "github.com/cloudwego/kitex/client/callopt"
+	order "zqzqsb/gomall/app/order/kitex_gen/order"
+)
+
+// Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
+type Client interface {
+	CreateOrder(ctx context.Context, Req *order.CreateOrderReq, callOptions ...callopt.Option) (r *order.CreateOrderResp, err error)
+	GetOrder(ctx context.Context, Req *order.GetOrderReq, callOptions ...callopt.Option) (r *order.GetOrderResp, err error)
+	ListUserOrders(ctx context.Context, Req *order.ListUserOrdersReq, callOptions ...callopt.Option) (r *order.ListOrdersResp, err error)
+	CancelOrder(ctx context.Context, Req *order.CancelOrderReq, callOptions ...callopt.Option) (r *order.CancelOrderResp, err error)
+	ConfirmReceipt(ctx context.Context, Req *order.ConfirmReceiptReq, callOptions ...callopt.Option) (r *order.ConfirmReceiptResp, err error)
+	MarkOrderPaid(ctx context.Context, Req *order.MarkOrderPaidReq, callOptions ...callopt.Option) (r *order.MarkOrderPaidResp, err error)
+	ShipOrder(ctx context.Context, Req *order.ShipOrderReq, callOptions ...callopt.Option) (r *order.ShipOrderResp, err error)
+	ListOrders(ctx context.Context, Req *order.ListOrdersReq, callOptions ...callopt.Option) (r *order.ListOrdersResp, err error)
+	MarkOrderRefunded(ctx context.Context, Req *order.MarkOrderRefundedReq, callOptions ...callopt.Option) (r *order.MarkOrderRefundedResp, err error)
+}
+
+// NewClient creates a client for the service defined in IDL.
+func NewClient(destService string, opts ...client.Option) (Client, error) {
+	var options []client.Option
+	options = append(options, client.WithDestService(destService))
+
+	options = append(options, opts...)
+
+	kc, err := client.NewClient(serviceInfo(), options...)
+	if err != nil {
+		return nil, err
+	}
+	return &kOrderServiceClient{
+		kClient: newServiceClient(kc),
+	}, nil
+}
+
+// MustNewClient creates a client for the service defined in IDL. It panics if any error occurs.
+func MustNewClient(destService string, opts ...client.Option) Client {
+	kc, err := NewClient(destService, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return kc
+}
+
+type kOrderServiceClient struct {
+	*kClient
+}
+
+func (p *kOrderServiceClient) CreateOrder(ctx context.Context, Req *order.CreateOrderReq, callOptions ...callopt.Option) (r *order.CreateOrderResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateOrder(ctx, Req)
+}
+
+func (p *kOrderServiceClient) GetOrder(ctx context.Context, Req *order.GetOrderReq, callOptions ...callopt.Option) (r *order.GetOrderResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetOrder(ctx, Req)
+}
+
+func (p *kOrderServiceClient) ListUserOrders(ctx context.Context, Req *order.ListUserOrdersReq, callOptions ...callopt.Option) (r *order.ListOrdersResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListUserOrders(ctx, Req)
+}
+
+func (p *kOrderServiceClient) CancelOrder(ctx context.Context, Req *order.CancelOrderReq, callOptions ...callopt.Option) (r *order.CancelOrderResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CancelOrder(ctx, Req)
+}
+
+func (p *kOrderServiceClient) ConfirmReceipt(ctx context.Context, Req *order.ConfirmReceiptReq, callOptions ...callopt.Option) (r *order.ConfirmReceiptResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ConfirmReceipt(ctx, Req)
+}
+
+func (p *kOrderServiceClient) MarkOrderPaid(ctx context.Context, Req *order.MarkOrderPaidReq, callOptions ...callopt.Option) (r *order.MarkOrderPaidResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.MarkOrderPaid(ctx, Req)
+}
+
+func (p *kOrderServiceClient) ShipOrder(ctx context.Context, Req *order.ShipOrderReq, callOptions ...callopt.Option) (r *order.ShipOrderResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ShipOrder(ctx, Req)
+}
+
+func (p *kOrderServiceClient) ListOrders(ctx context.Context, Req *order.ListOrdersReq, callOptions ...callopt.Option) (r *order.ListOrdersResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListOrders(ctx, Req)
+}
+
+func (p *kOrderServiceClient) MarkOrderRefunded(ctx context.Context, Req *order.MarkOrderRefundedReq, callOptions ...callopt.Option) (r *order.MarkOrderRefundedResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.MarkOrderRefunded(ctx, Req)
+}
@@ -0,0 +1,2268 @@
+// Code generated by Fastpb v0.0.2. DO NOT EDIT.
+
+package pay
+
+import (
+	fmt "fmt"
+	fastpb "github.com/cloudwego/fastpb"
+	api "zqzqsb/gomall/app/order/kitex_gen/api"
+	cart "zqzqsb/gomall/app/order/kitex_gen/cart"
+)
+
+var (
+	_ = fmt.Errorf
+	_ = fastpb.Skip
+)
+
+func (x *Payment) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 9:
+		offset, err = x.fastReadField9(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 10:
+		offset, err = x.fastReadField10(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 11:
+		offset, err = x.fastReadField11(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 12:
+		offset, err = x.fastReadField12(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 13:
+		offset, err = x.fastReadField13(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 14:
+		offset, err = x.fastReadField14(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 15:
+		offset, err = x.fastReadField15(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_Payment[number], err)
+}
+
+func (x *Payment) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Id, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Payment) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Payment) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Payment) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.PaymentNo, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Payment) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Amount, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Payment) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	var v int32
+	v, offset, err = fastpb.ReadInt32(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.PaymentMethod = PaymentMethod(v)
+	return offset, nil
+}
+
+func (x *Payment) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	var v int32
+	v, offset, err = fastpb.ReadInt32(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.Status = PaymentStatus(v)
+	return offset, nil
+}
+
+func (x *Payment) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.TransactionId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Payment) fastReadField9(buf []byte, _type int8) (offset int, err error) {
+	x.CallbackUrl, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Payment) fastReadField10(buf []byte, _type int8) (offset int, err error) {
+	x.ReturnUrl, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Payment) fastReadField11(buf []byte, _type int8) (offset int, err error) {
+	x.CreateTime, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Payment) fastReadField12(buf []byte, _type int8) (offset int, err error) {
+	x.UpdateTime, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Payment) fastReadField13(buf []byte, _type int8) (offset int, err error) {
+	x.PayTime, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Payment) fastReadField14(buf []byte, _type int8) (offset int, err error) {
+	x.ClientIp, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Payment) fastReadField15(buf []byte, _type int8) (offset int, err error) {
+	if x.Metadata == nil {
+		x.Metadata = make(map[string]string)
+	}
+	var key string
+	var value string
+	offset, err = fastpb.ReadMapEntry(buf, _type,
+		func(buf []byte, _type int8) (offset int, err error) {
+			key, offset, err = fastpb.ReadString(buf, _type)
+			return offset, err
+		},
+		func(buf []byte, _type int8) (offset int, err error) {
+			value, offset, err = fastpb.ReadString(buf, _type)
+			return offset, err
+		})
+	if err != nil {
+		return offset, err
+	}
+	x.Metadata[key] = value
+	return offset, nil
+}
+
+func (x *CreatePaymentReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CreatePaymentReq[number], err)
+}
+
+func (x *CreatePaymentReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *CreatePaymentReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v int32
+	v, offset, err = fastpb.ReadInt32(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.PaymentMethod = PaymentMethod(v)
+	return offset, nil
+}
+
+func (x *CreatePaymentReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.ReturnUrl, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreatePaymentReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	if x.Metadata == nil {
+		x.Metadata = make(map[string]string)
+	}
+	var key string
+	var value string
+	offset, err = fastpb.ReadMapEntry(buf, _type,
+		func(buf []byte, _type int8) (offset int, err error) {
+			key, offset, err = fastpb.ReadString(buf, _type)
+			return offset, err
+		},
+		func(buf []byte, _type int8) (offset int, err error) {
+			value, offset, err = fastpb.ReadString(buf, _type)
+			return offset, err
+		})
+	if err != nil {
+		return offset, err
+	}
+	x.Metadata[key] = value
+	return offset, nil
+}
+
+func (x *CreatePaymentResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CreatePaymentResp[number], err)
+}
+
+func (x *CreatePaymentResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.PaymentId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *CreatePaymentResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.PaymentNo, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreatePaymentResp) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.PayUrl, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreatePaymentResp) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.QrCode, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *QueryPaymentReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_QueryPaymentReq[number], err)
+}
+
+func (x *QueryPaymentReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var ov QueryPaymentReq_PaymentId
+	x.Identifier = &ov
+	ov.PaymentId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *QueryPaymentReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var ov QueryPaymentReq_PaymentNo
+	x.Identifier = &ov
+	ov.PaymentNo, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *QueryPaymentResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_QueryPaymentResp[number], err)
+}
+
+func (x *QueryPaymentResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Payment
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Payment = &v
+	return offset, nil
+}
+
+func (x *CancelPaymentReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CancelPaymentReq[number], err)
+}
+
+func (x *CancelPaymentReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var ov CancelPaymentReq_PaymentId
+	x.Identifier = &ov
+	ov.PaymentId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *CancelPaymentReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var ov CancelPaymentReq_PaymentNo
+	x.Identifier = &ov
+	ov.PaymentNo, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CancelPaymentReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Reason, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CancelPaymentResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CancelPaymentResp[number], err)
+}
+
+func (x *CancelPaymentResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *PaymentCallbackReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_PaymentCallbackReq[number], err)
+}
+
+func (x *PaymentCallbackReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.PaymentNo, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *PaymentCallbackReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.TransactionId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *PaymentCallbackReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	var v int32
+	v, offset, err = fastpb.ReadInt32(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.Status = PaymentStatus(v)
+	return offset, nil
+}
+
+func (x *PaymentCallbackReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Amount, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *PaymentCallbackReq) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.RawData, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *PaymentCallbackReq) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	if x.Params == nil {
+		x.Params = make(map[string]string)
+	}
+	var key string
+	var value string
+	offset, err = fastpb.ReadMapEntry(buf, _type,
+		func(buf []byte, _type int8) (offset int, err error) {
+			key, offset, err = fastpb.ReadString(buf, _type)
+			return offset, err
+		},
+		func(buf []byte, _type int8) (offset int, err error) {
+			value, offset, err = fastpb.ReadString(buf, _type)
+			return offset, err
+		})
+	if err != nil {
+		return offset, err
+	}
+	x.Params[key] = value
+	return offset, nil
+}
+
+func (x *PaymentCallbackResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_PaymentCallbackResp[number], err)
+}
+
+func (x *PaymentCallbackResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *RefundReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RefundReq[number], err)
+}
+
+func (x *RefundReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var ov RefundReq_PaymentId
+	x.Identifier = &ov
+	ov.PaymentId, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *RefundReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var ov RefundReq_PaymentNo
+	x.Identifier = &ov
+	ov.PaymentNo, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RefundReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Amount, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *RefundReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Reason, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RefundResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RefundResp[number], err)
+}
+
+func (x *RefundResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *RefundResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.RefundId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetUserPaymentsReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetUserPaymentsReq[number], err)
+}
+
+func (x *GetUserPaymentsReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Page, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *GetUserPaymentsReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.PageSize, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *GetUserPaymentsReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	var v int32
+	v, offset, err = fastpb.ReadInt32(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.Status = PaymentStatus(v)
+	return offset, nil
+}
+
+func (x *GetUserPaymentsResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetUserPaymentsResp[number], err)
+}
+
+func (x *GetUserPaymentsResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Payment
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Payments = append(x.Payments, &v)
+	return offset, nil
+}
+
+func (x *GetUserPaymentsResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Total, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *GetUserPaymentsResp) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Page, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *GetUserPaymentsResp) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.PageSize, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *CreatePaymentFromCartReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CreatePaymentFromCartReq[number], err)
+}
+
+func (x *CreatePaymentFromCartReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	offset, err = fastpb.ReadList(buf, _type,
+		func(buf []byte, _type int8) (n int, err error) {
+			var v int64
+			v, offset, err = fastpb.ReadInt64(buf, _type)
+			if err != nil {
+				return offset, err
+			}
+			x.CartItemIds = append(x.CartItemIds, v)
+			return offset, err
+		})
+	return offset, err
+}
+
+func (x *CreatePaymentFromCartReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v int32
+	v, offset, err = fastpb.ReadInt32(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.PaymentMethod = PaymentMethod(v)
+	return offset, nil
+}
+
+func (x *CreatePaymentFromCartReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.ReturnUrl, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreatePaymentFromCartReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.ShippingAddressId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CreatePaymentFromCartReq) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	if x.Metadata == nil {
+		x.Metadata = make(map[string]string)
+	}
+	var key string
+	var value string
+	offset, err = fastpb.ReadMapEntry(buf, _type,
+		func(buf []byte, _type int8) (offset int, err error) {
+			key, offset, err = fastpb.ReadString(buf, _type)
+			return offset, err
+		},
+		func(buf []byte, _type int8) (offset int, err error) {
+			value, offset, err = fastpb.ReadString(buf, _type)
+			return offset, err
+		})
+	if err != nil {
+		return offset, err
+	}
+	x.Metadata[key] = value
+	return offset, nil
+}
+
+func (x *Payment) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	offset += x.fastWriteField10(buf[offset:])
+	offset += x.fastWriteField11(buf[offset:])
+	offset += x.fastWriteField12(buf[offset:])
+	offset += x.fastWriteField13(buf[offset:])
+	offset += x.fastWriteField14(buf[offset:])
+	offset += x.fastWriteField15(buf[offset:])
+	return offset
+}
+
+func (x *Payment) fastWriteField1(buf []byte) (offset int) {
+	if x.Id == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetId())
+	return offset
+}
+
+func (x *Payment) fastWriteField2(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetUserId())
+	return offset
+}
+
+func (x *Payment) fastWriteField3(buf []byte) (offset int) {
+	if x.OrderId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetOrderId())
+	return offset
+}
+
+func (x *Payment) fastWriteField4(buf []byte) (offset int) {
+	if x.PaymentNo == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetPaymentNo())
+	return offset
+}
+
+func (x *Payment) fastWriteField5(buf []byte) (offset int) {
+	if x.Amount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 5, x.GetAmount())
+	return offset
+}
+
+func (x *Payment) fastWriteField6(buf []byte) (offset int) {
+	if x.PaymentMethod == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 6, int32(x.GetPaymentMethod()))
+	return offset
+}
+
+func (x *Payment) fastWriteField7(buf []byte) (offset int) {
+	if x.Status == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 7, int32(x.GetStatus()))
+	return offset
+}
+
+func (x *Payment) fastWriteField8(buf []byte) (offset int) {
+	if x.TransactionId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 8, x.GetTransactionId())
+	return offset
+}
+
+func (x *Payment) fastWriteField9(buf []byte) (offset int) {
+	if x.CallbackUrl == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 9, x.GetCallbackUrl())
+	return offset
+}
+
+func (x *Payment) fastWriteField10(buf []byte) (offset int) {
+	if x.ReturnUrl == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 10, x.GetReturnUrl())
+	return offset
+}
+
+func (x *Payment) fastWriteField11(buf []byte) (offset int) {
+	if x.CreateTime == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 11, x.GetCreateTime())
+	return offset
+}
+
+func (x *Payment) fastWriteField12(buf []byte) (offset int) {
+	if x.UpdateTime == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 12, x.GetUpdateTime())
+	return offset
+}
+
+func (x *Payment) fastWriteField13(buf []byte) (offset int) {
+	if x.PayTime == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 13, x.GetPayTime())
+	return offset
+}
+
+func (x *Payment) fastWriteField14(buf []byte) (offset int) {
+	if x.ClientIp == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 14, x.GetClientIp())
+	return offset
+}
+
+func (x *Payment) fastWriteField15(buf []byte) (offset int) {
+	if x.Metadata == nil {
+		return offset
+	}
+	for k, v := range x.GetMetadata() {
+		offset += fastpb.WriteMapEntry(buf[offset:], 15,
+			func(buf []byte, numTagOrKey, numIdxOrVal int32) int {
+				offset := 0
+				offset += fastpb.WriteString(buf[offset:], numTagOrKey, k)
+				offset += fastpb.WriteString(buf[offset:], numIdxOrVal, v)
+				return offset
+			})
+	}
+	return offset
+}
+
+func (x *CreatePaymentReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *CreatePaymentReq) fastWriteField1(buf []byte) (offset int) {
+	if x.OrderId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetOrderId())
+	return offset
+}
+
+func (x *CreatePaymentReq) fastWriteField2(buf []byte) (offset int) {
+	if x.PaymentMethod == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 2, int32(x.GetPaymentMethod()))
+	return offset
+}
+
+func (x *CreatePaymentReq) fastWriteField3(buf []byte) (offset int) {
+	if x.ReturnUrl == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetReturnUrl())
+	return offset
+}
+
+func (x *CreatePaymentReq) fastWriteField4(buf []byte) (offset int) {
+	if x.Metadata == nil {
+		return offset
+	}
+	for k, v := range x.GetMetadata() {
+		offset += fastpb.WriteMapEntry(buf[offset:], 4,
+			func(buf []byte, numTagOrKey, numIdxOrVal int32) int {
+				offset := 0
+				offset += fastpb.WriteString(buf[offset:], numTagOrKey, k)
+				offset += fastpb.WriteString(buf[offset:], numIdxOrVal, v)
+				return offset
+			})
+	}
+	return offset
+}
+
+func (x *CreatePaymentResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *CreatePaymentResp) fastWriteField1(buf []byte) (offset int) {
+	if x.PaymentId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetPaymentId())
+	return offset
+}
+
+func (x *CreatePaymentResp) fastWriteField2(buf []byte) (offset int) {
+	if x.PaymentNo == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetPaymentNo())
+	return offset
+}
+
+func (x *CreatePaymentResp) fastWriteField3(buf []byte) (offset int) {
+	if x.PayUrl == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetPayUrl())
+	return offset
+}
+
+func (x *CreatePaymentResp) fastWriteField4(buf []byte) (offset int) {
+	if x.QrCode == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetQrCode())
+	return offset
+}
+
+func (x *QueryPaymentReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *QueryPaymentReq) fastWriteField1(buf []byte) (offset int) {
+	if x.GetPaymentId() == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetPaymentId())
+	return offset
+}
+
+func (x *QueryPaymentReq) fastWriteField2(buf []byte) (offset int) {
+	if x.GetPaymentNo() == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetPaymentNo())
+	return offset
+}
+
+func (x *QueryPaymentResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *QueryPaymentResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Payment == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetPayment())
+	return offset
+}
+
+func (x *CancelPaymentReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *CancelPaymentReq) fastWriteField1(buf []byte) (offset int) {
+	if x.GetPaymentId() == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetPaymentId())
+	return offset
+}
+
+func (x *CancelPaymentReq) fastWriteField2(buf []byte) (offset int) {
+	if x.GetPaymentNo() == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetPaymentNo())
+	return offset
+}
+
+func (x *CancelPaymentReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Reason == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetReason())
+	return offset
+}
+
+func (x *CancelPaymentResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *CancelPaymentResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *PaymentCallbackReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	return offset
+}
+
+func (x *PaymentCallbackReq) fastWriteField1(buf []byte) (offset int) {
+	if x.PaymentNo == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetPaymentNo())
+	return offset
+}
+
+func (x *PaymentCallbackReq) fastWriteField2(buf []byte) (offset int) {
+	if x.TransactionId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetTransactionId())
+	return offset
+}
+
+func (x *PaymentCallbackReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Status == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 3, int32(x.GetStatus()))
+	return offset
+}
+
+func (x *PaymentCallbackReq) fastWriteField4(buf []byte) (offset int) {
+	if x.Amount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 4, x.GetAmount())
+	return offset
+}
+
+func (x *PaymentCallbackReq) fastWriteField5(buf []byte) (offset int) {
+	if x.RawData == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetRawData())
+	return offset
+}
+
+func (x *PaymentCallbackReq) fastWriteField6(buf []byte) (offset int) {
+	if x.Params == nil {
+		return offset
+	}
+	for k, v := range x.GetParams() {
+		offset += fastpb.WriteMapEntry(buf[offset:], 6,
+			func(buf []byte, numTagOrKey, numIdxOrVal int32) int {
+				offset := 0
+				offset += fastpb.WriteString(buf[offset:], numTagOrKey, k)
+				offset += fastpb.WriteString(buf[offset:], numIdxOrVal, v)
+				return offset
+			})
+	}
+	return offset
+}
+
+func (x *PaymentCallbackResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *PaymentCallbackResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *RefundReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *RefundReq) fastWriteField1(buf []byte) (offset int) {
+	if x.GetPaymentId() == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 1, x.GetPaymentId())
+	return offset
+}
+
+func (x *RefundReq) fastWriteField2(buf []byte) (offset int) {
+	if x.GetPaymentNo() == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetPaymentNo())
+	return offset
+}
+
+func (x *RefundReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Amount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 3, x.GetAmount())
+	return offset
+}
+
+func (x *RefundReq) fastWriteField4(buf []byte) (offset int) {
+	if x.Reason == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetReason())
+	return offset
+}
+
+func (x *RefundResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *RefundResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *RefundResp) fastWriteField2(buf []byte) (offset int) {
+	if x.RefundId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetRefundId())
+	return offset
+}
+
+func (x *GetUserPaymentsReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *GetUserPaymentsReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Page == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.GetPage())
+	return offset
+}
+
+func (x *GetUserPaymentsReq) fastWriteField2(buf []byte) (offset int) {
+	if x.PageSize == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 2, x.GetPageSize())
+	return offset
+}
+
+func (x *GetUserPaymentsReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Status == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 3, int32(x.GetStatus()))
+	return offset
+}
+
+func (x *GetUserPaymentsResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *GetUserPaymentsResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Payments == nil {
+		return offset
+	}
+	for i := range x.GetPayments() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetPayments()[i])
+	}
+	return offset
+}
+
+func (x *GetUserPaymentsResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Total == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 2, x.GetTotal())
+	return offset
+}
+
+func (x *GetUserPaymentsResp) fastWriteField3(buf []byte) (offset int) {
+	if x.Page == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 3, x.GetPage())
+	return offset
+}
+
+func (x *GetUserPaymentsResp) fastWriteField4(buf []byte) (offset int) {
+	if x.PageSize == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 4, x.GetPageSize())
+	return offset
+}
+
+func (x *CreatePaymentFromCartReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *CreatePaymentFromCartReq) fastWriteField1(buf []byte) (offset int) {
+	if len(x.CartItemIds) == 0 {
+		return offset
+	}
+	offset += fastpb.WriteListPacked(buf[offset:], 1, len(x.GetCartItemIds()),
+		func(buf []byte, numTagOrKey, numIdxOrVal int32) int {
+			offset := 0
+			offset += fastpb.WriteInt64(buf[offset:], numTagOrKey, x.GetCartItemIds()[numIdxOrVal])
+			return offset
+		})
+	return offset
+}
+
+func (x *CreatePaymentFromCartReq) fastWriteField2(buf []byte) (offset int) {
+	if x.PaymentMethod == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 2, int32(x.GetPaymentMethod()))
+	return offset
+}
+
+func (x *CreatePaymentFromCartReq) fastWriteField3(buf []byte) (offset int) {
+	if x.ReturnUrl == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetReturnUrl())
+	return offset
+}
+
+func (x *CreatePaymentFromCartReq) fastWriteField4(buf []byte) (offset int) {
+	if x.ShippingAddressId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetShippingAddressId())
+	return offset
+}
+
+func (x *CreatePaymentFromCartReq) fastWriteField5(buf []byte) (offset int) {
+	if x.Metadata == nil {
+		return offset
+	}
+	for k, v := range x.GetMetadata() {
+		offset += fastpb.WriteMapEntry(buf[offset:], 5,
+			func(buf []byte, numTagOrKey, numIdxOrVal int32) int {
+				offset := 0
+				offset += fastpb.WriteString(buf[offset:], numTagOrKey, k)
+				offset += fastpb.WriteString(buf[offset:], numIdxOrVal, v)
+				return offset
+			})
+	}
+	return offset
+}
+
+func (x *Payment) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
+	n += x.sizeField10()
+	n += x.sizeField11()
+	n += x.sizeField12()
+	n += x.sizeField13()
+	n += x.sizeField14()
+	n += x.sizeField15()
+	return n
+}
+
+func (x *Payment) sizeField1() (n int) {
+	if x.Id == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetId())
+	return n
+}
+
+func (x *Payment) sizeField2() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetUserId())
+	return n
+}
+
+func (x *Payment) sizeField3() (n int) {
+	if x.OrderId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.GetOrderId())
+	return n
+}
+
+func (x *Payment) sizeField4() (n int) {
+	if x.PaymentNo == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetPaymentNo())
+	return n
+}
+
+func (x *Payment) sizeField5() (n int) {
+	if x.Amount == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(5, x.GetAmount())
+	return n
+}
+
+func (x *Payment) sizeField6() (n int) {
+	if x.PaymentMethod == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(6, int32(x.GetPaymentMethod()))
+	return n
+}
+
+func (x *Payment) sizeField7() (n int) {
+	if x.Status == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(7, int32(x.GetStatus()))
+	return n
+}
+
+func (x *Payment) sizeField8() (n int) {
+	if x.TransactionId == "" {
+		return n
+	}
+	n += fastpb.SizeString(8, x.GetTransactionId())
+	return n
+}
+
+func (x *Payment) sizeField9() (n int) {
+	if x.CallbackUrl == "" {
+		return n
+	}
+	n += fastpb.SizeString(9, x.GetCallbackUrl())
+	return n
+}
+
+func (x *Payment) sizeField10() (n int) {
+	if x.ReturnUrl == "" {
+		return n
+	}
+	n += fastpb.SizeString(10, x.GetReturnUrl())
+	return n
+}
+
+func (x *Payment) sizeField11() (n int) {
+	if x.CreateTime == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(11, x.GetCreateTime())
+	return n
+}
+
+func (x *Payment) sizeField12() (n int) {
+	if x.UpdateTime == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(12, x.GetUpdateTime())
+	return n
+}
+
+func (x *Payment) sizeField13() (n int) {
+	if x.PayTime == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(13, x.GetPayTime())
+	return n
+}
+
+func (x *Payment) sizeField14() (n int) {
+	if x.ClientIp == "" {
+		return n
+	}
+	n += fastpb.SizeString(14, x.GetClientIp())
+	return n
+}
+
+func (x *Payment) sizeField15() (n int) {
+	if x.Metadata == nil {
+		return n
+	}
+	for k, v := range x.GetMetadata() {
+		n += fastpb.SizeMapEntry(15,
+			func(numTagOrKey, numIdxOrVal int32) int {
+				n := 0
+				n += fastpb.SizeString(numTagOrKey, k)
+				n += fastpb.SizeString(numIdxOrVal, v)
+				return n
+			})
+	}
+	return n
+}
+
+func (x *CreatePaymentReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *CreatePaymentReq) sizeField1() (n int) {
+	if x.OrderId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetOrderId())
+	return n
+}
+
+func (x *CreatePaymentReq) sizeField2() (n int) {
+	if x.PaymentMethod == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(2, int32(x.GetPaymentMethod()))
+	return n
+}
+
+func (x *CreatePaymentReq) sizeField3() (n int) {
+	if x.ReturnUrl == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetReturnUrl())
+	return n
+}
+
+func (x *CreatePaymentReq) sizeField4() (n int) {
+	if x.Metadata == nil {
+		return n
+	}
+	for k, v := range x.GetMetadata() {
+		n += fastpb.SizeMapEntry(4,
+			func(numTagOrKey, numIdxOrVal int32) int {
+				n := 0
+				n += fastpb.SizeString(numTagOrKey, k)
+				n += fastpb.SizeString(numIdxOrVal, v)
+				return n
+			})
+	}
+	return n
+}
+
+func (x *CreatePaymentResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *CreatePaymentResp) sizeField1() (n int) {
+	if x.PaymentId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetPaymentId())
+	return n
+}
+
+func (x *CreatePaymentResp) sizeField2() (n int) {
+	if x.PaymentNo == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetPaymentNo())
+	return n
+}
+
+func (x *CreatePaymentResp) sizeField3() (n int) {
+	if x.PayUrl == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetPayUrl())
+	return n
+}
+
+func (x *CreatePaymentResp) sizeField4() (n int) {
+	if x.QrCode == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetQrCode())
+	return n
+}
+
+func (x *QueryPaymentReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *QueryPaymentReq) sizeField1() (n int) {
+	if x.GetPaymentId() == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetPaymentId())
+	return n
+}
+
+func (x *QueryPaymentReq) sizeField2() (n int) {
+	if x.GetPaymentNo() == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetPaymentNo())
+	return n
+}
+
+func (x *QueryPaymentResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *QueryPaymentResp) sizeField1() (n int) {
+	if x.Payment == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetPayment())
+	return n
+}
+
+func (x *CancelPaymentReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *CancelPaymentReq) sizeField1() (n int) {
+	if x.GetPaymentId() == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetPaymentId())
+	return n
+}
+
+func (x *CancelPaymentReq) sizeField2() (n int) {
+	if x.GetPaymentNo() == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetPaymentNo())
+	return n
+}
+
+func (x *CancelPaymentReq) sizeField3() (n int) {
+	if x.Reason == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetReason())
+	return n
+}
+
+func (x *CancelPaymentResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *CancelPaymentResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
+func (x *PaymentCallbackReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	return n
+}
+
+func (x *PaymentCallbackReq) sizeField1() (n int) {
+	if x.PaymentNo == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetPaymentNo())
+	return n
+}
+
+func (x *PaymentCallbackReq) sizeField2() (n int) {
+	if x.TransactionId == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetTransactionId())
+	return n
+}
+
+func (x *PaymentCallbackReq) sizeField3() (n int) {
+	if x.Status == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(3, int32(x.GetStatus()))
+	return n
+}
+
+func (x *PaymentCallbackReq) sizeField4() (n int) {
+	if x.Amount == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(4, x.GetAmount())
+	return n
+}
+
+func (x *PaymentCallbackReq) sizeField5() (n int) {
+	if x.RawData == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.GetRawData())
+	return n
+}
+
+func (x *PaymentCallbackReq) sizeField6() (n int) {
+	if x.Params == nil {
+		return n
+	}
+	for k, v := range x.GetParams() {
+		n += fastpb.SizeMapEntry(6,
+			func(numTagOrKey, numIdxOrVal int32) int {
+				n := 0
+				n += fastpb.SizeString(numTagOrKey, k)
+				n += fastpb.SizeString(numIdxOrVal, v)
+				return n
+			})
+	}
+	return n
+}
+
+func (x *PaymentCallbackResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *PaymentCallbackResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
+func (x *RefundReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *RefundReq) sizeField1() (n int) {
+	if x.GetPaymentId() == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(1, x.GetPaymentId())
+	return n
+}
+
+func (x *RefundReq) sizeField2() (n int) {
+	if x.GetPaymentNo() == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetPaymentNo())
+	return n
+}
+
+func (x *RefundReq) sizeField3() (n int) {
+	if x.Amount == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(3, x.GetAmount())
+	return n
+}
+
+func (x *RefundReq) sizeField4() (n int) {
+	if x.Reason == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetReason())
+	return n
+}
+
+func (x *RefundResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *RefundResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
+func (x *RefundResp) sizeField2() (n int) {
+	if x.RefundId == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetRefundId())
+	return n
+}
+
+func (x *GetUserPaymentsReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *GetUserPaymentsReq) sizeField1() (n int) {
+	if x.Page == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.GetPage())
+	return n
+}
+
+func (x *GetUserPaymentsReq) sizeField2() (n int) {
+	if x.PageSize == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(2, x.GetPageSize())
+	return n
+}
+
+func (x *GetUserPaymentsReq) sizeField3() (n int) {
+	if x.Status == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(3, int32(x.GetStatus()))
+	return n
+}
+
+func (x *GetUserPaymentsResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *GetUserPaymentsResp) sizeField1() (n int) {
+	if x.Payments == nil {
+		return n
+	}
+	for i := range x.GetPayments() {
+		n += fastpb.SizeMessage(1, x.GetPayments()[i])
+	}
+	return n
+}
+
+func (x *GetUserPaymentsResp) sizeField2() (n int) {
+	if x.Total == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(2, x.GetTotal())
+	return n
+}
+
+func (x *GetUserPaymentsResp) sizeField3() (n int) {
+	if x.Page == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(3, x.GetPage())
+	return n
+}
+
+func (x *GetUserPaymentsResp) sizeField4() (n int) {
+	if x.PageSize == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(4, x.GetPageSize())
+	return n
+}
+
+func (x *CreatePaymentFromCartReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	return n
+}
+
+func (x *CreatePaymentFromCartReq) sizeField1() (n int) {
+	if len(x.CartItemIds) == 0 {
+		return n
+	}
+	n += fastpb.SizeListPacked(1, len(x.GetCartItemIds()),
+		func(numTagOrKey, numIdxOrVal int32) int {
+			n := 0
+			n += fastpb.SizeInt64(numTagOrKey, x.GetCartItemIds()[numIdxOrVal])
+			return n
+		})
+	return n
+}
+
+func (x *CreatePaymentFromCartReq) sizeField2() (n int) {
+	if x.PaymentMethod == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(2, int32(x.GetPaymentMethod()))
+	return n
+}
+
+func (x *CreatePaymentFromCartReq) sizeField3() (n int) {
+	if x.ReturnUrl == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetReturnUrl())
+	return n
+}
+
+func (x *CreatePaymentFromCartReq) sizeField4() (n int) {
+	if x.ShippingAddressId == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetShippingAddressId())
+	return n
+}
+
+func (x *CreatePaymentFromCartReq) sizeField5() (n int) {
+	if x.Metadata == nil {
+		return n
+	}
+	for k, v := range x.GetMetadata() {
+		n += fastpb.SizeMapEntry(5,
+			func(numTagOrKey, numIdxOrVal int32) int {
+				n := 0
+				n += fastpb.SizeString(numTagOrKey, k)
+				n += fastpb.SizeString(numIdxOrVal, v)
+				return n
+			})
+	}
+	return n
+}
+
+var fieldIDToName_Payment = map[int32]string{
+	1:  "Id",
+	2:  "UserId",
+	3:  "OrderId",
+	4:  "PaymentNo",
+	5:  "Amount",
+	6:  "PaymentMethod",
+	7:  "Status",
+	8:  "TransactionId",
+	9:  "CallbackUrl",
+	10: "ReturnUrl",
+	11: "CreateTime",
+	12: "UpdateTime",
+	13: "PayTime",
+	14: "ClientIp",
+	15: "Metadata",
+}
+
+var fieldIDToName_CreatePaymentReq = map[int32]string{
+	1: "OrderId",
+	2: "PaymentMethod",
+	3: "ReturnUrl",
+	4: "Metadata",
+}
+
+var fieldIDToName_CreatePaymentResp = map[int32]string{
+	1: "PaymentId",
+	2: "PaymentNo",
+	3: "PayUrl",
+	4: "QrCode",
+}
+
+var fieldIDToName_QueryPaymentReq = map[int32]string{
+	1: "PaymentId",
+	2: "PaymentNo",
+}
+
+var fieldIDToName_QueryPaymentResp = map[int32]string{
+	1: "Payment",
+}
+
+var fieldIDToName_CancelPaymentReq = map[int32]string{
+	1: "PaymentId",
+	2: "PaymentNo",
+	3: "Reason",
+}
+
+var fieldIDToName_CancelPaymentResp = map[int32]string{
+	1: "Success",
+}
+
+var fieldIDToName_PaymentCallbackReq = map[int32]string{
+	1: "PaymentNo",
+	2: "TransactionId",
+	3: "Status",
+	4: "Amount",
+	5: "RawData",
+	6: "Params",
+}
+
+var fieldIDToName_PaymentCallbackResp = map[int32]string{
+	1: "Success",
+}
+
+var fieldIDToName_RefundReq = map[int32]string{
+	1: "PaymentId",
+	2: "PaymentNo",
+	3: "Amount",
+	4: "Reason",
+}
+
+var fieldIDToName_RefundResp = map[int32]string{
+	1: "Success",
+	2: "RefundId",
+}
+
+var fieldIDToName_GetUserPaymentsReq = map[int32]string{
+	1: "Page",
+	2: "PageSize",
+	3: "Status",
+}
+
+var fieldIDToName_GetUserPaymentsResp = map[int32]string{
+	1: "Payments",
+	2: "Total",
+	3: "Page",
+	4: "PageSize",
+}
+
+var fieldIDToName_CreatePaymentFromCartReq = map[int32]string{
+	1: "CartItemIds",
+	2: "PaymentMethod",
+	3: "ReturnUrl",
+	4: "ShippingAddressId",
+	5: "Metadata",
+}
+
+var _ = api.File_api_proto
+var _ = cart.File_cart_proto
@@ -0,0 +1,11 @@
+package dal
+
+import (
+	"zqzqsb/gomall/app/payment/biz/dal/mysql"
+	"zqzqsb/gomall/app/payment/biz/dal/redis"
+)
+
+func Init() {
+	redis.Init()
+	mysql.Init()
+}
@@ -0,0 +1,30 @@
+package mysql
+
+import (
+	"zqzqsb/gomall/app/payment/biz/model"
+	"zqzqsb/gomall/app/payment/conf"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+var (
+	DB  *gorm.DB
+	err error
+)
+
+func Init() {
+	DB, err = gorm.Open(mysql.Open(conf.GetConf().MySQL.DSN),
+		&gorm.Config{
+			PrepareStmt:            true,
+			SkipDefaultTransaction: true,
+			TranslateError:         true,
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+	if err = DB.AutoMigrate(&model.Payment{}, &model.Refund{}); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,149 @@
+package mysql
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"zqzqsb/gomall/app/payment/biz/model"
+)
+
+var (
+	ErrPaymentNotFound = errors.New("payment not found")
+	// ErrStatusChanged 支付状态已被并发修改
+	ErrStatusChanged = errors.New("payment status has changed")
+	// ErrOrderPaymentExists 订单已有未取消或失败的支付单
+	ErrOrderPaymentExists = errors.New("order already has an active payment")
+)
+
+// CreatePayment 创建支付单
+func CreatePayment(db *gorm.DB, p *model.Payment) (int64, error) {
+	now := time.Now()
+	p.CreatedAt = now
+	p.UpdatedAt = now
+
+	result := db.Create(p)
+	if result.Error != nil {
+		// 并发为同一订单创建支付单时由 active_order_id 唯一索引拦截
+		if p.ActiveOrderID != nil && errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return 0, ErrOrderPaymentExists
+		}
+		return 0, result.Error
+	}
+	return p.ID, nil
+}
+
+// GetActiveOrderPayment 获取订单未取消或失败的支付单，没有时返回 nil
+func GetActiveOrderPayment(db *gorm.DB, orderID int64) (*model.Payment, error) {
+	var payment model.Payment
+	result := db.Where("active_order_id = ?", orderID).Limit(1).Find(&payment)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+	return &payment, nil
+}
+
+// GetPaymentByID 根据ID获取支付单
+func GetPaymentByID(db *gorm.DB, id int64) (*model.Payment, error) {
+	var payment model.Payment
+	result := db.First(&payment, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrPaymentNotFound
+		}
+		return nil, result.Error
+	}
+	return &payment, nil
+}
+
+// GetPaymentByNo 根据支付单号获取支付单
+func GetPaymentByNo(db *gorm.DB, paymentNo string) (*model.Payment, error) {
+	var payment model.Payment
+	result := db.Where("payment_no = ?", paymentNo).First(&payment)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrPaymentNotFound
+		}
+		return nil, result.Error
+	}
+	return &payment, nil
+}
+
+// TransitPayment 流转支付状态
+// 仅当支付单当前状态仍为 from 时才更新，避免并发回调和取消相互覆盖
+func TransitPayment(db *gorm.DB, id int64, from, to int32, updates map[string]interface{}) error {
+	if updates == nil {
+		updates = make(map[string]interface{})
+	}
+	updates["status"] = to
+	updates["updated_at"] = time.Now()
+
+	result := db.Model(&model.Payment{}).Where("id = ? AND status = ?", id, from).Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrStatusChanged
+	}
+	return nil
+}
+
+// ListUserPayments 分页获取用户支付列表，status 为 0 时不过滤
+func ListUserPayments(db *gorm.DB, userID int64, status int32, page, pageSize int) ([]*model.Payment, int64, error) {
+	var payments []*model.Payment
+	var count int64
+
+	query := db.Model(&model.Payment{}).Where("user_id = ?", userID)
+	if status != 0 {
+		query = query.Where("status = ?", status)
+	}
+
+	if err := query.Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	if err := query.Order("id DESC").Offset(offset).Limit(pageSize).Find(&payments).Error; err != nil {
+		return nil, 0, err
+	}
+	return payments, count, nil
+}
+
+// CreateRefund 记录退款并累加支付单已退款金额
+// completedStatus 为允许退款的状态，refundedStatus 为全额退款后支付单流转到的状态
+func CreateRefund(db *gorm.DB, refund *model.Refund, completedStatus, refundedStatus int32) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		var payment model.Payment
+		// 锁定支付单，防止并发退款超出支付金额
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&payment, refund.PaymentID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrPaymentNotFound
+			}
+			return err
+		}
+		if payment.Status != completedStatus {
+			return ErrStatusChanged
+		}
+		if payment.RefundedAmount+refund.Amount > payment.Amount {
+			return errors.New("refund amount exceeds paid amount")
+		}
+
+		refund.CreatedAt = time.Now()
+		if err := tx.Create(refund).Error; err != nil {
+			return err
+		}
+
+		updates := map[string]interface{}{
+			"refunded_amount": payment.RefundedAmount + refund.Amount,
+			"updated_at":      time.Now(),
+		}
+		if payment.RefundedAmount+refund.Amount == payment.Amount {
+			updates["status"] = refundedStatus
+		}
+		return tx.Model(&payment).Updates(updates).Error
+	})
+}
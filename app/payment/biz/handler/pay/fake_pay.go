@@ -0,0 +1,21 @@
+package pay
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"zqzqsb/gomall/app/payment/biz/service"
+)
+
+// FakePay 模拟收银台，仅在启用模拟渠道时注册
+// @router /fakepay/{payment_no} [GET]
+func FakePay(ctx context.Context, c *app.RequestContext) {
+	resp, err := service.NewFakePayService(ctx).Run(c.Param("payment_no"))
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
@@ -0,0 +1,194 @@
+// Code generated by hertz generator.
+
+package pay
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"zqzqsb.com/gomall/common/identity"
+	"zqzqsb/gomall/app/payment/biz/service"
+	pay "zqzqsb/gomall/app/payment/kitex_gen/pay"
+)
+
+// CreatePayment .
+// @router /payments [POST]
+func CreatePayment(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req pay.CreatePaymentReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 记录客户端IP，部分支付渠道下单时需要
+	ctx = identity.WithClientIP(ctx, c.ClientIP())
+
+	// 调用服务层创建支付单
+	resp, err := service.NewCreatePaymentService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// QueryPayment .
+// @router /payments/{payment_id} [GET]
+func QueryPayment(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req pay.QueryPaymentReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取支付ID
+	idStr := c.Param("payment_id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid payment ID")
+		return
+	}
+	req.Identifier = &pay.QueryPaymentReq_PaymentId{PaymentId: id}
+
+	// 调用服务层查询支付单
+	resp, err := service.NewQueryPaymentService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// CancelPayment .
+// @router /payments/{payment_id}/cancel [POST]
+func CancelPayment(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req pay.CancelPaymentReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取支付ID
+	idStr := c.Param("payment_id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid payment ID")
+		return
+	}
+	req.Identifier = &pay.CancelPaymentReq_PaymentId{PaymentId: id}
+
+	// 调用服务层取消支付单
+	resp, err := service.NewCancelPaymentService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// HandlePaymentCallback .
+// @router /payments/callback [POST]
+func HandlePaymentCallback(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req pay.PaymentCallbackReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用服务层处理渠道回调
+	resp, err := service.NewHandlePaymentCallbackService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RefundPayment .
+// @router /payments/{payment_id}/refund [POST]
+func RefundPayment(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req pay.RefundReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取支付ID
+	idStr := c.Param("payment_id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid payment ID")
+		return
+	}
+	req.Identifier = &pay.RefundReq_PaymentId{PaymentId: id}
+
+	// 调用服务层发起退款
+	resp, err := service.NewRefundPaymentService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetUserPayments .
+// @router /user/payments [GET]
+func GetUserPayments(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req pay.GetUserPaymentsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用服务层获取用户支付列表
+	resp, err := service.NewGetUserPaymentsService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// CreatePaymentFromCart .
+// @router /payments/from-cart [POST]
+func CreatePaymentFromCart(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req pay.CreatePaymentFromCartReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 记录客户端IP，部分支付渠道下单时需要
+	ctx = identity.WithClientIP(ctx, c.ClientIP())
+
+	// 调用服务层根据购物车创建支付单
+	resp, err := service.NewCreatePaymentFromCartService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
@@ -0,0 +1,70 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Payment 支付单模型
+type Payment struct {
+	ID             int64      `gorm:"primarykey"`
+	UserID         int64      `gorm:"not null;index"`
+	OrderID        int64      `gorm:"index"`
+	ActiveOrderID  *int64     `gorm:"uniqueIndex"` // 未取消或失败时等于 OrderID，唯一索引保证同一订单只有一笔有效支付单
+	PaymentNo      string     `gorm:"type:varchar(64);uniqueIndex;not null"`
+	Amount         int64      `gorm:"not null"` // 单位：分
+	RefundedAmount int64      `gorm:"default:0"`
+	PaymentMethod  int32      `gorm:"not null"`
+	Status         int32      `gorm:"not null;index"`
+	TransactionID  string     `gorm:"type:varchar(128)"` // 第三方支付交易ID
+	CallbackURL    string     `gorm:"type:varchar(255)"`
+	ReturnURL      string     `gorm:"type:varchar(255)"`
+	PayTime        *time.Time // 支付完成时间
+	ClientIP       string     `gorm:"type:varchar(64)"`
+	Metadata       string     `gorm:"type:text"` // JSON 格式存储元数据
+	CreatedAt      time.Time  `gorm:"not null"`
+	UpdatedAt      time.Time  `gorm:"not null"`
+}
+
+// TableName 设置表名
+func (Payment) TableName() string {
+	return "payments"
+}
+
+// GetMetadata 获取元数据
+func (p *Payment) GetMetadata() map[string]string {
+	var metadata map[string]string
+	if p.Metadata != "" {
+		_ = json.Unmarshal([]byte(p.Metadata), &metadata)
+	}
+	if metadata == nil {
+		metadata = make(map[string]string)
+	}
+	return metadata
+}
+
+// SetMetadata 设置元数据
+func (p *Payment) SetMetadata(metadata map[string]string) error {
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+	p.Metadata = string(data)
+	return nil
+}
+
+// Refund 退款记录
+type Refund struct {
+	ID        int64     `gorm:"primarykey"`
+	RefundNo  string    `gorm:"type:varchar(64);uniqueIndex;not null"`
+	PaymentID int64     `gorm:"not null;index"`
+	Amount    int64     `gorm:"not null"` // 单位：分
+	Reason    string    `gorm:"type:varchar(255)"`
+	ChannelID string    `gorm:"type:varchar(128)"` // 渠道退款单号
+	CreatedAt time.Time `gorm:"not null"`
+}
+
+// TableName 设置表名
+func (Refund) TableName() string {
+	return "payment_refunds"
+}
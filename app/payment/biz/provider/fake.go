@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"zqzqsb/gomall/app/payment/biz/model"
+	pay "zqzqsb/gomall/app/payment/kitex_gen/pay"
+)
+
+// signParam 回调参数中签名字段的名称
+const signParam = "sign"
+
+// FakeProvider 进程内模拟的支付渠道
+// 下单时返回指向本服务模拟收银台的 pay_url，支付结果通过带签名的回调通知支付服务，
+// 整个流程无需接入支付宝或微信即可完整测试
+type FakeProvider struct {
+	secret       []byte
+	payBaseURL   string
+	autoPayAfter time.Duration
+
+	mu       sync.Mutex
+	callback CallbackHandler
+	closed   map[string]struct{} // 已关闭的支付单号
+}
+
+// NewFakeProvider 创建模拟支付渠道
+func NewFakeProvider(secret, payBaseURL string, autoPayAfter time.Duration) *FakeProvider {
+	return &FakeProvider{
+		secret:       []byte(secret),
+		payBaseURL:   strings.TrimRight(payBaseURL, "/"),
+		autoPayAfter: autoPayAfter,
+		closed:       make(map[string]struct{}),
+	}
+}
+
+// SetCallbackHandler 设置接收回调的处理函数
+func (f *FakeProvider) SetCallbackHandler(h CallbackHandler) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.callback = h
+}
+
+// Pay 生成模拟收银台链接，配置了自动支付时会在延迟后回调支付成功
+func (f *FakeProvider) Pay(ctx context.Context, p *model.Payment) (*PayResult, error) {
+	payURL := fmt.Sprintf("%s/fakepay/%s", f.payBaseURL, p.PaymentNo)
+
+	if f.autoPayAfter > 0 {
+		paymentNo, amount := p.PaymentNo, p.Amount
+		time.AfterFunc(f.autoPayAfter, func() {
+			err := f.Simulate(context.Background(), paymentNo, amount, pay.PaymentStatus_PAYMENT_STATUS_COMPLETED)
+			if err != nil {
+				klog.Warnf("fake provider auto pay %s failed: %v", paymentNo, err)
+			}
+		})
+	}
+
+	return &PayResult{
+		PayURL: payURL,
+		// 模拟渠道不生成真实二维码图片，直接返回编码后的支付链接
+		QRCode: base64.StdEncoding.EncodeToString([]byte(payURL)),
+	}, nil
+}
+
+// Close 关闭支付单，之后的模拟支付将被拒绝
+func (f *FakeProvider) Close(ctx context.Context, p *model.Payment) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.closed[p.PaymentNo] = struct{}{}
+	return nil
+}
+
+// Refund 模拟退款，总是成功
+func (f *FakeProvider) Refund(ctx context.Context, p *model.Payment, refundNo string, amount int64) (string, error) {
+	return "FAKE-" + refundNo, nil
+}
+
+// VerifyCallback 校验回调签名，并确认请求字段与签名参数一致
+func (f *FakeProvider) VerifyCallback(ctx context.Context, req *pay.PaymentCallbackReq) error {
+	params := req.Params
+	if params == nil || !hmac.Equal([]byte(params[signParam]), []byte(f.Sign(params))) {
+		return ErrInvalidSignature
+	}
+	if params["payment_no"] != req.PaymentNo ||
+		params["transaction_id"] != req.TransactionId ||
+		params["status"] != strconv.Itoa(int(req.Status)) ||
+		params["amount"] != strconv.FormatInt(req.Amount, 10) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// Simulate 模拟用户完成支付，向支付服务发送带签名的回调
+func (f *FakeProvider) Simulate(ctx context.Context, paymentNo string, amount int64, status pay.PaymentStatus) error {
+	f.mu.Lock()
+	_, closed := f.closed[paymentNo]
+	callback := f.callback
+	f.mu.Unlock()
+
+	if closed {
+		return errors.New("payment is closed")
+	}
+	if callback == nil {
+		return errors.New("no callback handler registered")
+	}
+	return callback(ctx, f.BuildCallback(paymentNo, amount, status))
+}
+
+// BuildCallback 构造带签名的回调请求
+func (f *FakeProvider) BuildCallback(paymentNo string, amount int64, status pay.PaymentStatus) *pay.PaymentCallbackReq {
+	transactionID := fmt.Sprintf("FAKE%d", time.Now().UnixNano())
+	params := map[string]string{
+		"payment_no":     paymentNo,
+		"transaction_id": transactionID,
+		"status":         strconv.Itoa(int(status)),
+		"amount":         strconv.FormatInt(amount, 10),
+	}
+	params[signParam] = f.Sign(params)
+
+	return &pay.PaymentCallbackReq{
+		PaymentNo:     paymentNo,
+		TransactionId: transactionID,
+		Status:        status,
+		Amount:        amount,
+		RawData:       canonicalize(params),
+		Params:        params,
+	}
+}
+
+// Sign 计算参数签名：除 sign 外的参数按 key 排序拼接后做 HMAC-SHA256
+func (f *FakeProvider) Sign(params map[string]string) string {
+	mac := hmac.New(sha256.New, f.secret)
+	mac.Write([]byte(canonicalize(params)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// canonicalize 按 key 排序拼接为 k1=v1&k2=v2，忽略签名字段
+func canonicalize(params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		if k == signParam {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteByte('&')
+		}
+		b.WriteString(k)
+		b.WriteByte('=')
+		b.WriteString(params[k])
+	}
+	return b.String()
+}
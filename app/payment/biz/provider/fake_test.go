@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"zqzqsb/gomall/app/payment/biz/model"
+	pay "zqzqsb/gomall/app/payment/kitex_gen/pay"
+)
+
+func TestFakeProvider_Callback(t *testing.T) {
+	ctx := context.Background()
+	f := NewFakeProvider("secret", "http://127.0.0.1:8885", 0)
+
+	var got *pay.PaymentCallbackReq
+	f.SetCallbackHandler(func(ctx context.Context, req *pay.PaymentCallbackReq) error {
+		got = req
+		return nil
+	})
+
+	if err := f.Simulate(ctx, "P1", 100, pay.PaymentStatus_PAYMENT_STATUS_COMPLETED); err != nil {
+		t.Fatalf("simulate: %v", err)
+	}
+	if got == nil {
+		t.Fatal("callback not fired")
+	}
+	if err := f.VerifyCallback(ctx, got); err != nil {
+		t.Fatalf("verify signed callback: %v", err)
+	}
+
+	// 篡改金额后签名校验失败
+	got.Amount = 1
+	if err := f.VerifyCallback(ctx, got); err != ErrInvalidSignature {
+		t.Fatalf("tampered callback err = %v, want %v", err, ErrInvalidSignature)
+	}
+
+	// 其他密钥签发的回调无法通过校验
+	other := NewFakeProvider("other", "", 0)
+	if err := f.VerifyCallback(ctx, other.BuildCallback("P1", 100, pay.PaymentStatus_PAYMENT_STATUS_COMPLETED)); err != ErrInvalidSignature {
+		t.Fatalf("foreign callback err = %v, want %v", err, ErrInvalidSignature)
+	}
+}
+
+func TestFakeProvider_Close(t *testing.T) {
+	ctx := context.Background()
+	f := NewFakeProvider("secret", "", 0)
+	f.SetCallbackHandler(func(ctx context.Context, req *pay.PaymentCallbackReq) error { return nil })
+
+	if err := f.Close(ctx, &model.Payment{PaymentNo: "P1"}); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	// 已关闭的支付单不能再完成支付
+	if err := f.Simulate(ctx, "P1", 100, pay.PaymentStatus_PAYMENT_STATUS_COMPLETED); err == nil {
+		t.Fatal("simulate on closed payment should fail")
+	}
+}
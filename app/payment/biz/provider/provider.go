@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"zqzqsb/gomall/app/payment/biz/model"
+	pay "zqzqsb/gomall/app/payment/kitex_gen/pay"
+)
+
+var (
+	ErrUnsupportedMethod = errors.New("unsupported payment method")
+	ErrInvalidSignature  = errors.New("invalid callback signature")
+)
+
+// PayResult 渠道下单结果
+type PayResult struct {
+	PayURL string // 支付链接
+	QRCode string // 支付二维码（Base64编码）
+}
+
+// Provider 支付渠道，每种 PaymentMethod 对应一个实现
+type Provider interface {
+	// Pay 向渠道下单，返回收银台链接和二维码
+	Pay(ctx context.Context, p *model.Payment) (*PayResult, error)
+	// Close 关闭渠道侧尚未支付的订单
+	Close(ctx context.Context, p *model.Payment) error
+	// Refund 向渠道发起退款，返回渠道退款单号
+	Refund(ctx context.Context, p *model.Payment, refundNo string, amount int64) (string, error)
+	// VerifyCallback 校验渠道回调的签名
+	VerifyCallback(ctx context.Context, req *pay.PaymentCallbackReq) error
+}
+
+// CallbackHandler 处理渠道异步通知，由支付服务注册
+type CallbackHandler func(ctx context.Context, req *pay.PaymentCallbackReq) error
+
+var (
+	mu        sync.RWMutex
+	providers = make(map[pay.PaymentMethod]Provider)
+)
+
+// Register 注册支付方式对应的渠道
+func Register(method pay.PaymentMethod, p Provider) {
+	mu.Lock()
+	defer mu.Unlock()
+	providers[method] = p
+}
+
+// Get 获取支付方式对应的渠道
+func Get(method pay.PaymentMethod) (Provider, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+	p, ok := providers[method]
+	if !ok {
+		return nil, ErrUnsupportedMethod
+	}
+	return p, nil
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+
+	"zqzqsb/gomall/app/payment/biz/provider"
+	pay "zqzqsb/gomall/app/payment/kitex_gen/pay"
+)
+
+type CancelPaymentService struct {
+	ctx context.Context
+} // NewCancelPaymentService new CancelPaymentService
+func NewCancelPaymentService(ctx context.Context) *CancelPaymentService {
+	return &CancelPaymentService{ctx: ctx}
+}
+
+// Run cancel payment
+func (s *CancelPaymentService) Run(req *pay.CancelPaymentReq) (resp *pay.CancelPaymentResp, err error) {
+	p, err := loadUserPayment(s.ctx, req.GetPaymentId(), req.GetPaymentNo())
+	if err != nil {
+		return nil, err
+	}
+
+	// 重复取消直接返回成功
+	if pay.PaymentStatus(p.Status) == pay.PaymentStatus_PAYMENT_STATUS_CANCELLED {
+		return &pay.CancelPaymentResp{Success: true}, nil
+	}
+	if !canTransit(pay.PaymentStatus(p.Status), pay.PaymentStatus_PAYMENT_STATUS_CANCELLED) {
+		return nil, ErrInvalidTransition
+	}
+
+	// 先关闭渠道侧订单，避免取消后用户仍能完成支付
+	prov, err := provider.Get(pay.PaymentMethod(p.PaymentMethod))
+	if err != nil {
+		return nil, err
+	}
+	if err = prov.Close(s.ctx, p); err != nil {
+		return nil, err
+	}
+
+	metadata := p.GetMetadata()
+	if req.Reason != "" {
+		metadata["cancel_reason"] = req.Reason
+	}
+	if err = p.SetMetadata(metadata); err != nil {
+		return nil, err
+	}
+	if err = transitPayment(p, pay.PaymentStatus_PAYMENT_STATUS_CANCELLED, map[string]interface{}{
+		"metadata": p.Metadata,
+	}); err != nil {
+		return nil, err
+	}
+
+	// 返回响应
+	resp = &pay.CancelPaymentResp{
+		Success: true,
+	}
+
+	return resp, nil
+}
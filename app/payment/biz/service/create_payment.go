@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"zqzqsb.com/gomall/common/identity"
+	"zqzqsb/gomall/app/payment/infra/rpc"
+	order "zqzqsb/gomall/app/payment/kitex_gen/order"
+	pay "zqzqsb/gomall/app/payment/kitex_gen/pay"
+)
+
+type CreatePaymentService struct {
+	ctx context.Context
+} // NewCreatePaymentService new CreatePaymentService
+func NewCreatePaymentService(ctx context.Context) *CreatePaymentService {
+	return &CreatePaymentService{ctx: ctx}
+}
+
+// Run create payment for an order
+func (s *CreatePaymentService) Run(req *pay.CreatePaymentReq) (resp *pay.CreatePaymentResp, err error) {
+	userID, err := identity.GetUserID(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 参数验证
+	if req.OrderId <= 0 {
+		return nil, errors.New("invalid order id")
+	}
+
+	// 支付金额以订单服务为准，订单服务同时校验订单归属
+	orderResp, err := rpc.OrderClient.GetOrder(s.ctx, &order.GetOrderReq{OrderId: req.OrderId})
+	if err != nil {
+		return nil, err
+	}
+
+	return createOrderPayment(s.ctx, userID, orderResp.Order, req.PaymentMethod, req.ReturnUrl, req.Metadata)
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"zqzqsb.com/gomall/common/identity"
+	"zqzqsb/gomall/app/payment/infra/rpc"
+	order "zqzqsb/gomall/app/payment/kitex_gen/order"
+	pay "zqzqsb/gomall/app/payment/kitex_gen/pay"
+)
+
+type CreatePaymentFromCartService struct {
+	ctx context.Context
+} // NewCreatePaymentFromCartService new CreatePaymentFromCartService
+func NewCreatePaymentFromCartService(ctx context.Context) *CreatePaymentFromCartService {
+	return &CreatePaymentFromCartService{ctx: ctx}
+}
+
+// Run create order from selected cart items, then create payment for it
+func (s *CreatePaymentFromCartService) Run(req *pay.CreatePaymentFromCartReq) (resp *pay.CreatePaymentResp, err error) {
+	userID, err := identity.GetUserID(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 参数验证
+	if len(req.CartItemIds) == 0 {
+		return nil, errors.New("no cart item selected")
+	}
+	if req.ShippingAddressId == "" {
+		return nil, errors.New("shipping address is required")
+	}
+
+	// 由订单服务根据购物车生成订单并计算金额，用户身份随元信息透传
+	orderResp, err := rpc.OrderClient.CreateOrder(s.ctx, &order.CreateOrderReq{
+		CartItemIds: req.CartItemIds,
+		ShippingAddress: &order.ShippingAddress{
+			AddressId: req.ShippingAddressId,
+		},
+		Remark: req.Metadata["remark"],
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return createOrderPayment(s.ctx, userID, orderResp.Order, req.PaymentMethod, req.ReturnUrl, req.Metadata)
+}
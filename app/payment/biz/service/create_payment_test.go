@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+	"testing"
+	pay "zqzqsb/gomall/app/payment/kitex_gen/pay"
+)
+
+func TestCreatePayment_Run(t *testing.T) {
+	ctx := context.Background()
+	s := NewCreatePaymentService(ctx)
+	// init req and assert value
+
+	req := &pay.CreatePaymentReq{}
+	resp, err := s.Run(req)
+	t.Logf("err: %v", err)
+	t.Logf("resp: %v", resp)
+
+	// todo: edit your unit test
+
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"zqzqsb/gomall/app/payment/biz/dal/mysql"
+	pay "zqzqsb/gomall/app/payment/kitex_gen/pay"
+)
+
+type FakePayService struct {
+	ctx context.Context
+} // NewFakePayService new FakePayService
+func NewFakePayService(ctx context.Context) *FakePayService {
+	return &FakePayService{ctx: ctx}
+}
+
+// Run 模拟收银台：用户打开 pay_url 即视为支付成功，由模拟渠道发出签名回调
+func (s *FakePayService) Run(paymentNo string) (resp *pay.Payment, err error) {
+	if fakeProvider == nil {
+		return nil, errors.New("fake provider is not enabled")
+	}
+
+	p, err := mysql.GetPaymentByNo(mysql.DB, paymentNo)
+	if err != nil {
+		return nil, err
+	}
+	if err = fakeProvider.Simulate(s.ctx, p.PaymentNo, p.Amount, pay.PaymentStatus_PAYMENT_STATUS_COMPLETED); err != nil {
+		return nil, err
+	}
+
+	// 返回回调处理后的最新状态
+	p, err = mysql.GetPaymentByNo(mysql.DB, paymentNo)
+	if err != nil {
+		return nil, err
+	}
+	return toProtoPayment(p), nil
+}
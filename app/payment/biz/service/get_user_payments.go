@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+
+	"zqzqsb.com/gomall/common/identity"
+	"zqzqsb/gomall/app/payment/biz/dal/mysql"
+	pay "zqzqsb/gomall/app/payment/kitex_gen/pay"
+)
+
+type GetUserPaymentsService struct {
+	ctx context.Context
+} // NewGetUserPaymentsService new GetUserPaymentsService
+func NewGetUserPaymentsService(ctx context.Context) *GetUserPaymentsService {
+	return &GetUserPaymentsService{ctx: ctx}
+}
+
+// Run list payments of current user
+func (s *GetUserPaymentsService) Run(req *pay.GetUserPaymentsReq) (resp *pay.GetUserPaymentsResp, err error) {
+	userID, err := identity.GetUserID(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 设置默认值
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+
+	payments, total, err := mysql.ListUserPayments(mysql.DB, userID, int32(req.Status), int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	// 构建响应
+	resp = &pay.GetUserPaymentsResp{
+		Payments: make([]*pay.Payment, 0, len(payments)),
+		Total:    int32(total),
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+	for _, p := range payments {
+		resp.Payments = append(resp.Payments, toProtoPayment(p))
+	}
+
+	return resp, nil
+}
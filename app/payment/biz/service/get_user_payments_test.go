@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+	"testing"
+	pay "zqzqsb/gomall/app/payment/kitex_gen/pay"
+)
+
+func TestGetUserPayments_Run(t *testing.T) {
+	ctx := context.Background()
+	s := NewGetUserPaymentsService(ctx)
+	// init req and assert value
+
+	req := &pay.GetUserPaymentsReq{}
+	resp, err := s.Run(req)
+	t.Logf("err: %v", err)
+	t.Logf("resp: %v", resp)
+
+	// todo: edit your unit test
+
+}
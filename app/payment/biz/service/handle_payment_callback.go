@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"zqzqsb/gomall/app/payment/biz/dal/mysql"
+	"zqzqsb/gomall/app/payment/biz/provider"
+	pay "zqzqsb/gomall/app/payment/kitex_gen/pay"
+)
+
+type HandlePaymentCallbackService struct {
+	ctx context.Context
+} // NewHandlePaymentCallbackService new HandlePaymentCallbackService
+func NewHandlePaymentCallbackService(ctx context.Context) *HandlePaymentCallbackService {
+	return &HandlePaymentCallbackService{ctx: ctx}
+}
+
+// Run handle payment callback from provider
+func (s *HandlePaymentCallbackService) Run(req *pay.PaymentCallbackReq) (resp *pay.PaymentCallbackResp, err error) {
+	// 参数验证
+	if req.PaymentNo == "" {
+		return nil, errors.New("empty payment no")
+	}
+	switch req.Status {
+	case pay.PaymentStatus_PAYMENT_STATUS_PROCESSING,
+		pay.PaymentStatus_PAYMENT_STATUS_COMPLETED,
+		pay.PaymentStatus_PAYMENT_STATUS_FAILED:
+	default:
+		return nil, errors.New("unsupported callback status")
+	}
+
+	p, err := mysql.GetPaymentByNo(mysql.DB, req.PaymentNo)
+	if err != nil {
+		return nil, err
+	}
+
+	// 回调签名由对应渠道校验
+	prov, err := provider.Get(pay.PaymentMethod(p.PaymentMethod))
+	if err != nil {
+		return nil, err
+	}
+	if err = prov.VerifyCallback(s.ctx, req); err != nil {
+		return nil, err
+	}
+
+	// 渠道会重复通知，状态已一致时直接返回成功
+	// 支付成功的重复通知仍需通知订单服务，弥补上次通知失败的情况
+	if pay.PaymentStatus(p.Status) == req.Status {
+		if req.Status == pay.PaymentStatus_PAYMENT_STATUS_COMPLETED {
+			if err = notifyOrderPaid(s.ctx, p); err != nil {
+				return nil, err
+			}
+		}
+		return &pay.PaymentCallbackResp{Success: true}, nil
+	}
+	if req.Status == pay.PaymentStatus_PAYMENT_STATUS_COMPLETED && req.Amount != p.Amount {
+		klog.CtxErrorf(s.ctx, "payment %s amount mismatch, expect %d, got %d", p.PaymentNo, p.Amount, req.Amount)
+		return nil, errors.New("payment amount mismatch")
+	}
+
+	// 渠道通常只通知最终结果，收到最终结果时视为已经过处理中状态
+	if pay.PaymentStatus(p.Status) == pay.PaymentStatus_PAYMENT_STATUS_PENDING &&
+		req.Status != pay.PaymentStatus_PAYMENT_STATUS_PROCESSING {
+		if err = transitPayment(p, pay.PaymentStatus_PAYMENT_STATUS_PROCESSING, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	updates := map[string]interface{}{
+		"transaction_id": req.TransactionId,
+	}
+	if req.Status == pay.PaymentStatus_PAYMENT_STATUS_COMPLETED {
+		updates["pay_time"] = time.Now()
+	}
+	if err = transitPayment(p, req.Status, updates); err != nil {
+		return nil, err
+	}
+
+	klog.CtxInfof(s.ctx, "payment %s is %s", p.PaymentNo, req.Status)
+
+	if req.Status == pay.PaymentStatus_PAYMENT_STATUS_COMPLETED {
+		// 通知失败时返回错误，由渠道重试回调再次通知
+		if err = notifyOrderPaid(s.ctx, p); err != nil {
+			klog.CtxErrorf(s.ctx, "notify order %d paid by payment %s failed: %v", p.OrderID, p.PaymentNo, err)
+			return nil, err
+		}
+	}
+
+	// 返回响应
+	resp = &pay.PaymentCallbackResp{
+		Success: true,
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"zqzqsb.com/gomall/common/identity"
+	"zqzqsb/gomall/app/payment/biz/dal/mysql"
+	"zqzqsb/gomall/app/payment/biz/model"
+	"zqzqsb/gomall/app/payment/biz/provider"
+	"zqzqsb/gomall/app/payment/biz/utils"
+	"zqzqsb/gomall/app/payment/conf"
+	"zqzqsb/gomall/app/payment/infra/rpc"
+	order "zqzqsb/gomall/app/payment/kitex_gen/order"
+	pay "zqzqsb/gomall/app/payment/kitex_gen/pay"
+)
+
+var (
+	ErrInvalidTransition = errors.New("invalid payment status transition")
+	ErrPaymentForbidden  = errors.New("payment does not belong to current user")
+	ErrOrderNotPayable   = errors.New("order is not waiting for payment")
+)
+
+// paymentTransitions 支付状态机，key 为当前状态，value 为允许流转到的状态
+//
+//	PENDING -> PROCESSING -> COMPLETED -> REFUNDED
+//	   |            |-----> FAILED
+//	   |            |-----> CANCELLED
+//	   |------------------> CANCELLED
+var paymentTransitions = map[pay.PaymentStatus][]pay.PaymentStatus{
+	pay.PaymentStatus_PAYMENT_STATUS_PENDING: {
+		pay.PaymentStatus_PAYMENT_STATUS_PROCESSING,
+		pay.PaymentStatus_PAYMENT_STATUS_CANCELLED,
+	},
+	pay.PaymentStatus_PAYMENT_STATUS_PROCESSING: {
+		pay.PaymentStatus_PAYMENT_STATUS_COMPLETED,
+		pay.PaymentStatus_PAYMENT_STATUS_FAILED,
+		pay.PaymentStatus_PAYMENT_STATUS_CANCELLED,
+	},
+	pay.PaymentStatus_PAYMENT_STATUS_COMPLETED: {
+		pay.PaymentStatus_PAYMENT_STATUS_REFUNDED,
+	},
+}
+
+// canTransit 判断状态流转是否合法
+func canTransit(from, to pay.PaymentStatus) bool {
+	for _, s := range paymentTransitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// transitPayment 按状态机流转支付状态并同步到数据库
+func transitPayment(p *model.Payment, to pay.PaymentStatus, updates map[string]interface{}) error {
+	from := pay.PaymentStatus(p.Status)
+	if !canTransit(from, to) {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidTransition, from, to)
+	}
+	// 取消或失败的支付单不再占用订单，订单可以重新发起支付
+	release := to == pay.PaymentStatus_PAYMENT_STATUS_CANCELLED || to == pay.PaymentStatus_PAYMENT_STATUS_FAILED
+	if release {
+		if updates == nil {
+			updates = make(map[string]interface{})
+		}
+		updates["active_order_id"] = nil
+	}
+	if err := mysql.TransitPayment(mysql.DB, p.ID, p.Status, int32(to), updates); err != nil {
+		return err
+	}
+	p.Status = int32(to)
+	if release {
+		p.ActiveOrderID = nil
+	}
+	return nil
+}
+
+// generateNo 生成业务单号，如支付单号、退款单号
+func generateNo(prefix string) string {
+	return fmt.Sprintf("%s%s%06d", prefix, time.Now().Format("20060102150405"), rand.Intn(1000000))
+}
+
+// loadPayment 根据支付ID或支付单号获取支付单
+func loadPayment(paymentID int64, paymentNo string) (*model.Payment, error) {
+	switch {
+	case paymentID > 0:
+		return mysql.GetPaymentByID(mysql.DB, paymentID)
+	case paymentNo != "":
+		return mysql.GetPaymentByNo(mysql.DB, paymentNo)
+	default:
+		return nil, errors.New("payment id or payment no is required")
+	}
+}
+
+// loadUserPayment 根据支付ID或支付单号获取当前用户的支付单
+func loadUserPayment(ctx context.Context, paymentID int64, paymentNo string) (*model.Payment, error) {
+	userID, err := identity.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := loadPayment(paymentID, paymentNo)
+	if err != nil {
+		return nil, err
+	}
+	if p.UserID != userID {
+		return nil, ErrPaymentForbidden
+	}
+	return p, nil
+}
+
+// createPayment 创建支付单并向渠道下单
+func createPayment(ctx context.Context, userID, orderID, amount int64, method pay.PaymentMethod,
+	returnURL string, metadata map[string]string) (*pay.CreatePaymentResp, error) {
+	if amount <= 0 {
+		return nil, errors.New("invalid payment amount")
+	}
+	prov, err := provider.Get(method)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &model.Payment{
+		UserID:        userID,
+		OrderID:       orderID,
+		PaymentNo:     generateNo("P"),
+		Amount:        amount,
+		PaymentMethod: int32(method),
+		Status:        int32(pay.PaymentStatus_PAYMENT_STATUS_PENDING),
+		CallbackURL:   conf.GetConf().Payment.NotifyURL,
+		ReturnURL:     returnURL,
+		ClientIP:      utils.GetClientIP(ctx),
+	}
+	if err = p.SetMetadata(metadata); err != nil {
+		return nil, err
+	}
+	// 同一订单只能有一笔未取消或失败的支付单，避免两笔支付都完成，并发创建时由唯一索引兜底
+	if orderID > 0 {
+		existing, err := mysql.GetActiveOrderPayment(mysql.DB, orderID)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return nil, mysql.ErrOrderPaymentExists
+		}
+		p.ActiveOrderID = &orderID
+	}
+
+	// 先落库再向渠道下单，保证渠道回调时支付单一定存在
+	paymentID, err := mysql.CreatePayment(mysql.DB, p)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := prov.Pay(ctx, p)
+	if err != nil {
+		if terr := transitPayment(p, pay.PaymentStatus_PAYMENT_STATUS_CANCELLED, nil); terr != nil {
+			klog.CtxErrorf(ctx, "cancel payment %s after provider error failed: %v", p.PaymentNo, terr)
+		}
+		return nil, err
+	}
+
+	return &pay.CreatePaymentResp{
+		PaymentId: paymentID,
+		PaymentNo: p.PaymentNo,
+		PayUrl:    result.PayURL,
+		QrCode:    result.QRCode,
+	}, nil
+}
+
+// createOrderPayment 为待支付订单创建支付单，支付金额取订单金额
+func createOrderPayment(ctx context.Context, userID int64, o *order.Order, method pay.PaymentMethod,
+	returnURL string, metadata map[string]string) (*pay.CreatePaymentResp, error) {
+	if o == nil || o.UserId != userID {
+		return nil, ErrPaymentForbidden
+	}
+	if o.Status != order.OrderStatus_ORDER_STATUS_CREATED {
+		return nil, ErrOrderNotPayable
+	}
+
+	md := make(map[string]string, len(metadata)+1)
+	for k, v := range metadata {
+		md[k] = v
+	}
+	md["order_no"] = o.OrderNo
+
+	return createPayment(ctx, userID, o.Id, o.TotalAmount, method, returnURL, md)
+}
+
+// notifyOrderPaid 通知订单服务支付完成，订单服务对同一笔支付的重复通知做幂等处理
+func notifyOrderPaid(ctx context.Context, p *model.Payment) error {
+	if p.OrderID <= 0 {
+		return nil
+	}
+	_, err := rpc.OrderClient.MarkOrderPaid(ctx, &order.MarkOrderPaidReq{
+		OrderId:   p.OrderID,
+		PaymentId: p.ID,
+		Amount:    p.Amount,
+	})
+	return err
+}
+
+// notifyOrderRefunded 全额退款前通知订单服务取消订单，订单服务对同一笔支付的重复通知做幂等处理
+func notifyOrderRefunded(ctx context.Context, p *model.Payment) error {
+	if p.OrderID <= 0 {
+		return nil
+	}
+	_, err := rpc.OrderClient.MarkOrderRefunded(ctx, &order.MarkOrderRefundedReq{
+		OrderId:   p.OrderID,
+		PaymentId: p.ID,
+		Amount:    p.Amount,
+	})
+	return err
+}
+
+// toProtoPayment 将支付单模型转换为 IDL 结构
+func toProtoPayment(p *model.Payment) *pay.Payment {
+	var payTime int64
+	if p.PayTime != nil {
+		payTime = p.PayTime.Unix()
+	}
+	return &pay.Payment{
+		Id:            p.ID,
+		UserId:        p.UserID,
+		OrderId:       p.OrderID,
+		PaymentNo:     p.PaymentNo,
+		Amount:        p.Amount,
+		PaymentMethod: pay.PaymentMethod(p.PaymentMethod),
+		Status:        pay.PaymentStatus(p.Status),
+		TransactionId: p.TransactionID,
+		CallbackUrl:   p.CallbackURL,
+		ReturnUrl:     p.ReturnURL,
+		CreateTime:    p.CreatedAt.Unix(),
+		UpdateTime:    p.UpdatedAt.Unix(),
+		PayTime:       payTime,
+		ClientIp:      p.ClientIP,
+		Metadata:      p.GetMetadata(),
+	}
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"testing"
+
+	pay "zqzqsb/gomall/app/payment/kitex_gen/pay"
+)
+
+func TestCanTransit(t *testing.T) {
+	cases := []struct {
+		from, to pay.PaymentStatus
+		want     bool
+	}{
+		{pay.PaymentStatus_PAYMENT_STATUS_PENDING, pay.PaymentStatus_PAYMENT_STATUS_PROCESSING, true},
+		{pay.PaymentStatus_PAYMENT_STATUS_PENDING, pay.PaymentStatus_PAYMENT_STATUS_CANCELLED, true},
+		{pay.PaymentStatus_PAYMENT_STATUS_PENDING, pay.PaymentStatus_PAYMENT_STATUS_COMPLETED, false},
+		{pay.PaymentStatus_PAYMENT_STATUS_PROCESSING, pay.PaymentStatus_PAYMENT_STATUS_COMPLETED, true},
+		{pay.PaymentStatus_PAYMENT_STATUS_PROCESSING, pay.PaymentStatus_PAYMENT_STATUS_FAILED, true},
+		{pay.PaymentStatus_PAYMENT_STATUS_COMPLETED, pay.PaymentStatus_PAYMENT_STATUS_REFUNDED, true},
+		{pay.PaymentStatus_PAYMENT_STATUS_COMPLETED, pay.PaymentStatus_PAYMENT_STATUS_CANCELLED, false},
+		{pay.PaymentStatus_PAYMENT_STATUS_FAILED, pay.PaymentStatus_PAYMENT_STATUS_COMPLETED, false},
+		{pay.PaymentStatus_PAYMENT_STATUS_CANCELLED, pay.PaymentStatus_PAYMENT_STATUS_PROCESSING, false},
+		{pay.PaymentStatus_PAYMENT_STATUS_REFUNDED, pay.PaymentStatus_PAYMENT_STATUS_COMPLETED, false},
+	}
+	for _, c := range cases {
+		if got := canTransit(c.from, c.to); got != c.want {
+			t.Errorf("canTransit(%s, %s) = %v, want %v", c.from, c.to, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+
+	"zqzqsb/gomall/app/payment/biz/provider"
+	"zqzqsb/gomall/app/payment/conf"
+	pay "zqzqsb/gomall/app/payment/kitex_gen/pay"
+)
+
+// fakeProvider 本地模拟渠道，未启用时为 nil
+var fakeProvider *provider.FakeProvider
+
+// InitProviders 根据配置注册支付渠道
+func InitProviders() {
+	fakeConf := conf.GetConf().Payment.Fake
+	if !fakeConf.Enabled {
+		return
+	}
+
+	fakeProvider = provider.NewFakeProvider(fakeConf.Secret, fakeConf.PayBaseURL, fakeConf.AutoPayAfter)
+	// 模拟渠道在进程内直接把回调交给支付服务处理
+	fakeProvider.SetCallbackHandler(func(ctx context.Context, req *pay.PaymentCallbackReq) error {
+		_, err := NewHandlePaymentCallbackService(ctx).Run(req)
+		return err
+	})
+
+	// 未接入真实渠道前，所有支付方式都走模拟渠道
+	for _, method := range []pay.PaymentMethod{
+		pay.PaymentMethod_PAYMENT_METHOD_ALIPAY,
+		pay.PaymentMethod_PAYMENT_METHOD_WECHAT,
+		pay.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD,
+		pay.PaymentMethod_PAYMENT_METHOD_BANK_TRANSFER,
+	} {
+		provider.Register(method, fakeProvider)
+	}
+}
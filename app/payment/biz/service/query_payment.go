@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+
+	pay "zqzqsb/gomall/app/payment/kitex_gen/pay"
+)
+
+type QueryPaymentService struct {
+	ctx context.Context
+} // NewQueryPaymentService new QueryPaymentService
+func NewQueryPaymentService(ctx context.Context) *QueryPaymentService {
+	return &QueryPaymentService{ctx: ctx}
+}
+
+// Run query payment
+func (s *QueryPaymentService) Run(req *pay.QueryPaymentReq) (resp *pay.QueryPaymentResp, err error) {
+	p, err := loadUserPayment(s.ctx, req.GetPaymentId(), req.GetPaymentNo())
+	if err != nil {
+		return nil, err
+	}
+
+	// 构建响应
+	resp = &pay.QueryPaymentResp{
+		Payment: toProtoPayment(p),
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"zqzqsb/gomall/app/payment/biz/dal/mysql"
+	"zqzqsb/gomall/app/payment/biz/model"
+	"zqzqsb/gomall/app/payment/biz/provider"
+	"zqzqsb/gomall/app/payment/biz/utils"
+	pay "zqzqsb/gomall/app/payment/kitex_gen/pay"
+)
+
+type RefundPaymentService struct {
+	ctx context.Context
+} // NewRefundPaymentService new RefundPaymentService
+func NewRefundPaymentService(ctx context.Context) *RefundPaymentService {
+	return &RefundPaymentService{ctx: ctx}
+}
+
+// Run refund payment
+func (s *RefundPaymentService) Run(req *pay.RefundReq) (resp *pay.RefundResp, err error) {
+	p, err := loadPayment(req.GetPaymentId(), req.GetPaymentNo())
+	if err != nil {
+		return nil, err
+	}
+	// 退款只对管理员开放，买家需通过售后由管理员退款，避免订单发货后自行退款
+	adminID, err := utils.RequireAdmin(s.ctx, "POST", fmt.Sprintf("/payments/%d/refund", p.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	// 只有已完成的支付可以退款
+	if pay.PaymentStatus(p.Status) != pay.PaymentStatus_PAYMENT_STATUS_COMPLETED {
+		return nil, ErrInvalidTransition
+	}
+
+	// 未指定金额时退还剩余全部金额
+	amount := req.Amount
+	if amount == 0 {
+		amount = p.Amount - p.RefundedAmount
+	}
+	if amount <= 0 || amount > p.Amount-p.RefundedAmount {
+		return nil, errors.New("invalid refund amount")
+	}
+
+	prov, err := provider.Get(pay.PaymentMethod(p.PaymentMethod))
+	if err != nil {
+		return nil, err
+	}
+	// 全额退款时先由订单服务取消订单，订单状态不允许取消时不向渠道发起退款
+	// 渠道退款失败后重试时，订单服务对已取消的订单直接返回成功
+	if amount == p.Amount-p.RefundedAmount {
+		if err = notifyOrderRefunded(s.ctx, p); err != nil {
+			return nil, err
+		}
+	}
+	refund := &model.Refund{
+		RefundNo:  generateNo("R"),
+		PaymentID: p.ID,
+		Amount:    amount,
+		Reason:    req.Reason,
+	}
+	refund.ChannelID, err = prov.Refund(s.ctx, p, refund.RefundNo, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// 全额退款后支付单流转为已退款
+	if err = mysql.CreateRefund(mysql.DB, refund,
+		int32(pay.PaymentStatus_PAYMENT_STATUS_COMPLETED),
+		int32(pay.PaymentStatus_PAYMENT_STATUS_REFUNDED)); err != nil {
+		return nil, err
+	}
+	klog.CtxInfof(s.ctx, "payment %s refunded %d by admin %d", p.PaymentNo, amount, adminID)
+
+	// 返回响应
+	resp = &pay.RefundResp{
+		Success:  true,
+		RefundId: refund.RefundNo,
+	}
+
+	return resp, nil
+}
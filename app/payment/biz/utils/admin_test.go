@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"zqzqsb.com/gomall/common/identity"
+	"zqzqsb.com/gomall/common/rbac"
+)
+
+func TestRequireAdmin(t *testing.T) {
+	// 1 为 admin，2 为 merchant，3 为被拉黑的 admin
+	policy, err := os.ReadFile("../../conf/rbac_policy.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy = append(policy, "\ng, 1, admin\ng, 2, merchant\ng, 3, admin\np, 3, .*, .*, deny\n"...)
+	policyFile := filepath.Join(t.TempDir(), "rbac_policy.csv")
+	if err = os.WriteFile(policyFile, policy, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err = rbac.Init(nil, "../../conf/rbac_model.conf", policyFile); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		userID  int64
+		act     string
+		obj     string
+		wantErr error
+	}{
+		{"admin refunds payment", 1, "POST", "/payments/10/refund", nil},
+		{"admin reads refund", 1, "GET", "/payments/10/refund", ErrPermissionDenied},
+		{"merchant refunds payment", 2, "POST", "/payments/10/refund", ErrPermissionDenied},
+		{"blacklisted admin", 3, "POST", "/payments/10/refund", ErrPermissionDenied},
+		{"anonymous", 0, "POST", "/payments/10/refund", identity.ErrUnauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.userID > 0 {
+				ctx = identity.WithUserID(ctx, tt.userID)
+			}
+			userID, err := RequireAdmin(ctx, tt.act, tt.obj)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && userID != tt.userID {
+				t.Errorf("got user %d, want %d", userID, tt.userID)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"context"
+	"net"
+
+	"github.com/bytedance/gopkg/cloud/metainfo"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"zqzqsb.com/gomall/common/identity"
+)
+
+type clientIPKey struct{}
+
+// WithClientIP 将客户端IP写入上下文，HTTP 请求由 handler 写入
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPKey{}, ip)
+}
+
+// GetClientIP 获取客户端IP，RPC 请求优先取网关透传的客户端 IP，没有时取调用方地址
+func GetClientIP(ctx context.Context) string {
+	if ip, ok := ctx.Value(clientIPKey{}).(string); ok {
+		return ip
+	}
+	if ip, ok := metainfo.GetPersistentValue(ctx, identity.ClientIPKey); ok && ip != "" {
+		return ip
+	}
+	ri := rpcinfo.GetRPCInfo(ctx)
+	if ri == nil || ri.From() == nil || ri.From().Address() == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(ri.From().Address().String())
+	if err != nil {
+		return ri.From().Address().String()
+	}
+	return host
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	pay "zqzqsb/gomall/app/payment/kitex_gen/pay"
+	"zqzqsb/gomall/app/payment/biz/service"
+)
+
+// PaymentServiceImpl implements the last service interface defined in the IDL.
+type PaymentServiceImpl struct{}
+
+// CreatePayment implements the PaymentServiceImpl interface.
+func (s *PaymentServiceImpl) CreatePayment(ctx context.Context, req *pay.CreatePaymentReq) (resp *pay.CreatePaymentResp, err error) {
+	resp, err = service.NewCreatePaymentService(ctx).Run(req)
+
+	return resp, err
+}
+
+// QueryPayment implements the PaymentServiceImpl interface.
+func (s *PaymentServiceImpl) QueryPayment(ctx context.Context, req *pay.QueryPaymentReq) (resp *pay.QueryPaymentResp, err error) {
+	resp, err = service.NewQueryPaymentService(ctx).Run(req)
+
+	return resp, err
+}
+
+// CancelPayment implements the PaymentServiceImpl interface.
+func (s *PaymentServiceImpl) CancelPayment(ctx context.Context, req *pay.CancelPaymentReq) (resp *pay.CancelPaymentResp, err error) {
+	resp, err = service.NewCancelPaymentService(ctx).Run(req)
+
+	return resp, err
+}
+
+// HandlePaymentCallback implements the PaymentServiceImpl interface.
+func (s *PaymentServiceImpl) HandlePaymentCallback(ctx context.Context, req *pay.PaymentCallbackReq) (resp *pay.PaymentCallbackResp, err error) {
+	resp, err = service.NewHandlePaymentCallbackService(ctx).Run(req)
+
+	return resp, err
+}
+
+// RefundPayment implements the PaymentServiceImpl interface.
+func (s *PaymentServiceImpl) RefundPayment(ctx context.Context, req *pay.RefundReq) (resp *pay.RefundResp, err error) {
+	resp, err = service.NewRefundPaymentService(ctx).Run(req)
+
+	return resp, err
+}
+
+// GetUserPayments implements the PaymentServiceImpl interface.
+func (s *PaymentServiceImpl) GetUserPayments(ctx context.Context, req *pay.GetUserPaymentsReq) (resp *pay.GetUserPaymentsResp, err error) {
+	resp, err = service.NewGetUserPaymentsService(ctx).Run(req)
+
+	return resp, err
+}
+
+// CreatePaymentFromCart implements the PaymentServiceImpl interface.
+func (s *PaymentServiceImpl) CreatePaymentFromCart(ctx context.Context, req *pay.CreatePaymentFromCartReq) (resp *pay.CreatePaymentResp, err error) {
+	resp, err = service.NewCreatePaymentFromCartService(ctx).Run(req)
+
+	return resp, err
+}
@@ -0,0 +1,51 @@
+package rpc
+
+import (
+	"sync"
+
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/transmeta"
+	"zqzqsb.com/gomall/common/clientsuite"
+	"zqzqsb/gomall/app/payment/conf"
+	"zqzqsb/gomall/app/payment/kitex_gen/order/orderservice"
+)
+
+var (
+	OrderClient orderservice.Client
+	once       sync.Once
+	err        error
+)
+
+// InitClient 初始化下游服务客户端
+func InitClient() {
+	once.Do(func() {
+		initOrderClient()
+	})
+}
+
+func initOrderClient() {
+	opts := []client.Option{
+		// gRPC 传输下需要显式开启元信息透传，用户身份才能带到下游
+		client.WithMetaHandler(transmeta.ClientHTTP2Handler),
+	}
+	suite := clientsuite.CommonClientSuite{
+		CurrentServiceName: conf.GetConf().Kitex.Service,
+		Policy:             conf.GetConf().OrderService.Policy,
+	}
+	opts = append(opts, target(&suite, conf.GetConf().OrderService.Address)...)
+	opts = append(opts, suite.Options()...)
+
+	OrderClient, err = orderservice.NewClient("order", opts...)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// target 配置了下游地址时直连，否则通过 Consul 发现服务
+func target(suite *clientsuite.CommonClientSuite, addrs []string) []client.Option {
+	if len(addrs) > 0 {
+		return []client.Option{client.WithHostPorts(addrs...)}
+	}
+	suite.RegisteryAddr = conf.GetConf().Registry.Address()
+	return nil
+}
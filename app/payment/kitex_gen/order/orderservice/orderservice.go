@@ -0,0 +1,1622 @@
+// Code generated by Kitex v0.9.1. DO NOT EDIT.
+
+package orderservice
+
+import (
+	"context"
+	"errors"
+	client "github.com/cloudwego/kitex/client"
+	kitex "github.com/cloudwego/kitex/pkg/serviceinfo"
+	streaming "github.com/cloudwego/kitex/pkg/streaming"
+	proto "google.golang.org/protobuf/proto"
+	order "zqzqsb/gomall/app/payment/kitex_gen/order"
+)
+
+var errInvalidMessageType = errors.New("invalid message type for service method handler")
+
+var serviceMethods = map[string]kitex.MethodInfo{
+	"CreateOrder": kitex.NewMethodInfo(
+		createOrderHandler,
+		newCreateOrderArgs,
+		newCreateOrderResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"GetOrder": kitex.NewMethodInfo(
+		getOrderHandler,
+		newGetOrderArgs,
+		newGetOrderResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListUserOrders": kitex.NewMethodInfo(
+		listUserOrdersHandler,
+		newListUserOrdersArgs,
+		newListUserOrdersResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"CancelOrder": kitex.NewMethodInfo(
+		cancelOrderHandler,
+		newCancelOrderArgs,
+		newCancelOrderResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ConfirmReceipt": kitex.NewMethodInfo(
+		confirmReceiptHandler,
+		newConfirmReceiptArgs,
+		newConfirmReceiptResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"MarkOrderPaid": kitex.NewMethodInfo(
+		markOrderPaidHandler,
+		newMarkOrderPaidArgs,
+		newMarkOrderPaidResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ShipOrder": kitex.NewMethodInfo(
+		shipOrderHandler,
+		newShipOrderArgs,
+		newShipOrderResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListOrders": kitex.NewMethodInfo(
+		listOrdersHandler,
+		newListOrdersArgs,
+		newListOrdersResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"MarkOrderRefunded": kitex.NewMethodInfo(
+		markOrderRefundedHandler,
+		newMarkOrderRefundedArgs,
+		newMarkOrderRefundedResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+}
+
+var (
+	orderServiceServiceInfo                = NewServiceInfo()
+	orderServiceServiceInfoForClient       = NewServiceInfoForClient()
+	orderServiceServiceInfoForStreamClient = NewServiceInfoForStreamClient()
+)
+
+// for server
+func serviceInfo() *kitex.ServiceInfo {
+	return orderServiceServiceInfo
+}
+
+// for client
+func serviceInfoForStreamClient() *kitex.ServiceInfo {
+	return orderServiceServiceInfoForStreamClient
+}
+
+// for stream client
+func serviceInfoForClient() *kitex.ServiceInfo {
+	return orderServiceServiceInfoForClient
+}
+
+// NewServiceInfo creates a new ServiceInfo containing all methods
+func NewServiceInfo() *kitex.ServiceInfo {
+	return newServiceInfo(false, true, true)
+}
+
+// NewServiceInfo creates a new ServiceInfo containing non-streaming methods
+func NewServiceInfoForClient() *kitex.ServiceInfo {
+	return newServiceInfo(false, false, true)
+}
+func NewServiceInfoForStreamClient() *kitex.ServiceInfo {
+	return newServiceInfo(true, true, false)
+}
+
+func newServiceInfo(hasStreaming bool, keepStreamingMethods bool, keepNonStreamingMethods bool) *kitex.ServiceInfo {
+	serviceName := "OrderService"
+	handlerType := (*order.OrderService)(nil)
+	methods := map[string]kitex.MethodInfo{}
+	for name, m := range serviceMethods {
+		if m.IsStreaming() && !keepStreamingMethods {
+			continue
+		}
+		if !m.IsStreaming() && !keepNonStreamingMethods {
+			continue
+		}
+		methods[name] = m
+	}
+	extra := map[string]interface{}{
+		"PackageName": "order",
+	}
+	if hasStreaming {
+		extra["streaming"] = hasStreaming
+	}
+	svcInfo := &kitex.ServiceInfo{
+		ServiceName:     serviceName,
+		HandlerType:     handlerType,
+		Methods:         methods,
+		PayloadCodec:    kitex.Protobuf,
+		KiteXGenVersion: "v0.9.1",
+		Extra:           extra,
+	}
+	return svcInfo
+}
+
+func createOrderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(order.CreateOrderReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(order.OrderService).CreateOrder(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CreateOrderArgs:
+		success, err := handler.(order.OrderService).CreateOrder(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CreateOrderResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCreateOrderArgs() interface{} {
+	return &CreateOrderArgs{}
+}
+
+func newCreateOrderResult() interface{} {
+	return &CreateOrderResult{}
+}
+
+type CreateOrderArgs struct {
+	Req *order.CreateOrderReq
+}
+
+func (p *CreateOrderArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(order.CreateOrderReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *CreateOrderArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *CreateOrderArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *CreateOrderArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CreateOrderArgs) Unmarshal(in []byte) error {
+	msg := new(order.CreateOrderReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CreateOrderArgs_Req_DEFAULT *order.CreateOrderReq
+
+func (p *CreateOrderArgs) GetReq() *order.CreateOrderReq {
+	if !p.IsSetReq() {
+		return CreateOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CreateOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CreateOrderArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CreateOrderResult struct {
+	Success *order.CreateOrderResp
+}
+
+var CreateOrderResult_Success_DEFAULT *order.CreateOrderResp
+
+func (p *CreateOrderResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(order.CreateOrderResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *CreateOrderResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *CreateOrderResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *CreateOrderResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CreateOrderResult) Unmarshal(in []byte) error {
+	msg := new(order.CreateOrderResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CreateOrderResult) GetSuccess() *order.CreateOrderResp {
+	if !p.IsSetSuccess() {
+		return CreateOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CreateOrderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*order.CreateOrderResp)
+}
+
+func (p *CreateOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CreateOrderResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getOrderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(order.GetOrderReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(order.OrderService).GetOrder(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetOrderArgs:
+		success, err := handler.(order.OrderService).GetOrder(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetOrderResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetOrderArgs() interface{} {
+	return &GetOrderArgs{}
+}
+
+func newGetOrderResult() interface{} {
+	return &GetOrderResult{}
+}
+
+type GetOrderArgs struct {
+	Req *order.GetOrderReq
+}
+
+func (p *GetOrderArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(order.GetOrderReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetOrderArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetOrderArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetOrderArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetOrderArgs) Unmarshal(in []byte) error {
+	msg := new(order.GetOrderReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetOrderArgs_Req_DEFAULT *order.GetOrderReq
+
+func (p *GetOrderArgs) GetReq() *order.GetOrderReq {
+	if !p.IsSetReq() {
+		return GetOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetOrderArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetOrderResult struct {
+	Success *order.GetOrderResp
+}
+
+var GetOrderResult_Success_DEFAULT *order.GetOrderResp
+
+func (p *GetOrderResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(order.GetOrderResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetOrderResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetOrderResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetOrderResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetOrderResult) Unmarshal(in []byte) error {
+	msg := new(order.GetOrderResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetOrderResult) GetSuccess() *order.GetOrderResp {
+	if !p.IsSetSuccess() {
+		return GetOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetOrderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*order.GetOrderResp)
+}
+
+func (p *GetOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetOrderResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listUserOrdersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(order.ListUserOrdersReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(order.OrderService).ListUserOrders(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListUserOrdersArgs:
+		success, err := handler.(order.OrderService).ListUserOrders(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListUserOrdersResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListUserOrdersArgs() interface{} {
+	return &ListUserOrdersArgs{}
+}
+
+func newListUserOrdersResult() interface{} {
+	return &ListUserOrdersResult{}
+}
+
+type ListUserOrdersArgs struct {
+	Req *order.ListUserOrdersReq
+}
+
+func (p *ListUserOrdersArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(order.ListUserOrdersReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListUserOrdersArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListUserOrdersArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListUserOrdersArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListUserOrdersArgs) Unmarshal(in []byte) error {
+	msg := new(order.ListUserOrdersReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListUserOrdersArgs_Req_DEFAULT *order.ListUserOrdersReq
+
+func (p *ListUserOrdersArgs) GetReq() *order.ListUserOrdersReq {
+	if !p.IsSetReq() {
+		return ListUserOrdersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListUserOrdersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListUserOrdersArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListUserOrdersResult struct {
+	Success *order.ListOrdersResp
+}
+
+var ListUserOrdersResult_Success_DEFAULT *order.ListOrdersResp
+
+func (p *ListUserOrdersResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(order.ListOrdersResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListUserOrdersResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListUserOrdersResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListUserOrdersResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListUserOrdersResult) Unmarshal(in []byte) error {
+	msg := new(order.ListOrdersResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListUserOrdersResult) GetSuccess() *order.ListOrdersResp {
+	if !p.IsSetSuccess() {
+		return ListUserOrdersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListUserOrdersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*order.ListOrdersResp)
+}
+
+func (p *ListUserOrdersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListUserOrdersResult) GetResult() interface{} {
+	return p.Success
+}
+
+func cancelOrderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(order.CancelOrderReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(order.OrderService).CancelOrder(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CancelOrderArgs:
+		success, err := handler.(order.OrderService).CancelOrder(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CancelOrderResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCancelOrderArgs() interface{} {
+	return &CancelOrderArgs{}
+}
+
+func newCancelOrderResult() interface{} {
+	return &CancelOrderResult{}
+}
+
+type CancelOrderArgs struct {
+	Req *order.CancelOrderReq
+}
+
+func (p *CancelOrderArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(order.CancelOrderReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *CancelOrderArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *CancelOrderArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *CancelOrderArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CancelOrderArgs) Unmarshal(in []byte) error {
+	msg := new(order.CancelOrderReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CancelOrderArgs_Req_DEFAULT *order.CancelOrderReq
+
+func (p *CancelOrderArgs) GetReq() *order.CancelOrderReq {
+	if !p.IsSetReq() {
+		return CancelOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CancelOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CancelOrderArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CancelOrderResult struct {
+	Success *order.CancelOrderResp
+}
+
+var CancelOrderResult_Success_DEFAULT *order.CancelOrderResp
+
+func (p *CancelOrderResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(order.CancelOrderResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *CancelOrderResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *CancelOrderResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *CancelOrderResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CancelOrderResult) Unmarshal(in []byte) error {
+	msg := new(order.CancelOrderResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CancelOrderResult) GetSuccess() *order.CancelOrderResp {
+	if !p.IsSetSuccess() {
+		return CancelOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CancelOrderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*order.CancelOrderResp)
+}
+
+func (p *CancelOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CancelOrderResult) GetResult() interface{} {
+	return p.Success
+}
+
+func confirmReceiptHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(order.ConfirmReceiptReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(order.OrderService).ConfirmReceipt(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ConfirmReceiptArgs:
+		success, err := handler.(order.OrderService).ConfirmReceipt(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ConfirmReceiptResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newConfirmReceiptArgs() interface{} {
+	return &ConfirmReceiptArgs{}
+}
+
+func newConfirmReceiptResult() interface{} {
+	return &ConfirmReceiptResult{}
+}
+
+type ConfirmReceiptArgs struct {
+	Req *order.ConfirmReceiptReq
+}
+
+func (p *ConfirmReceiptArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(order.ConfirmReceiptReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ConfirmReceiptArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ConfirmReceiptArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ConfirmReceiptArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ConfirmReceiptArgs) Unmarshal(in []byte) error {
+	msg := new(order.ConfirmReceiptReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ConfirmReceiptArgs_Req_DEFAULT *order.ConfirmReceiptReq
+
+func (p *ConfirmReceiptArgs) GetReq() *order.ConfirmReceiptReq {
+	if !p.IsSetReq() {
+		return ConfirmReceiptArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ConfirmReceiptArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ConfirmReceiptArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ConfirmReceiptResult struct {
+	Success *order.ConfirmReceiptResp
+}
+
+var ConfirmReceiptResult_Success_DEFAULT *order.ConfirmReceiptResp
+
+func (p *ConfirmReceiptResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(order.ConfirmReceiptResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ConfirmReceiptResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ConfirmReceiptResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ConfirmReceiptResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ConfirmReceiptResult) Unmarshal(in []byte) error {
+	msg := new(order.ConfirmReceiptResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ConfirmReceiptResult) GetSuccess() *order.ConfirmReceiptResp {
+	if !p.IsSetSuccess() {
+		return ConfirmReceiptResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ConfirmReceiptResult) SetSuccess(x interface{}) {
+	p.Success = x.(*order.ConfirmReceiptResp)
+}
+
+func (p *ConfirmReceiptResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ConfirmReceiptResult) GetResult() interface{} {
+	return p.Success
+}
+
+func markOrderPaidHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(order.MarkOrderPaidReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(order.OrderService).MarkOrderPaid(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *MarkOrderPaidArgs:
+		success, err := handler.(order.OrderService).MarkOrderPaid(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*MarkOrderPaidResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newMarkOrderPaidArgs() interface{} {
+	return &MarkOrderPaidArgs{}
+}
+
+func newMarkOrderPaidResult() interface{} {
+	return &MarkOrderPaidResult{}
+}
+
+type MarkOrderPaidArgs struct {
+	Req *order.MarkOrderPaidReq
+}
+
+func (p *MarkOrderPaidArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(order.MarkOrderPaidReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *MarkOrderPaidArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *MarkOrderPaidArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *MarkOrderPaidArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *MarkOrderPaidArgs) Unmarshal(in []byte) error {
+	msg := new(order.MarkOrderPaidReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var MarkOrderPaidArgs_Req_DEFAULT *order.MarkOrderPaidReq
+
+func (p *MarkOrderPaidArgs) GetReq() *order.MarkOrderPaidReq {
+	if !p.IsSetReq() {
+		return MarkOrderPaidArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *MarkOrderPaidArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *MarkOrderPaidArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type MarkOrderPaidResult struct {
+	Success *order.MarkOrderPaidResp
+}
+
+var MarkOrderPaidResult_Success_DEFAULT *order.MarkOrderPaidResp
+
+func (p *MarkOrderPaidResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(order.MarkOrderPaidResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *MarkOrderPaidResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *MarkOrderPaidResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *MarkOrderPaidResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *MarkOrderPaidResult) Unmarshal(in []byte) error {
+	msg := new(order.MarkOrderPaidResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *MarkOrderPaidResult) GetSuccess() *order.MarkOrderPaidResp {
+	if !p.IsSetSuccess() {
+		return MarkOrderPaidResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *MarkOrderPaidResult) SetSuccess(x interface{}) {
+	p.Success = x.(*order.MarkOrderPaidResp)
+}
+
+func (p *MarkOrderPaidResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *MarkOrderPaidResult) GetResult() interface{} {
+	return p.Success
+}
+
+func shipOrderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(order.ShipOrderReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(order.OrderService).ShipOrder(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ShipOrderArgs:
+		success, err := handler.(order.OrderService).ShipOrder(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ShipOrderResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newShipOrderArgs() interface{} {
+	return &ShipOrderArgs{}
+}
+
+func newShipOrderResult() interface{} {
+	return &ShipOrderResult{}
+}
+
+type ShipOrderArgs struct {
+	Req *order.ShipOrderReq
+}
+
+func (p *ShipOrderArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(order.ShipOrderReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ShipOrderArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ShipOrderArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ShipOrderArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ShipOrderArgs) Unmarshal(in []byte) error {
+	msg := new(order.ShipOrderReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ShipOrderArgs_Req_DEFAULT *order.ShipOrderReq
+
+func (p *ShipOrderArgs) GetReq() *order.ShipOrderReq {
+	if !p.IsSetReq() {
+		return ShipOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ShipOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ShipOrderArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ShipOrderResult struct {
+	Success *order.ShipOrderResp
+}
+
+var ShipOrderResult_Success_DEFAULT *order.ShipOrderResp
+
+func (p *ShipOrderResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(order.ShipOrderResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ShipOrderResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ShipOrderResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ShipOrderResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ShipOrderResult) Unmarshal(in []byte) error {
+	msg := new(order.ShipOrderResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ShipOrderResult) GetSuccess() *order.ShipOrderResp {
+	if !p.IsSetSuccess() {
+		return ShipOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ShipOrderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*order.ShipOrderResp)
+}
+
+func (p *ShipOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ShipOrderResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listOrdersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(order.ListOrdersReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(order.OrderService).ListOrders(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListOrdersArgs:
+		success, err := handler.(order.OrderService).ListOrders(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListOrdersResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListOrdersArgs() interface{} {
+	return &ListOrdersArgs{}
+}
+
+func newListOrdersResult() interface{} {
+	return &ListOrdersResult{}
+}
+
+type ListOrdersArgs struct {
+	Req *order.ListOrdersReq
+}
+
+func (p *ListOrdersArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(order.ListOrdersReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListOrdersArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListOrdersArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListOrdersArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListOrdersArgs) Unmarshal(in []byte) error {
+	msg := new(order.ListOrdersReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListOrdersArgs_Req_DEFAULT *order.ListOrdersReq
+
+func (p *ListOrdersArgs) GetReq() *order.ListOrdersReq {
+	if !p.IsSetReq() {
+		return ListOrdersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListOrdersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListOrdersArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListOrdersResult struct {
+	Success *order.ListOrdersResp
+}
+
+var ListOrdersResult_Success_DEFAULT *order.ListOrdersResp
+
+func (p *ListOrdersResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(order.ListOrdersResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListOrdersResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListOrdersResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListOrdersResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListOrdersResult) Unmarshal(in []byte) error {
+	msg := new(order.ListOrdersResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListOrdersResult) GetSuccess() *order.ListOrdersResp {
+	if !p.IsSetSuccess() {
+		return ListOrdersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListOrdersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*order.ListOrdersResp)
+}
+
+func (p *ListOrdersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListOrdersResult) GetResult() interface{} {
+	return p.Success
+}
+
+func markOrderRefundedHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(order.MarkOrderRefundedReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(order.OrderService).MarkOrderRefunded(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *MarkOrderRefundedArgs:
+		success, err := handler.(order.OrderService).MarkOrderRefunded(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*MarkOrderRefundedResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newMarkOrderRefundedArgs() interface{} {
+	return &MarkOrderRefundedArgs{}
+}
+
+func newMarkOrderRefundedResult() interface{} {
+	return &MarkOrderRefundedResult{}
+}
+
+type MarkOrderRefundedArgs struct {
+	Req *order.MarkOrderRefundedReq
+}
+
+func (p *MarkOrderRefundedArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(order.MarkOrderRefundedReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *MarkOrderRefundedArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *MarkOrderRefundedArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *MarkOrderRefundedArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *MarkOrderRefundedArgs) Unmarshal(in []byte) error {
+	msg := new(order.MarkOrderRefundedReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var MarkOrderRefundedArgs_Req_DEFAULT *order.MarkOrderRefundedReq
+
+func (p *MarkOrderRefundedArgs) GetReq() *order.MarkOrderRefundedReq {
+	if !p.IsSetReq() {
+		return MarkOrderRefundedArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *MarkOrderRefundedArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *MarkOrderRefundedArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type MarkOrderRefundedResult struct {
+	Success *order.MarkOrderRefundedResp
+}
+
+var MarkOrderRefundedResult_Success_DEFAULT *order.MarkOrderRefundedResp
+
+func (p *MarkOrderRefundedResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(order.MarkOrderRefundedResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *MarkOrderRefundedResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *MarkOrderRefundedResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *MarkOrderRefundedResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *MarkOrderRefundedResult) Unmarshal(in []byte) error {
+	msg := new(order.MarkOrderRefundedResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *MarkOrderRefundedResult) GetSuccess() *order.MarkOrderRefundedResp {
+	if !p.IsSetSuccess() {
+		return MarkOrderRefundedResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *MarkOrderRefundedResult) SetSuccess(x interface{}) {
+	p.Success = x.(*order.MarkOrderRefundedResp)
+}
+
+func (p *MarkOrderRefundedResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *MarkOrderRefundedResult) GetResult() interface{} {
+	return p.Success
+}
+
+type kClient struct {
+	c client.Client
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c: c,
+	}
+}
+
+func (p *kClient) CreateOrder(ctx context.Context, Req *order.CreateOrderReq) (r *order.CreateOrderResp, err error) {
+	var _args CreateOrderArgs
+	_args.Req = Req
+	var _result CreateOrderResult
+	if err = p.c.Call(ctx, "CreateOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetOrder(ctx context.Context, Req *order.GetOrderReq) (r *order.GetOrderResp, err error) {
+	var _args GetOrderArgs
+	_args.Req = Req
+	var _result GetOrderResult
+	if err = p.c.Call(ctx, "GetOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListUserOrders(ctx context.Context, Req *order.ListUserOrdersReq) (r *order.ListOrdersResp, err error) {
+	var _args ListUserOrdersArgs
+	_args.Req = Req
+	var _result ListUserOrdersResult
+	if err = p.c.Call(ctx, "ListUserOrders", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CancelOrder(ctx context.Context, Req *order.CancelOrderReq) (r *order.CancelOrderResp, err error) {
+	var _args CancelOrderArgs
+	_args.Req = Req
+	var _result CancelOrderResult
+	if err = p.c.Call(ctx, "CancelOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ConfirmReceipt(ctx context.Context, Req *order.ConfirmReceiptReq) (r *order.ConfirmReceiptResp, err error) {
+	var _args ConfirmReceiptArgs
+	_args.Req = Req
+	var _result ConfirmReceiptResult
+	if err = p.c.Call(ctx, "ConfirmReceipt", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) MarkOrderPaid(ctx context.Context, Req *order.MarkOrderPaidReq) (r *order.MarkOrderPaidResp, err error) {
+	var _args MarkOrderPaidArgs
+	_args.Req = Req
+	var _result MarkOrderPaidResult
+	if err = p.c.Call(ctx, "MarkOrderPaid", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ShipOrder(ctx context.Context, Req *order.ShipOrderReq) (r *order.ShipOrderResp, err error) {
+	var _args ShipOrderArgs
+	_args.Req = Req
+	var _result ShipOrderResult
+	if err = p.c.Call(ctx, "ShipOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListOrders(ctx context.Context, Req *order.ListOrdersReq) (r *order.ListOrdersResp, err error) {
+	var _args ListOrdersArgs
+	_args.Req = Req
+	var _result ListOrdersResult
+	if err = p.c.Call(ctx, "ListOrders", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) MarkOrderRefunded(ctx context.Context, Req *order.MarkOrderRefundedReq) (r *order.MarkOrderRefundedResp, err error) {
+	var _args MarkOrderRefundedArgs
+	_args.Req = Req
+	var _result MarkOrderRefundedResult
+	if err = p.c.Call(ctx, "MarkOrderRefunded", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
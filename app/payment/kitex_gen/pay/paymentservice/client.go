@@ -0,0 +1,85 @@
+// Code generated by Kitex v0.9.1. DO NOT EDIT.
+
+package paymentservice
+
+import (
+	"context"
+	client "github.com/cloudwego/kitex/client"
+	callopt "github.com/cloudwego/kitex/client/callopt"
+	pay "zqzqsb/gomall/app/payment/kitex_gen/pay"
+)
+
+// Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
+type Client interface {
+	CreatePayment(ctx context.Context, Req *pay.CreatePaymentReq, callOptions ...callopt.Option) (r *pay.CreatePaymentResp, err error)
+	QueryPayment(ctx context.Context, Req *pay.QueryPaymentReq, callOptions ...callopt.Option) (r *pay.QueryPaymentResp, err error)
+	CancelPayment(ctx context.Context, Req *pay.CancelPaymentReq, callOptions ...callopt.Option) (r *pay.CancelPaymentResp, err error)
+	HandlePaymentCallback(ctx context.Context, Req *pay.PaymentCallbackReq, callOptions ...callopt.Option) (r *pay.PaymentCallbackResp, err error)
+	RefundPayment(ctx context.Context, Req *pay.RefundReq, callOptions ...callopt.Option) (r *pay.RefundResp, err error)
+	GetUserPayments(ctx context.Context, Req *pay.GetUserPaymentsReq, callOptions ...callopt.Option) (r *pay.GetUserPaymentsResp, err error)
+	CreatePaymentFromCart(ctx context.Context, Req *pay.CreatePaymentFromCartReq, callOptions ...callopt.Option) (r *pay.CreatePaymentResp, err error)
+}
+
+// NewClient creates a client for the service defined in IDL.
+func NewClient(destService string, opts ...client.Option) (Client, error) {
+	var options []client.Option
+	options = append(options, client.WithDestService(destService))
+
+	options = append(options, opts...)
+
+	kc, err := client.NewClient(serviceInfo(), options...)
+	if err != nil {
+		return nil, err
+	}
+	return &kPaymentServiceClient{
+		kClient: newServiceClient(kc),
+	}, nil
+}
+
+// MustNewClient creates a client for the service defined in IDL. It panics if any error occurs.
+func MustNewClient(destService string, opts ...client.Option) Client {
+	kc, err := NewClient(destService, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return kc
+}
+
+type kPaymentServiceClient struct {
+	*kClient
+}
+
+func (p *kPaymentServiceClient) CreatePayment(ctx context.Context, Req *pay.CreatePaymentReq, callOptions ...callopt.Option) (r *pay.CreatePaymentResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreatePayment(ctx, Req)
+}
+
+func (p *kPaymentServiceClient) QueryPayment(ctx context.Context, Req *pay.QueryPaymentReq, callOptions ...callopt.Option) (r *pay.QueryPaymentResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.QueryPayment(ctx, Req)
+}
+
+func (p *kPaymentServiceClient) CancelPayment(ctx context.Context, Req *pay.CancelPaymentReq, callOptions ...callopt.Option) (r *pay.CancelPaymentResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CancelPayment(ctx, Req)
+}
+
+func (p *kPaymentServiceClient) HandlePaymentCallback(ctx context.Context, Req *pay.PaymentCallbackReq, callOptions ...callopt.Option) (r *pay.PaymentCallbackResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.HandlePaymentCallback(ctx, Req)
+}
+
+func (p *kPaymentServiceClient) RefundPayment(ctx context.Context, Req *pay.RefundReq, callOptions ...callopt.Option) (r *pay.RefundResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RefundPayment(ctx, Req)
+}
+
+func (p *kPaymentServiceClient) GetUserPayments(ctx context.Context, Req *pay.GetUserPaymentsReq, callOptions ...callopt.Option) (r *pay.GetUserPaymentsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetUserPayments(ctx, Req)
+}
+
+func (p *kPaymentServiceClient) CreatePaymentFromCart(ctx context.Context, Req *pay.CreatePaymentFromCartReq, callOptions ...callopt.Option) (r *pay.CreatePaymentResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreatePaymentFromCart(ctx, Req)
+}
@@ -0,0 +1,1282 @@
+// Code generated by Kitex v0.9.1. DO NOT EDIT.
+
+package paymentservice
+
+import (
+	"context"
+	"errors"
+	client "github.com/cloudwego/kitex/client"
+	kitex "github.com/cloudwego/kitex/pkg/serviceinfo"
+	streaming "github.com/cloudwego/kitex/pkg/streaming"
+	proto "google.golang.org/protobuf/proto"
+	pay "zqzqsb/gomall/app/payment/kitex_gen/pay"
+)
+
+var errInvalidMessageType = errors.New("invalid message type for service method handler")
+
+var serviceMethods = map[string]kitex.MethodInfo{
+	"CreatePayment": kitex.NewMethodInfo(
+		createPaymentHandler,
+		newCreatePaymentArgs,
+		newCreatePaymentResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"QueryPayment": kitex.NewMethodInfo(
+		queryPaymentHandler,
+		newQueryPaymentArgs,
+		newQueryPaymentResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"CancelPayment": kitex.NewMethodInfo(
+		cancelPaymentHandler,
+		newCancelPaymentArgs,
+		newCancelPaymentResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"HandlePaymentCallback": kitex.NewMethodInfo(
+		handlePaymentCallbackHandler,
+		newHandlePaymentCallbackArgs,
+		newHandlePaymentCallbackResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RefundPayment": kitex.NewMethodInfo(
+		refundPaymentHandler,
+		newRefundPaymentArgs,
+		newRefundPaymentResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"GetUserPayments": kitex.NewMethodInfo(
+		getUserPaymentsHandler,
+		newGetUserPaymentsArgs,
+		newGetUserPaymentsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"CreatePaymentFromCart": kitex.NewMethodInfo(
+		createPaymentFromCartHandler,
+		newCreatePaymentFromCartArgs,
+		newCreatePaymentFromCartResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+}
+
+var (
+	paymentServiceServiceInfo                = NewServiceInfo()
+	paymentServiceServiceInfoForClient       = NewServiceInfoForClient()
+	paymentServiceServiceInfoForStreamClient = NewServiceInfoForStreamClient()
+)
+
+// for server
+func serviceInfo() *kitex.ServiceInfo {
+	return paymentServiceServiceInfo
+}
+
+// for client
+func serviceInfoForStreamClient() *kitex.ServiceInfo {
+	return paymentServiceServiceInfoForStreamClient
+}
+
+// for stream client
+func serviceInfoForClient() *kitex.ServiceInfo {
+	return paymentServiceServiceInfoForClient
+}
+
+// NewServiceInfo creates a new ServiceInfo containing all methods
+func NewServiceInfo() *kitex.ServiceInfo {
+	return newServiceInfo(false, true, true)
+}
+
+// NewServiceInfo creates a new ServiceInfo containing non-streaming methods
+func NewServiceInfoForClient() *kitex.ServiceInfo {
+	return newServiceInfo(false, false, true)
+}
+func NewServiceInfoForStreamClient() *kitex.ServiceInfo {
+	return newServiceInfo(true, true, false)
+}
+
+func newServiceInfo(hasStreaming bool, keepStreamingMethods bool, keepNonStreamingMethods bool) *kitex.ServiceInfo {
+	serviceName := "PaymentService"
+	handlerType := (*pay.PaymentService)(nil)
+	methods := map[string]kitex.MethodInfo{}
+	for name, m := range serviceMethods {
+		if m.IsStreaming() && !keepStreamingMethods {
+			continue
+		}
+		if !m.IsStreaming() && !keepNonStreamingMethods {
+			continue
+		}
+		methods[name] = m
+	}
+	extra := map[string]interface{}{
+		"PackageName": "pay",
+	}
+	if hasStreaming {
+		extra["streaming"] = hasStreaming
+	}
+	svcInfo := &kitex.ServiceInfo{
+		ServiceName:     serviceName,
+		HandlerType:     handlerType,
+		Methods:         methods,
+		PayloadCodec:    kitex.Protobuf,
+		KiteXGenVersion: "v0.9.1",
+		Extra:           extra,
+	}
+	return svcInfo
+}
+
+func createPaymentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(pay.CreatePaymentReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(pay.PaymentService).CreatePayment(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CreatePaymentArgs:
+		success, err := handler.(pay.PaymentService).CreatePayment(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CreatePaymentResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCreatePaymentArgs() interface{} {
+	return &CreatePaymentArgs{}
+}
+
+func newCreatePaymentResult() interface{} {
+	return &CreatePaymentResult{}
+}
+
+type CreatePaymentArgs struct {
+	Req *pay.CreatePaymentReq
+}
+
+func (p *CreatePaymentArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(pay.CreatePaymentReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *CreatePaymentArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *CreatePaymentArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *CreatePaymentArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CreatePaymentArgs) Unmarshal(in []byte) error {
+	msg := new(pay.CreatePaymentReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CreatePaymentArgs_Req_DEFAULT *pay.CreatePaymentReq
+
+func (p *CreatePaymentArgs) GetReq() *pay.CreatePaymentReq {
+	if !p.IsSetReq() {
+		return CreatePaymentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CreatePaymentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CreatePaymentArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CreatePaymentResult struct {
+	Success *pay.CreatePaymentResp
+}
+
+var CreatePaymentResult_Success_DEFAULT *pay.CreatePaymentResp
+
+func (p *CreatePaymentResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(pay.CreatePaymentResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *CreatePaymentResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *CreatePaymentResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *CreatePaymentResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CreatePaymentResult) Unmarshal(in []byte) error {
+	msg := new(pay.CreatePaymentResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CreatePaymentResult) GetSuccess() *pay.CreatePaymentResp {
+	if !p.IsSetSuccess() {
+		return CreatePaymentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CreatePaymentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*pay.CreatePaymentResp)
+}
+
+func (p *CreatePaymentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CreatePaymentResult) GetResult() interface{} {
+	return p.Success
+}
+
+func queryPaymentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(pay.QueryPaymentReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(pay.PaymentService).QueryPayment(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *QueryPaymentArgs:
+		success, err := handler.(pay.PaymentService).QueryPayment(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*QueryPaymentResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newQueryPaymentArgs() interface{} {
+	return &QueryPaymentArgs{}
+}
+
+func newQueryPaymentResult() interface{} {
+	return &QueryPaymentResult{}
+}
+
+type QueryPaymentArgs struct {
+	Req *pay.QueryPaymentReq
+}
+
+func (p *QueryPaymentArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(pay.QueryPaymentReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *QueryPaymentArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *QueryPaymentArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *QueryPaymentArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *QueryPaymentArgs) Unmarshal(in []byte) error {
+	msg := new(pay.QueryPaymentReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var QueryPaymentArgs_Req_DEFAULT *pay.QueryPaymentReq
+
+func (p *QueryPaymentArgs) GetReq() *pay.QueryPaymentReq {
+	if !p.IsSetReq() {
+		return QueryPaymentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *QueryPaymentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *QueryPaymentArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type QueryPaymentResult struct {
+	Success *pay.QueryPaymentResp
+}
+
+var QueryPaymentResult_Success_DEFAULT *pay.QueryPaymentResp
+
+func (p *QueryPaymentResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(pay.QueryPaymentResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *QueryPaymentResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *QueryPaymentResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *QueryPaymentResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *QueryPaymentResult) Unmarshal(in []byte) error {
+	msg := new(pay.QueryPaymentResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *QueryPaymentResult) GetSuccess() *pay.QueryPaymentResp {
+	if !p.IsSetSuccess() {
+		return QueryPaymentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *QueryPaymentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*pay.QueryPaymentResp)
+}
+
+func (p *QueryPaymentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *QueryPaymentResult) GetResult() interface{} {
+	return p.Success
+}
+
+func cancelPaymentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(pay.CancelPaymentReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(pay.PaymentService).CancelPayment(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CancelPaymentArgs:
+		success, err := handler.(pay.PaymentService).CancelPayment(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CancelPaymentResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCancelPaymentArgs() interface{} {
+	return &CancelPaymentArgs{}
+}
+
+func newCancelPaymentResult() interface{} {
+	return &CancelPaymentResult{}
+}
+
+type CancelPaymentArgs struct {
+	Req *pay.CancelPaymentReq
+}
+
+func (p *CancelPaymentArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(pay.CancelPaymentReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *CancelPaymentArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *CancelPaymentArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *CancelPaymentArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CancelPaymentArgs) Unmarshal(in []byte) error {
+	msg := new(pay.CancelPaymentReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CancelPaymentArgs_Req_DEFAULT *pay.CancelPaymentReq
+
+func (p *CancelPaymentArgs) GetReq() *pay.CancelPaymentReq {
+	if !p.IsSetReq() {
+		return CancelPaymentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CancelPaymentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CancelPaymentArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CancelPaymentResult struct {
+	Success *pay.CancelPaymentResp
+}
+
+var CancelPaymentResult_Success_DEFAULT *pay.CancelPaymentResp
+
+func (p *CancelPaymentResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(pay.CancelPaymentResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *CancelPaymentResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *CancelPaymentResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *CancelPaymentResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CancelPaymentResult) Unmarshal(in []byte) error {
+	msg := new(pay.CancelPaymentResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CancelPaymentResult) GetSuccess() *pay.CancelPaymentResp {
+	if !p.IsSetSuccess() {
+		return CancelPaymentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CancelPaymentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*pay.CancelPaymentResp)
+}
+
+func (p *CancelPaymentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CancelPaymentResult) GetResult() interface{} {
+	return p.Success
+}
+
+func handlePaymentCallbackHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(pay.PaymentCallbackReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(pay.PaymentService).HandlePaymentCallback(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *HandlePaymentCallbackArgs:
+		success, err := handler.(pay.PaymentService).HandlePaymentCallback(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*HandlePaymentCallbackResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newHandlePaymentCallbackArgs() interface{} {
+	return &HandlePaymentCallbackArgs{}
+}
+
+func newHandlePaymentCallbackResult() interface{} {
+	return &HandlePaymentCallbackResult{}
+}
+
+type HandlePaymentCallbackArgs struct {
+	Req *pay.PaymentCallbackReq
+}
+
+func (p *HandlePaymentCallbackArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(pay.PaymentCallbackReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *HandlePaymentCallbackArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *HandlePaymentCallbackArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *HandlePaymentCallbackArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *HandlePaymentCallbackArgs) Unmarshal(in []byte) error {
+	msg := new(pay.PaymentCallbackReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var HandlePaymentCallbackArgs_Req_DEFAULT *pay.PaymentCallbackReq
+
+func (p *HandlePaymentCallbackArgs) GetReq() *pay.PaymentCallbackReq {
+	if !p.IsSetReq() {
+		return HandlePaymentCallbackArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *HandlePaymentCallbackArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *HandlePaymentCallbackArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type HandlePaymentCallbackResult struct {
+	Success *pay.PaymentCallbackResp
+}
+
+var HandlePaymentCallbackResult_Success_DEFAULT *pay.PaymentCallbackResp
+
+func (p *HandlePaymentCallbackResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(pay.PaymentCallbackResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *HandlePaymentCallbackResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *HandlePaymentCallbackResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *HandlePaymentCallbackResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *HandlePaymentCallbackResult) Unmarshal(in []byte) error {
+	msg := new(pay.PaymentCallbackResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *HandlePaymentCallbackResult) GetSuccess() *pay.PaymentCallbackResp {
+	if !p.IsSetSuccess() {
+		return HandlePaymentCallbackResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *HandlePaymentCallbackResult) SetSuccess(x interface{}) {
+	p.Success = x.(*pay.PaymentCallbackResp)
+}
+
+func (p *HandlePaymentCallbackResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *HandlePaymentCallbackResult) GetResult() interface{} {
+	return p.Success
+}
+
+func refundPaymentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(pay.RefundReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(pay.PaymentService).RefundPayment(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *RefundPaymentArgs:
+		success, err := handler.(pay.PaymentService).RefundPayment(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RefundPaymentResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newRefundPaymentArgs() interface{} {
+	return &RefundPaymentArgs{}
+}
+
+func newRefundPaymentResult() interface{} {
+	return &RefundPaymentResult{}
+}
+
+type RefundPaymentArgs struct {
+	Req *pay.RefundReq
+}
+
+func (p *RefundPaymentArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(pay.RefundReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RefundPaymentArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RefundPaymentArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RefundPaymentArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RefundPaymentArgs) Unmarshal(in []byte) error {
+	msg := new(pay.RefundReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RefundPaymentArgs_Req_DEFAULT *pay.RefundReq
+
+func (p *RefundPaymentArgs) GetReq() *pay.RefundReq {
+	if !p.IsSetReq() {
+		return RefundPaymentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RefundPaymentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RefundPaymentArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RefundPaymentResult struct {
+	Success *pay.RefundResp
+}
+
+var RefundPaymentResult_Success_DEFAULT *pay.RefundResp
+
+func (p *RefundPaymentResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(pay.RefundResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RefundPaymentResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RefundPaymentResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RefundPaymentResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RefundPaymentResult) Unmarshal(in []byte) error {
+	msg := new(pay.RefundResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RefundPaymentResult) GetSuccess() *pay.RefundResp {
+	if !p.IsSetSuccess() {
+		return RefundPaymentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RefundPaymentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*pay.RefundResp)
+}
+
+func (p *RefundPaymentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RefundPaymentResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getUserPaymentsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(pay.GetUserPaymentsReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(pay.PaymentService).GetUserPayments(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetUserPaymentsArgs:
+		success, err := handler.(pay.PaymentService).GetUserPayments(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetUserPaymentsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetUserPaymentsArgs() interface{} {
+	return &GetUserPaymentsArgs{}
+}
+
+func newGetUserPaymentsResult() interface{} {
+	return &GetUserPaymentsResult{}
+}
+
+type GetUserPaymentsArgs struct {
+	Req *pay.GetUserPaymentsReq
+}
+
+func (p *GetUserPaymentsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(pay.GetUserPaymentsReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetUserPaymentsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetUserPaymentsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetUserPaymentsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetUserPaymentsArgs) Unmarshal(in []byte) error {
+	msg := new(pay.GetUserPaymentsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetUserPaymentsArgs_Req_DEFAULT *pay.GetUserPaymentsReq
+
+func (p *GetUserPaymentsArgs) GetReq() *pay.GetUserPaymentsReq {
+	if !p.IsSetReq() {
+		return GetUserPaymentsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetUserPaymentsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetUserPaymentsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetUserPaymentsResult struct {
+	Success *pay.GetUserPaymentsResp
+}
+
+var GetUserPaymentsResult_Success_DEFAULT *pay.GetUserPaymentsResp
+
+func (p *GetUserPaymentsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(pay.GetUserPaymentsResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetUserPaymentsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetUserPaymentsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetUserPaymentsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetUserPaymentsResult) Unmarshal(in []byte) error {
+	msg := new(pay.GetUserPaymentsResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetUserPaymentsResult) GetSuccess() *pay.GetUserPaymentsResp {
+	if !p.IsSetSuccess() {
+		return GetUserPaymentsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetUserPaymentsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*pay.GetUserPaymentsResp)
+}
+
+func (p *GetUserPaymentsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetUserPaymentsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func createPaymentFromCartHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(pay.CreatePaymentFromCartReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(pay.PaymentService).CreatePaymentFromCart(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CreatePaymentFromCartArgs:
+		success, err := handler.(pay.PaymentService).CreatePaymentFromCart(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CreatePaymentFromCartResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCreatePaymentFromCartArgs() interface{} {
+	return &CreatePaymentFromCartArgs{}
+}
+
+func newCreatePaymentFromCartResult() interface{} {
+	return &CreatePaymentFromCartResult{}
+}
+
+type CreatePaymentFromCartArgs struct {
+	Req *pay.CreatePaymentFromCartReq
+}
+
+func (p *CreatePaymentFromCartArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(pay.CreatePaymentFromCartReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *CreatePaymentFromCartArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *CreatePaymentFromCartArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *CreatePaymentFromCartArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CreatePaymentFromCartArgs) Unmarshal(in []byte) error {
+	msg := new(pay.CreatePaymentFromCartReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CreatePaymentFromCartArgs_Req_DEFAULT *pay.CreatePaymentFromCartReq
+
+func (p *CreatePaymentFromCartArgs) GetReq() *pay.CreatePaymentFromCartReq {
+	if !p.IsSetReq() {
+		return CreatePaymentFromCartArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CreatePaymentFromCartArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CreatePaymentFromCartArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CreatePaymentFromCartResult struct {
+	Success *pay.CreatePaymentResp
+}
+
+var CreatePaymentFromCartResult_Success_DEFAULT *pay.CreatePaymentResp
+
+func (p *CreatePaymentFromCartResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(pay.CreatePaymentResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *CreatePaymentFromCartResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *CreatePaymentFromCartResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *CreatePaymentFromCartResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CreatePaymentFromCartResult) Unmarshal(in []byte) error {
+	msg := new(pay.CreatePaymentResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CreatePaymentFromCartResult) GetSuccess() *pay.CreatePaymentResp {
+	if !p.IsSetSuccess() {
+		return CreatePaymentFromCartResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CreatePaymentFromCartResult) SetSuccess(x interface{}) {
+	p.Success = x.(*pay.CreatePaymentResp)
+}
+
+func (p *CreatePaymentFromCartResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CreatePaymentFromCartResult) GetResult() interface{} {
+	return p.Success
+}
+
+type kClient struct {
+	c client.Client
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c: c,
+	}
+}
+
+func (p *kClient) CreatePayment(ctx context.Context, Req *pay.CreatePaymentReq) (r *pay.CreatePaymentResp, err error) {
+	var _args CreatePaymentArgs
+	_args.Req = Req
+	var _result CreatePaymentResult
+	if err = p.c.Call(ctx, "CreatePayment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) QueryPayment(ctx context.Context, Req *pay.QueryPaymentReq) (r *pay.QueryPaymentResp, err error) {
+	var _args QueryPaymentArgs
+	_args.Req = Req
+	var _result QueryPaymentResult
+	if err = p.c.Call(ctx, "QueryPayment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CancelPayment(ctx context.Context, Req *pay.CancelPaymentReq) (r *pay.CancelPaymentResp, err error) {
+	var _args CancelPaymentArgs
+	_args.Req = Req
+	var _result CancelPaymentResult
+	if err = p.c.Call(ctx, "CancelPayment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) HandlePaymentCallback(ctx context.Context, Req *pay.PaymentCallbackReq) (r *pay.PaymentCallbackResp, err error) {
+	var _args HandlePaymentCallbackArgs
+	_args.Req = Req
+	var _result HandlePaymentCallbackResult
+	if err = p.c.Call(ctx, "HandlePaymentCallback", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RefundPayment(ctx context.Context, Req *pay.RefundReq) (r *pay.RefundResp, err error) {
+	var _args RefundPaymentArgs
+	_args.Req = Req
+	var _result RefundPaymentResult
+	if err = p.c.Call(ctx, "RefundPayment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetUserPayments(ctx context.Context, Req *pay.GetUserPaymentsReq) (r *pay.GetUserPaymentsResp, err error) {
+	var _args GetUserPaymentsArgs
+	_args.Req = Req
+	var _result GetUserPaymentsResult
+	if err = p.c.Call(ctx, "GetUserPayments", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreatePaymentFromCart(ctx context.Context, Req *pay.CreatePaymentFromCartReq) (r *pay.CreatePaymentResp, err error) {
+	var _args CreatePaymentFromCartArgs
+	_args.Req = Req
+	var _result CreatePaymentFromCartResult
+	if err = p.c.Call(ctx, "CreatePaymentFromCart", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -0,0 +1,4 @@
+#! /usr/bin/env bash
+CURDIR=$(cd $(dirname $0); pwd)
+echo "$CURDIR/bin/payment"
+exec "$CURDIR/bin/payment"
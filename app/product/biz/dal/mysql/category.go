@@ -0,0 +1,198 @@
+package mysql
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"zqzqsb/gomall/app/product/biz/model"
+)
+
+var (
+	ErrCategoryNotFound    = errors.New("category not found")
+	ErrCategorySlugExists  = errors.New("category slug already exists")
+	ErrCategoryHasChildren = errors.New("category has children")
+	ErrCategoryInUse       = errors.New("category has products")
+	ErrCategoryCycle       = errors.New("category cannot be moved under itself or its descendants")
+	ErrCategoryTooDeep     = errors.New("category tree is too deep")
+)
+
+// ListCategories 获取全部分类，同级分类按 sort_order、id 排序
+func ListCategories(db *gorm.DB) ([]*model.Category, error) {
+	var categories []*model.Category
+	err := db.Order("sort_order, id").Find(&categories).Error
+	return categories, err
+}
+
+// GetCategoryByID 根据ID获取分类
+func GetCategoryByID(db *gorm.DB, id int64) (*model.Category, error) {
+	var category model.Category
+	if err := db.First(&category, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCategoryNotFound
+		}
+		return nil, err
+	}
+	return &category, nil
+}
+
+// FindCategory 按 slug 或名称查找分类，兼容按分类名称传参的旧接口
+func FindCategory(db *gorm.DB, key string) (*model.Category, error) {
+	var category model.Category
+	err := db.Where("slug = ?", key).Or("name = ?", key).Order("id").First(&category).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCategoryNotFound
+		}
+		return nil, err
+	}
+	return &category, nil
+}
+
+// subtreeIDs 分类及其全部子孙分类ID的子查询
+func subtreeIDs(db *gorm.DB, c *model.Category) *gorm.DB {
+	return db.Model(&model.Category{}).Select("id").Where("path LIKE ?", c.Path+"%")
+}
+
+func lockCategory(tx *gorm.DB, id int64) (*model.Category, error) {
+	var category model.Category
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&category, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCategoryNotFound
+		}
+		return nil, err
+	}
+	return &category, nil
+}
+
+// parentPath 获取父分类路径，顶级分类的父路径为 /
+func parentPath(tx *gorm.DB, parentID int64) (string, error) {
+	if parentID == 0 {
+		return "/", nil
+	}
+	parent, err := lockCategory(tx, parentID)
+	if err != nil {
+		return "", err
+	}
+	return parent.Path, nil
+}
+
+func checkSlug(tx *gorm.DB, slug string, id int64) error {
+	var count int64
+	if err := tx.Model(&model.Category{}).Where("slug = ? AND id <> ?", slug, id).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrCategorySlugExists
+	}
+	return nil
+}
+
+// CreateCategory 创建分类，插入后根据自增ID生成路径
+func CreateCategory(db *gorm.DB, c *model.Category) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		path, err := parentPath(tx, c.ParentID)
+		if err != nil {
+			return err
+		}
+		if strings.Count(path, "/") > model.MaxCategoryDepth {
+			return ErrCategoryTooDeep
+		}
+		if err = checkSlug(tx, c.Slug, 0); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		c.CreatedAt = now
+		c.UpdatedAt = now
+		if err = tx.Create(c).Error; err != nil {
+			return err
+		}
+		c.Path = model.CategoryPath(path, c.ID)
+		return tx.Model(c).Update("path", c.Path).Error
+	})
+}
+
+// UpdateCategory 更新分类，父分类变化时移动整个子树并重写子孙分类的路径，
+// 名称变化时同步更新所属商品冗余的分类名称
+func UpdateCategory(db *gorm.DB, c *model.Category) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		current, err := lockCategory(tx, c.ID)
+		if err != nil {
+			return err
+		}
+		if err = checkSlug(tx, c.Slug, c.ID); err != nil {
+			return err
+		}
+
+		c.Path = current.Path
+		c.CreatedAt = current.CreatedAt
+		c.UpdatedAt = time.Now()
+		if c.ParentID != current.ParentID {
+			path, err := parentPath(tx, c.ParentID)
+			if err != nil {
+				return err
+			}
+			if strings.HasPrefix(path, current.Path) {
+				return ErrCategoryCycle
+			}
+
+			// 子树中最深的分类移动后也不能超过层级限制
+			var descendants []*model.Category
+			if err = tx.Select("path").Where("path LIKE ?", current.Path+"%").Find(&descendants).Error; err != nil {
+				return err
+			}
+			height := 0
+			for _, d := range descendants {
+				if h := d.Depth() - current.Depth(); h > height {
+					height = h
+				}
+			}
+			if strings.Count(path, "/")+height > model.MaxCategoryDepth {
+				return ErrCategoryTooDeep
+			}
+
+			c.Path = model.CategoryPath(path, c.ID)
+			err = tx.Model(&model.Category{}).Where("path LIKE ?", current.Path+"%").
+				Update("path", gorm.Expr("CONCAT(?, SUBSTRING(path, ?))", c.Path, len(current.Path)+1)).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		err = tx.Model(c).Select("parent_id", "name", "slug", "icon", "sort_order", "path", "updated_at").Updates(c).Error
+		if err != nil {
+			return err
+		}
+		if c.Name != current.Name {
+			return tx.Model(&model.Product{}).Unscoped().Where("category_id = ?", c.ID).Update("category", c.Name).Error
+		}
+		return nil
+	})
+}
+
+// DeleteCategory 删除分类，有子分类或仍有商品（含已删除商品）关联的分类不能删除
+func DeleteCategory(db *gorm.DB, id int64) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if _, err := lockCategory(tx, id); err != nil {
+			return err
+		}
+
+		var count int64
+		if err := tx.Model(&model.Category{}).Where("parent_id = ?", id).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrCategoryHasChildren
+		}
+		if err := tx.Model(&model.Product{}).Unscoped().Where("category_id = ?", id).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrCategoryInUse
+		}
+		return tx.Delete(&model.Category{}, id).Error
+	})
+}
@@ -0,0 +1,51 @@
+package mysql
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"zqzqsb/gomall/app/product/biz/model"
+)
+
+// GetProductByExternalCode 根据外部 SKU 编码获取 ownerID 名下的商品，包括已删除的商品
+func GetProductByExternalCode(db *gorm.DB, ownerID int64, code string) (*model.Product, error) {
+	var product model.Product
+	if err := db.Unscoped().Where("owner_id = ? AND external_code = ?", ownerID, code).First(&product).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrProductNotFound
+		}
+		return nil, err
+	}
+	return &product, nil
+}
+
+// ImportProduct 用导入的字段覆盖已有商品并递增版本号，已删除的商品同时撤销删除
+func ImportProduct(db *gorm.DB, id int64, fields map[string]interface{}) error {
+	updates := make(map[string]interface{}, len(fields)+3)
+	for k, v := range fields {
+		updates[k] = v
+	}
+	updates["deleted_at"] = nil
+	updates["version"] = gorm.Expr("version + 1")
+	updates["updated_at"] = time.Now()
+	return db.Unscoped().Model(&model.Product{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// ScanCategoryProducts 按 ID 升序分批遍历分类及其子分类下的商品，category 为 nil 时遍历全部商品
+// ownerID 大于0时只遍历该用户的商品
+func ScanCategoryProducts(db *gorm.DB, category *model.Category, ownerID, afterID int64, limit int) ([]*model.Product, error) {
+	if category == nil && ownerID <= 0 {
+		return ScanProducts(db, afterID, limit)
+	}
+	var products []*model.Product
+	query := db.Model(&model.Product{})
+	if category != nil {
+		query = categoryFilter(db, query, category)
+	}
+	if ownerID > 0 {
+		query = query.Where("owner_id = ?", ownerID)
+	}
+	err := query.Where("id > ?", afterID).Order("id").Limit(limit).Find(&products).Error
+	return products, err
+}
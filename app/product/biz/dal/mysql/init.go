@@ -1,6 +1,7 @@
 package mysql
 
 import (
+	"zqzqsb/gomall/app/product/biz/model"
 	"zqzqsb/gomall/app/product/conf"
 
 	"gorm.io/driver/mysql"
@@ -22,4 +23,19 @@ func Init() {
 	if err != nil {
 		panic(err)
 	}
+	if err = DB.AutoMigrate(&model.Product{}, &model.StockReservation{}, &model.ProductOption{}, &model.Sku{}, &model.Category{}, &model.ProductRevision{}); err != nil {
+		panic(err)
+	}
+	// 预占记录改为按 SKU 区分后，旧的 (token, product_id) 唯一索引会阻止同一商品的多个 SKU 一起预占
+	if DB.Migrator().HasIndex(&model.StockReservation{}, "idx_token_product") {
+		if err = DB.Migrator().DropIndex(&model.StockReservation{}, "idx_token_product"); err != nil {
+			panic(err)
+		}
+	}
+	// 外部编码改为按商品所有者唯一后，旧的全局唯一索引会阻止不同商家使用相同的编码
+	if DB.Migrator().HasIndex(&model.Product{}, "idx_products_external_code") {
+		if err = DB.Migrator().DropIndex(&model.Product{}, "idx_products_external_code"); err != nil {
+			panic(err)
+		}
+	}
 }
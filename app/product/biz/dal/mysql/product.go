@@ -10,6 +10,15 @@ import (
 	product "zqzqsb/gomall/app/product/kitex_gen/product"
 )
 
+var (
+	// ErrProductNotFound 商品不存在或已删除
+	ErrProductNotFound = errors.New("product not found")
+	// ErrVersionConflict 商品在读取之后已被其他请求修改
+	ErrVersionConflict = errors.New("product version conflict")
+	// ErrVersionRequired 更新商品时没有携带读取时的版本号
+	ErrVersionRequired = errors.New("product version is required")
+)
+
 // CreateProduct 创建商品
 func CreateProduct(db *gorm.DB, p *model.Product) (int64, error) {
 	now := time.Now()
@@ -29,18 +38,86 @@ func GetProductByID(db *gorm.DB, id int64) (*model.Product, error) {
 	result := db.First(&product, id)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			return nil, errors.New("product not found")
+			return nil, ErrProductNotFound
 		}
 		return nil, result.Error
 	}
 	return &product, nil
 }
 
-// UpdateProduct 更新商品信息
-func UpdateProduct(db *gorm.DB, p *model.Product) error {
-	p.UpdatedAt = time.Now()
-	result := db.Save(p)
-	return result.Error
+// GetProductOwner 获取商品的所有者ID，包括已删除的商品，用于撤销删除和查看历史时校验归属
+func GetProductOwner(db *gorm.DB, id int64) (int64, error) {
+	var product model.Product
+	result := db.Unscoped().Select("id", "owner_id").First(&product, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return 0, ErrProductNotFound
+		}
+		return 0, result.Error
+	}
+	return product.OwnerID, nil
+}
+
+// GetProductsByIDs 批量获取商品，按 ids 的顺序返回，不存在或已删除的商品被忽略
+func GetProductsByIDs(db *gorm.DB, ids []int64) ([]*model.Product, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	var products []*model.Product
+	if err := db.Where("id IN ?", ids).Find(&products).Error; err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]*model.Product, len(products))
+	for _, p := range products {
+		byID[p.ID] = p
+	}
+	result := make([]*model.Product, 0, len(products))
+	for _, id := range ids {
+		if p, ok := byID[id]; ok {
+			result = append(result, p)
+		}
+	}
+	return result, nil
+}
+
+// ScanProducts 按 ID 升序分批遍历商品，返回 ID 大于 afterID 的至多 limit 个商品
+func ScanProducts(db *gorm.DB, afterID int64, limit int) ([]*model.Product, error) {
+	var products []*model.Product
+	err := db.Where("id > ?", afterID).Order("id").Limit(limit).Find(&products).Error
+	return products, err
+}
+
+// UpdateProduct 更新商品的指定字段并递增版本号，返回更新后的版本号
+// version 作为乐观锁必须大于0，只在与当前版本一致时更新，否则返回 ErrVersionConflict
+func UpdateProduct(db *gorm.DB, id, version int64, fields map[string]interface{}) (int64, error) {
+	if version <= 0 {
+		return 0, ErrVersionRequired
+	}
+	var current int64
+	err := db.Transaction(func(tx *gorm.DB) error {
+		updates := make(map[string]interface{}, len(fields)+2)
+		for column, value := range fields {
+			updates[column] = value
+		}
+		updates["version"] = gorm.Expr("version + 1")
+		updates["updated_at"] = time.Now()
+
+		result := tx.Model(&model.Product{}).Where("id = ? AND version = ?", id, version).Updates(updates)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			if _, err := GetProductByID(tx, id); err != nil {
+				return err
+			}
+			return ErrVersionConflict
+		}
+		return tx.Model(&model.Product{}).Select("version").Where("id = ?", id).Scan(&current).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+	return current, nil
 }
 
 // DeleteProduct 删除商品
@@ -50,24 +127,73 @@ func DeleteProduct(db *gorm.DB, id int64) error {
 		return result.Error
 	}
 	if result.RowsAffected == 0 {
-		return errors.New("product not found")
+		return ErrProductNotFound
 	}
 	return nil
 }
 
-// ListProducts 获取商品列表
-func ListProducts(db *gorm.DB, req *product.ListProductsReq) ([]*model.Product, int64, error) {
-	var products []*model.Product
-	var count int64
+// ListOptions 商品列表的附加查询条件
+type ListOptions struct {
+	MatchedIDs []int64 // 搜索索引按关键词命中的商品，不为 nil 时代替 LIKE 匹配关键词
+	After      *Keyset // 游标分页时上一页最后一个商品的排序值，不为 nil 时忽略 page
+	SkipTotal  bool    // 不统计总数，返回的总数为0
+}
+
+// Keyset 排序字段的值和商品ID，排序字段相同的商品按ID排序
+type Keyset struct {
+	Value interface{}
+	ID    int64
+}
+
+// SortOrder 排序字段对应的列和方向，未指定或不支持的排序字段按ID降序排序
+func SortOrder(req *product.ListProductsReq) (column string, asc bool) {
+	switch req.SortBy {
+	case "price":
+		return "price", req.Ascending
+	case "create_time":
+		return "created_at", req.Ascending
+	case "sales":
+		return "sales_count", req.Ascending
+	case "rating":
+		return "rating", req.Ascending
+	case "id":
+		return "id", req.Ascending
+	default:
+		return "id", false // 默认按ID降序排序
+	}
+}
 
+// ListProducts 获取商品列表，hasMore 表示当前页之后是否还有商品
+// 游标分页按 (排序字段, id) 定位上一页末尾，不受翻页期间新增商品的影响，也无需扫描前面各页
+func ListProducts(db *gorm.DB, req *product.ListProductsReq, opts ListOptions) (products []*model.Product, count int64, hasMore bool, err error) {
 	query := db
 
-	// 应用筛选条件
-	if req.Category != "" {
-		query = query.Where("category = ?", req.Category)
+	// 应用筛选条件，按分类筛选时包含全部子分类下的商品
+	switch {
+	case req.CategoryId > 0:
+		category, err := GetCategoryByID(db, req.CategoryId)
+		if err != nil {
+			return nil, 0, false, err
+		}
+		query = categoryFilter(db, query, category)
+	case req.Category != "":
+		category, err := FindCategory(db, req.Category)
+		if errors.Is(err, ErrCategoryNotFound) {
+			// 未关联分类表的商品仍按分类名称匹配
+			query = query.Where("category = ?", req.Category)
+		} else if err != nil {
+			return nil, 0, false, err
+		} else {
+			query = categoryFilter(db, query, category)
+		}
 	}
 
-	if req.Keyword != "" {
+	if opts.MatchedIDs != nil {
+		if len(opts.MatchedIDs) == 0 {
+			return nil, 0, false, nil
+		}
+		query = query.Where("id IN ?", opts.MatchedIDs)
+	} else if req.Keyword != "" {
 		query = query.Where("name LIKE ? OR description LIKE ?", "%"+req.Keyword+"%", "%"+req.Keyword+"%")
 	}
 
@@ -84,39 +210,22 @@ func ListProducts(db *gorm.DB, req *product.ListProductsReq) ([]*model.Product,
 	}
 
 	// 获取总数
-	if err := query.Model(&model.Product{}).Count(&count).Error; err != nil {
-		return nil, 0, err
+	if !opts.SkipTotal {
+		if err = query.Model(&model.Product{}).Count(&count).Error; err != nil {
+			return nil, 0, false, err
+		}
 	}
 
-	// 应用排序
-	switch req.SortBy {
-	case "price":
-		if req.Ascending {
-			query = query.Order("price ASC")
-		} else {
-			query = query.Order("price DESC")
-		}
-	case "create_time":
-		if req.Ascending {
-			query = query.Order("created_at ASC")
-		} else {
-			query = query.Order("created_at DESC")
-		}
-	case "sales":
-		if req.Ascending {
-			query = query.Order("sales_count ASC")
-		} else {
-			query = query.Order("sales_count DESC")
-		}
-	case "rating":
-		if req.Ascending {
-			query = query.Order("rating ASC")
-		} else {
-			query = query.Order("rating DESC")
-		}
-	default:
-		query = query.Order("id DESC") // 默认按ID降序排序
+	// 应用排序，排序字段相同时按ID排序，保证翻页顺序稳定
+	column, asc := SortOrder(req)
+	direction, op := "DESC", "<"
+	if asc {
+		direction, op = "ASC", ">"
+	}
+	if column != "id" {
+		query = query.Order(column + " " + direction)
 	}
+	query = query.Order("id " + direction)
 
 	// 分页
 	pageSize := int(req.PageSize)
@@ -124,30 +233,49 @@ func ListProducts(db *gorm.DB, req *product.ListProductsReq) ([]*model.Product,
 		pageSize = 10 // 默认每页10条
 	}
 
-	page := int(req.Page)
-	if page <= 0 {
-		page = 1 // 默认第1页
+	if opts.After != nil {
+		if column == "id" {
+			query = query.Where("id "+op+" ?", opts.After.ID)
+		} else {
+			query = query.Where(column+" "+op+" ? OR ("+column+" = ? AND id "+op+" ?)",
+				opts.After.Value, opts.After.Value, opts.After.ID)
+		}
+	} else {
+		page := int(req.Page)
+		if page <= 0 {
+			page = 1 // 默认第1页
+		}
+		query = query.Offset((page - 1) * pageSize)
 	}
-
-	offset := (page - 1) * pageSize
-	query = query.Offset(offset).Limit(pageSize)
+	// 多取一条判断是否还有下一页
+	query = query.Limit(pageSize + 1)
 
 	// 执行查询
-	if err := query.Find(&products).Error; err != nil {
-		return nil, 0, err
+	if err = query.Find(&products).Error; err != nil {
+		return nil, 0, false, err
+	}
+	if len(products) > pageSize {
+		products, hasMore = products[:pageSize], true
 	}
 
-	return products, count, nil
+	return products, count, hasMore, nil
 }
 
-// GetCategories 获取所有商品分类
-func GetCategories(db *gorm.DB) ([]string, error) {
+// categoryFilter 筛选分类及其子孙分类下的商品，尚未关联分类ID的旧商品按分类名称匹配
+func categoryFilter(db, query *gorm.DB, category *model.Category) *gorm.DB {
+	return query.Where("category_id IN (?) OR (category_id = 0 AND category = ?)",
+		subtreeIDs(db, category), category.Name)
+}
+
+// GetLegacyCategories 获取商品上填写的所有分类名称，分类表为空时使用
+func GetLegacyCategories(db *gorm.DB) ([]string, error) {
 	var categories []string
 	result := db.Model(&model.Product{}).Distinct().Pluck("category", &categories)
 	return categories, result.Error
 }
 
 // UpdateStock 更新商品库存
+// 仅用于入库、盘点等库存调整，不计入销量；下单扣减走库存预占
 func UpdateStock(db *gorm.DB, productID int64, quantity int32) (int32, error) {
 	var product model.Product
 	
@@ -158,6 +286,15 @@ func UpdateStock(db *gorm.DB, productID int64, quantity int32) (int32, error) {
 			return err
 		}
 
+		// 有 SKU 的商品库存为各 SKU 库存之和，只能按 SKU 调整
+		hasSkus, err := HasSkus(tx, productID)
+		if err != nil {
+			return err
+		}
+		if hasSkus {
+			return ErrSkuRequired
+		}
+
 		// 检查库存是否足够（如果是减少库存的操作）
 		if quantity < 0 && product.Stock < -quantity {
 			return errors.New("insufficient stock")
@@ -166,11 +303,6 @@ func UpdateStock(db *gorm.DB, productID int64, quantity int32) (int32, error) {
 		// 更新库存
 		product.Stock += quantity
 		product.UpdatedAt = time.Now()
-		
-		// 如果是减少库存，增加销量
-		if quantity < 0 {
-			product.SalesCount += -quantity
-		}
 
 		return tx.Save(&product).Error
 	})
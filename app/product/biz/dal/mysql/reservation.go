@@ -0,0 +1,318 @@
+package mysql
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"zqzqsb/gomall/app/product/biz/model"
+)
+
+var (
+	ErrInsufficientStock    = errors.New("insufficient stock")
+	ErrReservationNotFound  = errors.New("reservation not found")
+	ErrReservationReleased  = errors.New("reservation has been released")
+	ErrReservationConfirmed = errors.New("reservation has been confirmed")
+	ErrReservationExpired   = errors.New("reservation has expired")
+)
+
+// ReserveStock 在一个事务中为 token 预占多个商品的库存，全部成功或全部失败
+// items 为库存单位到数量的映射，有 SKU 的商品必须通过 SkuID 或 Spec 指定 SKU，
+// 预占同时扣除 SKU 库存和商品的汇总库存；token 已存在预占记录时不做任何修改，直接返回已有记录
+func ReserveStock(db *gorm.DB, token string, items map[model.StockKey]int32, expiresAt time.Time) ([]*model.StockReservation, error) {
+	var reservations []*model.StockReservation
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("token = ?", token).Find(&reservations).Error; err != nil {
+			return err
+		}
+		if len(reservations) > 0 {
+			return nil
+		}
+
+		// 按商品ID升序加锁，避免并发预占相互死锁
+		quantities := make(map[int64]int32, len(items))
+		for key, quantity := range items {
+			quantities[key.ProductID] += quantity
+		}
+		ids := make([]int64, 0, len(quantities))
+		for id := range quantities {
+			ids = append(ids, id)
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+		var products []*model.Product
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ?", ids).Order("id").Find(&products).Error; err != nil {
+			return err
+		}
+		if len(products) != len(ids) {
+			found := make(map[int64]bool, len(products))
+			for _, p := range products {
+				found[p.ID] = true
+			}
+			for _, id := range ids {
+				if !found[id] {
+					return fmt.Errorf("product %d not found", id)
+				}
+			}
+		}
+		for _, p := range products {
+			if !p.IsOnSale {
+				return fmt.Errorf("product %d is not on sale", p.ID)
+			}
+			if p.Stock < quantities[p.ID] {
+				return fmt.Errorf("%w: product %d", ErrInsufficientStock, p.ID)
+			}
+		}
+
+		skus, err := lockSkus(tx, ids...)
+		if err != nil {
+			return err
+		}
+		skuItems, err := resolveSkus(items, skus)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		for _, p := range products {
+			if err := tx.Model(&model.Product{}).Where("id = ?", p.ID).Updates(map[string]interface{}{
+				"stock":      gorm.Expr("stock - ?", quantities[p.ID]),
+				"updated_at": now,
+			}).Error; err != nil {
+				return err
+			}
+		}
+		for _, key := range sortedStockKeys(skuItems) {
+			if key.SkuID > 0 {
+				if err := tx.Model(&model.Sku{}).Where("id = ?", key.SkuID).Updates(map[string]interface{}{
+					"stock":      gorm.Expr("stock - ?", skuItems[key]),
+					"updated_at": now,
+				}).Error; err != nil {
+					return err
+				}
+			}
+			reservations = append(reservations, &model.StockReservation{
+				Token:     token,
+				ProductID: key.ProductID,
+				SkuID:     key.SkuID,
+				Quantity:  skuItems[key],
+				Status:    model.ReservationStatusHeld,
+				ExpiresAt: expiresAt,
+				CreatedAt: now,
+				UpdatedAt: now,
+			})
+		}
+		return tx.Create(&reservations).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reservations, nil
+}
+
+// resolveSkus 将预占的库存单位定位到具体 SKU 并校验 SKU 库存，返回按 (商品ID, SKU ID) 合并后的数量
+// 没有 SKU 的商品 SkuID 为 0，忽略请求中的规格值
+func resolveSkus(items map[model.StockKey]int32, skus []*model.Sku) (map[model.StockKey]int32, error) {
+	byID := make(map[int64]*model.Sku, len(skus))
+	bySpec := make(map[model.StockKey]*model.Sku, len(skus))
+	hasSkus := make(map[int64]bool)
+	for _, s := range skus {
+		byID[s.ID] = s
+		bySpec[model.StockKey{ProductID: s.ProductID, Spec: s.Spec}] = s
+		hasSkus[s.ProductID] = true
+	}
+
+	resolved := make(map[model.StockKey]int32, len(items))
+	for key, quantity := range items {
+		if !hasSkus[key.ProductID] {
+			if key.SkuID > 0 {
+				return nil, fmt.Errorf("%w: product %d sku %d", ErrSkuNotFound, key.ProductID, key.SkuID)
+			}
+			resolved[model.StockKey{ProductID: key.ProductID}] += quantity
+			continue
+		}
+
+		var sku *model.Sku
+		switch {
+		case key.SkuID > 0:
+			if s := byID[key.SkuID]; s != nil && s.ProductID == key.ProductID {
+				sku = s
+			}
+		case key.Spec != "":
+			sku = bySpec[model.StockKey{ProductID: key.ProductID, Spec: key.Spec}]
+		default:
+			return nil, fmt.Errorf("%w: product %d", ErrSkuRequired, key.ProductID)
+		}
+		if sku == nil {
+			return nil, fmt.Errorf("%w: product %d", ErrSkuNotFound, key.ProductID)
+		}
+		resolved[model.StockKey{ProductID: key.ProductID, SkuID: sku.ID}] += quantity
+	}
+
+	for key, quantity := range resolved {
+		if key.SkuID == 0 {
+			continue
+		}
+		sku := byID[key.SkuID]
+		if !sku.IsOnSale {
+			return nil, fmt.Errorf("sku %d is not on sale", sku.ID)
+		}
+		if sku.Stock < quantity {
+			return nil, fmt.Errorf("%w: sku %d", ErrInsufficientStock, sku.ID)
+		}
+	}
+	return resolved, nil
+}
+
+// sortedStockKeys 按商品ID和 SKU ID 排序，保证更新 SKU 的加锁顺序一致
+func sortedStockKeys(items map[model.StockKey]int32) []model.StockKey {
+	keys := make([]model.StockKey, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].ProductID != keys[j].ProductID {
+			return keys[i].ProductID < keys[j].ProductID
+		}
+		return keys[i].SkuID < keys[j].SkuID
+	})
+	return keys
+}
+
+// lockReservations 锁定 token 下的全部预占记录
+func lockReservations(tx *gorm.DB, token string) ([]*model.StockReservation, error) {
+	var reservations []*model.StockReservation
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("token = ?", token).Order("product_id, sku_id").Find(&reservations).Error; err != nil {
+		return nil, err
+	}
+	if len(reservations) == 0 {
+		return nil, ErrReservationNotFound
+	}
+	return reservations, nil
+}
+
+// ConfirmReservation 确认预占，预占的库存正式扣减并计入销量，返回本次发生变化的商品ID
+// 已确认时直接返回成功；已释放或已过期时返回错误
+func ConfirmReservation(db *gorm.DB, token string, now time.Time) ([]int64, error) {
+	var productIDs []int64
+	err := db.Transaction(func(tx *gorm.DB) error {
+		reservations, err := lockReservations(tx, token)
+		if err != nil {
+			return err
+		}
+
+		for _, r := range reservations {
+			switch r.Status {
+			case model.ReservationStatusReleased:
+				return ErrReservationReleased
+			case model.ReservationStatusHeld:
+				if r.ExpiresAt.Before(now) {
+					return ErrReservationExpired
+				}
+			}
+		}
+
+		for _, r := range reservations {
+			if r.Status != model.ReservationStatusHeld {
+				continue
+			}
+			if err = tx.Model(&model.Product{}).Where("id = ?", r.ProductID).Updates(map[string]interface{}{
+				"sales_count": gorm.Expr("sales_count + ?", r.Quantity),
+				"updated_at":  now,
+			}).Error; err != nil {
+				return err
+			}
+			if r.SkuID > 0 {
+				if err = tx.Model(&model.Sku{}).Where("id = ?", r.SkuID).Updates(map[string]interface{}{
+					"sales_count": gorm.Expr("sales_count + ?", r.Quantity),
+					"updated_at":  now,
+				}).Error; err != nil {
+					return err
+				}
+			}
+			if err = tx.Model(r).Updates(map[string]interface{}{
+				"status":     model.ReservationStatusConfirmed,
+				"updated_at": now,
+			}).Error; err != nil {
+				return err
+			}
+			productIDs = append(productIDs, r.ProductID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return productIDs, nil
+}
+
+// ReleaseReservation 释放预占，归还库存，返回本次发生变化的商品ID
+// 已释放时直接返回成功；已确认的预占不能释放
+func ReleaseReservation(db *gorm.DB, token string, now time.Time) ([]int64, error) {
+	var productIDs []int64
+	err := db.Transaction(func(tx *gorm.DB) error {
+		reservations, err := lockReservations(tx, token)
+		if err != nil {
+			return err
+		}
+
+		for _, r := range reservations {
+			if r.Status == model.ReservationStatusConfirmed {
+				return ErrReservationConfirmed
+			}
+		}
+
+		for _, r := range reservations {
+			if r.Status != model.ReservationStatusHeld {
+				continue
+			}
+			if err = tx.Model(&model.Product{}).Where("id = ?", r.ProductID).Updates(map[string]interface{}{
+				"stock":      gorm.Expr("stock + ?", r.Quantity),
+				"updated_at": now,
+			}).Error; err != nil {
+				return err
+			}
+			// SKU 已被删除时只归还商品的汇总库存，删除前会检查是否有未确认的预占，正常情况下不会发生
+			if r.SkuID > 0 {
+				if err = tx.Model(&model.Sku{}).Where("id = ?", r.SkuID).Updates(map[string]interface{}{
+					"stock":      gorm.Expr("stock + ?", r.Quantity),
+					"updated_at": now,
+				}).Error; err != nil {
+					return err
+				}
+			}
+			if err = tx.Model(r).Updates(map[string]interface{}{
+				"status":     model.ReservationStatusReleased,
+				"updated_at": now,
+			}).Error; err != nil {
+				return err
+			}
+			productIDs = append(productIDs, r.ProductID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return productIDs, nil
+}
+
+// ListExpiredReservationTokens 获取已过期但仍处于预占状态的 token
+func ListExpiredReservationTokens(db *gorm.DB, now time.Time, limit int) ([]string, error) {
+	var tokens []string
+	err := db.Model(&model.StockReservation{}).
+		Where("status = ? AND expires_at < ?", model.ReservationStatusHeld, now).
+		Distinct().
+		Limit(limit).
+		Pluck("token", &tokens).Error
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
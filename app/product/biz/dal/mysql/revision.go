@@ -0,0 +1,143 @@
+package mysql
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"zqzqsb/gomall/app/product/biz/model"
+)
+
+var (
+	ErrRevisionNotFound  = errors.New("product revision not found")
+	ErrProductNotDeleted = errors.New("product is not deleted")
+)
+
+// lockProductUnscoped 锁定商品行，包括已删除的商品
+func lockProductUnscoped(tx *gorm.DB, id int64) (*model.Product, error) {
+	var product model.Product
+	if err := tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).First(&product, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrProductNotFound
+		}
+		return nil, err
+	}
+	return &product, nil
+}
+
+// RecordRevision 在同一事务中执行商品变更并追加变更记录，变更失败时不留下记录
+// rev.ProductID 为0表示创建商品，change 返回被变更商品的ID；已删除的商品快照为空
+func RecordRevision(db *gorm.DB, rev *model.ProductRevision, change func(tx *gorm.DB) (int64, error)) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		var before *model.Product
+		if rev.ProductID > 0 {
+			p, err := lockProductUnscoped(tx, rev.ProductID)
+			if err != nil {
+				return err
+			}
+			if !p.DeletedAt.Valid {
+				before = p
+			}
+		}
+
+		id, err := change(tx)
+		if err != nil {
+			return err
+		}
+		after, err := lockProductUnscoped(tx, id)
+		if err != nil {
+			return err
+		}
+		if after.DeletedAt.Valid {
+			after = nil
+		}
+
+		rev.ProductID = id
+		rev.CreatedAt = time.Now()
+		if err = rev.SetSnapshots(model.SnapshotOf(before), model.SnapshotOf(after)); err != nil {
+			return err
+		}
+		return tx.Create(rev).Error
+	})
+}
+
+// ListRevisions 按时间倒序分页获取商品的变更记录
+func ListRevisions(db *gorm.DB, productID int64, page, pageSize int) ([]*model.ProductRevision, int64, error) {
+	var revisions []*model.ProductRevision
+	var count int64
+	query := db.Model(&model.ProductRevision{}).Where("product_id = ?", productID)
+	if err := query.Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+	err := query.Order("id DESC").Offset((page - 1) * pageSize).Limit(pageSize).Find(&revisions).Error
+	return revisions, count, err
+}
+
+// GetRevision 获取商品的一条变更记录
+func GetRevision(db *gorm.DB, productID, revisionID int64) (*model.ProductRevision, error) {
+	var rev model.ProductRevision
+	if err := db.Where("id = ? AND product_id = ?", revisionID, productID).First(&rev).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRevisionNotFound
+		}
+		return nil, err
+	}
+	return &rev, nil
+}
+
+// UndeleteProduct 撤销商品的软删除
+func UndeleteProduct(db *gorm.DB, id int64) error {
+	result := db.Unscoped().Model(&model.Product{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Updates(map[string]interface{}{
+			"deleted_at": nil,
+			"version":    gorm.Expr("version + 1"),
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrProductNotDeleted
+	}
+	return nil
+}
+
+// RestoreSnapshot 将商品恢复为快照中的字段，已删除的商品同时撤销删除
+// 库存只能通过库存调整修改，不随快照恢复；有 SKU 的商品价格按 SKU 汇总，也不恢复
+// 外部编码可能已被其他商品使用，同样不恢复
+func RestoreSnapshot(db *gorm.DB, id int64, s *model.ProductSnapshot) error {
+	p := &model.Product{}
+	if err := p.SetGallery(s.Gallery); err != nil {
+		return err
+	}
+	if err := p.SetAttributes(s.Attributes); err != nil {
+		return err
+	}
+	if len(s.Gallery) == 0 {
+		p.Gallery = ""
+	}
+
+	updates := map[string]interface{}{
+		"name":        s.Name,
+		"description": s.Description,
+		"image_url":   s.ImageURL,
+		"gallery":     p.Gallery,
+		"category":    s.Category,
+		"category_id": s.CategoryID,
+		"is_on_sale":  s.IsOnSale,
+		"attributes":  p.Attributes,
+		"deleted_at":  nil,
+		"version":     gorm.Expr("version + 1"),
+		"updated_at":  time.Now(),
+	}
+	hasSkus, err := HasSkus(db, id)
+	if err != nil {
+		return err
+	}
+	if !hasSkus {
+		updates["price"] = s.Price
+	}
+	return db.Unscoped().Model(&model.Product{}).Where("id = ?", id).Updates(updates).Error
+}
@@ -0,0 +1,253 @@
+package mysql
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"zqzqsb/gomall/app/product/biz/model"
+)
+
+var (
+	ErrSkuNotFound     = errors.New("sku not found")
+	ErrSkuRequired     = errors.New("product has skus, sku must be specified")
+	ErrOptionsRequired = errors.New("product options must be set before saving skus")
+	ErrSkuMismatch     = errors.New("sku attributes do not match product options")
+	ErrSkuReserved     = errors.New("sku has held reservations")
+)
+
+// GetProductDetail 根据ID获取商品，同时加载规格轴和 SKU
+func GetProductDetail(db *gorm.DB, id int64) (*model.Product, error) {
+	var product model.Product
+	result := db.
+		Preload("Options", func(db *gorm.DB) *gorm.DB { return db.Order("position, id") }).
+		Preload("Skus", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+		First(&product, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrProductNotFound
+		}
+		return nil, result.Error
+	}
+	return &product, nil
+}
+
+// lockProduct 锁定商品行，SKU 的增删和库存变化都先锁定所属商品，保证与商品汇总库存一致
+func lockProduct(tx *gorm.DB, id int64) (*model.Product, error) {
+	var product model.Product
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&product, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrProductNotFound
+		}
+		return nil, err
+	}
+	return &product, nil
+}
+
+func listOptions(tx *gorm.DB, productID int64) ([]*model.ProductOption, error) {
+	var options []*model.ProductOption
+	err := tx.Where("product_id = ?", productID).Order("position, id").Find(&options).Error
+	return options, err
+}
+
+func lockSkus(tx *gorm.DB, productIDs ...int64) ([]*model.Sku, error) {
+	var skus []*model.Sku
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("product_id IN ?", productIDs).Order("id").Find(&skus).Error
+	return skus, err
+}
+
+// syncProductSummary 按 SKU 重新计算商品的价格和库存，价格取在售 SKU 的最低价，都不在售时取全部 SKU 的最低价
+func syncProductSummary(tx *gorm.DB, productID int64, now time.Time) error {
+	skus, err := lockSkus(tx, productID)
+	if err != nil || len(skus) == 0 {
+		return err
+	}
+	var stock int32
+	var price, onSalePrice int64
+	for _, s := range skus {
+		stock += s.Stock
+		if price == 0 || s.Price < price {
+			price = s.Price
+		}
+		if s.IsOnSale && (onSalePrice == 0 || s.Price < onSalePrice) {
+			onSalePrice = s.Price
+		}
+	}
+	if onSalePrice > 0 {
+		price = onSalePrice
+	}
+	return tx.Model(&model.Product{}).Where("id = ?", productID).Updates(map[string]interface{}{
+		"price":      price,
+		"stock":      stock,
+		"updated_at": now,
+	}).Error
+}
+
+// ReplaceOptions 替换商品的规格轴，已有的 SKU 必须与新的规格轴匹配，否则需要先删除这些 SKU
+func ReplaceOptions(db *gorm.DB, productID int64, options []*model.ProductOption) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if _, err := lockProduct(tx, productID); err != nil {
+			return err
+		}
+		skus, err := lockSkus(tx, productID)
+		if err != nil {
+			return err
+		}
+		for _, s := range skus {
+			if !model.MatchOptions(options, s.GetAttributes()) {
+				return fmt.Errorf("%w: sku %d", ErrSkuMismatch, s.ID)
+			}
+		}
+
+		if err = tx.Where("product_id = ?", productID).Delete(&model.ProductOption{}).Error; err != nil {
+			return err
+		}
+		if len(options) == 0 {
+			return nil
+		}
+		now := time.Now()
+		for i, o := range options {
+			o.ProductID = productID
+			o.Position = int32(i)
+			o.CreatedAt = now
+			o.UpdatedAt = now
+		}
+		return tx.Create(&options).Error
+	})
+}
+
+// SaveSkus 按规格值组合新增或更新 SKU，返回商品的全部 SKU
+// 已存在的 SKU 只更新价格、条形码和在售状态，库存通过 UpdateSkuStock 调整
+func SaveSkus(db *gorm.DB, productID int64, skus []*model.Sku) ([]*model.Sku, error) {
+	var saved []*model.Sku
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if _, err := lockProduct(tx, productID); err != nil {
+			return err
+		}
+		options, err := listOptions(tx, productID)
+		if err != nil {
+			return err
+		}
+		if len(options) == 0 {
+			return ErrOptionsRequired
+		}
+		existing, err := lockSkus(tx, productID)
+		if err != nil {
+			return err
+		}
+		bySpec := make(map[string]*model.Sku, len(existing))
+		for _, s := range existing {
+			bySpec[s.Spec] = s
+		}
+
+		now := time.Now()
+		for _, s := range skus {
+			if !model.MatchOptions(options, s.GetAttributes()) {
+				return fmt.Errorf("%w: %s", ErrSkuMismatch, s.Spec)
+			}
+			if old, ok := bySpec[s.Spec]; ok {
+				if err = tx.Model(old).Updates(map[string]interface{}{
+					"price":      s.Price,
+					"barcode":    s.Barcode,
+					"is_on_sale": s.IsOnSale,
+					"updated_at": now,
+				}).Error; err != nil {
+					return err
+				}
+				continue
+			}
+			s.ID = 0
+			s.ProductID = productID
+			s.SalesCount = 0
+			s.CreatedAt = now
+			s.UpdatedAt = now
+			if err = tx.Create(s).Error; err != nil {
+				return err
+			}
+			bySpec[s.Spec] = s
+		}
+
+		if err = syncProductSummary(tx, productID, now); err != nil {
+			return err
+		}
+		saved, err = lockSkus(tx, productID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
+
+// DeleteSku 删除 SKU，有未确认预占的 SKU 不能删除
+func DeleteSku(db *gorm.DB, productID, skuID int64) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if _, err := lockProduct(tx, productID); err != nil {
+			return err
+		}
+		var held int64
+		if err := tx.Model(&model.StockReservation{}).
+			Where("sku_id = ? AND status = ?", skuID, model.ReservationStatusHeld).
+			Count(&held).Error; err != nil {
+			return err
+		}
+		if held > 0 {
+			return ErrSkuReserved
+		}
+		result := tx.Where("id = ? AND product_id = ?", skuID, productID).Delete(&model.Sku{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrSkuNotFound
+		}
+		return syncProductSummary(tx, productID, time.Now())
+	})
+}
+
+// UpdateSkuStock 调整 SKU 库存，同时调整商品的汇总库存，返回 SKU 调整后的库存
+func UpdateSkuStock(db *gorm.DB, productID, skuID int64, quantity int32) (int32, error) {
+	var sku model.Sku
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if _, err := lockProduct(tx, productID); err != nil {
+			return err
+		}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND product_id = ?", skuID, productID).First(&sku).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrSkuNotFound
+			}
+			return err
+		}
+		if quantity < 0 && sku.Stock < -quantity {
+			return ErrInsufficientStock
+		}
+
+		now := time.Now()
+		sku.Stock += quantity
+		if err := tx.Model(&sku).Updates(map[string]interface{}{
+			"stock":      sku.Stock,
+			"updated_at": now,
+		}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&model.Product{}).Where("id = ?", productID).Updates(map[string]interface{}{
+			"stock":      gorm.Expr("stock + ?", quantity),
+			"updated_at": now,
+		}).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+	return sku.Stock, nil
+}
+
+// HasSkus 商品是否定义了 SKU
+func HasSkus(db *gorm.DB, productID int64) (bool, error) {
+	var count int64
+	err := db.Model(&model.Sku{}).Where("product_id = ?", productID).Count(&count).Error
+	return count > 0, err
+}
@@ -9,6 +9,8 @@ import (
 
 var (
 	RedisClient *redis.Client
+	// TokenClient 读取用户服务写入的令牌吊销状态
+	TokenClient *redis.Client
 )
 
 func Init() {
@@ -21,4 +23,17 @@ func Init() {
 	if err := RedisClient.Ping(context.Background()).Err(); err != nil {
 		panic(err)
 	}
+
+	TokenClient = RedisClient
+	if cfg := conf.GetConf().Jwt.TokenRedis; cfg.Address != "" {
+		TokenClient = redis.NewClient(&redis.Options{
+			Addr:     cfg.Address,
+			Username: cfg.Username,
+			Password: cfg.Password,
+			DB:       cfg.DB,
+		})
+		if err := TokenClient.Ping(context.Background()).Err(); err != nil {
+			panic(err)
+		}
+	}
 }
@@ -0,0 +1,119 @@
+package redis
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"zqzqsb/gomall/app/product/biz/model"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+const (
+	productKeyPrefix      = "product:info:"
+	productListKeyPrefix  = "product:list:"
+	productListVersionKey = "product:list:version"
+	// nullValue 缓存不存在的商品，防止缓存穿透
+	nullValue = "null"
+)
+
+// ProductKey 商品详情缓存 key
+func ProductKey(id int64) string {
+	return productKeyPrefix + strconv.FormatInt(id, 10)
+}
+
+// GetProduct 获取商品详情缓存
+// 未命中时返回 redis.Nil；命中空值缓存时返回 (nil, nil)
+func GetProduct(ctx context.Context, rdb *redis.Client, id int64) (*model.Product, error) {
+	data, err := rdb.Get(ctx, ProductKey(id)).Bytes()
+	if err != nil {
+		return nil, err
+	}
+	if string(data) == nullValue {
+		return nil, nil
+	}
+
+	var p model.Product
+	if err = json.Unmarshal(data, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// SetProduct 写入商品详情缓存，p 为 nil 时写入空值
+func SetProduct(ctx context.Context, rdb *redis.Client, id int64, p *model.Product, ttl time.Duration) error {
+	value := []byte(nullValue)
+	if p != nil {
+		data, err := json.Marshal(p)
+		if err != nil {
+			return err
+		}
+		value = data
+	}
+	return rdb.Set(ctx, ProductKey(id), value, ttl).Err()
+}
+
+// DeleteProducts 删除商品详情缓存
+func DeleteProducts(ctx context.Context, rdb *redis.Client, ids ...int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, ProductKey(id))
+	}
+	return rdb.Del(ctx, keys...).Err()
+}
+
+// GetListVersion 获取商品列表缓存的版本号
+// 列表的查询条件组合无法逐个删除，通过递增版本号使旧版本的列表缓存全部失效
+func GetListVersion(ctx context.Context, rdb *redis.Client) (int64, error) {
+	version, err := rdb.Get(ctx, productListVersionKey).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	return version, err
+}
+
+// BumpListVersion 递增商品列表缓存的版本号
+func BumpListVersion(ctx context.Context, rdb *redis.Client) error {
+	return rdb.Incr(ctx, productListVersionKey).Err()
+}
+
+// ProductListKey 商品列表缓存 key，由版本号和查询条件摘要组成
+func ProductListKey(version int64, req *product.ListProductsReq) string {
+	cond := fmt.Sprintf("%s|%s|%t|%d|%d|%s|%t|%d|%d|%d|%t|%s|%t",
+		req.Category, req.Keyword, req.OnSaleOnly, req.Page, req.PageSize,
+		req.SortBy, req.Ascending, req.MinPrice, req.MaxPrice, req.CategoryId,
+		req.UseCursor, req.Cursor, req.SkipTotal)
+	sum := md5.Sum([]byte(cond))
+	return productListKeyPrefix + strconv.FormatInt(version, 10) + ":" + hex.EncodeToString(sum[:])
+}
+
+// GetProductList 获取商品列表缓存，未命中时返回 redis.Nil
+func GetProductList(ctx context.Context, rdb *redis.Client, key string) (*product.ListProductsResp, error) {
+	data, err := rdb.Get(ctx, key).Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	var resp product.ListProductsResp
+	if err = json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SetProductList 写入商品列表缓存
+func SetProductList(ctx context.Context, rdb *redis.Client, key string, resp *product.ListProductsResp, ttl time.Duration) error {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	return rdb.Set(ctx, key, data, ttl).Err()
+}
@@ -0,0 +1,103 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"zqzqsb/gomall/app/product/biz/model"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+func newTestClient(t *testing.T) (*miniredis.Miniredis, *redis.Client) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+	return mr, rdb
+}
+
+func TestProductCache(t *testing.T) {
+	mr, rdb := newTestClient(t)
+	ctx := context.Background()
+
+	// 未命中
+	if _, err := GetProduct(ctx, rdb, 1); !errors.Is(err, redis.Nil) {
+		t.Fatalf("got error %v, want redis.Nil", err)
+	}
+
+	// 写入后命中，并带有有效期
+	if err := SetProduct(ctx, rdb, 1, &model.Product{ID: 1, Name: "phone", Price: 1999}, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	p, err := GetProduct(ctx, rdb, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p == nil || p.Name != "phone" || p.Price != 1999 {
+		t.Fatalf("unexpected product %+v", p)
+	}
+	if ttl := mr.TTL(ProductKey(1)); ttl != time.Minute {
+		t.Errorf("got ttl %v, want %v", ttl, time.Minute)
+	}
+
+	// 空值缓存命中时返回 (nil, nil)
+	if err = SetProduct(ctx, rdb, 2, nil, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	p, err = GetProduct(ctx, rdb, 2)
+	if err != nil || p != nil {
+		t.Fatalf("got (%v, %v), want (nil, nil)", p, err)
+	}
+
+	if err = DeleteProducts(ctx, rdb, 1, 2); err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []int64{1, 2} {
+		if mr.Exists(ProductKey(id)) {
+			t.Errorf("expect product %d cache deleted", id)
+		}
+	}
+}
+
+func TestProductListCache(t *testing.T) {
+	_, rdb := newTestClient(t)
+	ctx := context.Background()
+
+	version, err := GetListVersion(ctx, rdb)
+	if err != nil || version != 0 {
+		t.Fatalf("got (%d, %v), want (0, nil)", version, err)
+	}
+	req := &product.ListProductsReq{Category: "phone", Page: 1, PageSize: 10}
+	key := ProductListKey(version, req)
+	if _, err = GetProductList(ctx, rdb, key); !errors.Is(err, redis.Nil) {
+		t.Fatalf("got error %v, want redis.Nil", err)
+	}
+	if err = SetProductList(ctx, rdb, key, &product.ListProductsResp{Total: 3}, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := GetProductList(ctx, rdb, key)
+	if err != nil || resp.Total != 3 {
+		t.Fatalf("got (%v, %v), want total 3", resp, err)
+	}
+
+	// 不同的查询条件使用不同的 key
+	if ProductListKey(version, &product.ListProductsReq{Category: "phone", Page: 2, PageSize: 10}) == key {
+		t.Error("expect different key for different page")
+	}
+
+	// 递增版本号后旧 key 不再被使用
+	if err = BumpListVersion(ctx, rdb); err != nil {
+		t.Fatal(err)
+	}
+	version, err = GetListVersion(ctx, rdb)
+	if err != nil || version != 1 {
+		t.Fatalf("got (%d, %v), want (1, nil)", version, err)
+	}
+	if ProductListKey(version, req) == key {
+		t.Error("expect different key after version bump")
+	}
+}
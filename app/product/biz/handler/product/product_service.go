@@ -3,8 +3,14 @@
 package product
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
 	"strconv"
+	"strings"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
@@ -55,6 +61,10 @@ func UpdateProduct(ctx context.Context, c *app.RequestContext) {
 
 	// 调用服务层更新商品
 	resp, err := service.NewUpdateProductService(ctx).Run(&req)
+	if errors.Is(err, service.ErrVersionConflict) {
+		c.String(consts.StatusConflict, service.ErrVersionConflict.BizMessage())
+		return
+	}
 	if err != nil {
 		c.String(consts.StatusInternalServerError, err.Error())
 		return
@@ -133,6 +143,10 @@ func ListProducts(ctx context.Context, c *app.RequestContext) {
 		c.String(consts.StatusBadRequest, err.Error())
 		return
 	}
+	// 游标分页参数
+	req.Cursor = c.Query("cursor")
+	req.UseCursor, _ = strconv.ParseBool(c.Query("use_cursor"))
+	req.SkipTotal, _ = strconv.ParseBool(c.Query("skip_total"))
 
 	// 调用服务层获取商品列表
 	resp, err := service.NewListProductsService(ctx).Run(&req)
@@ -164,3 +178,355 @@ func GetCategories(ctx context.Context, c *app.RequestContext) {
 
 	c.JSON(consts.StatusOK, resp)
 }
+
+// SetProductOptions .
+// @router /admin/products/{id}/options [PUT]
+func SetProductOptions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.SetProductOptionsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取商品ID
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid product ID")
+		return
+	}
+	req.ProductId = id
+
+	// 调用服务层设置商品规格轴
+	resp, err := service.NewSetProductOptionsService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// SaveSkus .
+// @router /admin/products/{id}/skus [PUT]
+func SaveSkus(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.SaveSkusReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取商品ID
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid product ID")
+		return
+	}
+	req.ProductId = id
+
+	// 调用服务层新增或更新商品 SKU
+	resp, err := service.NewSaveSkusService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// DeleteSku .
+// @router /admin/products/{id}/skus/{sku_id} [DELETE]
+func DeleteSku(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.DeleteSkuReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取商品ID
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid product ID")
+		return
+	}
+	req.ProductId = id
+
+	skuID, err := strconv.ParseInt(c.Param("sku_id"), 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid sku ID")
+		return
+	}
+	req.SkuId = skuID
+
+	// 调用服务层删除商品 SKU
+	resp, err := service.NewDeleteSkuService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// CreateCategory .
+// @router /admin/categories [POST]
+func CreateCategory(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.CreateCategoryReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用服务层创建分类
+	resp, err := service.NewCreateCategoryService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// UpdateCategory .
+// @router /admin/categories/{id} [PUT]
+func UpdateCategory(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.UpdateCategoryReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取分类ID
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid category ID")
+		return
+	}
+	req.Id = id
+
+	// 调用服务层更新分类
+	resp, err := service.NewUpdateCategoryService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// DeleteCategory .
+// @router /admin/categories/{id} [DELETE]
+func DeleteCategory(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.DeleteCategoryReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取分类ID
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid category ID")
+		return
+	}
+	req.Id = id
+
+	// 调用服务层删除分类
+	resp, err := service.NewDeleteCategoryService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// SearchProducts .
+// @router /products/search [GET]
+func SearchProducts(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.SearchProductsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+	bindSearchQuery(c, &req)
+
+	// 调用服务层搜索商品
+	resp, err := service.NewSearchProductsService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// bindSearchQuery 从查询参数读取搜索条件，属性筛选使用 attr.<属性名>=<属性值> 的形式
+func bindSearchQuery(c *app.RequestContext, req *product.SearchProductsReq) {
+	req.Keyword = c.Query("keyword")
+	req.CategoryId, _ = strconv.ParseInt(c.Query("category_id"), 10, 64)
+	req.MinPrice, _ = strconv.ParseInt(c.Query("min_price"), 10, 64)
+	req.MaxPrice, _ = strconv.ParseInt(c.Query("max_price"), 10, 64)
+	req.OnSaleOnly, _ = strconv.ParseBool(c.Query("on_sale_only"))
+	page, _ := strconv.ParseInt(c.Query("page"), 10, 32)
+	pageSize, _ := strconv.ParseInt(c.Query("page_size"), 10, 32)
+	req.Page, req.PageSize = int32(page), int32(pageSize)
+	c.QueryArgs().VisitAll(func(key, value []byte) {
+		if name, ok := strings.CutPrefix(string(key), "attr."); ok && name != "" {
+			if req.Attributes == nil {
+				req.Attributes = make(map[string]string)
+			}
+			req.Attributes[name] = string(value)
+		}
+	})
+}
+
+// ListProductRevisions .
+// @router /admin/products/{id}/revisions [GET]
+func ListProductRevisions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.ListProductRevisionsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取商品ID
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid product ID")
+		return
+	}
+	req.ProductId = id
+	page, _ := strconv.ParseInt(c.Query("page"), 10, 32)
+	pageSize, _ := strconv.ParseInt(c.Query("page_size"), 10, 32)
+	req.Page, req.PageSize = int32(page), int32(pageSize)
+
+	// 调用服务层查询商品变更历史
+	resp, err := service.NewListProductRevisionsService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RestoreProduct .
+// @router /admin/products/{id}/restore [POST]
+func RestoreProduct(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.RestoreProductReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取商品ID
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid product ID")
+		return
+	}
+	req.ProductId = id
+
+	// 调用服务层恢复商品
+	resp, err := service.NewRestoreProductService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// BulkImportProducts .
+// @router /admin/products/import [POST]
+func BulkImportProducts(ctx context.Context, c *app.RequestContext) {
+	// 支持 multipart 表单的 file 字段或直接以请求体上传文件，格式取 format 参数，其次取文件扩展名
+	format := strings.ToLower(c.Query("format"))
+	var body io.Reader
+	if file, err := c.FormFile("file"); err == nil {
+		if format == "" {
+			format = strings.ToLower(strings.TrimPrefix(path.Ext(file.Filename), "."))
+		}
+		f, err := file.Open()
+		if err != nil {
+			c.String(consts.StatusBadRequest, err.Error())
+			return
+		}
+		defer f.Close()
+		body = f
+	} else {
+		body = bytes.NewReader(c.Request.Body())
+	}
+	if format == "" {
+		format = service.FormatCSV
+	}
+
+	// 调用服务层导入商品，行级错误在响应的 errors 中返回
+	resp, err := service.NewBulkImportProductsService(ctx).Import(format, c.Query("mode"), c.Query("reason"), body)
+	if errors.Is(err, service.ErrUnsupportedFormat) || errors.Is(err, service.ErrInvalidImportMode) ||
+		errors.Is(err, service.ErrInvalidImportFile) || errors.Is(err, service.ErrTooManyImportRows) {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ExportProducts .
+// @router /admin/products/export [GET]
+func ExportProducts(ctx context.Context, c *app.RequestContext) {
+	var req product.ExportProductsReq
+	categoryID, _ := strconv.ParseInt(c.Query("category_id"), 10, 64)
+	batchSize, _ := strconv.ParseInt(c.Query("batch_size"), 10, 32)
+	req.CategoryId, req.BatchSize = categoryID, int32(batchSize)
+
+	format := strings.ToLower(c.DefaultQuery("format", service.FormatCSV))
+	contentType := "text/csv; charset=utf-8"
+	if format == service.FormatJSONL {
+		contentType = "application/x-ndjson; charset=utf-8"
+	}
+
+	// 调用服务层导出商品，写完后再返回，出错时不会返回不完整的文件
+	var buf bytes.Buffer
+	err := service.NewExportProductsService(ctx).ExportFile(&req, format, &buf)
+	if errors.Is(err, service.ErrUnsupportedFormat) {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="products.%s"`, format))
+	c.Data(consts.StatusOK, contentType, buf.Bytes())
+}
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxCategoryDepth 分类树的最大层级
+const MaxCategoryDepth = 5
+
+// Category 商品分类，通过 ParentID 组成树形结构
+// Path 为从根分类到当前分类的 ID 路径，如 /1/5/，用于按前缀一次查出全部子孙分类
+type Category struct {
+	ID        int64     `gorm:"primarykey"`
+	ParentID  int64     `gorm:"not null;default:0;index"` // 顶级分类为0
+	Name      string    `gorm:"type:varchar(100);not null"`
+	Slug      string    `gorm:"type:varchar(100);not null;uniqueIndex"`
+	Icon      string    `gorm:"type:varchar(255)"`
+	SortOrder int32     `gorm:"not null;default:0"`
+	Path      string    `gorm:"type:varchar(255);not null;index"`
+	CreatedAt time.Time `gorm:"not null"`
+	UpdatedAt time.Time `gorm:"not null"`
+}
+
+// TableName 设置表名
+func (Category) TableName() string {
+	return "categories"
+}
+
+// CategoryPath 根据父分类路径生成子分类路径
+func CategoryPath(parentPath string, id int64) string {
+	if parentPath == "" {
+		parentPath = "/"
+	}
+	return parentPath + strconv.FormatInt(id, 10) + "/"
+}
+
+// Depth 分类所在层级，顶级分类为1
+func (c *Category) Depth() int {
+	return strings.Count(c.Path, "/") - 1
+}
+
+// AncestorIDs 从根分类到当前分类（含自身）的ID
+func (c *Category) AncestorIDs() []int64 {
+	var ids []int64
+	for _, s := range strings.Split(strings.Trim(c.Path, "/"), "/") {
+		if id, err := strconv.ParseInt(s, 10, 64); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
@@ -9,21 +9,29 @@ import (
 
 // Product 商品模型
 type Product struct {
-	ID          int64          `gorm:"primarykey"`
-	Name        string         `gorm:"type:varchar(255);not null"`
-	Description string         `gorm:"type:text"`
-	Price       int64          `gorm:"not null"` // 单位：分
-	Stock       int32          `gorm:"not null"`
-	ImageURL    string         `gorm:"type:varchar(255)"`
-	Gallery     string         `gorm:"type:text"` // JSON 格式存储图片集
-	Category    string         `gorm:"type:varchar(100);index"`
-	IsOnSale    bool           `gorm:"default:true"`
-	Attributes  string         `gorm:"type:text"` // JSON 格式存储属性
-	Rating      float32        `gorm:"default:5.0"`
-	SalesCount  int32          `gorm:"default:0"`
-	CreatedAt   time.Time      `gorm:"not null"`
-	UpdatedAt   time.Time      `gorm:"not null"`
-	DeletedAt   gorm.DeletedAt `gorm:"index"`
+	ID           int64          `gorm:"primarykey"`
+	Name         string         `gorm:"type:varchar(255);not null"`
+	Description  string         `gorm:"type:text"`
+	Price        int64          `gorm:"not null"` // 单位：分
+	Stock        int32          `gorm:"not null"`
+	ImageURL     string         `gorm:"type:varchar(255)"`
+	Gallery      string         `gorm:"type:text"`               // JSON 格式存储图片集
+	Category     string         `gorm:"type:varchar(100);index"` // 分类名称，关联分类时与分类表保持一致
+	CategoryID   int64          `gorm:"not null;default:0;index"`
+	IsOnSale     bool           `gorm:"default:true"`
+	Attributes   string         `gorm:"type:text"` // JSON 格式存储属性
+	Rating       float32        `gorm:"default:5.0"`
+	SalesCount   int32          `gorm:"default:0"`
+	Version      int64          `gorm:"not null;default:1"`                                                // 乐观锁版本号，仅修改商品信息时递增
+	OwnerID      int64          `gorm:"not null;default:0;uniqueIndex:idx_owner_external_code,priority:1"` // 创建商品的用户ID，merchant 只能管理自己的商品，为0时只有 admin 可以管理
+	ExternalCode *string        `gorm:"type:varchar(64);uniqueIndex:idx_owner_external_code,priority:2"`   // 商家侧的外部 SKU 编码，同一用户内唯一，未设置时为 NULL，不占用唯一索引
+	CreatedAt    time.Time      `gorm:"not null"`
+	UpdatedAt    time.Time      `gorm:"not null"`
+	DeletedAt    gorm.DeletedAt `gorm:"index"`
+
+	// 规格轴和 SKU 只在查询商品详情时加载
+	Options []*ProductOption `gorm:"foreignKey:ProductID"`
+	Skus    []*Sku           `gorm:"foreignKey:ProductID"`
 }
 
 // TableName 设置表名
@@ -31,6 +39,14 @@ func (Product) TableName() string {
 	return "products"
 }
 
+// GetExternalCode 获取外部 SKU 编码，未设置时返回空字符串
+func (p *Product) GetExternalCode() string {
+	if p.ExternalCode == nil {
+		return ""
+	}
+	return *p.ExternalCode
+}
+
 // GetGallery 获取图片集
 func (p *Product) GetGallery() []string {
 	var gallery []string
@@ -0,0 +1,36 @@
+package model
+
+import "time"
+
+// 库存预占状态，与 IDL 中的 ReservationStatus 保持一致
+const (
+	ReservationStatusHeld      int32 = 1 // 已预占
+	ReservationStatusConfirmed int32 = 2 // 已确认扣减
+	ReservationStatusReleased  int32 = 3 // 已释放
+)
+
+// StockReservation 库存预占记录，一个 token 下每个商品（有 SKU 时为每个 SKU）一行
+// 预占时商品库存即被扣除，确认后计入销量，释放或超时后归还库存
+type StockReservation struct {
+	ID        int64     `gorm:"primarykey"`
+	Token     string    `gorm:"type:varchar(64);not null;uniqueIndex:idx_token_product_sku"`
+	ProductID int64     `gorm:"not null;uniqueIndex:idx_token_product_sku"`
+	SkuID     int64     `gorm:"not null;default:0;uniqueIndex:idx_token_product_sku"` // 商品没有 SKU 时为 0
+	Quantity  int32     `gorm:"not null"`
+	Status    int32     `gorm:"not null;index:idx_status_expire"`
+	ExpiresAt time.Time `gorm:"not null;index:idx_status_expire"`
+	CreatedAt time.Time `gorm:"not null"`
+	UpdatedAt time.Time `gorm:"not null"`
+}
+
+// TableName 设置表名
+func (StockReservation) TableName() string {
+	return "stock_reservations"
+}
+
+// StockKey 预占的库存单位，商品有 SKU 时通过 SkuID 或规格值组合 Spec 定位 SKU
+type StockKey struct {
+	ProductID int64
+	SkuID     int64
+	Spec      string // 见 SpecKey
+}
@@ -0,0 +1,157 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"time"
+)
+
+// 商品变更类型
+const (
+	RevisionCreate  = "create"
+	RevisionUpdate  = "update"
+	RevisionDelete  = "delete"
+	RevisionStock   = "stock"
+	RevisionRestore = "restore"
+)
+
+// ProductRevision 商品变更记录，只追加不修改
+// Before 和 After 为变更前后的商品快照，创建时 Before 为空，删除时 After 为空
+type ProductRevision struct {
+	ID         int64     `gorm:"primarykey"`
+	ProductID  int64     `gorm:"not null;index:idx_product_revision"`
+	Action     string    `gorm:"type:varchar(20);not null"`
+	OperatorID int64     `gorm:"not null;index"`
+	Reason     string    `gorm:"type:varchar(255)"`
+	SkuID      int64     `gorm:"not null;default:0"`
+	Before     string    `gorm:"type:text"` // JSON 格式存储 ProductSnapshot
+	After      string    `gorm:"type:text"` // JSON 格式存储 ProductSnapshot
+	CreatedAt  time.Time `gorm:"not null;index:idx_product_revision"`
+}
+
+// TableName 设置表名
+func (ProductRevision) TableName() string {
+	return "product_revisions"
+}
+
+// ProductSnapshot 变更记录中保存的商品字段
+type ProductSnapshot struct {
+	Name         string            `json:"name"`
+	Description  string            `json:"description"`
+	Price        int64             `json:"price"`
+	Stock        int32             `json:"stock"`
+	ImageURL     string            `json:"image_url"`
+	Gallery      []string          `json:"gallery"`
+	Category     string            `json:"category"`
+	CategoryID   int64             `json:"category_id"`
+	IsOnSale     bool              `json:"is_on_sale"`
+	Attributes   map[string]string `json:"attributes"`
+	Version      int64             `json:"version"`
+	ExternalCode string            `json:"external_code,omitempty"`
+}
+
+// FieldChange 快照之间单个字段的变化，值为 JSON 格式
+type FieldChange struct {
+	Field  string
+	Before string
+	After  string
+}
+
+// SnapshotOf 生成商品快照，p 为 nil 时返回 nil
+func SnapshotOf(p *Product) *ProductSnapshot {
+	if p == nil {
+		return nil
+	}
+	// 空图片集统一为 nil，避免 "[]" 和 "null" 被当作变化
+	gallery := p.GetGallery()
+	if len(gallery) == 0 {
+		gallery = nil
+	}
+	return &ProductSnapshot{
+		Name:         p.Name,
+		Description:  p.Description,
+		Price:        p.Price,
+		Stock:        p.Stock,
+		ImageURL:     p.ImageURL,
+		Gallery:      gallery,
+		Category:     p.Category,
+		CategoryID:   p.CategoryID,
+		IsOnSale:     p.IsOnSale,
+		Attributes:   p.GetAttributes(),
+		Version:      p.Version,
+		ExternalCode: p.GetExternalCode(),
+	}
+}
+
+// SetSnapshots 保存变更前后的快照
+func (r *ProductRevision) SetSnapshots(before, after *ProductSnapshot) error {
+	var err error
+	if r.Before, err = encodeSnapshot(before); err != nil {
+		return err
+	}
+	r.After, err = encodeSnapshot(after)
+	return err
+}
+
+// GetBefore 获取变更前的快照，创建记录返回 nil
+func (r *ProductRevision) GetBefore() *ProductSnapshot {
+	return decodeSnapshot(r.Before)
+}
+
+// GetAfter 获取变更后的快照，删除记录返回 nil
+func (r *ProductRevision) GetAfter() *ProductSnapshot {
+	return decodeSnapshot(r.After)
+}
+
+// Changes 比较变更前后的快照，按字段名排序返回发生变化的字段
+func (r *ProductRevision) Changes() []FieldChange {
+	before, after := snapshotFields(r.Before), snapshotFields(r.After)
+	fields := make(map[string]bool, len(before)+len(after))
+	for f := range before {
+		fields[f] = true
+	}
+	for f := range after {
+		fields[f] = true
+	}
+
+	var changes []FieldChange
+	for f := range fields {
+		if !bytes.Equal(before[f], after[f]) {
+			changes = append(changes, FieldChange{Field: f, Before: string(before[f]), After: string(after[f])})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Field < changes[j].Field })
+	return changes
+}
+
+func encodeSnapshot(s *ProductSnapshot) (string, error) {
+	if s == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func decodeSnapshot(data string) *ProductSnapshot {
+	if data == "" {
+		return nil
+	}
+	var s ProductSnapshot
+	if err := json.Unmarshal([]byte(data), &s); err != nil {
+		return nil
+	}
+	return &s
+}
+
+// snapshotFields 将快照拆分为字段，map 类型的值由 encoding/json 按键排序，可以直接比较
+func snapshotFields(data string) map[string]json.RawMessage {
+	fields := make(map[string]json.RawMessage)
+	if data != "" {
+		_ = json.Unmarshal([]byte(data), &fields)
+	}
+	return fields
+}
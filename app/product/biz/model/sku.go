@@ -0,0 +1,126 @@
+package model
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ProductOption 商品规格轴，如颜色、尺寸，一个商品的规格值组合对应一个 SKU
+type ProductOption struct {
+	ID        int64     `gorm:"primarykey"`
+	ProductID int64     `gorm:"not null;uniqueIndex:idx_product_option"`
+	Name      string    `gorm:"type:varchar(50);not null;uniqueIndex:idx_product_option"`
+	Values    string    `gorm:"column:option_values;type:text"` // JSON 格式存储可选值
+	Position  int32     `gorm:"not null;default:0"`             // 规格轴的展示顺序
+	CreatedAt time.Time `gorm:"not null"`
+	UpdatedAt time.Time `gorm:"not null"`
+}
+
+// TableName 设置表名
+func (ProductOption) TableName() string {
+	return "product_options"
+}
+
+// GetValues 获取可选值
+func (o *ProductOption) GetValues() []string {
+	var values []string
+	if o.Values != "" {
+		_ = json.Unmarshal([]byte(o.Values), &values)
+	}
+	return values
+}
+
+// SetValues 设置可选值
+func (o *ProductOption) SetValues(values []string) error {
+	data, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	o.Values = string(data)
+	return nil
+}
+
+// Sku 商品 SKU，每个规格值组合一行，独立定价和管理库存
+// 商品有 SKU 时，商品的价格为在售 SKU 的最低价，库存为各 SKU 库存之和
+type Sku struct {
+	ID         int64     `gorm:"primarykey"`
+	ProductID  int64     `gorm:"not null;uniqueIndex:idx_product_spec"`
+	Spec       string    `gorm:"type:varchar(255);not null;uniqueIndex:idx_product_spec"` // 规格值组合的规范化表示，见 SpecKey
+	Attributes string    `gorm:"type:text"`                                               // JSON 格式存储规格值组合
+	Price      int64     `gorm:"not null"`                                                // 单位：分
+	Stock      int32     `gorm:"not null"`
+	Barcode    string    `gorm:"type:varchar(64);index"`
+	IsOnSale   bool      `gorm:"default:true"`
+	SalesCount int32     `gorm:"default:0"`
+	CreatedAt  time.Time `gorm:"not null"`
+	UpdatedAt  time.Time `gorm:"not null"`
+}
+
+// TableName 设置表名
+func (Sku) TableName() string {
+	return "product_skus"
+}
+
+// GetAttributes 获取规格值组合
+func (s *Sku) GetAttributes() map[string]string {
+	var attributes map[string]string
+	if s.Attributes != "" {
+		_ = json.Unmarshal([]byte(s.Attributes), &attributes)
+	}
+	if attributes == nil {
+		attributes = make(map[string]string)
+	}
+	return attributes
+}
+
+// SetAttributes 设置规格值组合，同时更新 Spec
+func (s *Sku) SetAttributes(attributes map[string]string) error {
+	data, err := json.Marshal(attributes)
+	if err != nil {
+		return err
+	}
+	s.Attributes = string(data)
+	s.Spec = SpecKey(attributes)
+	return nil
+}
+
+// SpecKey 将规格值组合按规格名排序后拼接为 name=value;name=value，用于唯一索引和查找 SKU
+// 规格名和规格值中不能包含 = 和 ;
+func SpecKey(attributes map[string]string) string {
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	pairs := make([]string, 0, len(names))
+	for _, name := range names {
+		pairs = append(pairs, name+"="+attributes[name])
+	}
+	return strings.Join(pairs, ";")
+}
+
+// MatchOptions 检查规格值组合是否为每个规格轴各取了一个可选值
+func MatchOptions(options []*ProductOption, attributes map[string]string) bool {
+	if len(options) == 0 || len(attributes) != len(options) {
+		return false
+	}
+	for _, o := range options {
+		value, ok := attributes[o.Name]
+		if !ok {
+			return false
+		}
+		found := false
+		for _, v := range o.GetValues() {
+			if v == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,84 @@
+package mw
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+	gormmysql "gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"zqzqsb.com/gomall/common/identity"
+	"zqzqsb.com/gomall/common/rbac"
+	"zqzqsb/gomall/app/product/biz/dal/mysql"
+	"zqzqsb/gomall/app/product/biz/dal/redis"
+	"zqzqsb/gomall/app/product/conf"
+)
+
+const (
+	defaultModelFile  = "conf/rbac_model.conf"
+	defaultPolicyFile = "conf/rbac_policy.csv"
+)
+
+// InitCasbin 加载商品管理接口的权限策略，并跟随用户服务发布的角色和黑名单变更重新加载
+func InitCasbin() {
+	cfg := conf.GetConf().Casbin
+	if cfg.ModelFile == "" {
+		cfg.ModelFile = defaultModelFile
+	}
+	if cfg.PolicyFile == "" {
+		cfg.PolicyFile = defaultPolicyFile
+	}
+	// 角色和黑名单读取用户服务的数据库，未单独配置时与本服务共用一个库
+	db := mysql.DB
+	if cfg.DSN != "" {
+		var err error
+		if db, err = gorm.Open(gormmysql.Open(cfg.DSN), &gorm.Config{}); err != nil {
+			panic(err)
+		}
+	}
+	if err := rbac.Init(db, cfg.ModelFile, cfg.PolicyFile); err != nil {
+		panic(err)
+	}
+	// 用户服务在自己的 Redis 上发布策略变更，与令牌吊销状态是同一个实例
+	if err := rbac.Watch(context.Background(), redis.TokenClient, cfg.Channel); err != nil {
+		panic(err)
+	}
+	if cfg.SyncInterval > 0 {
+		go rbac.ReloadPeriodically(context.Background(), cfg.SyncInterval)
+	}
+}
+
+// CasbinMiddleware 按请求路径和方法校验当前用户的权限，需放在 IdentityMiddleware 之后
+func CasbinMiddleware() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		userID, err := identity.GetUserID(ctx)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, utils.H{
+				"code":    http.StatusUnauthorized,
+				"message": err.Error(),
+			})
+			return
+		}
+
+		ok, err := rbac.Enforce(strconv.FormatInt(userID, 10), string(c.Path()), string(c.Method()))
+		if err != nil {
+			hlog.CtxErrorf(ctx, "casbin enforce failed: %v", err)
+			c.AbortWithStatusJSON(http.StatusForbidden, utils.H{
+				"code":    http.StatusForbidden,
+				"message": "权限验证错误",
+			})
+			return
+		}
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusForbidden, utils.H{
+				"code":    http.StatusForbidden,
+				"message": "没有访问权限",
+			})
+			return
+		}
+		c.Next(ctx)
+	}
+}
@@ -0,0 +1,140 @@
+package mw
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/app/server"
+	"github.com/cloudwego/hertz/pkg/common/ut"
+	"zqzqsb.com/gomall/common/identity"
+	"zqzqsb.com/gomall/common/rbac"
+)
+
+// initTestPolicy 使用服务的模型和策略文件初始化，并追加用户服务维护的角色和黑名单：
+// 1 为 admin，2 为 merchant，3 为普通用户，4 为被拉黑的 admin
+func initTestPolicy(t *testing.T) {
+	t.Helper()
+	policy, err := os.ReadFile("../../../conf/rbac_policy.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy = append(policy, "\ng, 1, admin\ng, 2, merchant\ng, 4, admin\np, 4, .*, .*, deny\n"...)
+	policyFile := filepath.Join(t.TempDir(), "rbac_policy.csv")
+	if err = os.WriteFile(policyFile, policy, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err = rbac.Init(nil, "../../../conf/rbac_model.conf", policyFile); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProductPolicy(t *testing.T) {
+	initTestPolicy(t)
+
+	routes := []struct {
+		act, obj string
+	}{
+		{"POST", "/admin/products"},
+		{"POST", "/admin/products/import"},
+		{"GET", "/admin/products/export"},
+		{"PUT", "/admin/products/1"},
+		{"DELETE", "/admin/products/1"},
+		{"PUT", "/admin/products/1/options"},
+		{"PUT", "/admin/products/1/skus"},
+		{"DELETE", "/admin/products/1/skus/2"},
+		{"GET", "/admin/products/1/revisions"},
+		{"POST", "/admin/products/1/restore"},
+		{"POST", "/admin/categories"},
+		{"PUT", "/admin/categories/1"},
+		{"DELETE", "/admin/categories/1"},
+	}
+	isCategory := func(obj string) bool {
+		return strings.HasPrefix(obj, "/admin/categories")
+	}
+
+	tests := []struct {
+		name  string
+		sub   string
+		allow func(obj string) bool
+	}{
+		{"admin", "1", func(string) bool { return true }},
+		{"merchant", "2", func(obj string) bool { return !isCategory(obj) }},
+		{"user", "3", func(string) bool { return false }},
+		{"blacklisted", "4", func(string) bool { return false }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, r := range routes {
+				ok, err := rbac.Enforce(tt.sub, r.obj, r.act)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if want := tt.allow(r.obj); ok != want {
+					t.Errorf("%s %s: got %v, want %v", r.act, r.obj, ok, want)
+				}
+			}
+		})
+	}
+
+	// 路径和方法都必须完全匹配
+	for _, r := range []struct{ act, obj string }{
+		{"GET", "/admin/products/1"},
+		{"PUT", "/admin/products/abc"},
+		{"PUT", "/admin/products/1/extra"},
+		{"DELETE", "/admin/products"},
+	} {
+		if ok, _ := rbac.Enforce("1", r.obj, r.act); ok {
+			t.Errorf("%s %s: expect denied", r.act, r.obj)
+		}
+	}
+}
+
+func TestCasbinMiddleware(t *testing.T) {
+	initTestPolicy(t)
+
+	h := server.New()
+	// 代替 JWT 和 IdentityMiddleware 写入用户身份
+	identity := func(ctx context.Context, c *app.RequestContext) {
+		if userID, err := strconv.ParseInt(string(c.GetHeader("X-User-Id")), 10, 64); err == nil {
+			ctx = identity.WithUserID(ctx, userID)
+		}
+		c.Next(ctx)
+	}
+	ok := func(ctx context.Context, c *app.RequestContext) { c.Status(http.StatusOK) }
+	admin := h.Group("/admin", identity, CasbinMiddleware())
+	admin.PUT("/products/:id", ok)
+	admin.DELETE("/products/:id", ok)
+	admin.POST("/products/:id/restore", ok)
+	admin.POST("/categories", ok)
+
+	tests := []struct {
+		name   string
+		user   string
+		method string
+		path   string
+		want   int
+	}{
+		{"admin updates product", "1", http.MethodPut, "/admin/products/10", http.StatusOK},
+		{"admin creates category", "1", http.MethodPost, "/admin/categories", http.StatusOK},
+		{"merchant deletes product", "2", http.MethodDelete, "/admin/products/10", http.StatusOK},
+		{"merchant restores product", "2", http.MethodPost, "/admin/products/10/restore", http.StatusOK},
+		{"merchant creates category", "2", http.MethodPost, "/admin/categories", http.StatusForbidden},
+		{"user updates product", "3", http.MethodPut, "/admin/products/10", http.StatusForbidden},
+		{"blacklisted admin updates product", "4", http.MethodPut, "/admin/products/10", http.StatusForbidden},
+		{"anonymous", "", http.MethodPut, "/admin/products/10", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := ut.PerformRequest(h.Engine, tt.method, tt.path, nil, ut.Header{Key: "X-User-Id", Value: tt.user})
+			if got := w.Result().StatusCode(); got != tt.want {
+				t.Errorf("got status %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
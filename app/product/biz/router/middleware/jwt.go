@@ -0,0 +1,73 @@
+package mw
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+	"github.com/hertz-contrib/jwt"
+	"zqzqsb.com/gomall/common/identity"
+	"zqzqsb.com/gomall/common/jwtauth"
+	"zqzqsb/gomall/app/product/biz/dal/redis"
+	"zqzqsb/gomall/app/product/conf"
+)
+
+var (
+	JwtMiddleware *jwt.HertzJWTMiddleware // JWT 中间件实例
+	IdentityKey   = "identity"            // 与用户服务保持一致的身份 key
+)
+
+// InitJwt 初始化 JWT 中间件，校验用户服务签发的访问令牌，签名密钥与用户服务保持一致
+func InitJwt() {
+	var err error
+	JwtMiddleware, err = jwt.New(&jwt.HertzJWTMiddleware{
+		Realm:         "gomall",
+		Key:           []byte(conf.GetConf().Jwt.Secret),
+		TokenLookup:   "header: Authorization, cookie: jwt",
+		TokenHeadName: "Bearer",
+		IdentityKey:   IdentityKey,
+		// 从 JWT 载荷中提取用户身份信息
+		IdentityHandler: func(ctx context.Context, c *app.RequestContext) interface{} {
+			claims := jwt.ExtractClaims(ctx, c)
+			// JWT 解析数字通常为 float64
+			f64, ok := claims[IdentityKey].(float64)
+			if !ok {
+				return nil
+			}
+			return int64(f64)
+		},
+		// 拒绝刷新令牌、等待二次验证的令牌和已吊销的访问令牌
+		Authorizator: jwtauth.Authorizator(redis.TokenClient),
+		HTTPStatusMessageFunc: func(e error, ctx context.Context, c *app.RequestContext) string {
+			hlog.CtxErrorf(ctx, "jwt biz err = %+v", e.Error())
+			return e.Error()
+		},
+		Unauthorized: func(ctx context.Context, c *app.RequestContext, code int, message string) {
+			c.JSON(http.StatusUnauthorized, utils.H{
+				"code":    code,
+				"message": message,
+			})
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// IdentityMiddleware 将 JWT 中的用户身份写入上下文，服务层统一通过上下文获取，与 RPC 调用保持一致
+func IdentityMiddleware() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		id, ok := c.Get(IdentityKey)
+		userID, _ := id.(int64)
+		if !ok || userID <= 0 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, utils.H{
+				"code":    http.StatusUnauthorized,
+				"message": "invalid identity in token",
+			})
+			return
+		}
+		c.Next(identity.WithUserID(ctx, userID))
+	}
+}
@@ -4,6 +4,7 @@ package product
 
 import (
 	"github.com/cloudwego/hertz/pkg/app"
+	mw "zqzqsb/gomall/app/product/biz/router/middleware"
 )
 
 func rootMw() []app.HandlerFunc {
@@ -27,8 +28,12 @@ func _getcategoriesMw() []app.HandlerFunc {
 }
 
 func _adminMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	// 商品管理接口需要登录，并由 Casbin 校验 admin 或 merchant 角色
+	return []app.HandlerFunc{
+		mw.JwtMiddleware.MiddlewareFunc(),
+		mw.IdentityMiddleware(),
+		mw.CasbinMiddleware(),
+	}
 }
 
 func _products0Mw() []app.HandlerFunc {
@@ -60,3 +65,73 @@ func _getproductMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _idMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _setproductoptionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _saveskusMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _skusMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deleteskuMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createcategoryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _categoriesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deletecategoryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updatecategoryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _searchproductsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _restoreproductMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listproductrevisionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _exportproductsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _bulkimportproductsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
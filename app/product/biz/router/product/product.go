@@ -20,15 +20,29 @@ func Register(r *server.Hertz) {
 	root.GET("/products", append(_listproductsMw(), product.ListProducts)...)
 	_products := root.Group("/products", _productsMw()...)
 	_products.GET("/categories", append(_getcategoriesMw(), product.GetCategories)...)
+	_products.GET("/search", append(_searchproductsMw(), product.SearchProducts)...)
 	{
 		_admin := root.Group("/admin", _adminMw()...)
+		_admin.POST("/categories", append(_createcategoryMw(), product.CreateCategory)...)
+		_categories := _admin.Group("/categories", _categoriesMw()...)
+		_categories.DELETE("/:id", append(_deletecategoryMw(), product.DeleteCategory)...)
+		_categories.PUT("/:id", append(_updatecategoryMw(), product.UpdateCategory)...)
 		_admin.POST("/products", append(_createproductMw(), product.CreateProduct)...)
 		_products0 := _admin.Group("/products", _products0Mw()...)
-		_products0.PUT("/{id}", append(_updateproductMw(), product.UpdateProduct)...)
-		_products0.DELETE("/{id}", append(_deleteproductMw(), product.DeleteProduct)...)
+		_products0.GET("/export", append(_exportproductsMw(), product.ExportProducts)...)
+		_products0.POST("/import", append(_bulkimportproductsMw(), product.BulkImportProducts)...)
+		_products0.PUT("/:id", append(_updateproductMw(), product.UpdateProduct)...)
+		_products0.DELETE("/:id", append(_deleteproductMw(), product.DeleteProduct)...)
+		_id := _products0.Group("/:id", _idMw()...)
+		_id.PUT("/options", append(_setproductoptionsMw(), product.SetProductOptions)...)
+		_id.POST("/restore", append(_restoreproductMw(), product.RestoreProduct)...)
+		_id.GET("/revisions", append(_listproductrevisionsMw(), product.ListProductRevisions)...)
+		_id.PUT("/skus", append(_saveskusMw(), product.SaveSkus)...)
+		_skus := _id.Group("/skus", _skusMw()...)
+		_skus.DELETE("/:sku_id", append(_deleteskuMw(), product.DeleteSku)...)
 	}
 	{
 		_products1 := root.Group("/products", _products1Mw()...)
-		_products1.GET("/{id}", append(_getproductMw(), product.GetProduct)...)
+		_products1.GET("/:id", append(_getproductMw(), product.GetProduct)...)
 	}
 }
@@ -0,0 +1,325 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
+	"zqzqsb/gomall/app/product/biz/dal/mysql"
+	"zqzqsb/gomall/app/product/biz/model"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+// 批量导入模式
+const (
+	// ImportModeAtomic 所有行都通过校验并写入成功才提交，任意一行失败时整批回滚
+	ImportModeAtomic = "atomic"
+	// ImportModePerRow 每行单独提交，失败的行不影响其他行
+	ImportModePerRow = "per_row"
+)
+
+// maxImportRows 单次导入的最大行数，atomic 模式需要在内存中保留全部行直到提交
+const maxImportRows = 10000
+
+var (
+	// ErrInvalidImportMode 导入模式不是 atomic 或 per_row
+	ErrInvalidImportMode = errors.New("invalid import mode, expect atomic or per_row")
+	// ErrTooManyImportRows 导入的行数超过上限
+	ErrTooManyImportRows = fmt.Errorf("too many rows, at most %d rows per import", maxImportRows)
+)
+
+type BulkImportProductsService struct {
+	ctx context.Context
+} // NewBulkImportProductsService new BulkImportProductsService
+func NewBulkImportProductsService(ctx context.Context) *BulkImportProductsService {
+	return &BulkImportProductsService{ctx: ctx}
+}
+
+// Run 接收客户端流式上传的商品行，流结束后返回导入结果
+// 导入模式和原因取第一条消息中的值；per_row 模式下流中断前已处理的行仍然生效
+func (s *BulkImportProductsService) Run(stream product.ProductService_BulkImportProductsServer) (err error) {
+	req, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		return err
+	}
+	done := err == io.EOF
+	if done {
+		req = &product.BulkImportProductsReq{}
+	}
+
+	im, err := newProductImporter(s.ctx, req.Mode, req.Reason)
+	if err != nil {
+		return err
+	}
+	defer im.invalidate()
+
+	row := 0
+	for {
+		for _, rec := range req.Records {
+			row++
+			if err = im.add(row, rec, nil); err != nil {
+				return err
+			}
+		}
+		if done {
+			break
+		}
+		if req, err = stream.Recv(); err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+	}
+	return stream.SendAndClose(im.finish())
+}
+
+// Import 导入上传的 CSV 或 JSON Lines 文件，供 HTTP 接口使用
+func (s *BulkImportProductsService) Import(format, mode, reason string, r io.Reader) (*product.BulkImportProductsResp, error) {
+	if format != FormatCSV && format != FormatJSONL {
+		return nil, ErrUnsupportedFormat
+	}
+	im, err := newProductImporter(s.ctx, mode, reason)
+	if err != nil {
+		return nil, err
+	}
+	defer im.invalidate()
+
+	if err = decodeRecords(format, r, im.add); err != nil {
+		return nil, err
+	}
+	return im.finish(), nil
+}
+
+// importRow 通过校验等待写入的商品行
+type importRow struct {
+	row      int
+	rec      *product.ProductRecord
+	category *model.Category
+}
+
+// productImporter 逐行校验并写入导入的商品，按外部 SKU 编码新建或更新操作人自己的商品
+type productImporter struct {
+	ctx        context.Context
+	operatorID int64
+	mode       string
+	reason     string
+
+	resp       *product.BulkImportProductsResp
+	pending    []*importRow               // atomic 模式下等待提交的行
+	codes      map[string]int             // 已出现的外部编码及其行号
+	categories map[string]*model.Category // 按名称或 slug 缓存的分类，不存在的分类为 nil
+	changed    []int64                    // 已提交变更的商品ID
+}
+
+func newProductImporter(ctx context.Context, mode, reason string) (*productImporter, error) {
+	if mode == "" {
+		mode = ImportModeAtomic
+	}
+	if mode != ImportModeAtomic && mode != ImportModePerRow {
+		return nil, ErrInvalidImportMode
+	}
+	operatorID, err := requireProductManager(ctx, "POST", productPath(0)+"/import")
+	if err != nil {
+		return nil, err
+	}
+	return &productImporter{
+		ctx:        ctx,
+		operatorID: operatorID,
+		mode:       mode,
+		reason:     reason,
+		resp:       &product.BulkImportProductsResp{},
+		codes:      make(map[string]int),
+		categories: make(map[string]*model.Category),
+	}, nil
+}
+
+// add 校验一行数据，per_row 模式下立即写入；parseErr 为该行的解析错误
+// 只有超过行数上限或查询分类失败时返回错误，其余错误记入错误报告
+func (im *productImporter) add(row int, rec *product.ProductRecord, parseErr error) error {
+	im.resp.Total++
+	if im.resp.Total > maxImportRows {
+		return ErrTooManyImportRows
+	}
+	if parseErr != nil {
+		im.fail(row, "", parseErr)
+		return nil
+	}
+	if err := validateRecord(rec); err != nil {
+		im.fail(row, rec.ExternalCode, err)
+		return nil
+	}
+	if first, ok := im.codes[rec.ExternalCode]; ok {
+		im.fail(row, rec.ExternalCode, fmt.Errorf("duplicate external_code, first seen in row %d", first))
+		return nil
+	}
+	im.codes[rec.ExternalCode] = row
+
+	category, err := im.category(rec.Category)
+	if err != nil {
+		return err
+	}
+	if category == nil {
+		im.fail(row, rec.ExternalCode, fmt.Errorf("unknown category %q", rec.Category))
+		return nil
+	}
+
+	r := &importRow{row: row, rec: rec, category: category}
+	if im.mode == ImportModeAtomic {
+		im.pending = append(im.pending, r)
+		return nil
+	}
+	if err = im.apply(mysql.DB, r); err != nil {
+		im.fail(row, rec.ExternalCode, err)
+	}
+	return nil
+}
+
+// finish 提交 atomic 模式下等待写入的行，返回导入结果
+func (im *productImporter) finish() *product.BulkImportProductsResp {
+	if im.mode == ImportModeAtomic && len(im.resp.Errors) == 0 && len(im.pending) > 0 {
+		created, updated := im.resp.Created, im.resp.Updated
+		err := mysql.DB.Transaction(func(tx *gorm.DB) error {
+			for _, r := range im.pending {
+				if err := im.apply(tx, r); err != nil {
+					im.fail(r.row, r.rec.ExternalCode, err)
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			// 整批回滚，没有商品被修改
+			im.resp.Created, im.resp.Updated, im.changed = created, updated, nil
+		}
+	}
+	im.pending = nil
+	im.resp.Committed = im.resp.Created+im.resp.Updated > 0
+	klog.CtxInfof(im.ctx, "user %d imported products: total %d, created %d, updated %d, failed %d",
+		im.operatorID, im.resp.Total, im.resp.Created, im.resp.Updated, len(im.resp.Errors))
+	return im.resp
+}
+
+// invalidate 清除已提交变更的商品缓存并更新搜索索引
+func (im *productImporter) invalidate() {
+	if len(im.changed) == 0 {
+		return
+	}
+	invalidateProducts(im.ctx, true, im.changed...)
+	reindexProducts(im.ctx, im.changed...)
+	im.changed = nil
+}
+
+func (im *productImporter) fail(row int, code string, err error) {
+	im.resp.Errors = append(im.resp.Errors, &product.ImportRowError{
+		Row:          int32(row),
+		ExternalCode: code,
+		Message:      err.Error(),
+	})
+}
+
+// category 按名称或 slug 查找分类，不存在时返回 nil
+func (im *productImporter) category(key string) (*model.Category, error) {
+	if c, ok := im.categories[key]; ok {
+		return c, nil
+	}
+	c, err := mysql.FindCategory(mysql.DB, key)
+	if errors.Is(err, mysql.ErrCategoryNotFound) {
+		c, err = nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	im.categories[key] = c
+	return c, nil
+}
+
+// apply 按外部编码新建或更新一个商品，并记录变更历史
+// 外部编码只在操作人自己的商品中查找，不会修改其他用户的商品；
+// 已删除的商品撤销删除后更新；已有商品的库存不修改，有 SKU 的商品不修改价格
+func (im *productImporter) apply(db *gorm.DB, r *importRow) error {
+	p, err := recordProduct(r.rec, r.category)
+	if err != nil {
+		return err
+	}
+	p.OwnerID = im.operatorID
+	existing, err := mysql.GetProductByExternalCode(db, im.operatorID, r.rec.ExternalCode)
+	if err != nil && !errors.Is(err, mysql.ErrProductNotFound) {
+		return err
+	}
+
+	rev := &model.ProductRevision{OperatorID: im.operatorID, Reason: im.reason}
+	if existing == nil {
+		rev.Action = model.RevisionCreate
+		err = mysql.RecordRevision(db, rev, func(tx *gorm.DB) (int64, error) {
+			return mysql.CreateProduct(tx, p)
+		})
+		if err != nil {
+			return err
+		}
+		im.resp.Created++
+		im.changed = append(im.changed, p.ID)
+		return nil
+	}
+
+	rev.ProductID = existing.ID
+	rev.Action = model.RevisionUpdate
+	if existing.DeletedAt.Valid {
+		rev.Action = model.RevisionRestore
+	}
+	err = mysql.RecordRevision(db, rev, func(tx *gorm.DB) (int64, error) {
+		hasSkus, err := mysql.HasSkus(tx, existing.ID)
+		if err != nil {
+			return 0, err
+		}
+		fields := map[string]interface{}{
+			"name":        p.Name,
+			"description": p.Description,
+			"image_url":   p.ImageURL,
+			"gallery":     p.Gallery,
+			"category":    p.Category,
+			"category_id": p.CategoryID,
+			"is_on_sale":  p.IsOnSale,
+			"attributes":  p.Attributes,
+		}
+		if !hasSkus {
+			fields["price"] = p.Price
+		}
+		return existing.ID, mysql.ImportProduct(tx, existing.ID, fields)
+	})
+	if err != nil {
+		return err
+	}
+	im.resp.Updated++
+	im.changed = append(im.changed, existing.ID)
+	return nil
+}
+
+// recordProduct 将导入的商品行转换为商品模型
+func recordProduct(rec *product.ProductRecord, category *model.Category) (*model.Product, error) {
+	code := rec.ExternalCode
+	p := &model.Product{
+		Name:         rec.Name,
+		Description:  rec.Description,
+		Price:        rec.Price,
+		Stock:        rec.Stock,
+		ImageURL:     rec.ImageUrl,
+		Category:     category.Name,
+		CategoryID:   category.ID,
+		IsOnSale:     rec.IsOnSale,
+		ExternalCode: &code,
+	}
+	if len(rec.Gallery) > 0 {
+		if err := p.SetGallery(rec.Gallery); err != nil {
+			return nil, err
+		}
+	}
+	if len(rec.Attributes) > 0 {
+		if err := p.SetAttributes(rec.Attributes); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
@@ -0,0 +1,20 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestBulkImportProducts_Run(t *testing.T) {
+	ctx := context.Background()
+	s := NewBulkImportProductsService(ctx)
+	// init req and assert value
+
+	resp, err := s.Import(FormatCSV, ImportModeAtomic, "", strings.NewReader(""))
+	t.Logf("err: %v", err)
+	t.Logf("resp: %v", resp)
+
+	// todo: edit your unit test
+
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+
+	"zqzqsb/gomall/app/product/biz/dal/mysql"
+	"zqzqsb/gomall/app/product/biz/model"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+const (
+	maxCategoryNameLength = 100
+	maxCategorySlugLength = 100
+	maxCategoryIconLength = 255
+)
+
+// ErrInvalidCategory 分类参数不合法
+var ErrInvalidCategory = errors.New("invalid category")
+
+// slugPattern slug 由小写字母、数字和中划线组成，不能以中划线开头或结尾
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// validateCategory 校验分类的名称、slug 和图标
+func validateCategory(c *model.Category) error {
+	if c.Name == "" || utf8.RuneCountInString(c.Name) > maxCategoryNameLength ||
+		len(c.Slug) > maxCategorySlugLength || !slugPattern.MatchString(c.Slug) ||
+		len(c.Icon) > maxCategoryIconLength || c.ParentID < 0 {
+		return ErrInvalidCategory
+	}
+	return nil
+}
+
+// categoryPath 分类管理接口的路径，id 为 0 时为创建分类的路径
+func categoryPath(id int64) string {
+	if id == 0 {
+		return "/admin/categories"
+	}
+	return fmt.Sprintf("/admin/categories/%d", id)
+}
+
+// toProtoCategory 将分类模型转换为 IDL 结构，不包含子分类
+func toProtoCategory(c *model.Category) *product.Category {
+	return &product.Category{
+		Id:        c.ID,
+		ParentId:  c.ParentID,
+		Name:      c.Name,
+		Slug:      c.Slug,
+		Icon:      c.Icon,
+		SortOrder: c.SortOrder,
+	}
+}
+
+// buildCategoryTree 将按 sort_order 排好序的分类组装为树，父分类不存在的分类作为顶级分类
+func buildCategoryTree(categories []*model.Category) []*product.Category {
+	nodes := make(map[int64]*product.Category, len(categories))
+	for _, c := range categories {
+		nodes[c.ID] = toProtoCategory(c)
+	}
+	roots := make([]*product.Category, 0)
+	for _, c := range categories {
+		node := nodes[c.ID]
+		if parent, ok := nodes[c.ParentID]; ok && c.ParentID != c.ID {
+			parent.Children = append(parent.Children, node)
+		} else {
+			roots = append(roots, node)
+		}
+	}
+	return roots
+}
+
+// flattenCategoryNames 按先序遍历返回分类树中的全部分类名称
+func flattenCategoryNames(tree []*product.Category) []string {
+	var names []string
+	for _, c := range tree {
+		names = append(names, c.Name)
+		names = append(names, flattenCategoryNames(c.Children)...)
+	}
+	return names
+}
+
+// resolveCategory 确定商品所属分类：优先使用分类ID，其次按 slug 或名称匹配分类表，
+// 都未匹配时保留填写的分类名称，兼容尚未建立分类表的数据
+func resolveCategory(categoryID int64, name string) (int64, string, error) {
+	if categoryID > 0 {
+		c, err := mysql.GetCategoryByID(mysql.DB, categoryID)
+		if err != nil {
+			return 0, "", err
+		}
+		return c.ID, c.Name, nil
+	}
+	if name == "" {
+		return 0, "", nil
+	}
+	c, err := mysql.FindCategory(mysql.DB, name)
+	if errors.Is(err, mysql.ErrCategoryNotFound) {
+		return 0, name, nil
+	}
+	if err != nil {
+		return 0, "", err
+	}
+	return c.ID, c.Name, nil
+}
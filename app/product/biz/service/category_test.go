@@ -0,0 +1,62 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"zqzqsb/gomall/app/product/biz/model"
+)
+
+func TestBuildCategoryTree(t *testing.T) {
+	// 已按 sort_order 排序
+	categories := []*model.Category{
+		{ID: 3, ParentID: 1, Name: "手机", Path: "/1/3/"},
+		{ID: 1, ParentID: 0, Name: "数码", Path: "/1/"},
+		{ID: 4, ParentID: 3, Name: "5G手机", Path: "/1/3/4/"},
+		{ID: 2, ParentID: 0, Name: "服装", Path: "/2/"},
+		{ID: 5, ParentID: 9, Name: "孤立分类", Path: "/9/5/"},
+	}
+	tree := buildCategoryTree(categories)
+	if len(tree) != 3 {
+		t.Fatalf("expect 3 roots, got %d", len(tree))
+	}
+	if tree[0].Id != 1 || len(tree[0].Children) != 1 || tree[0].Children[0].Children[0].Id != 4 {
+		t.Errorf("unexpected tree: %v", tree)
+	}
+
+	names := flattenCategoryNames(tree)
+	want := []string{"数码", "手机", "5G手机", "服装", "孤立分类"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("expect %v, got %v", want, names)
+	}
+}
+
+func TestValidateCategory(t *testing.T) {
+	if err := validateCategory(&model.Category{Name: "手机", Slug: "mobile-phone"}); err != nil {
+		t.Errorf("expect valid category, got %v", err)
+	}
+	invalid := []*model.Category{
+		{Name: "", Slug: "phone"},
+		{Name: "手机", Slug: ""},
+		{Name: "手机", Slug: "Phone"},
+		{Name: "手机", Slug: "-phone"},
+		{Name: "手机", Slug: "mobile--phone"},
+		{Name: "手机", Slug: "phone", ParentID: -1},
+	}
+	for _, c := range invalid {
+		if err := validateCategory(c); err != ErrInvalidCategory {
+			t.Errorf("expect %+v to be invalid, got %v", c, err)
+		}
+	}
+}
+
+func TestCategoryPath(t *testing.T) {
+	root := &model.Category{ID: 1, Path: model.CategoryPath("/", 1)}
+	child := &model.Category{ID: 5, Path: model.CategoryPath(root.Path, 5)}
+	if child.Path != "/1/5/" || root.Depth() != 1 || child.Depth() != 2 {
+		t.Errorf("unexpected path %q or depth %d", child.Path, child.Depth())
+	}
+	if ids := child.AncestorIDs(); !reflect.DeepEqual(ids, []int64{1, 5}) {
+		t.Errorf("unexpected ancestors %v", ids)
+	}
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"zqzqsb/gomall/app/product/biz/dal/mysql"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+type ConfirmReservationService struct {
+	ctx context.Context
+} // NewConfirmReservationService new ConfirmReservationService
+func NewConfirmReservationService(ctx context.Context) *ConfirmReservationService {
+	return &ConfirmReservationService{ctx: ctx}
+}
+
+// Run confirm reservation, held stock is deducted permanently
+func (s *ConfirmReservationService) Run(req *product.ConfirmReservationReq) (resp *product.ConfirmReservationResp, err error) {
+	// 参数验证
+	if req.Token == "" {
+		return nil, errors.New("invalid reservation token")
+	}
+
+	productIDs, err := mysql.ConfirmReservation(mysql.DB, req.Token, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// 确认后销量变化，删除商品详情缓存
+	invalidateProducts(s.ctx, false, productIDs...)
+
+	// 构建响应
+	resp = &product.ConfirmReservationResp{
+		Success: true,
+	}
+
+	return resp, nil
+}
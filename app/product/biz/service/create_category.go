@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"zqzqsb/gomall/app/product/biz/dal/mysql"
+	"zqzqsb/gomall/app/product/biz/model"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+type CreateCategoryService struct {
+	ctx context.Context
+} // NewCreateCategoryService new CreateCategoryService
+func NewCreateCategoryService(ctx context.Context) *CreateCategoryService {
+	return &CreateCategoryService{ctx: ctx}
+}
+
+// Run create a category
+func (s *CreateCategoryService) Run(req *product.CreateCategoryReq) (resp *product.CreateCategoryResp, err error) {
+	operatorID, err := requireProductManager(s.ctx, "POST", categoryPath(0))
+	if err != nil {
+		return nil, err
+	}
+
+	c := &model.Category{
+		ParentID:  req.ParentId,
+		Name:      req.Name,
+		Slug:      req.Slug,
+		Icon:      req.Icon,
+		SortOrder: req.SortOrder,
+	}
+	if err = validateCategory(c); err != nil {
+		return nil, err
+	}
+
+	if err = mysql.CreateCategory(mysql.DB, c); err != nil {
+		return nil, err
+	}
+	klog.CtxInfof(s.ctx, "category %d created by user %d", c.ID, operatorID)
+
+	return &product.CreateCategoryResp{Category: toProtoCategory(c)}, nil
+}
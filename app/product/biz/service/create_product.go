@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
-	
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
 	"zqzqsb/gomall/app/product/biz/dal/mysql"
 	"zqzqsb/gomall/app/product/biz/model"
 	product "zqzqsb/gomall/app/product/kitex_gen/product"
@@ -17,6 +19,17 @@ func NewCreateProductService(ctx context.Context) *CreateProductService {
 
 // Run create product info
 func (s *CreateProductService) Run(req *product.CreateProductReq) (resp *product.CreateProductResp, err error) {
+	operatorID, err := requireProductManager(s.ctx, "POST", productPath(0))
+	if err != nil {
+		return nil, err
+	}
+
+	// 关联分类
+	categoryID, category, err := resolveCategory(req.CategoryId, req.Category)
+	if err != nil {
+		return nil, err
+	}
+
 	// 创建商品模型
 	p := &model.Product{
 		Name:        req.Name,
@@ -24,8 +37,10 @@ func (s *CreateProductService) Run(req *product.CreateProductReq) (resp *product
 		Price:       req.Price,
 		Stock:       req.Stock,
 		ImageURL:    req.ImageUrl,
-		Category:    req.Category,
+		Category:    category,
+		CategoryID:  categoryID,
 		IsOnSale:    req.IsOnSale,
+		OwnerID:     operatorID,
 	}
 
 	// 设置图片集
@@ -42,11 +57,20 @@ func (s *CreateProductService) Run(req *product.CreateProductReq) (resp *product
 		}
 	}
 
-	// 保存到数据库
-	productID, err := mysql.CreateProduct(mysql.DB, p)
+	// 保存到数据库，并记录变更历史
+	rev := &model.ProductRevision{Action: model.RevisionCreate, OperatorID: operatorID, Reason: req.Reason}
+	err = mysql.RecordRevision(mysql.DB, rev, func(tx *gorm.DB) (int64, error) {
+		return mysql.CreateProduct(tx, p)
+	})
 	if err != nil {
 		return nil, err
 	}
+	productID := p.ID
+
+	// 清除可能存在的空值缓存，并使列表缓存失效
+	invalidateProducts(s.ctx, true, productID)
+	reindexProducts(s.ctx, productID)
+	klog.CtxInfof(s.ctx, "product %d created by user %d", productID, operatorID)
 
 	// 返回响应
 	resp = &product.CreateProductResp{
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"zqzqsb/gomall/app/product/biz/dal/mysql"
+	"zqzqsb/gomall/app/product/biz/model"
+	"zqzqsb/gomall/app/product/conf"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+// ErrInvalidCursor 游标被篡改、格式错误或不属于当前查询
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// listCursor 游标内容，客户端只透传不解析
+type listCursor struct {
+	Filter string `json:"f"` // 查询条件摘要
+	Value  string `json:"v"` // 上一页最后一个商品的排序字段值
+	ID     int64  `json:"id"`
+}
+
+// listFilterDigest 影响结果集和排序的查询条件摘要，游标只能用于生成它的同一查询
+func listFilterDigest(req *product.ListProductsReq) string {
+	cond := fmt.Sprintf("%s|%d|%s|%t|%d|%d|%s|%t",
+		req.Category, req.CategoryId, req.Keyword, req.OnSaleOnly,
+		req.MinPrice, req.MaxPrice, req.SortBy, req.Ascending)
+	sum := md5.Sum([]byte(cond))
+	return hex.EncodeToString(sum[:8])
+}
+
+// cursorSecret 游标签名密钥
+func cursorSecret() []byte {
+	return []byte(conf.GetConf().Pagination.CursorSecret)
+}
+
+func signCursor(secret, payload []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// encodeCursor 根据当前页最后一个商品生成下一页的游标，格式为 base64(内容).base64(签名)
+func encodeCursor(secret []byte, req *product.ListProductsReq, last *model.Product) (string, error) {
+	c := listCursor{Filter: listFilterDigest(req), ID: last.ID}
+	switch column, _ := mysql.SortOrder(req); column {
+	case "price":
+		c.Value = strconv.FormatInt(last.Price, 10)
+	case "created_at":
+		c.Value = last.CreatedAt.Format(time.RFC3339Nano)
+	case "sales_count":
+		c.Value = strconv.FormatInt(int64(last.SalesCount), 10)
+	case "rating":
+		c.Value = strconv.FormatFloat(float64(last.Rating), 'g', -1, 32)
+	}
+	payload, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString(signCursor(secret, payload)), nil
+}
+
+// decodeCursor 校验签名和查询条件后解析出上一页末尾的排序值
+func decodeCursor(secret []byte, req *product.ListProductsReq, cursor string) (*mysql.Keyset, error) {
+	encoded, sig, ok := strings.Cut(cursor, ".")
+	if !ok {
+		return nil, ErrInvalidCursor
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(mac, signCursor(secret, payload)) {
+		return nil, ErrInvalidCursor
+	}
+
+	var c listCursor
+	if err = json.Unmarshal(payload, &c); err != nil || c.Filter != listFilterDigest(req) {
+		return nil, ErrInvalidCursor
+	}
+
+	keyset := &mysql.Keyset{ID: c.ID}
+	switch column, _ := mysql.SortOrder(req); column {
+	case "price":
+		keyset.Value, err = strconv.ParseInt(c.Value, 10, 64)
+	case "created_at":
+		keyset.Value, err = time.Parse(time.RFC3339Nano, c.Value)
+	case "sales_count":
+		var v int64
+		v, err = strconv.ParseInt(c.Value, 10, 32)
+		keyset.Value = int32(v)
+	case "rating":
+		// 按 float32 解析，与 FLOAT 列中存储的值精确相等
+		var v float64
+		v, err = strconv.ParseFloat(c.Value, 32)
+		keyset.Value = float64(float32(v))
+	}
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return keyset, nil
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"zqzqsb/gomall/app/product/biz/model"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+func TestCursor(t *testing.T) {
+	secret := []byte("secret")
+	created := time.Date(2024, 5, 1, 12, 0, 0, 123000000, time.Local)
+	last := &model.Product{ID: 42, Price: 1999, SalesCount: 7, Rating: 4.7, CreatedAt: created}
+
+	cases := map[string]interface{}{
+		"price":       int64(1999),
+		"sales":       int32(7),
+		"rating":      float64(float32(4.7)),
+		"create_time": created,
+		"":            nil,
+	}
+	for sortBy, want := range cases {
+		req := &product.ListProductsReq{SortBy: sortBy, Keyword: "手机"}
+		cursor, err := encodeCursor(secret, req, last)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keyset, err := decodeCursor(secret, req, cursor)
+		if err != nil {
+			t.Fatalf("%s: %v", sortBy, err)
+		}
+		if keyset.ID != 42 {
+			t.Errorf("%s: expect id 42, got %d", sortBy, keyset.ID)
+		}
+		if v, ok := want.(time.Time); ok {
+			if !keyset.Value.(time.Time).Equal(v) {
+				t.Errorf("%s: expect %v, got %v", sortBy, v, keyset.Value)
+			}
+		} else if keyset.Value != want {
+			t.Errorf("%s: expect %v, got %v", sortBy, want, keyset.Value)
+		}
+	}
+}
+
+func TestCursorRejected(t *testing.T) {
+	secret := []byte("secret")
+	req := &product.ListProductsReq{SortBy: "price"}
+	cursor, err := encodeCursor(secret, req, &model.Product{ID: 1, Price: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = decodeCursor([]byte("other"), req, cursor); err != ErrInvalidCursor {
+		t.Errorf("expect wrong signature rejected, got %v", err)
+	}
+	if _, err = decodeCursor(secret, &product.ListProductsReq{SortBy: "price", Ascending: true}, cursor); err != ErrInvalidCursor {
+		t.Errorf("expect cursor of another query rejected, got %v", err)
+	}
+	tampered := "x" + cursor[1:]
+	if _, err = decodeCursor(secret, req, tampered); err != ErrInvalidCursor {
+		t.Errorf("expect tampered cursor rejected, got %v", err)
+	}
+	if _, err = decodeCursor(secret, req, "garbage"); err != ErrInvalidCursor {
+		t.Errorf("expect malformed cursor rejected, got %v", err)
+	}
+}
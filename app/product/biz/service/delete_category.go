@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"zqzqsb/gomall/app/product/biz/dal/mysql"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+type DeleteCategoryService struct {
+	ctx context.Context
+} // NewDeleteCategoryService new DeleteCategoryService
+func NewDeleteCategoryService(ctx context.Context) *DeleteCategoryService {
+	return &DeleteCategoryService{ctx: ctx}
+}
+
+// Run delete a category without children or products
+func (s *DeleteCategoryService) Run(req *product.DeleteCategoryReq) (resp *product.DeleteCategoryResp, err error) {
+	// 参数验证
+	if req.Id <= 0 {
+		return nil, errors.New("invalid category id")
+	}
+	operatorID, err := requireProductManager(s.ctx, "DELETE", categoryPath(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = mysql.DeleteCategory(mysql.DB, req.Id); err != nil {
+		return nil, err
+	}
+	klog.CtxInfof(s.ctx, "category %d deleted by user %d", req.Id, operatorID)
+
+	return &product.DeleteCategoryResp{Success: true}, nil
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+	"testing"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+func TestDeleteCategory_Run(t *testing.T) {
+	ctx := context.Background()
+	s := NewDeleteCategoryService(ctx)
+	// init req and assert value
+
+	req := &product.DeleteCategoryReq{}
+	resp, err := s.Run(req)
+	t.Logf("err: %v", err)
+	t.Logf("resp: %v", resp)
+
+	// todo: edit your unit test
+
+}
@@ -3,8 +3,11 @@ package service
 import (
 	"context"
 	"errors"
-	
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
 	"zqzqsb/gomall/app/product/biz/dal/mysql"
+	"zqzqsb/gomall/app/product/biz/model"
 	product "zqzqsb/gomall/app/product/kitex_gen/product"
 )
 
@@ -21,12 +24,25 @@ func (s *DeleteProductService) Run(req *product.DeleteProductReq) (resp *product
 	if req.Id <= 0 {
 		return nil, errors.New("invalid product id")
 	}
+	operatorID, err := requireProductOwner(s.ctx, "DELETE", productPath(req.Id), req.Id)
+	if err != nil {
+		return nil, err
+	}
 
-	// 从数据库删除商品
-	if err = mysql.DeleteProduct(mysql.DB, req.Id); err != nil {
+	// 从数据库删除商品，并记录变更历史；软删除的商品可以通过 RestoreProduct 恢复
+	rev := &model.ProductRevision{ProductID: req.Id, Action: model.RevisionDelete, OperatorID: operatorID, Reason: req.Reason}
+	err = mysql.RecordRevision(mysql.DB, rev, func(tx *gorm.DB) (int64, error) {
+		return req.Id, mysql.DeleteProduct(tx, req.Id)
+	})
+	if err != nil {
 		return nil, err
 	}
 
+	// 先更新数据库，再删除缓存
+	invalidateProducts(s.ctx, true, req.Id)
+	reindexProducts(s.ctx, req.Id)
+	klog.CtxInfof(s.ctx, "product %d deleted by user %d", req.Id, operatorID)
+
 	// 返回响应
 	resp = &product.DeleteProductResp{
 		Success: true,
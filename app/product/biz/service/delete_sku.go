@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"zqzqsb/gomall/app/product/biz/dal/mysql"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+type DeleteSkuService struct {
+	ctx context.Context
+} // NewDeleteSkuService new DeleteSkuService
+func NewDeleteSkuService(ctx context.Context) *DeleteSkuService {
+	return &DeleteSkuService{ctx: ctx}
+}
+
+// Run delete a sku of a product
+func (s *DeleteSkuService) Run(req *product.DeleteSkuReq) (resp *product.DeleteSkuResp, err error) {
+	// 参数验证
+	if req.ProductId <= 0 || req.SkuId <= 0 {
+		return nil, errors.New("invalid product id or sku id")
+	}
+	operatorID, err := requireProductOwner(s.ctx, "DELETE", fmt.Sprintf("%s/skus/%d", productPath(req.ProductId), req.SkuId), req.ProductId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = mysql.DeleteSku(mysql.DB, req.ProductId, req.SkuId); err != nil {
+		return nil, err
+	}
+
+	invalidateProducts(s.ctx, true, req.ProductId)
+	reindexProducts(s.ctx, req.ProductId)
+	klog.CtxInfof(s.ctx, "sku %d of product %d deleted by user %d", req.SkuId, req.ProductId, operatorID)
+
+	return &product.DeleteSkuResp{Success: true}, nil
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	"zqzqsb/gomall/app/product/biz/dal/mysql"
+	"zqzqsb/gomall/app/product/biz/model"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+// 导出时每批商品数的默认值和上限
+const (
+	defaultExportBatchSize = 100
+	maxExportBatchSize     = 1000
+)
+
+type ExportProductsService struct {
+	ctx context.Context
+} // NewExportProductsService new ExportProductsService
+func NewExportProductsService(ctx context.Context) *ExportProductsService {
+	return &ExportProductsService{ctx: ctx}
+}
+
+// Run 按商品ID升序分批流式返回商品，包括已下架的商品
+func (s *ExportProductsService) Run(req *product.ExportProductsReq, stream product.ProductService_ExportProductsServer) (err error) {
+	return s.Export(req, func(records []*product.ProductRecord) error {
+		return stream.Send(&product.ExportProductsResp{Records: records})
+	})
+}
+
+// ExportFile 将商品导出为 CSV 或 JSON Lines 文件写入 w，供 HTTP 接口使用
+func (s *ExportProductsService) ExportFile(req *product.ExportProductsReq, format string, w io.Writer) error {
+	encoder, err := newRecordEncoder(format, w)
+	if err != nil {
+		return err
+	}
+	err = s.Export(req, func(records []*product.ProductRecord) error {
+		for _, rec := range records {
+			if err := encoder.Encode(rec); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return encoder.Flush()
+}
+
+// Export 分批读取要导出的商品并交给 send 输出，send 返回错误时停止导出
+// admin 导出全部商品，其他角色只导出自己创建的商品
+func (s *ExportProductsService) Export(req *product.ExportProductsReq, send func(records []*product.ProductRecord) error) error {
+	operatorID, err := requireProductManager(s.ctx, "GET", productPath(0)+"/export")
+	if err != nil {
+		return err
+	}
+	var ownerID int64
+	all, err := canManageAllProducts(operatorID)
+	if err != nil {
+		return err
+	}
+	if !all {
+		ownerID = operatorID
+	}
+
+	batchSize := int(req.BatchSize)
+	if batchSize <= 0 {
+		batchSize = defaultExportBatchSize
+	}
+	if batchSize > maxExportBatchSize {
+		batchSize = maxExportBatchSize
+	}
+
+	var category *model.Category
+	if req.CategoryId > 0 {
+		c, err := mysql.GetCategoryByID(mysql.DB, req.CategoryId)
+		if err != nil {
+			return err
+		}
+		category = c
+	}
+
+	var afterID int64
+	for {
+		products, err := mysql.ScanCategoryProducts(mysql.DB, category, ownerID, afterID, batchSize)
+		if err != nil {
+			return err
+		}
+		if len(products) == 0 {
+			return nil
+		}
+		records := make([]*product.ProductRecord, 0, len(products))
+		for _, p := range products {
+			records = append(records, toRecord(p))
+		}
+		if err = send(records); err != nil {
+			return err
+		}
+		if len(products) < batchSize {
+			return nil
+		}
+		afterID = products[len(products)-1].ID
+	}
+}
@@ -0,0 +1,23 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+func TestExportProducts_Run(t *testing.T) {
+	ctx := context.Background()
+	s := NewExportProductsService(ctx)
+	// init req and assert value
+
+	req := &product.ExportProductsReq{}
+	var buf bytes.Buffer
+	err := s.ExportFile(req, FormatCSV, &buf)
+	t.Logf("err: %v", err)
+	t.Logf("resp: %v", buf.String())
+
+	// todo: edit your unit test
+
+}
@@ -16,15 +16,25 @@ func NewGetCategoriesService(ctx context.Context) *GetCategoriesService {
 
 // Run get categories
 func (s *GetCategoriesService) Run(req *product.GetCategoriesReq) (resp *product.GetCategoriesResp, err error) {
-	// 从数据库获取所有商品分类
-	categories, err := mysql.GetCategories(mysql.DB)
+	// 从数据库获取全部分类并组装为树
+	categories, err := mysql.ListCategories(mysql.DB)
 	if err != nil {
 		return nil, err
 	}
+	tree := buildCategoryTree(categories)
+
+	// 尚未建立分类表时，返回商品上填写的分类名称
+	names := flattenCategoryNames(tree)
+	if len(names) == 0 {
+		if names, err = mysql.GetLegacyCategories(mysql.DB); err != nil {
+			return nil, err
+		}
+	}
 
 	// 构建响应
 	resp = &product.GetCategoriesResp{
-		Categories: categories,
+		Categories: names,
+		Tree:       tree,
 	}
 
 	return resp, nil
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	
-	"zqzqsb/gomall/app/product/biz/dal/mysql"
 	product "zqzqsb/gomall/app/product/kitex_gen/product"
 )
 
@@ -22,33 +21,15 @@ func (s *GetProductService) Run(req *product.GetProductReq) (resp *product.GetPr
 		return nil, errors.New("invalid product id")
 	}
 
-	// 从数据库获取商品
-	p, err := mysql.GetProductByID(mysql.DB, req.Id)
+	// 优先从缓存获取商品
+	p, err := getProduct(s.ctx, req.Id)
 	if err != nil {
 		return nil, err
 	}
 
 	// 构建响应
-	gallery := p.GetGallery()
-	attributes := p.GetAttributes()
-
 	resp = &product.GetProductResp{
-		Product: &product.Product{
-			Id:          p.ID,
-			Name:        p.Name,
-			Description: p.Description,
-			Price:       p.Price,
-			Stock:       p.Stock,
-			ImageUrl:    p.ImageURL,
-			Gallery:     gallery,
-			Category:    p.Category,
-			IsOnSale:    p.IsOnSale,
-			Attributes:  attributes,
-			Rating:      p.Rating,
-			SalesCount:  p.SalesCount,
-			CreateTime:  p.CreatedAt.Unix(),
-			UpdateTime:  p.UpdatedAt.Unix(),
-		},
+		Product: toProtoProduct(p),
 	}
 
 	return resp, nil
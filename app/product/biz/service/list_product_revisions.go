@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"zqzqsb/gomall/app/product/biz/dal/mysql"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+const maxRevisionPageSize = 100
+
+type ListProductRevisionsService struct {
+	ctx context.Context
+} // NewListProductRevisionsService new ListProductRevisionsService
+func NewListProductRevisionsService(ctx context.Context) *ListProductRevisionsService {
+	return &ListProductRevisionsService{ctx: ctx}
+}
+
+// Run list change history of a product
+func (s *ListProductRevisionsService) Run(req *product.ListProductRevisionsReq) (resp *product.ListProductRevisionsResp, err error) {
+	// 参数验证
+	if req.ProductId <= 0 {
+		return nil, errors.New("invalid product id")
+	}
+	if _, err = requireProductOwner(s.ctx, "GET", productPath(req.ProductId)+"/revisions", req.ProductId); err != nil {
+		return nil, err
+	}
+
+	// 设置默认值
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+	if req.PageSize > maxRevisionPageSize {
+		req.PageSize = maxRevisionPageSize
+	}
+
+	revisions, total, err := mysql.ListRevisions(mysql.DB, req.ProductId, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &product.ListProductRevisionsResp{
+		Revisions: make([]*product.ProductRevision, 0, len(revisions)),
+		Total:     int32(total),
+	}
+	for _, r := range revisions {
+		rev := &product.ProductRevision{
+			Id:         r.ID,
+			ProductId:  r.ProductID,
+			Action:     r.Action,
+			OperatorId: r.OperatorID,
+			Reason:     r.Reason,
+			SkuId:      r.SkuID,
+			CreateTime: r.CreatedAt.Unix(),
+		}
+		for _, c := range r.Changes() {
+			rev.Changes = append(rev.Changes, &product.FieldChange{Field: c.Field, Before: c.Before, After: c.After})
+		}
+		resp.Revisions = append(resp.Revisions, rev)
+	}
+
+	return resp, nil
+}
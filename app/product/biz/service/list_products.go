@@ -25,45 +25,57 @@ func (s *ListProductsService) Run(req *product.ListProductsReq) (resp *product.L
 		req.PageSize = 10
 	}
 
-	// 从数据库获取商品列表
-	products, total, err := mysql.ListProducts(mysql.DB, req)
+	// 游标分页忽略页码，传入游标时先校验，避免无效游标占用缓存
+	var opts mysql.ListOptions
+	if req.Cursor != "" {
+		req.UseCursor = true
+		if opts.After, err = decodeCursor(cursorSecret(), req, req.Cursor); err != nil {
+			return nil, err
+		}
+	}
+	if req.UseCursor {
+		req.Page = 1
+	}
+
+	// 优先从缓存获取，未命中时查询数据库
+	return listProducts(s.ctx, req, func() (*product.ListProductsResp, error) {
+		return s.load(req, opts)
+	})
+}
+
+// load 从数据库获取商品列表，关键词优先通过搜索索引匹配
+func (s *ListProductsService) load(req *product.ListProductsReq, opts mysql.ListOptions) (*product.ListProductsResp, error) {
+	opts.MatchedIDs = searchProductIDs(s.ctx, req.Keyword)
+	opts.SkipTotal = req.SkipTotal
+	products, total, hasMore, err := mysql.ListProducts(mysql.DB, req, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// 计算总页数
-	totalPages := int32(math.Ceil(float64(total) / float64(req.PageSize)))
-
 	// 构建响应
-	resp = &product.ListProductsResp{
+	resp := &product.ListProductsResp{
 		Products:    make([]*product.Product, 0, len(products)),
 		Total:       int32(total),
 		Page:        req.Page,
 		PageSize:    req.PageSize,
-		TotalPages:  totalPages,
+		HasMore:     hasMore,
+	}
+
+	// 计算总页数
+	if !req.SkipTotal {
+		resp.TotalPages = int32(math.Ceil(float64(total) / float64(req.PageSize)))
+	}
+
+	// 游标分页时返回下一页的游标
+	if req.UseCursor && hasMore {
+		if resp.NextCursor, err = encodeCursor(cursorSecret(), req, products[len(products)-1]); err != nil {
+			return nil, err
+		}
 	}
 
 	// 填充商品信息
 	for _, p := range products {
-		gallery := p.GetGallery()
-		attributes := p.GetAttributes()
-
-		resp.Products = append(resp.Products, &product.Product{
-			Id:          p.ID,
-			Name:        p.Name,
-			Description: p.Description,
-			Price:       p.Price,
-			Stock:       p.Stock,
-			ImageUrl:    p.ImageURL,
-			Gallery:     gallery,
-			Category:    p.Category,
-			IsOnSale:    p.IsOnSale,
-			Attributes:  attributes,
-			Rating:      p.Rating,
-			SalesCount:  p.SalesCount,
-			CreateTime:  p.CreatedAt.Unix(),
-			UpdateTime:  p.UpdatedAt.Unix(),
-		})
+		resp.Products = append(resp.Products, toProtoProduct(p))
 	}
 
 	return resp, nil
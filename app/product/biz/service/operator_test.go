@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"zqzqsb.com/gomall/common/identity"
+	"zqzqsb.com/gomall/common/rbac"
+)
+
+func TestRequireProductManager(t *testing.T) {
+	// 1 为 admin，2 为 merchant，3 为普通用户，4 为被拉黑的 merchant
+	policy, err := os.ReadFile("../../conf/rbac_policy.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy = append(policy, "\ng, 1, admin\ng, 2, merchant\ng, 4, merchant\np, 4, .*, .*, deny\n"...)
+	policyFile := filepath.Join(t.TempDir(), "rbac_policy.csv")
+	if err = os.WriteFile(policyFile, policy, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err = rbac.Init(nil, "../../conf/rbac_model.conf", policyFile); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		userID  int64
+		act     string
+		obj     string
+		wantErr error
+	}{
+		{"admin", 1, "PUT", productPath(10), nil},
+		{"merchant", 2, "POST", productPath(0), nil},
+		{"merchant on category", 2, "POST", categoryPath(0), ErrPermissionDenied},
+		{"user", 3, "PUT", productPath(10), ErrPermissionDenied},
+		{"blacklisted", 4, "POST", productPath(0), ErrPermissionDenied},
+		{"anonymous", 0, "PUT", productPath(10), identity.ErrUnauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.userID > 0 {
+				ctx = identity.WithUserID(ctx, tt.userID)
+			}
+			userID, err := requireProductManager(ctx, tt.act, tt.obj)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && userID != tt.userID {
+				t.Errorf("got user %d, want %d", userID, tt.userID)
+			}
+		})
+	}
+
+	// admin 管理任意商品，不需要查询商品的所有者
+	ctx := identity.WithUserID(context.Background(), 1)
+	if _, err = requireProductOwner(ctx, "DELETE", productPath(10), 10); err != nil {
+		t.Errorf("expect admin to manage any product, got %v", err)
+	}
+	// 没有角色权限时在查询所有者之前拒绝
+	ctx = identity.WithUserID(context.Background(), 3)
+	if _, err = requireProductOwner(ctx, "DELETE", productPath(10), 10); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("got error %v, want %v", err, ErrPermissionDenied)
+	}
+}
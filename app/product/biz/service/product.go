@@ -0,0 +1,31 @@
+package service
+
+import (
+	"zqzqsb/gomall/app/product/biz/model"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+// toProtoProduct 将商品模型转换为 IDL 结构
+func toProtoProduct(p *model.Product) *product.Product {
+	return &product.Product{
+		Id:           p.ID,
+		Name:         p.Name,
+		Description:  p.Description,
+		Price:        p.Price,
+		Stock:        p.Stock,
+		ImageUrl:     p.ImageURL,
+		Gallery:      p.GetGallery(),
+		Category:     p.Category,
+		CategoryId:   p.CategoryID,
+		Version:      p.Version,
+		ExternalCode: p.GetExternalCode(),
+		IsOnSale:     p.IsOnSale,
+		Attributes:   p.GetAttributes(),
+		Rating:       p.Rating,
+		SalesCount:   p.SalesCount,
+		CreateTime:   p.CreatedAt.Unix(),
+		UpdateTime:   p.UpdatedAt.Unix(),
+		Options:      toProtoOptions(p.Options),
+		Skus:         toProtoSkus(p.Skus),
+	}
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	goredis "github.com/redis/go-redis/v9"
+	"golang.org/x/sync/singleflight"
+	"zqzqsb/gomall/app/product/biz/dal/mysql"
+	"zqzqsb/gomall/app/product/biz/dal/redis"
+	"zqzqsb/gomall/app/product/biz/model"
+	"zqzqsb/gomall/app/product/conf"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+// 未配置缓存有效期时使用的默认值
+const (
+	defaultProductCacheTTL = 30 * time.Minute
+	defaultListCacheTTL    = time.Minute
+	defaultNullCacheTTL    = time.Minute
+)
+
+// productGroup 合并同一商品或同一列表查询的并发回源请求，防止热点 key 过期时缓存击穿
+var productGroup singleflight.Group
+
+// jitterTTL 在基础有效期上增加不超过 jitter 比例的随机时长，避免缓存集中过期导致雪崩
+func jitterTTL(ttl, fallback time.Duration, jitter float64) time.Duration {
+	if ttl <= 0 {
+		ttl = fallback
+	}
+	if jitter <= 0 {
+		return ttl
+	}
+	return ttl + time.Duration(rand.Int63n(int64(float64(ttl)*jitter)+1))
+}
+
+// cacheEnabled Redis 未初始化时（如单元测试）直接读写数据库
+func cacheEnabled() bool {
+	return redis.RedisClient != nil
+}
+
+// getProduct 按 cache-aside 读取商品详情：先读缓存，未命中时回源数据库并写入缓存
+// 缓存不可用时降级为直接查询数据库
+func getProduct(ctx context.Context, id int64) (*model.Product, error) {
+	if !cacheEnabled() {
+		return mysql.GetProductDetail(mysql.DB, id)
+	}
+
+	p, err := redis.GetProduct(ctx, redis.RedisClient, id)
+	switch {
+	case err == nil && p != nil:
+		return p, nil
+	case err == nil:
+		// 命中空值缓存
+		return nil, mysql.ErrProductNotFound
+	case !errors.Is(err, goredis.Nil):
+		klog.CtxWarnf(ctx, "get product %d from cache failed: %v", id, err)
+	}
+
+	v, err, _ := productGroup.Do("product:"+strconv.FormatInt(id, 10), func() (interface{}, error) {
+		cacheConf := conf.GetConf().Cache
+		p, err := mysql.GetProductDetail(mysql.DB, id)
+		if errors.Is(err, mysql.ErrProductNotFound) {
+			if serr := redis.SetProduct(ctx, redis.RedisClient, id, nil, jitterTTL(cacheConf.NullTTL, defaultNullCacheTTL, cacheConf.Jitter)); serr != nil {
+				klog.CtxWarnf(ctx, "set null product %d cache failed: %v", id, serr)
+			}
+			return nil, err
+		}
+		if err != nil {
+			return nil, err
+		}
+		if serr := redis.SetProduct(ctx, redis.RedisClient, id, p, jitterTTL(cacheConf.ProductTTL, defaultProductCacheTTL, cacheConf.Jitter)); serr != nil {
+			klog.CtxWarnf(ctx, "set product %d cache failed: %v", id, serr)
+		}
+		return p, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*model.Product), nil
+}
+
+// listProducts 按 cache-aside 读取商品列表，load 负责从数据库构建响应
+func listProducts(ctx context.Context, req *product.ListProductsReq,
+	load func() (*product.ListProductsResp, error)) (*product.ListProductsResp, error) {
+	if !cacheEnabled() {
+		return load()
+	}
+
+	version, err := redis.GetListVersion(ctx, redis.RedisClient)
+	if err != nil {
+		klog.CtxWarnf(ctx, "get product list version failed: %v", err)
+		return load()
+	}
+	key := redis.ProductListKey(version, req)
+
+	resp, err := redis.GetProductList(ctx, redis.RedisClient, key)
+	if err == nil {
+		return resp, nil
+	}
+	if !errors.Is(err, goredis.Nil) {
+		klog.CtxWarnf(ctx, "get product list from cache failed: %v", err)
+	}
+
+	v, err, _ := productGroup.Do(key, func() (interface{}, error) {
+		resp, err := load()
+		if err != nil {
+			return nil, err
+		}
+		cacheConf := conf.GetConf().Cache
+		ttl := jitterTTL(cacheConf.ListTTL, defaultListCacheTTL, cacheConf.Jitter)
+		if serr := redis.SetProductList(ctx, redis.RedisClient, key, resp, ttl); serr != nil {
+			klog.CtxWarnf(ctx, "set product list cache failed: %v", serr)
+		}
+		return resp, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*product.ListProductsResp), nil
+}
+
+// invalidateProducts 数据库更新成功后删除商品详情缓存
+// listChanged 为 true 时同时使全部列表缓存失效；仅库存、销量变化时列表缓存等待自然过期
+func invalidateProducts(ctx context.Context, listChanged bool, ids ...int64) {
+	if !cacheEnabled() {
+		return
+	}
+	if err := redis.DeleteProducts(ctx, redis.RedisClient, ids...); err != nil {
+		klog.CtxWarnf(ctx, "delete product %v cache failed: %v", ids, err)
+	}
+	if listChanged {
+		if err := redis.BumpListVersion(ctx, redis.RedisClient); err != nil {
+			klog.CtxWarnf(ctx, "bump product list version failed: %v", err)
+		}
+	}
+}
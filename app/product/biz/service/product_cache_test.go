@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	goredis "github.com/redis/go-redis/v9"
+	"zqzqsb/gomall/app/product/biz/dal/mysql"
+	"zqzqsb/gomall/app/product/biz/dal/redis"
+	"zqzqsb/gomall/app/product/biz/model"
+	"zqzqsb/gomall/app/product/conf"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+// setupCache 使用 miniredis 作为缓存，并从服务根目录加载测试配置
+func setupCache(t *testing.T) *miniredis.Miniredis {
+	t.Helper()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Chdir("../.."); err != nil {
+		t.Fatal(err)
+	}
+	conf.GetConf()
+	if err = os.Chdir(wd); err != nil {
+		t.Fatal(err)
+	}
+
+	mr := miniredis.RunT(t)
+	redis.RedisClient = goredis.NewClient(&goredis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() {
+		redis.RedisClient.Close()
+		redis.RedisClient = nil
+	})
+	return mr
+}
+
+func TestJitterTTL(t *testing.T) {
+	if got := jitterTTL(0, time.Minute, 0); got != time.Minute {
+		t.Errorf("got %v, want fallback %v", got, time.Minute)
+	}
+	if got := jitterTTL(time.Hour, time.Minute, 0); got != time.Hour {
+		t.Errorf("got %v, want %v without jitter", got, time.Hour)
+	}
+	ttl := 10 * time.Minute
+	for i := 0; i < 1000; i++ {
+		got := jitterTTL(ttl, time.Minute, 0.1)
+		if got < ttl || got > ttl+ttl/10 {
+			t.Fatalf("got %v, want within [%v, %v]", got, ttl, ttl+ttl/10)
+		}
+	}
+}
+
+func TestGetProductCached(t *testing.T) {
+	setupCache(t)
+	ctx := context.Background()
+
+	// 缓存命中时不访问数据库
+	if err := redis.SetProduct(ctx, redis.RedisClient, 1, &model.Product{ID: 1, Name: "phone"}, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	p, err := getProduct(ctx, 1)
+	if err != nil || p.Name != "phone" {
+		t.Fatalf("got (%+v, %v), want cached product", p, err)
+	}
+
+	// 空值缓存命中时直接返回商品不存在
+	if err = redis.SetProduct(ctx, redis.RedisClient, 2, nil, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = getProduct(ctx, 2); !errors.Is(err, mysql.ErrProductNotFound) {
+		t.Fatalf("got error %v, want %v", err, mysql.ErrProductNotFound)
+	}
+}
+
+func TestListProductsCache(t *testing.T) {
+	mr := setupCache(t)
+	ctx := context.Background()
+
+	loads := 0
+	load := func() (*product.ListProductsResp, error) {
+		loads++
+		return &product.ListProductsResp{Total: int32(loads)}, nil
+	}
+	req := &product.ListProductsReq{Category: "phone", Page: 1, PageSize: 10}
+
+	// 未命中时回源并写入缓存，有效期不超过配置的上限
+	resp, err := listProducts(ctx, req, load)
+	if err != nil || resp.Total != 1 || loads != 1 {
+		t.Fatalf("got (%v, %v) after %d loads, want loaded once", resp, err, loads)
+	}
+	key := redis.ProductListKey(0, req)
+	cacheConf := conf.GetConf().Cache
+	maxTTL := cacheConf.ListTTL + time.Duration(float64(cacheConf.ListTTL)*cacheConf.Jitter)
+	if ttl := mr.TTL(key); ttl < cacheConf.ListTTL || ttl > maxTTL {
+		t.Errorf("got ttl %v, want within [%v, %v]", ttl, cacheConf.ListTTL, maxTTL)
+	}
+
+	// 再次查询命中缓存
+	resp, err = listProducts(ctx, req, load)
+	if err != nil || resp.Total != 1 || loads != 1 {
+		t.Fatalf("got (%v, %v) after %d loads, want cached", resp, err, loads)
+	}
+
+	// 商品变更后列表版本号递增，旧缓存不再命中
+	if err = redis.SetProduct(ctx, redis.RedisClient, 1, &model.Product{ID: 1}, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	invalidateProducts(ctx, true, 1)
+	if mr.Exists(redis.ProductKey(1)) {
+		t.Error("expect product cache deleted")
+	}
+	if version, _ := redis.GetListVersion(ctx, redis.RedisClient); version != 1 {
+		t.Errorf("got list version %d, want 1", version)
+	}
+	resp, err = listProducts(ctx, req, load)
+	if err != nil || resp.Total != 2 || loads != 2 {
+		t.Fatalf("got (%v, %v) after %d loads, want reloaded", resp, err, loads)
+	}
+
+	// 只有库存变化时列表缓存不失效
+	invalidateProducts(ctx, false, 1)
+	if version, _ := redis.GetListVersion(ctx, redis.RedisClient); version != 1 {
+		t.Errorf("got list version %d, want 1", version)
+	}
+}
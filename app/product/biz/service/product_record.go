@@ -0,0 +1,308 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"zqzqsb/gomall/app/product/biz/model"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+// 批量导入导出支持的文件格式
+const (
+	FormatCSV   = "csv"
+	FormatJSONL = "jsonl"
+)
+
+var (
+	// ErrUnsupportedFormat 文件格式不是 csv 或 jsonl
+	ErrUnsupportedFormat = errors.New("unsupported format, expect csv or jsonl")
+	// ErrInvalidImportFile 上传的文件无法解析，如文件头错误，单行的格式错误不使用此错误
+	ErrInvalidImportFile = errors.New("invalid import file")
+)
+
+// recordColumns CSV 文件的列，导入时列的顺序不限，product_id 只在导出时填写，导入时忽略
+// gallery 为以 | 分隔的图片URL，attributes 为 JSON 对象
+var recordColumns = []string{
+	"external_code", "name", "description", "price", "stock", "image_url",
+	"gallery", "category", "is_on_sale", "attributes", "product_id",
+}
+
+// requiredColumns CSV 文件必须包含的列
+var requiredColumns = []string{"external_code", "name", "price", "category"}
+
+// maxJSONLineSize JSON Lines 文件单行的最大长度
+const maxJSONLineSize = 1 << 20
+
+// validateRecord 校验导入的商品行，分类是否存在由导入时查询
+func validateRecord(rec *product.ProductRecord) error {
+	switch {
+	case rec.ExternalCode == "":
+		return errors.New("external_code is required")
+	case utf8.RuneCountInString(rec.ExternalCode) > 64:
+		return errors.New("external_code is longer than 64 characters")
+	case strings.TrimSpace(rec.Name) == "":
+		return errors.New("name is required")
+	case utf8.RuneCountInString(rec.Name) > 255:
+		return errors.New("name is longer than 255 characters")
+	case rec.Price <= 0:
+		return errors.New("price must be greater than 0")
+	case rec.Stock < 0:
+		return errors.New("stock must not be negative")
+	case rec.Category == "":
+		return errors.New("category is required")
+	}
+	return nil
+}
+
+// toRecord 将商品转换为导出的商品行
+func toRecord(p *model.Product) *product.ProductRecord {
+	return &product.ProductRecord{
+		ExternalCode: p.GetExternalCode(),
+		Name:         p.Name,
+		Description:  p.Description,
+		Price:        p.Price,
+		Stock:        p.Stock,
+		ImageUrl:     p.ImageURL,
+		Gallery:      p.GetGallery(),
+		Category:     p.Category,
+		IsOnSale:     p.IsOnSale,
+		Attributes:   p.GetAttributes(),
+		ProductId:    p.ID,
+	}
+}
+
+// decodeRecords 逐行解析上传的文件，row 为数据所在的行号
+// 单行的格式错误通过 err 交给 fn 记入错误报告，文件头错误和读取错误直接返回；fn 返回错误时停止解析
+func decodeRecords(format string, r io.Reader, fn func(row int, rec *product.ProductRecord, err error) error) error {
+	switch format {
+	case FormatCSV:
+		return decodeCSV(r, fn)
+	case FormatJSONL:
+		return decodeJSONL(r, fn)
+	}
+	return ErrUnsupportedFormat
+}
+
+func decodeCSV(r io.Reader, fn func(row int, rec *product.ProductRecord, err error) error) error {
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidImportFile, err)
+	}
+	columns, err := parseHeader(header)
+	if err != nil {
+		return err
+	}
+
+	for {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		line, _ := reader.FieldPos(0)
+		var rec *product.ProductRecord
+		switch {
+		case errors.Is(err, csv.ErrFieldCount):
+			// 列数不一致只影响当前行
+		case err != nil:
+			return fmt.Errorf("%w: %v", ErrInvalidImportFile, err)
+		default:
+			rec, err = parseCSVRecord(columns, fields)
+		}
+		if err = fn(line, rec, err); err != nil {
+			return err
+		}
+	}
+}
+
+// parseHeader 校验 CSV 文件头，返回每一列对应的字段名
+func parseHeader(header []string) ([]string, error) {
+	known := make(map[string]bool, len(recordColumns))
+	for _, c := range recordColumns {
+		known[c] = true
+	}
+	columns := make([]string, len(header))
+	seen := make(map[string]bool, len(header))
+	for i, h := range header {
+		// 去掉 Excel 导出时添加的 BOM
+		if i == 0 {
+			h = strings.TrimPrefix(h, "\ufeff")
+		}
+		h = strings.ToLower(strings.TrimSpace(h))
+		if !known[h] {
+			return nil, fmt.Errorf("%w: unknown column %q", ErrInvalidImportFile, h)
+		}
+		if seen[h] {
+			return nil, fmt.Errorf("%w: duplicate column %q", ErrInvalidImportFile, h)
+		}
+		seen[h] = true
+		columns[i] = h
+	}
+	for _, c := range requiredColumns {
+		if !seen[c] {
+			return nil, fmt.Errorf("%w: missing column %q", ErrInvalidImportFile, c)
+		}
+	}
+	return columns, nil
+}
+
+func parseCSVRecord(columns, fields []string) (*product.ProductRecord, error) {
+	rec := &product.ProductRecord{}
+	for i, c := range columns {
+		value := strings.TrimSpace(fields[i])
+		var err error
+		switch c {
+		case "external_code":
+			rec.ExternalCode = value
+		case "name":
+			rec.Name = value
+		case "description":
+			rec.Description = fields[i]
+		case "price":
+			rec.Price, err = parseInt(value, 64)
+		case "stock":
+			var stock int64
+			stock, err = parseInt(value, 32)
+			rec.Stock = int32(stock)
+		case "image_url":
+			rec.ImageUrl = value
+		case "gallery":
+			for _, url := range strings.Split(value, "|") {
+				if url = strings.TrimSpace(url); url != "" {
+					rec.Gallery = append(rec.Gallery, url)
+				}
+			}
+		case "category":
+			rec.Category = value
+		case "is_on_sale":
+			if value != "" {
+				rec.IsOnSale, err = strconv.ParseBool(value)
+			}
+		case "attributes":
+			if value != "" {
+				err = json.Unmarshal([]byte(value), &rec.Attributes)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q", c, value)
+		}
+	}
+	return rec, nil
+}
+
+// parseInt 解析整数列，空值为0
+func parseInt(value string, bitSize int) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(value, 10, bitSize)
+}
+
+func decodeJSONL(r io.Reader, fn func(row int, rec *product.ProductRecord, err error) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxJSONLineSize)
+	line := 0
+	for scanner.Scan() {
+		line++
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+		rec := &product.ProductRecord{}
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err := decoder.Decode(rec)
+		if err != nil {
+			rec = nil
+			err = fmt.Errorf("invalid json: %v", err)
+		}
+		if err = fn(line, rec, err); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("%w: line %d: %v", ErrInvalidImportFile, line+1, err)
+	}
+	return nil
+}
+
+// recordEncoder 将导出的商品行写入文件
+type recordEncoder interface {
+	Encode(rec *product.ProductRecord) error
+	// Flush 写出缓冲的数据
+	Flush() error
+}
+
+// newRecordEncoder 创建指定格式的编码器，CSV 格式会先写入文件头
+func newRecordEncoder(format string, w io.Writer) (recordEncoder, error) {
+	switch format {
+	case FormatCSV:
+		writer := csv.NewWriter(w)
+		if err := writer.Write(recordColumns); err != nil {
+			return nil, err
+		}
+		return &csvEncoder{writer: writer}, nil
+	case FormatJSONL:
+		buf := bufio.NewWriter(w)
+		return &jsonlEncoder{buf: buf, encoder: json.NewEncoder(buf)}, nil
+	}
+	return nil, ErrUnsupportedFormat
+}
+
+type csvEncoder struct {
+	writer *csv.Writer
+}
+
+func (e *csvEncoder) Encode(rec *product.ProductRecord) error {
+	attributes := ""
+	if len(rec.Attributes) > 0 {
+		data, err := json.Marshal(rec.Attributes)
+		if err != nil {
+			return err
+		}
+		attributes = string(data)
+	}
+	return e.writer.Write([]string{
+		rec.ExternalCode,
+		rec.Name,
+		rec.Description,
+		strconv.FormatInt(rec.Price, 10),
+		strconv.FormatInt(int64(rec.Stock), 10),
+		rec.ImageUrl,
+		strings.Join(rec.Gallery, "|"),
+		rec.Category,
+		strconv.FormatBool(rec.IsOnSale),
+		attributes,
+		strconv.FormatInt(rec.ProductId, 10),
+	})
+}
+
+func (e *csvEncoder) Flush() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+type jsonlEncoder struct {
+	buf     *bufio.Writer
+	encoder *json.Encoder
+}
+
+func (e *jsonlEncoder) Encode(rec *product.ProductRecord) error {
+	return e.encoder.Encode(rec)
+}
+
+func (e *jsonlEncoder) Flush() error {
+	return e.buf.Flush()
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+type decodedRow struct {
+	row int
+	rec *product.ProductRecord
+	err error
+}
+
+func decodeAll(t *testing.T, format, data string) []decodedRow {
+	var rows []decodedRow
+	err := decodeRecords(format, strings.NewReader(data), func(row int, rec *product.ProductRecord, err error) error {
+		rows = append(rows, decodedRow{row, rec, err})
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rows
+}
+
+func TestDecodeCSV(t *testing.T) {
+	data := "\ufeffName,External_Code,price,category,gallery,attributes,is_on_sale\n" +
+		"手机,P-001,199900,phones,a.jpg|b.jpg,\"{\"\"color\"\":\"\"red\"\"}\",true\n" +
+		"耳机,P-002,abc,audio,,,\n" +
+		"平板,P-003,100\n"
+	rows := decodeAll(t, FormatCSV, data)
+	if len(rows) != 3 {
+		t.Fatalf("expect 3 rows, got %d", len(rows))
+	}
+
+	want := &product.ProductRecord{
+		ExternalCode: "P-001",
+		Name:         "手机",
+		Price:        199900,
+		Category:     "phones",
+		Gallery:      []string{"a.jpg", "b.jpg"},
+		Attributes:   map[string]string{"color": "red"},
+		IsOnSale:     true,
+	}
+	if rows[0].err != nil || rows[0].row != 2 || !proto.Equal(rows[0].rec, want) {
+		t.Errorf("unexpected first row %+v", rows[0])
+	}
+	if rows[1].err == nil || rows[1].row != 3 {
+		t.Errorf("expect invalid price in row 3, got %+v", rows[1])
+	}
+	if rows[2].err == nil || rows[2].row != 4 {
+		t.Errorf("expect wrong field count in row 4, got %+v", rows[2])
+	}
+
+	for _, header := range []string{"name,price,category\n", "external_code,name,price,category,color\n"} {
+		err := decodeRecords(FormatCSV, strings.NewReader(header), func(int, *product.ProductRecord, error) error { return nil })
+		if !errors.Is(err, ErrInvalidImportFile) {
+			t.Errorf("expect header %q to be invalid, got %v", header, err)
+		}
+	}
+}
+
+func TestDecodeJSONL(t *testing.T) {
+	data := `{"external_code":"P-001","name":"手机","price":199900,"category":"phones"}` + "\n\n" +
+		`{"external_code":"P-002","title":"耳机"}` + "\n" +
+		`not json` + "\n"
+	rows := decodeAll(t, FormatJSONL, data)
+	if len(rows) != 3 {
+		t.Fatalf("expect 3 rows, got %d", len(rows))
+	}
+	if rows[0].err != nil || rows[0].rec.ExternalCode != "P-001" || rows[0].rec.Price != 199900 {
+		t.Errorf("unexpected first row %+v", rows[0])
+	}
+	if rows[1].err == nil || rows[1].row != 3 {
+		t.Errorf("expect unknown field in line 3, got %+v", rows[1])
+	}
+	if rows[2].err == nil || rows[2].row != 4 {
+		t.Errorf("expect invalid json in line 4, got %+v", rows[2])
+	}
+}
+
+func TestValidateRecord(t *testing.T) {
+	valid := func() *product.ProductRecord {
+		return &product.ProductRecord{ExternalCode: "P-001", Name: "手机", Price: 1, Category: "phones"}
+	}
+	if err := validateRecord(valid()); err != nil {
+		t.Fatal(err)
+	}
+
+	invalid := []func(r *product.ProductRecord){
+		func(r *product.ProductRecord) { r.ExternalCode = "" },
+		func(r *product.ProductRecord) { r.Name = " " },
+		func(r *product.ProductRecord) { r.Price = 0 },
+		func(r *product.ProductRecord) { r.Stock = -1 },
+		func(r *product.ProductRecord) { r.Category = "" },
+	}
+	for i, modify := range invalid {
+		rec := valid()
+		modify(rec)
+		if err := validateRecord(rec); err == nil {
+			t.Errorf("expect case %d to be invalid", i)
+		}
+	}
+}
+
+func TestRecordRoundTrip(t *testing.T) {
+	records := []*product.ProductRecord{
+		{ExternalCode: "P-001", Name: "手机", Description: "a, \"b\"", Price: 199900, Stock: 3,
+			Gallery: []string{"a.jpg", "b.jpg"}, Category: "phones", IsOnSale: true,
+			Attributes: map[string]string{"color": "red"}, ProductId: 7},
+		{ExternalCode: "P-002", Name: "耳机", Price: 100, Category: "audio"},
+	}
+	for _, format := range []string{FormatCSV, FormatJSONL} {
+		var buf bytes.Buffer
+		encoder, err := newRecordEncoder(format, &buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, rec := range records {
+			if err = encoder.Encode(rec); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err = encoder.Flush(); err != nil {
+			t.Fatal(err)
+		}
+
+		rows := decodeAll(t, format, buf.String())
+		if len(rows) != len(records) {
+			t.Fatalf("%s: expect %d rows, got %d", format, len(records), len(rows))
+		}
+		for i, r := range rows {
+			if r.err != nil {
+				t.Fatalf("%s: row %d: %v", format, i, r.err)
+			}
+			// CSV 导入时忽略商品ID
+			if format == FormatCSV {
+				r.rec.ProductId = records[i].ProductId
+			}
+			if !proto.Equal(r.rec, records[i]) {
+				t.Errorf("%s: row %d = %+v", format, i, r.rec)
+			}
+		}
+	}
+}
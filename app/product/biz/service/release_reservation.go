@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"zqzqsb/gomall/app/product/biz/dal/mysql"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+type ReleaseReservationService struct {
+	ctx context.Context
+} // NewReleaseReservationService new ReleaseReservationService
+func NewReleaseReservationService(ctx context.Context) *ReleaseReservationService {
+	return &ReleaseReservationService{ctx: ctx}
+}
+
+// Run release reservation, held stock is returned
+func (s *ReleaseReservationService) Run(req *product.ReleaseReservationReq) (resp *product.ReleaseReservationResp, err error) {
+	// 参数验证
+	if req.Token == "" {
+		return nil, errors.New("invalid reservation token")
+	}
+
+	productIDs, err := mysql.ReleaseReservation(mysql.DB, req.Token, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// 库存归还，删除商品详情缓存
+	invalidateProducts(s.ctx, false, productIDs...)
+
+	// 构建响应
+	resp = &product.ReleaseReservationResp{
+		Success: true,
+	}
+
+	return resp, nil
+}
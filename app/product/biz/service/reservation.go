@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"zqzqsb/gomall/app/product/biz/dal/mysql"
+	"zqzqsb/gomall/app/product/biz/model"
+	"zqzqsb/gomall/app/product/conf"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+const (
+	// defaultReservationTTL 未配置预占有效期时使用的默认值
+	defaultReservationTTL = 15 * time.Minute
+	// defaultReservationScanInterval 未配置扫描间隔时使用的默认值
+	defaultReservationScanInterval = time.Minute
+	// releaseBatchSize 每轮最多释放的预占数
+	releaseBatchSize = 100
+	// maxTokenLength 与预占表 token 字段长度一致
+	maxTokenLength = 64
+)
+
+// reservationTTL 计算预占有效期，ttlSeconds 为 0 时使用默认值，超过上限时截断
+func reservationTTL(ttlSeconds int64) time.Duration {
+	c := conf.GetConf().Reservation
+	ttl := time.Duration(ttlSeconds) * time.Second
+	if ttl <= 0 {
+		ttl = c.DefaultTTL
+	}
+	if ttl <= 0 {
+		ttl = defaultReservationTTL
+	}
+	if c.MaxTTL > 0 && ttl > c.MaxTTL {
+		ttl = c.MaxTTL
+	}
+	return ttl
+}
+
+// mergeStockItems 校验预占商品并合并重复的库存单位
+func mergeStockItems(items []*product.StockItem) (map[model.StockKey]int32, error) {
+	if len(items) == 0 {
+		return nil, errors.New("no item to reserve")
+	}
+	merged := make(map[model.StockKey]int32, len(items))
+	for _, item := range items {
+		if item.ProductId <= 0 || item.SkuId < 0 {
+			return nil, errors.New("invalid product id")
+		}
+		if item.Quantity <= 0 {
+			return nil, errors.New("invalid quantity")
+		}
+		key := model.StockKey{ProductID: item.ProductId, SkuID: item.SkuId}
+		if key.SkuID == 0 && len(item.Attributes) > 0 {
+			key.Spec = model.SpecKey(item.Attributes)
+		}
+		merged[key] += item.Quantity
+	}
+	return merged, nil
+}
+
+// sameReservation 判断已有预占是否与本次请求的商品一致
+// 请求可能按规格值指定 SKU，只比较每个商品的预占总数
+func sameReservation(reservations []*model.StockReservation, items map[model.StockKey]int32) bool {
+	want := make(map[int64]int32, len(items))
+	for key, quantity := range items {
+		want[key.ProductID] += quantity
+	}
+	got := make(map[int64]int32, len(reservations))
+	for _, r := range reservations {
+		got[r.ProductID] += r.Quantity
+	}
+	if len(got) != len(want) {
+		return false
+	}
+	for id, quantity := range want {
+		if got[id] != quantity {
+			return false
+		}
+	}
+	return true
+}
+
+// reservationStatus 汇总一个 token 下各商品的预占状态
+func reservationStatus(reservations []*model.StockReservation) product.ReservationStatus {
+	status := product.ReservationStatus_RESERVATION_STATUS_CONFIRMED
+	for _, r := range reservations {
+		switch r.Status {
+		case model.ReservationStatusReleased:
+			return product.ReservationStatus_RESERVATION_STATUS_RELEASED
+		case model.ReservationStatusHeld:
+			status = product.ReservationStatus_RESERVATION_STATUS_HELD
+		}
+	}
+	return status
+}
+
+// StartReservationReaper 定时释放过期未确认的库存预占
+// 释放在事务中按预占状态判断，多个实例同时扫描也不会重复归还库存
+func StartReservationReaper(ctx context.Context) {
+	interval := conf.GetConf().Reservation.ScanInterval
+	if interval <= 0 {
+		interval = defaultReservationScanInterval
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if n, err := ReleaseExpiredReservations(ctx, time.Now()); err != nil {
+					klog.CtxErrorf(ctx, "release expired reservations failed: %v", err)
+				} else if n > 0 {
+					klog.CtxInfof(ctx, "released %d expired reservations", n)
+				}
+			}
+		}
+	}()
+}
+
+// ReleaseExpiredReservations 释放在 now 之前过期的预占，返回释放的 token 数
+func ReleaseExpiredReservations(ctx context.Context, now time.Time) (int, error) {
+	var released int
+	for {
+		tokens, err := mysql.ListExpiredReservationTokens(mysql.DB, now, releaseBatchSize)
+		if err != nil {
+			return released, err
+		}
+
+		var batch int
+		for _, token := range tokens {
+			productIDs, err := mysql.ReleaseReservation(mysql.DB, token, now)
+			if err != nil {
+				// 扫描后预占可能已被确认
+				if !errors.Is(err, mysql.ErrReservationConfirmed) {
+					klog.CtxWarnf(ctx, "release expired reservation %s failed: %v", token, err)
+				}
+				continue
+			}
+			invalidateProducts(ctx, false, productIDs...)
+			batch++
+		}
+		released += batch
+
+		// 本轮没有释放任何预占时退出，避免对无法释放的预占反复重试
+		if len(tokens) < releaseBatchSize || batch == 0 {
+			return released, nil
+		}
+	}
+}
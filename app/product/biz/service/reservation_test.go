@@ -0,0 +1,64 @@
+package service
+
+import (
+	"testing"
+
+	"zqzqsb/gomall/app/product/biz/model"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+func TestMergeStockItems(t *testing.T) {
+	items, err := mergeStockItems([]*product.StockItem{
+		{ProductId: 1, Quantity: 2},
+		{ProductId: 2, Quantity: 1},
+		{ProductId: 1, Quantity: 3},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 2 || items[model.StockKey{ProductID: 1}] != 5 || items[model.StockKey{ProductID: 2}] != 1 {
+		t.Errorf("unexpected merged items: %v", items)
+	}
+
+	// 按规格值指定 SKU 时，规格值的顺序不影响合并
+	items, err = mergeStockItems([]*product.StockItem{
+		{ProductId: 1, Quantity: 1, Attributes: map[string]string{"color": "red", "size": "M"}},
+		{ProductId: 1, Quantity: 2, Attributes: map[string]string{"size": "M", "color": "red"}},
+		{ProductId: 1, Quantity: 1, SkuId: 3},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 2 || items[model.StockKey{ProductID: 1, Spec: "color=red;size=M"}] != 3 ||
+		items[model.StockKey{ProductID: 1, SkuID: 3}] != 1 {
+		t.Errorf("unexpected merged sku items: %v", items)
+	}
+
+	if _, err = mergeStockItems(nil); err == nil {
+		t.Error("expect error for empty items")
+	}
+	if _, err = mergeStockItems([]*product.StockItem{{ProductId: 1, Quantity: 0}}); err == nil {
+		t.Error("expect error for zero quantity")
+	}
+}
+
+func TestReservationStatus(t *testing.T) {
+	held := &model.StockReservation{Status: model.ReservationStatusHeld}
+	confirmed := &model.StockReservation{Status: model.ReservationStatusConfirmed}
+	released := &model.StockReservation{Status: model.ReservationStatusReleased}
+
+	cases := []struct {
+		reservations []*model.StockReservation
+		want         product.ReservationStatus
+	}{
+		{[]*model.StockReservation{held, held}, product.ReservationStatus_RESERVATION_STATUS_HELD},
+		{[]*model.StockReservation{confirmed, confirmed}, product.ReservationStatus_RESERVATION_STATUS_CONFIRMED},
+		{[]*model.StockReservation{confirmed, held}, product.ReservationStatus_RESERVATION_STATUS_HELD},
+		{[]*model.StockReservation{held, released}, product.ReservationStatus_RESERVATION_STATUS_RELEASED},
+	}
+	for _, c := range cases {
+		if got := reservationStatus(c.reservations); got != c.want {
+			t.Errorf("reservationStatus() = %s, want %s", got, c.want)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"zqzqsb/gomall/app/product/biz/dal/mysql"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+type ReserveStockService struct {
+	ctx context.Context
+} // NewReserveStockService new ReserveStockService
+func NewReserveStockService(ctx context.Context) *ReserveStockService {
+	return &ReserveStockService{ctx: ctx}
+}
+
+// Run reserve stock for all items, either all or none are held
+func (s *ReserveStockService) Run(req *product.ReserveStockReq) (resp *product.ReserveStockResp, err error) {
+	// 参数验证
+	if req.Token == "" || len(req.Token) > maxTokenLength {
+		return nil, errors.New("invalid reservation token")
+	}
+	items, err := mergeStockItems(req.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(reservationTTL(req.TtlSeconds))
+	reservations, err := mysql.ReserveStock(mysql.DB, req.Token, items, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	// 同一 token 重复预占时返回已有结果，商品不一致说明 token 被误用
+	if !sameReservation(reservations, items) {
+		return nil, errors.New("reservation token already used for different items")
+	}
+
+	// 预占扣除了可售库存，删除商品详情缓存
+	productIDs := make([]int64, 0, len(reservations))
+	for _, r := range reservations {
+		productIDs = append(productIDs, r.ProductID)
+	}
+	invalidateProducts(s.ctx, false, productIDs...)
+
+	// 构建响应
+	resp = &product.ReserveStockResp{
+		Token:      req.Token,
+		Status:     reservationStatus(reservations),
+		ExpireTime: reservations[0].ExpiresAt.Unix(),
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
+	"zqzqsb/gomall/app/product/biz/dal/mysql"
+	"zqzqsb/gomall/app/product/biz/model"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+type RestoreProductService struct {
+	ctx context.Context
+} // NewRestoreProductService new RestoreProductService
+func NewRestoreProductService(ctx context.Context) *RestoreProductService {
+	return &RestoreProductService{ctx: ctx}
+}
+
+// Run undelete a product or revert it to a revision
+func (s *RestoreProductService) Run(req *product.RestoreProductReq) (resp *product.RestoreProductResp, err error) {
+	// 参数验证
+	if req.ProductId <= 0 || req.RevisionId < 0 {
+		return nil, errors.New("invalid product id or revision id")
+	}
+	operatorID, err := requireProductOwner(s.ctx, "POST", productPath(req.ProductId)+"/restore", req.ProductId)
+	if err != nil {
+		return nil, err
+	}
+
+	rev := &model.ProductRevision{ProductID: req.ProductId, Action: model.RevisionRestore, OperatorID: operatorID, Reason: req.Reason}
+	change := func(tx *gorm.DB) (int64, error) {
+		return req.ProductId, mysql.UndeleteProduct(tx, req.ProductId)
+	}
+	if req.RevisionId > 0 {
+		target, err := mysql.GetRevision(mysql.DB, req.ProductId, req.RevisionId)
+		if err != nil {
+			return nil, err
+		}
+		// 删除记录没有变更后的快照，恢复到删除前的状态
+		snapshot := target.GetAfter()
+		if snapshot == nil {
+			snapshot = target.GetBefore()
+		}
+		if snapshot == nil {
+			return nil, mysql.ErrRevisionNotFound
+		}
+		rev.Reason = fmt.Sprintf("%s (revision %d)", req.Reason, req.RevisionId)
+		change = func(tx *gorm.DB) (int64, error) {
+			return req.ProductId, mysql.RestoreSnapshot(tx, req.ProductId, snapshot)
+		}
+	}
+
+	if err = mysql.RecordRevision(mysql.DB, rev, change); err != nil {
+		return nil, err
+	}
+
+	invalidateProducts(s.ctx, true, req.ProductId)
+	reindexProducts(s.ctx, req.ProductId)
+	klog.CtxInfof(s.ctx, "product %d restored to revision %d by user %d", req.ProductId, req.RevisionId, operatorID)
+
+	resp = &product.RestoreProductResp{Success: true}
+	if after := rev.GetAfter(); after != nil {
+		resp.Version = after.Version
+	}
+	return resp, nil
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"testing"
+
+	"zqzqsb/gomall/app/product/biz/model"
+)
+
+func TestRevisionChanges(t *testing.T) {
+	before := &model.Product{Name: "phone", Price: 100, Stock: 5, Version: 1}
+	if err := before.SetAttributes(map[string]string{"color": "red"}); err != nil {
+		t.Fatal(err)
+	}
+	after := *before
+	after.Price = 120
+	after.Version = 2
+
+	rev := &model.ProductRevision{}
+	if err := rev.SetSnapshots(model.SnapshotOf(before), model.SnapshotOf(&after)); err != nil {
+		t.Fatal(err)
+	}
+	changes := rev.Changes()
+	if len(changes) != 2 || changes[0].Field != "price" || changes[1].Field != "version" {
+		t.Fatalf("unexpected changes %+v", changes)
+	}
+	if changes[0].Before != "100" || changes[0].After != "120" {
+		t.Errorf("unexpected price change %+v", changes[0])
+	}
+
+	// 创建记录没有变更前快照，所有字段都视为变化
+	created := &model.ProductRevision{}
+	if err := created.SetSnapshots(nil, model.SnapshotOf(before)); err != nil {
+		t.Fatal(err)
+	}
+	if created.GetBefore() != nil || created.GetAfter().Name != "phone" {
+		t.Error("expect only after snapshot on create")
+	}
+	for _, c := range created.Changes() {
+		if c.Before != "" {
+			t.Errorf("expect empty before for %s, got %s", c.Field, c.Before)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"zqzqsb/gomall/app/product/biz/dal/mysql"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+type SaveSkusService struct {
+	ctx context.Context
+} // NewSaveSkusService new SaveSkusService
+func NewSaveSkusService(ctx context.Context) *SaveSkusService {
+	return &SaveSkusService{ctx: ctx}
+}
+
+// Run create or update skus of a product by attribute combination
+func (s *SaveSkusService) Run(req *product.SaveSkusReq) (resp *product.SaveSkusResp, err error) {
+	// 参数验证
+	if req.ProductId <= 0 {
+		return nil, errors.New("invalid product id")
+	}
+	operatorID, err := requireProductOwner(s.ctx, "PUT", productPath(req.ProductId)+"/skus", req.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	skus, err := toModelSkus(req.Skus)
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := mysql.SaveSkus(mysql.DB, req.ProductId, skus)
+	if err != nil {
+		return nil, err
+	}
+
+	// 商品的价格和库存按 SKU 重新汇总，列表缓存也需要失效
+	invalidateProducts(s.ctx, true, req.ProductId)
+	reindexProducts(s.ctx, req.ProductId)
+	klog.CtxInfof(s.ctx, "%d skus of product %d saved by user %d", len(skus), req.ProductId, operatorID)
+
+	return &product.SaveSkusResp{Skus: toProtoSkus(saved)}, nil
+}
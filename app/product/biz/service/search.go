@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"sync/atomic"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"zqzqsb/gomall/app/product/biz/dal/mysql"
+	"zqzqsb/gomall/app/product/biz/dal/redis"
+	"zqzqsb/gomall/app/product/biz/model"
+	"zqzqsb/gomall/app/product/conf"
+	"zqzqsb/gomall/app/product/infra/search"
+)
+
+const (
+	defaultSearchChannel = "product:search"
+	searchScanBatchSize  = 500
+)
+
+var (
+	// searcher 商品搜索后端，未启动搜索时（如单元测试）为 nil
+	searcher search.Searcher
+	// searchReady 首次全量构建完成前，关键词查询降级为 LIKE 匹配
+	searchReady atomic.Bool
+	// searchInstance 当前实例的随机标识，用于忽略自己发布的索引变更
+	searchInstance string
+)
+
+// searchMessage 各实例之间同步的索引变更，Rebuild 为 true 时全量重建
+type searchMessage struct {
+	Instance string  `json:"instance"`
+	IDs      []int64 `json:"ids,omitempty"`
+	Rebuild  bool    `json:"rebuild,omitempty"`
+}
+
+// StartSearch 创建搜索后端并在后台构建索引
+// 进程内索引每个实例各有一份，写操作所在实例直接更新索引并通过 Redis 通知其他实例从数据库重新加载，
+// 定期全量重建兜底通知丢失和构建期间的并发修改
+func StartSearch(ctx context.Context) {
+	cfg := conf.GetConf().Search
+	s, err := search.New(cfg.Backend, cfg.PriceBuckets)
+	if err != nil {
+		panic(err)
+	}
+	b := make([]byte, 8)
+	if _, err = rand.Read(b); err != nil {
+		panic(err)
+	}
+	searchInstance = hex.EncodeToString(b)
+	searcher = s
+
+	if cfg.Channel == "" {
+		cfg.Channel = defaultSearchChannel
+	}
+	if cacheEnabled() {
+		if err = watchSearch(ctx, cfg.Channel); err != nil {
+			panic(err)
+		}
+	}
+
+	go func() {
+		rebuild := func() {
+			if err := rebuildSearchIndex(ctx); err != nil {
+				klog.CtxErrorf(ctx, "rebuild search index failed: %v", err)
+				return
+			}
+			searchReady.Store(true)
+		}
+		rebuild()
+		if cfg.RebuildInterval <= 0 {
+			return
+		}
+		ticker := time.NewTicker(cfg.RebuildInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				rebuild()
+			}
+		}
+	}()
+}
+
+// watchSearch 订阅其他实例发布的索引变更
+func watchSearch(ctx context.Context, channel string) error {
+	pubsub := redis.RedisClient.Subscribe(ctx, channel)
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return err
+	}
+	go func() {
+		defer pubsub.Close()
+		ch := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-ch:
+				if !ok {
+					return
+				}
+				var m searchMessage
+				if err := json.Unmarshal([]byte(msg.Payload), &m); err != nil {
+					klog.CtxWarnf(ctx, "invalid search message %q: %v", msg.Payload, err)
+					continue
+				}
+				if m.Instance == searchInstance {
+					continue
+				}
+				var err error
+				if m.Rebuild {
+					err = rebuildSearchIndex(ctx)
+				} else {
+					err = refreshSearchIndex(ctx, m.IDs...)
+				}
+				if err != nil {
+					klog.CtxErrorf(ctx, "sync search index failed: %v", err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// publishSearch 通知其他实例同步索引
+func publishSearch(ctx context.Context, m *searchMessage) {
+	if !cacheEnabled() {
+		return
+	}
+	m.Instance = searchInstance
+	payload, err := json.Marshal(m)
+	if err != nil {
+		return
+	}
+	channel := conf.GetConf().Search.Channel
+	if channel == "" {
+		channel = defaultSearchChannel
+	}
+	if err = redis.RedisClient.Publish(ctx, channel, payload).Err(); err != nil {
+		klog.CtxWarnf(ctx, "publish search message failed: %v", err)
+	}
+}
+
+// reindexProducts 商品新增、修改或删除后更新索引，并通知其他实例
+func reindexProducts(ctx context.Context, ids ...int64) {
+	if searcher == nil || len(ids) == 0 {
+		return
+	}
+	if err := refreshSearchIndex(ctx, ids...); err != nil {
+		klog.CtxWarnf(ctx, "reindex products %v failed: %v", ids, err)
+	}
+	publishSearch(ctx, &searchMessage{IDs: ids})
+}
+
+// rebuildSearch 分类调整会影响大量商品，在后台全量重建索引，并通知其他实例
+func rebuildSearch(ctx context.Context) {
+	if searcher == nil {
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		if err := rebuildSearchIndex(ctx); err != nil {
+			klog.CtxWarnf(ctx, "rebuild search index failed: %v", err)
+		}
+	}()
+	publishSearch(ctx, &searchMessage{Rebuild: true})
+}
+
+// refreshSearchIndex 从数据库重新加载商品，已删除的商品从索引中移除
+func refreshSearchIndex(ctx context.Context, ids ...int64) error {
+	products, err := mysql.GetProductsByIDs(mysql.DB, ids)
+	if err != nil {
+		return err
+	}
+	categories, err := categoryIndex()
+	if err != nil {
+		return err
+	}
+
+	found := make(map[int64]bool, len(products))
+	docs := make([]*search.Document, 0, len(products))
+	for _, p := range products {
+		found[p.ID] = true
+		docs = append(docs, toDocument(p, categories))
+	}
+	var deleted []int64
+	for _, id := range ids {
+		if !found[id] {
+			deleted = append(deleted, id)
+		}
+	}
+	if err = searcher.Index(ctx, docs...); err != nil {
+		return err
+	}
+	return searcher.Delete(ctx, deleted...)
+}
+
+// rebuildSearchIndex 分批读取全部商品并替换索引
+func rebuildSearchIndex(ctx context.Context) error {
+	categories, err := categoryIndex()
+	if err != nil {
+		return err
+	}
+	var docs []*search.Document
+	var afterID int64
+	for {
+		products, err := mysql.ScanProducts(mysql.DB, afterID, searchScanBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, p := range products {
+			docs = append(docs, toDocument(p, categories))
+		}
+		if len(products) < searchScanBatchSize {
+			break
+		}
+		afterID = products[len(products)-1].ID
+	}
+	return searcher.Rebuild(ctx, docs)
+}
+
+func categoryIndex() (map[int64]*model.Category, error) {
+	categories, err := mysql.ListCategories(mysql.DB)
+	if err != nil {
+		return nil, err
+	}
+	index := make(map[int64]*model.Category, len(categories))
+	for _, c := range categories {
+		index[c.ID] = c
+	}
+	return index, nil
+}
+
+// toDocument 将商品转换为索引文档，分类名称以分类表为准
+func toDocument(p *model.Product, categories map[int64]*model.Category) *search.Document {
+	doc := &search.Document{
+		ID:          p.ID,
+		Name:        p.Name,
+		Description: p.Description,
+		Category:    p.Category,
+		CategoryID:  p.CategoryID,
+		Price:       p.Price,
+		IsOnSale:    p.IsOnSale,
+		Attributes:  p.GetAttributes(),
+	}
+	if c, ok := categories[p.CategoryID]; ok {
+		doc.Category = c.Name
+		doc.CategoryIDs = c.AncestorIDs()
+	}
+	return doc
+}
+
+// searchProductIDs 通过搜索索引匹配关键词，索引未就绪时返回 nil
+func searchProductIDs(ctx context.Context, keyword string) []int64 {
+	if keyword == "" || searcher == nil || !searchReady.Load() {
+		return nil
+	}
+	result, err := searcher.Search(ctx, &search.Query{Keyword: keyword, SkipFacets: true})
+	if err != nil {
+		klog.CtxWarnf(ctx, "search %q failed: %v", keyword, err)
+		return nil
+	}
+	ids := make([]int64, 0, len(result.Hits))
+	for _, hit := range result.Hits {
+		ids = append(ids, hit.ID)
+	}
+	return ids
+}
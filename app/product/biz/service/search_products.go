@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"zqzqsb/gomall/app/product/biz/dal/mysql"
+	"zqzqsb/gomall/app/product/infra/search"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+const maxSearchPageSize = 100
+
+// ErrSearchUnavailable 搜索索引尚未构建完成
+var ErrSearchUnavailable = errors.New("search index is not ready")
+
+type SearchProductsService struct {
+	ctx context.Context
+} // NewSearchProductsService new SearchProductsService
+func NewSearchProductsService(ctx context.Context) *SearchProductsService {
+	return &SearchProductsService{ctx: ctx}
+}
+
+// Run search products by relevance with facets
+func (s *SearchProductsService) Run(req *product.SearchProductsReq) (resp *product.SearchProductsResp, err error) {
+	if searcher == nil || !searchReady.Load() {
+		return nil, ErrSearchUnavailable
+	}
+
+	// 设置默认值
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+	if req.PageSize > maxSearchPageSize {
+		req.PageSize = maxSearchPageSize
+	}
+
+	result, err := searcher.Search(s.ctx, &search.Query{
+		Keyword:    req.Keyword,
+		CategoryID: req.CategoryId,
+		MinPrice:   req.MinPrice,
+		MaxPrice:   req.MaxPrice,
+		OnSaleOnly: req.OnSaleOnly,
+		Attributes: req.Attributes,
+		Offset:     int((req.Page - 1) * req.PageSize),
+		Limit:      int(req.PageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 命中的商品从数据库读取最新数据，索引同步前已删除的商品被忽略
+	ids := make([]int64, 0, len(result.Hits))
+	for _, hit := range result.Hits {
+		ids = append(ids, hit.ID)
+	}
+	products, err := mysql.GetProductsByIDs(mysql.DB, ids)
+	if err != nil {
+		return nil, err
+	}
+	scores := make(map[int64]float64, len(result.Hits))
+	for _, hit := range result.Hits {
+		scores[hit.ID] = hit.Score
+	}
+
+	resp = &product.SearchProductsResp{
+		Hits:     make([]*product.SearchHit, 0, len(products)),
+		Total:    int32(result.Total),
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+	for _, p := range products {
+		resp.Hits = append(resp.Hits, &product.SearchHit{Product: toProtoProduct(p), Score: scores[p.ID]})
+	}
+	if f := result.Facets; f != nil {
+		for _, c := range f.Categories {
+			resp.CategoryFacets = append(resp.CategoryFacets, &product.CategoryFacet{
+				CategoryId: c.CategoryID,
+				Name:       c.Name,
+				Count:      c.Count,
+			})
+		}
+		for _, p := range f.Prices {
+			resp.PriceFacets = append(resp.PriceFacets, &product.PriceFacet{Min: p.Min, Max: p.Max, Count: p.Count})
+		}
+		for _, a := range f.Attributes {
+			facet := &product.AttributeFacet{Name: a.Name}
+			for _, v := range a.Values {
+				facet.Values = append(facet.Values, &product.AttributeValueFacet{Value: v.Value, Count: v.Count})
+			}
+			resp.AttributeFacets = append(resp.AttributeFacets, facet)
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"zqzqsb/gomall/app/product/biz/dal/mysql"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+type SetProductOptionsService struct {
+	ctx context.Context
+} // NewSetProductOptionsService new SetProductOptionsService
+func NewSetProductOptionsService(ctx context.Context) *SetProductOptionsService {
+	return &SetProductOptionsService{ctx: ctx}
+}
+
+// Run replace the option axes of a product
+func (s *SetProductOptionsService) Run(req *product.SetProductOptionsReq) (resp *product.SetProductOptionsResp, err error) {
+	// 参数验证
+	if req.ProductId <= 0 {
+		return nil, errors.New("invalid product id")
+	}
+	operatorID, err := requireProductOwner(s.ctx, "PUT", productPath(req.ProductId)+"/options", req.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	options, err := toModelOptions(req.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = mysql.ReplaceOptions(mysql.DB, req.ProductId, options); err != nil {
+		return nil, err
+	}
+
+	// 规格轴只在商品详情中返回，不影响列表缓存
+	invalidateProducts(s.ctx, false, req.ProductId)
+	klog.CtxInfof(s.ctx, "options of product %d set by user %d", req.ProductId, operatorID)
+
+	return &product.SetProductOptionsResp{Success: true}, nil
+}
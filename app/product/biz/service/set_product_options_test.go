@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+	"testing"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+func TestSetProductOptions_Run(t *testing.T) {
+	ctx := context.Background()
+	s := NewSetProductOptionsService(ctx)
+	// init req and assert value
+
+	req := &product.SetProductOptionsReq{}
+	resp, err := s.Run(req)
+	t.Logf("err: %v", err)
+	t.Logf("resp: %v", resp)
+
+	// todo: edit your unit test
+
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"errors"
+	"strings"
+
+	"zqzqsb/gomall/app/product/biz/model"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+const (
+	maxOptions          = 5  // 规格轴数量上限
+	maxOptionValues     = 50 // 每个规格轴的可选值数量上限
+	maxOptionNameLength = 50
+	maxBarcodeLength    = 64
+)
+
+var (
+	ErrInvalidOptions = errors.New("invalid product options")
+	ErrInvalidSku     = errors.New("invalid sku")
+)
+
+// validSpecField 规格名和规格值不能为空，也不能包含 SpecKey 使用的分隔符
+func validSpecField(s string) bool {
+	return s != "" && len(s) <= maxOptionNameLength && !strings.ContainsAny(s, "=;")
+}
+
+// toModelOptions 校验并转换规格轴，规格名和同一规格轴的可选值不能重复
+func toModelOptions(options []*product.ProductOption) ([]*model.ProductOption, error) {
+	if len(options) > maxOptions {
+		return nil, ErrInvalidOptions
+	}
+	result := make([]*model.ProductOption, 0, len(options))
+	names := make(map[string]bool, len(options))
+	for _, o := range options {
+		if o == nil || !validSpecField(o.Name) || names[o.Name] ||
+			len(o.Values) == 0 || len(o.Values) > maxOptionValues {
+			return nil, ErrInvalidOptions
+		}
+		names[o.Name] = true
+		seen := make(map[string]bool, len(o.Values))
+		for _, v := range o.Values {
+			if !validSpecField(v) || seen[v] {
+				return nil, ErrInvalidOptions
+			}
+			seen[v] = true
+		}
+		m := &model.ProductOption{Name: o.Name}
+		if err := m.SetValues(o.Values); err != nil {
+			return nil, err
+		}
+		result = append(result, m)
+	}
+	return result, nil
+}
+
+// toModelSkus 校验并转换 SKU，规格值组合是否与规格轴匹配在保存时校验
+func toModelSkus(skus []*product.Sku) ([]*model.Sku, error) {
+	if len(skus) == 0 {
+		return nil, ErrInvalidSku
+	}
+	result := make([]*model.Sku, 0, len(skus))
+	specs := make(map[string]bool, len(skus))
+	for _, s := range skus {
+		if s == nil || len(s.Attributes) == 0 || s.Price <= 0 || s.Stock < 0 || len(s.Barcode) > maxBarcodeLength {
+			return nil, ErrInvalidSku
+		}
+		m := &model.Sku{
+			Price:    s.Price,
+			Stock:    s.Stock,
+			Barcode:  s.Barcode,
+			IsOnSale: s.IsOnSale,
+		}
+		if err := m.SetAttributes(s.Attributes); err != nil {
+			return nil, err
+		}
+		if specs[m.Spec] {
+			return nil, ErrInvalidSku
+		}
+		specs[m.Spec] = true
+		result = append(result, m)
+	}
+	return result, nil
+}
+
+func toProtoOptions(options []*model.ProductOption) []*product.ProductOption {
+	result := make([]*product.ProductOption, 0, len(options))
+	for _, o := range options {
+		result = append(result, &product.ProductOption{Name: o.Name, Values: o.GetValues()})
+	}
+	return result
+}
+
+func toProtoSkus(skus []*model.Sku) []*product.Sku {
+	result := make([]*product.Sku, 0, len(skus))
+	for _, s := range skus {
+		result = append(result, &product.Sku{
+			Id:         s.ID,
+			ProductId:  s.ProductID,
+			Attributes: s.GetAttributes(),
+			Price:      s.Price,
+			Stock:      s.Stock,
+			Barcode:    s.Barcode,
+			IsOnSale:   s.IsOnSale,
+			SalesCount: s.SalesCount,
+		})
+	}
+	return result
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"testing"
+
+	"zqzqsb/gomall/app/product/biz/model"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+func TestToModelOptions(t *testing.T) {
+	options, err := toModelOptions([]*product.ProductOption{
+		{Name: "color", Values: []string{"red", "blue"}},
+		{Name: "size", Values: []string{"S", "M"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !model.MatchOptions(options, map[string]string{"color": "blue", "size": "S"}) {
+		t.Error("expect attributes to match options")
+	}
+	if model.MatchOptions(options, map[string]string{"color": "green", "size": "S"}) {
+		t.Error("expect unknown value not to match")
+	}
+	if model.MatchOptions(options, map[string]string{"color": "red"}) {
+		t.Error("expect missing axis not to match")
+	}
+
+	invalid := [][]*product.ProductOption{
+		{{Name: "", Values: []string{"red"}}},
+		{{Name: "color", Values: nil}},
+		{{Name: "color", Values: []string{"red", "red"}}},
+		{{Name: "color", Values: []string{"red"}}, {Name: "color", Values: []string{"blue"}}},
+		{{Name: "color", Values: []string{"red;blue"}}},
+	}
+	for _, o := range invalid {
+		if _, err = toModelOptions(o); err != ErrInvalidOptions {
+			t.Errorf("expect %v to be invalid, got %v", o, err)
+		}
+	}
+}
+
+func TestToModelSkus(t *testing.T) {
+	skus, err := toModelSkus([]*product.Sku{
+		{Attributes: map[string]string{"size": "M", "color": "red"}, Price: 100, Stock: 5},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if skus[0].Spec != "color=red;size=M" {
+		t.Errorf("unexpected spec %q", skus[0].Spec)
+	}
+
+	invalid := [][]*product.Sku{
+		nil,
+		{{Attributes: map[string]string{"color": "red"}, Price: 0}},
+		{{Attributes: map[string]string{"color": "red"}, Price: 100, Stock: -1}},
+		{{Price: 100}},
+		{
+			{Attributes: map[string]string{"color": "red"}, Price: 100},
+			{Attributes: map[string]string{"color": "red"}, Price: 200},
+		},
+	}
+	for _, s := range invalid {
+		if _, err = toModelSkus(s); err != ErrInvalidSku {
+			t.Errorf("expect %v to be invalid, got %v", s, err)
+		}
+	}
+}
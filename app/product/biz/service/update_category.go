@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"zqzqsb/gomall/app/product/biz/dal/mysql"
+	"zqzqsb/gomall/app/product/biz/model"
+	product "zqzqsb/gomall/app/product/kitex_gen/product"
+)
+
+type UpdateCategoryService struct {
+	ctx context.Context
+} // NewUpdateCategoryService new UpdateCategoryService
+func NewUpdateCategoryService(ctx context.Context) *UpdateCategoryService {
+	return &UpdateCategoryService{ctx: ctx}
+}
+
+// Run update a category, moving its subtree when the parent changes
+func (s *UpdateCategoryService) Run(req *product.UpdateCategoryReq) (resp *product.UpdateCategoryResp, err error) {
+	// 参数验证
+	if req.Id <= 0 {
+		return nil, errors.New("invalid category id")
+	}
+	operatorID, err := requireProductManager(s.ctx, "PUT", categoryPath(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	c := &model.Category{
+		ID:        req.Id,
+		ParentID:  req.ParentId,
+		Name:      req.Name,
+		Slug:      req.Slug,
+		Icon:      req.Icon,
+		SortOrder: req.SortOrder,
+	}
+	if err = validateCategory(c); err != nil {
+		return nil, err
+	}
+
+	if err = mysql.UpdateCategory(mysql.DB, c); err != nil {
+		return nil, err
+	}
+
+	// 分类移动或改名会影响按分类筛选的列表和商品上冗余的分类名称，详情缓存等待自然过期
+	invalidateProducts(s.ctx, true)
+	rebuildSearch(s.ctx)
+	klog.CtxInfof(s.ctx, "category %d updated by user %d", c.ID, operatorID)
+
+	return &product.UpdateCategoryResp{Category: toProtoCategory(c)}, nil
+}
@@ -2,12 +2,35 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
-	
+
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
 	"zqzqsb/gomall/app/product/biz/dal/mysql"
+	"zqzqsb/gomall/app/product/biz/model"
 	product "zqzqsb/gomall/app/product/kitex_gen/product"
 )
 
+var (
+	// ErrInvalidUpdateMask 更新字段不存在或不允许修改
+	ErrInvalidUpdateMask = errors.New("invalid update mask")
+	// ErrStockNotUpdatable 库存只能通过 UpdateStock 修改
+	ErrStockNotUpdatable = errors.New("stock can only be changed by UpdateStock")
+	// ErrVersionConflict 商品已被其他请求修改，RPC 调用方收到 409 业务状态码，需重新读取后再提交
+	ErrVersionConflict = kerrors.NewBizStatusError(consts.StatusConflict, "product has been modified, reload and retry")
+	// ErrVersionRequired 没有携带读取时的版本号，RPC 调用方收到 400 业务状态码，不允许跳过乐观锁覆盖他人的修改
+	ErrVersionRequired = kerrors.NewBizStatusError(consts.StatusBadRequest, "product version is required, read the product first")
+)
+
+// updatableFields 可以通过 update_mask 指定的字段，update_mask 为空时更新全部字段
+var updatableFields = []string{
+	"name", "description", "price", "image_url", "gallery",
+	"category", "category_id", "is_on_sale", "attributes",
+}
+
 type UpdateProductService struct {
 	ctx context.Context
 } // NewUpdateProductService new UpdateProductService
@@ -21,45 +44,135 @@ func (s *UpdateProductService) Run(req *product.UpdateProductReq) (resp *product
 	if req.Id <= 0 {
 		return nil, errors.New("invalid product id")
 	}
+	if req.Version <= 0 {
+		return nil, ErrVersionRequired
+	}
+	operatorID, err := requireProductOwner(s.ctx, "PUT", productPath(req.Id), req.Id)
+	if err != nil {
+		return nil, err
+	}
 
-	// 从数据库获取商品
-	p, err := mysql.GetProductByID(mysql.DB, req.Id)
+	// 有 SKU 的商品价格按 SKU 汇总，不能直接修改
+	hasSkus, err := mysql.HasSkus(mysql.DB, req.Id)
 	if err != nil {
 		return nil, err
 	}
 
-	// 更新商品信息
-	p.Name = req.Name
-	p.Description = req.Description
-	p.Price = req.Price
-	p.Stock = req.Stock
-	p.ImageURL = req.ImageUrl
-	p.Category = req.Category
-	p.IsOnSale = req.IsOnSale
-
-	// 更新图片集
-	if len(req.Gallery) > 0 {
-		if err = p.SetGallery(req.Gallery); err != nil {
-			return nil, err
-		}
+	fields, err := productUpdates(req, hasSkus)
+	if err != nil {
+		return nil, err
 	}
 
-	// 更新属性
-	if len(req.Attributes) > 0 {
-		if err = p.SetAttributes(req.Attributes); err != nil {
+	// 关联分类
+	if _, ok := fields["category_id"]; ok {
+		categoryID, category, err := resolveCategory(req.CategoryId, req.Category)
+		if err != nil {
 			return nil, err
 		}
+		fields["category_id"], fields["category"] = categoryID, category
 	}
 
-	// 保存到数据库
-	if err = mysql.UpdateProduct(mysql.DB, p); err != nil {
+	// 保存到数据库，并记录变更历史
+	var version int64
+	rev := &model.ProductRevision{ProductID: req.Id, Action: model.RevisionUpdate, OperatorID: operatorID, Reason: req.Reason}
+	err = mysql.RecordRevision(mysql.DB, rev, func(tx *gorm.DB) (int64, error) {
+		v, err := mysql.UpdateProduct(tx, req.Id, req.Version, fields)
+		version = v
+		return req.Id, err
+	})
+	if errors.Is(err, mysql.ErrVersionConflict) {
+		return nil, ErrVersionConflict
+	}
+	if err != nil {
 		return nil, err
 	}
 
+	// 先更新数据库，再删除缓存
+	invalidateProducts(s.ctx, true, req.Id)
+	reindexProducts(s.ctx, req.Id)
+	klog.CtxInfof(s.ctx, "product %d updated to version %d by user %d", req.Id, version, operatorID)
+
 	// 返回响应
 	resp = &product.UpdateProductResp{
 		Success: true,
+		Version: version,
 	}
 
 	return resp, nil
 }
+
+// productUpdates 按 update_mask 生成需要更新的列，分类ID和分类名称总是一起更新
+// update_mask 为空时兼容旧的整体更新：更新除库存外的全部字段，图片集和属性为空时保留原值，有 SKU 时忽略价格
+func productUpdates(req *product.UpdateProductReq, hasSkus bool) (map[string]interface{}, error) {
+	mask := req.UpdateMask
+	partial := len(mask) > 0
+	if !partial {
+		mask = updatableFields
+	}
+
+	fields := make(map[string]interface{}, len(mask))
+	for _, field := range mask {
+		switch field {
+		case "stock":
+			return nil, ErrStockNotUpdatable
+		case "name":
+			if req.Name == "" {
+				return nil, errors.New("product name is required")
+			}
+			fields["name"] = req.Name
+		case "description":
+			fields["description"] = req.Description
+		case "price":
+			// 有 SKU 时整体更新忽略价格，调用方可能不再填写
+			if hasSkus {
+				if partial {
+					return nil, mysql.ErrSkuRequired
+				}
+				continue
+			}
+			if req.Price <= 0 {
+				return nil, errors.New("invalid product price")
+			}
+			fields["price"] = req.Price
+		case "image_url":
+			fields["image_url"] = req.ImageUrl
+		case "gallery":
+			if !partial && len(req.Gallery) == 0 {
+				continue
+			}
+			gallery, err := jsonColumn(req.Gallery, len(req.Gallery) == 0)
+			if err != nil {
+				return nil, err
+			}
+			fields["gallery"] = gallery
+		case "category", "category_id":
+			fields["category_id"], fields["category"] = req.CategoryId, req.Category
+		case "is_on_sale":
+			fields["is_on_sale"] = req.IsOnSale
+		case "attributes":
+			if !partial && len(req.Attributes) == 0 {
+				continue
+			}
+			attributes, err := jsonColumn(req.Attributes, len(req.Attributes) == 0)
+			if err != nil {
+				return nil, err
+			}
+			fields["attributes"] = attributes
+		default:
+			return nil, ErrInvalidUpdateMask
+		}
+	}
+	return fields, nil
+}
+
+// jsonColumn 图片集、属性等 JSON 格式存储的列，清空时存为空字符串
+func jsonColumn(v interface{}, empty bool) (string, error) {
+	if empty {
+		return "", nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
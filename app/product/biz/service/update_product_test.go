@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"testing"
 	product "zqzqsb/gomall/app/product/kitex_gen/product"
 )
@@ -19,3 +20,52 @@ func TestUpdateProduct_Run(t *testing.T) {
 	// todo: edit your unit test
 
 }
+
+func TestProductUpdates(t *testing.T) {
+	// 只更新 update_mask 中的字段
+	fields, err := productUpdates(&product.UpdateProductReq{
+		Name: "ignored", Price: 1999, UpdateMask: []string{"price", "attributes"},
+	}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fields) != 2 || fields["price"] != int64(1999) || fields["attributes"] != "" {
+		t.Errorf("unexpected fields: %v", fields)
+	}
+
+	// 未指定 update_mask 时更新全部字段，但不包括库存，空图片集和属性保留原值，有 SKU 时忽略价格
+	fields, err = productUpdates(&product.UpdateProductReq{Name: "手机", Price: 1999, Stock: 10}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, column := range []string{"stock", "price", "gallery", "attributes"} {
+		if _, ok := fields[column]; ok {
+			t.Errorf("expect %s not updated: %v", column, fields)
+		}
+	}
+	if fields["name"] != "手机" || fields["is_on_sale"] != false {
+		t.Errorf("unexpected fields: %v", fields)
+	}
+
+	invalid := map[string]*product.UpdateProductReq{
+		"stock":       {UpdateMask: []string{"stock"}},
+		"unknown":     {UpdateMask: []string{"sales_count"}},
+		"empty name":  {UpdateMask: []string{"name"}},
+		"sku product": {Price: 100, UpdateMask: []string{"price"}},
+		"zero price":  {UpdateMask: []string{"price"}},
+		"negative":    {Price: -1, UpdateMask: []string{"price"}},
+	}
+	for name, req := range invalid {
+		if _, err = productUpdates(req, name == "sku product"); err == nil {
+			t.Errorf("%s: expect error", name)
+		}
+	}
+}
+
+func TestUpdateProductRequiresVersion(t *testing.T) {
+	// 不携带版本号时在鉴权和访问数据库之前拒绝，不允许跳过乐观锁
+	_, err := NewUpdateProductService(context.Background()).Run(&product.UpdateProductReq{Id: 1, Name: "手机"})
+	if !errors.Is(err, ErrVersionRequired) {
+		t.Fatalf("got error %v, want %v", err, ErrVersionRequired)
+	}
+}
@@ -3,8 +3,11 @@ package service
 import (
 	"context"
 	"errors"
-	
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
 	"zqzqsb/gomall/app/product/biz/dal/mysql"
+	"zqzqsb/gomall/app/product/biz/model"
 	product "zqzqsb/gomall/app/product/kitex_gen/product"
 )
 
@@ -21,13 +24,38 @@ func (s *UpdateStockService) Run(req *product.UpdateStockReq) (resp *product.Upd
 	if req.ProductId <= 0 {
 		return nil, errors.New("invalid product id")
 	}
+	// 库存调整是内部接口，不走 /admin 的角色校验，但调用方必须透传操作人身份
+	operatorID, err := requireOperator(s.ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	// 更新商品库存
-	currentStock, err := mysql.UpdateStock(mysql.DB, req.ProductId, req.Quantity)
+	// 更新商品库存，有 SKU 的商品按 SKU 调整，并记录变更历史
+	var currentStock int32
+	rev := &model.ProductRevision{
+		ProductID:  req.ProductId,
+		Action:     model.RevisionStock,
+		OperatorID: operatorID,
+		Reason:     req.Reason,
+		SkuID:      req.SkuId,
+	}
+	err = mysql.RecordRevision(mysql.DB, rev, func(tx *gorm.DB) (int64, error) {
+		var err error
+		if req.SkuId > 0 {
+			currentStock, err = mysql.UpdateSkuStock(tx, req.ProductId, req.SkuId, req.Quantity)
+		} else {
+			currentStock, err = mysql.UpdateStock(tx, req.ProductId, req.Quantity)
+		}
+		return req.ProductId, err
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	// 库存变化只删除商品详情缓存
+	invalidateProducts(s.ctx, false, req.ProductId)
+	klog.CtxInfof(s.ctx, "stock of product %d sku %d adjusted by %d to %d, operator %d", req.ProductId, req.SkuId, req.Quantity, currentStock, operatorID)
+
 	// 构建响应
 	resp = &product.UpdateStockResp{
 		Success:      true,
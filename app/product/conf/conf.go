@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/cloudwego/kitex/pkg/klog"
 	"github.com/kr/pretty"
@@ -17,12 +18,25 @@ var (
 	once sync.Once
 )
 
+const (
+	// cursorSecretEnv 游标签名密钥的环境变量，设置时覆盖配置文件中的值，线上环境必须设置
+	cursorSecretEnv = "PRODUCT_CURSOR_SECRET"
+	// defaultCursorSecret 开发和测试配置中的游标签名密钥，公开在代码仓库中，不能用于线上环境
+	defaultCursorSecret = "cursor secret key"
+)
+
 type Config struct {
-	Env      string
-	Kitex    Kitex    `yaml:"kitex"`
-	MySQL    MySQL    `yaml:"mysql"`
-	Redis    Redis    `yaml:"redis"`
-	Registry Registry `yaml:"registry"`
+	Env         string
+	Kitex       Kitex       `yaml:"kitex"`
+	MySQL       MySQL       `yaml:"mysql"`
+	Redis       Redis       `yaml:"redis"`
+	Registry    Registry    `yaml:"registry"`
+	Reservation Reservation `yaml:"reservation"`
+	Cache       Cache       `yaml:"cache"`
+	Jwt         Jwt         `yaml:"jwt"`
+	Casbin      Casbin      `yaml:"casbin"`
+	Search      Search      `yaml:"search"`
+	Pagination  Pagination  `yaml:"pagination"`
 }
 
 type MySQL struct {
@@ -50,6 +64,62 @@ type Registry struct {
 	RegistryAddress []string `yaml:"registry_address"`
 	Username        string   `yaml:"username"`
 	Password        string   `yaml:"password"`
+	Weight          int      `yaml:"weight"`  // 实例权重，调用方按权重负载均衡
+	Version         string   `yaml:"version"` // 作为 version 标签注册到 Consul
+}
+
+// Address 首个注册中心地址，未配置时返回空，不注册也不发现服务
+func (r Registry) Address() string {
+	if len(r.RegistryAddress) == 0 {
+		return ""
+	}
+	return r.RegistryAddress[0]
+}
+
+// Reservation 库存预占配置
+type Reservation struct {
+	DefaultTTL   time.Duration `yaml:"default_ttl"`   // 调用方未指定有效期时使用
+	MaxTTL       time.Duration `yaml:"max_ttl"`       // 预占有效期上限
+	ScanInterval time.Duration `yaml:"scan_interval"` // 扫描过期预占的间隔
+}
+
+// Cache 商品缓存配置
+type Cache struct {
+	ProductTTL time.Duration `yaml:"product_ttl"` // 商品详情缓存有效期
+	ListTTL    time.Duration `yaml:"list_ttl"`    // 商品列表缓存有效期，库存、销量变化不主动失效列表缓存
+	NullTTL    time.Duration `yaml:"null_ttl"`    // 不存在商品的空值缓存有效期
+	Jitter     float64       `yaml:"jitter"`      // 有效期随机增加的比例，避免大量缓存同时过期
+}
+
+// Jwt 校验用户服务签发的访问令牌，密钥与用户服务保持一致
+type Jwt struct {
+	Secret string `yaml:"secret"`
+	// TokenRedis 用户服务写入令牌黑名单和令牌版本的 Redis，未配置地址时使用本服务的 Redis
+	TokenRedis Redis `yaml:"token_redis"`
+}
+
+// Casbin 商品管理接口的权限配置，访问规则来自 PolicyFile，角色和黑名单来自用户服务维护的 casbin_rule 表
+type Casbin struct {
+	// DSN 用户服务数据库的连接串，角色授予关系和黑名单从其中的 casbin_rule 表读取。
+	// 未配置时读取本服务的数据库，只适用于两个服务共用一个库的部署；分库后必须配置，否则角色无法生效
+	DSN          string        `yaml:"dsn"`
+	ModelFile    string        `yaml:"model_file"`
+	PolicyFile   string        `yaml:"policy_file"`
+	Channel      string        `yaml:"channel"`       // 用户服务发布策略变更的 Redis 频道，在 jwt.token_redis 上订阅
+	SyncInterval time.Duration `yaml:"sync_interval"` // 定期重新加载策略的间隔，0 表示不定期加载
+}
+
+// Search 商品搜索配置
+type Search struct {
+	Backend         string        `yaml:"backend"`          // 搜索后端，默认为进程内倒排索引 memory
+	Channel         string        `yaml:"channel"`          // 各实例同步索引变更的 Redis 频道
+	RebuildInterval time.Duration `yaml:"rebuild_interval"` // 定期全量重建索引的间隔，0 表示不定期重建
+	PriceBuckets    []int64       `yaml:"price_buckets"`    // 价格分面的分段边界，单位：分
+}
+
+// Pagination 商品列表分页配置
+type Pagination struct {
+	CursorSecret string `yaml:"cursor_secret"` // 游标签名密钥，防止客户端伪造游标，可由 PRODUCT_CURSOR_SECRET 环境变量覆盖
 }
 
 // GetConf gets configuration instance
@@ -76,6 +146,12 @@ func initConf() {
 		panic(err)
 	}
 	conf.Env = GetEnv()
+	if secret := os.Getenv(cursorSecretEnv); secret != "" {
+		conf.Pagination.CursorSecret = secret
+	}
+	if conf.Env == "online" && (conf.Pagination.CursorSecret == "" || conf.Pagination.CursorSecret == defaultCursorSecret) {
+		panic("Missing required environment variable: " + cursorSecretEnv)
+	}
 	pretty.Printf("%+v\n", conf)
 }
 
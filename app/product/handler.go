@@ -57,3 +57,101 @@ func (s *ProductServiceImpl) UpdateStock(ctx context.Context, req *product.Updat
 
 	return resp, err
 }
+
+// ReserveStock implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ReserveStock(ctx context.Context, req *product.ReserveStockReq) (resp *product.ReserveStockResp, err error) {
+	resp, err = service.NewReserveStockService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ConfirmReservation implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ConfirmReservation(ctx context.Context, req *product.ConfirmReservationReq) (resp *product.ConfirmReservationResp, err error) {
+	resp, err = service.NewConfirmReservationService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ReleaseReservation implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ReleaseReservation(ctx context.Context, req *product.ReleaseReservationReq) (resp *product.ReleaseReservationResp, err error) {
+	resp, err = service.NewReleaseReservationService(ctx).Run(req)
+
+	return resp, err
+}
+
+// SetProductOptions implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) SetProductOptions(ctx context.Context, req *product.SetProductOptionsReq) (resp *product.SetProductOptionsResp, err error) {
+	resp, err = service.NewSetProductOptionsService(ctx).Run(req)
+
+	return resp, err
+}
+
+// SaveSkus implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) SaveSkus(ctx context.Context, req *product.SaveSkusReq) (resp *product.SaveSkusResp, err error) {
+	resp, err = service.NewSaveSkusService(ctx).Run(req)
+
+	return resp, err
+}
+
+// DeleteSku implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) DeleteSku(ctx context.Context, req *product.DeleteSkuReq) (resp *product.DeleteSkuResp, err error) {
+	resp, err = service.NewDeleteSkuService(ctx).Run(req)
+
+	return resp, err
+}
+
+// CreateCategory implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) CreateCategory(ctx context.Context, req *product.CreateCategoryReq) (resp *product.CreateCategoryResp, err error) {
+	resp, err = service.NewCreateCategoryService(ctx).Run(req)
+
+	return resp, err
+}
+
+// UpdateCategory implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) UpdateCategory(ctx context.Context, req *product.UpdateCategoryReq) (resp *product.UpdateCategoryResp, err error) {
+	resp, err = service.NewUpdateCategoryService(ctx).Run(req)
+
+	return resp, err
+}
+
+// DeleteCategory implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) DeleteCategory(ctx context.Context, req *product.DeleteCategoryReq) (resp *product.DeleteCategoryResp, err error) {
+	resp, err = service.NewDeleteCategoryService(ctx).Run(req)
+
+	return resp, err
+}
+
+// SearchProducts implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) SearchProducts(ctx context.Context, req *product.SearchProductsReq) (resp *product.SearchProductsResp, err error) {
+	resp, err = service.NewSearchProductsService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ListProductRevisions implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ListProductRevisions(ctx context.Context, req *product.ListProductRevisionsReq) (resp *product.ListProductRevisionsResp, err error) {
+	resp, err = service.NewListProductRevisionsService(ctx).Run(req)
+
+	return resp, err
+}
+
+// RestoreProduct implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) RestoreProduct(ctx context.Context, req *product.RestoreProductReq) (resp *product.RestoreProductResp, err error) {
+	resp, err = service.NewRestoreProductService(ctx).Run(req)
+
+	return resp, err
+}
+
+// BulkImportProducts implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) BulkImportProducts(stream product.ProductService_BulkImportProductsServer) (err error) {
+	err = service.NewBulkImportProductsService(stream.Context()).Run(stream)
+
+	return err
+}
+
+// ExportProducts implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ExportProducts(req *product.ExportProductsReq, stream product.ProductService_ExportProductsServer) (err error) {
+	err = service.NewExportProductsService(stream.Context()).Run(req, stream)
+
+	return err
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/cloudwego/kitex/pkg/remote/trans/netpoll"
 	"github.com/cloudwego/kitex/pkg/remote/trans/nphttp2"
 	"zqzqsb/gomall/app/product/biz/router"
+	mw "zqzqsb/gomall/app/product/biz/router/middleware"
 )
 
 type mixTransHandlerFactory struct {
@@ -75,6 +76,11 @@ func initHertz() *route.Engine {
 	h.GET("/ping", func(c context.Context, ctx *app.RequestContext) {
 		ctx.JSON(consts.StatusOK, utils.H{"ping": "pong"})
 	})
+
+	// 管理接口的 JWT 校验和权限策略，权限策略依赖数据库，需在 dal.Init 之后初始化
+	mw.InitJwt()
+	mw.InitCasbin()
+
 	router.GeneratedRegister(h)
 	if err := h.Engine.Init(); err != nil {
 		panic(err)
@@ -86,7 +92,3 @@ func initHertz() *route.Engine {
 }
 
 var hertzEngine *route.Engine
-
-func init() {
-	hertzEngine = initHertz()
-}
@@ -0,0 +1,305 @@
+package search
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+)
+
+// 各字段的词频权重，商品名称命中比描述命中更相关
+const (
+	nameWeight        = 3
+	categoryWeight    = 2
+	attributeWeight   = 1
+	descriptionWeight = 1
+)
+
+// BM25 参数
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+type indexedDoc struct {
+	*Document
+	terms  map[string]float64 // 加权词频
+	length float64
+}
+
+// MemoryIndex 进程内倒排索引，每个实例各自维护一份，适合商品量不大的场景
+type MemoryIndex struct {
+	mu           sync.RWMutex
+	docs         map[int64]*indexedDoc
+	postings     map[string]map[int64]float64
+	totalLength  float64
+	priceBuckets []int64
+}
+
+// NewMemoryIndex 创建空的内存索引，priceBuckets 为升序的价格分段边界
+func NewMemoryIndex(priceBuckets []int64) *MemoryIndex {
+	buckets := append([]int64(nil), priceBuckets...)
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i] < buckets[j] })
+	return &MemoryIndex{
+		docs:         make(map[int64]*indexedDoc),
+		postings:     make(map[string]map[int64]float64),
+		priceBuckets: buckets,
+	}
+}
+
+func analyze(doc *Document) *indexedDoc {
+	d := &indexedDoc{Document: doc, terms: make(map[string]float64)}
+	add := func(text string, weight float64) {
+		for _, term := range Tokenize(text) {
+			d.terms[term] += weight
+			d.length += weight
+		}
+	}
+	add(doc.Name, nameWeight)
+	add(doc.Category, categoryWeight)
+	for _, v := range doc.Attributes {
+		add(v, attributeWeight)
+	}
+	add(doc.Description, descriptionWeight)
+	return d
+}
+
+func (m *MemoryIndex) add(d *indexedDoc) {
+	m.remove(d.ID)
+	m.docs[d.ID] = d
+	m.totalLength += d.length
+	for term, tf := range d.terms {
+		posting := m.postings[term]
+		if posting == nil {
+			posting = make(map[int64]float64)
+			m.postings[term] = posting
+		}
+		posting[d.ID] = tf
+	}
+}
+
+func (m *MemoryIndex) remove(id int64) {
+	d, ok := m.docs[id]
+	if !ok {
+		return
+	}
+	for term := range d.terms {
+		delete(m.postings[term], id)
+		if len(m.postings[term]) == 0 {
+			delete(m.postings, term)
+		}
+	}
+	m.totalLength -= d.length
+	delete(m.docs, id)
+}
+
+// Index 新增或覆盖商品
+func (m *MemoryIndex) Index(_ context.Context, docs ...*Document) error {
+	analyzed := make([]*indexedDoc, 0, len(docs))
+	for _, doc := range docs {
+		analyzed = append(analyzed, analyze(doc))
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, d := range analyzed {
+		m.add(d)
+	}
+	return nil
+}
+
+// Delete 删除商品
+func (m *MemoryIndex) Delete(_ context.Context, ids ...int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, id := range ids {
+		m.remove(id)
+	}
+	return nil
+}
+
+// Rebuild 在锁外构建新索引后整体替换，重建期间不阻塞搜索
+func (m *MemoryIndex) Rebuild(ctx context.Context, docs []*Document) error {
+	fresh := NewMemoryIndex(m.priceBuckets)
+	if err := fresh.Index(ctx, docs...); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.docs, m.postings, m.totalLength = fresh.docs, fresh.postings, fresh.totalLength
+	return nil
+}
+
+// Search 按条件搜索，多个查询词需全部命中，按 BM25 得分排序，得分相同时新商品在前
+func (m *MemoryIndex) Search(_ context.Context, q *Query) (*Result, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	terms := QueryTerms(q.Keyword)
+	var hits []Hit
+	if len(terms) == 0 {
+		if q.Keyword == "" {
+			for _, d := range m.docs {
+				if m.match(d, q) {
+					hits = append(hits, Hit{ID: d.ID})
+				}
+			}
+		}
+	} else {
+		hits = m.score(terms, q)
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].ID > hits[j].ID
+	})
+
+	result := &Result{Total: int64(len(hits))}
+	if !q.SkipFacets {
+		result.Facets = m.facets(hits)
+	}
+	if q.Offset > 0 {
+		if q.Offset >= len(hits) {
+			hits = nil
+		} else {
+			hits = hits[q.Offset:]
+		}
+	}
+	if q.Limit > 0 && q.Limit < len(hits) {
+		hits = hits[:q.Limit]
+	}
+	result.Hits = hits
+	return result, nil
+}
+
+// score 从文档数最少的查询词开始求交集，并计算 BM25 得分
+func (m *MemoryIndex) score(terms []string, q *Query) []Hit {
+	postings := make([]map[int64]float64, 0, len(terms))
+	for _, term := range terms {
+		posting, ok := m.postings[term]
+		if !ok {
+			return nil
+		}
+		postings = append(postings, posting)
+	}
+	sort.Slice(postings, func(i, j int) bool { return len(postings[i]) < len(postings[j]) })
+
+	n := float64(len(m.docs))
+	avgLength := m.totalLength / n
+	var hits []Hit
+next:
+	for id := range postings[0] {
+		d := m.docs[id]
+		if !m.match(d, q) {
+			continue
+		}
+		var score float64
+		for _, posting := range postings {
+			tf, ok := posting[id]
+			if !ok {
+				continue next
+			}
+			df := float64(len(posting))
+			idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+			score += idf * tf * (bm25K1 + 1) / (tf + bm25K1*(1-bm25B+bm25B*d.length/avgLength))
+		}
+		hits = append(hits, Hit{ID: id, Score: score})
+	}
+	return hits
+}
+
+// match 判断商品是否满足筛选条件
+func (m *MemoryIndex) match(d *indexedDoc, q *Query) bool {
+	if q.OnSaleOnly && !d.IsOnSale {
+		return false
+	}
+	if q.MinPrice > 0 && d.Price < q.MinPrice {
+		return false
+	}
+	if q.MaxPrice > 0 && d.Price > q.MaxPrice {
+		return false
+	}
+	if q.CategoryID > 0 {
+		found := false
+		for _, id := range d.CategoryIDs {
+			if id == q.CategoryID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for name, value := range q.Attributes {
+		if d.Attributes[name] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// facets 统计全部命中结果的分类、价格分段和属性值分布
+func (m *MemoryIndex) facets(hits []Hit) *Facets {
+	type categoryKey struct {
+		id   int64
+		name string
+	}
+	categories := make(map[categoryKey]int64)
+	prices := make([]int64, len(m.priceBuckets))
+	attributes := make(map[string]map[string]int64)
+	for _, hit := range hits {
+		d := m.docs[hit.ID]
+		if d.CategoryID > 0 || d.Category != "" {
+			categories[categoryKey{d.CategoryID, d.Category}]++
+		}
+		// 价格低于第一个边界的商品不计入价格分面
+		if i := sort.Search(len(m.priceBuckets), func(i int) bool { return m.priceBuckets[i] > d.Price }) - 1; i >= 0 {
+			prices[i]++
+		}
+		for name, value := range d.Attributes {
+			if attributes[name] == nil {
+				attributes[name] = make(map[string]int64)
+			}
+			attributes[name][value]++
+		}
+	}
+
+	facets := &Facets{}
+	for key, count := range categories {
+		facets.Categories = append(facets.Categories, CategoryFacet{CategoryID: key.id, Name: key.name, Count: count})
+	}
+	sort.Slice(facets.Categories, func(i, j int) bool {
+		a, b := facets.Categories[i], facets.Categories[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		return a.Name < b.Name
+	})
+	for i, count := range prices {
+		if count == 0 {
+			continue
+		}
+		bucket := PriceFacet{Min: m.priceBuckets[i], Count: count}
+		if i+1 < len(m.priceBuckets) {
+			bucket.Max = m.priceBuckets[i+1]
+		}
+		facets.Prices = append(facets.Prices, bucket)
+	}
+	for name, values := range attributes {
+		facet := AttributeFacet{Name: name}
+		for value, count := range values {
+			facet.Values = append(facet.Values, AttributeValueFacet{Value: value, Count: count})
+		}
+		sort.Slice(facet.Values, func(i, j int) bool {
+			a, b := facet.Values[i], facet.Values[j]
+			if a.Count != b.Count {
+				return a.Count > b.Count
+			}
+			return a.Value < b.Value
+		})
+		facets.Attributes = append(facets.Attributes, facet)
+	}
+	sort.Slice(facets.Attributes, func(i, j int) bool { return facets.Attributes[i].Name < facets.Attributes[j].Name })
+	return facets
+}
@@ -0,0 +1,86 @@
+package search
+
+import (
+	"context"
+	"testing"
+)
+
+func newTestIndex(t *testing.T) *MemoryIndex {
+	m := NewMemoryIndex(DefaultPriceBuckets)
+	err := m.Index(context.Background(),
+		&Document{ID: 1, Name: "华为智能手机", Description: "5G 旗舰", Category: "手机", CategoryID: 3, CategoryIDs: []int64{1, 3},
+			Price: 399900, IsOnSale: true, Attributes: map[string]string{"品牌": "华为"}},
+		&Document{ID: 2, Name: "手机壳", Description: "适用于华为智能手机", Category: "配件", CategoryID: 4, CategoryIDs: []int64{1, 4},
+			Price: 2900, IsOnSale: true, Attributes: map[string]string{"品牌": "其他"}},
+		&Document{ID: 3, Name: "智能手表", Description: "运动手表", Category: "手表", CategoryID: 5, CategoryIDs: []int64{5},
+			Price: 99900, IsOnSale: false, Attributes: map[string]string{"品牌": "华为"}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestMemoryIndexSearch(t *testing.T) {
+	m := newTestIndex(t)
+	ctx := context.Background()
+
+	result, err := m.Search(ctx, &Query{Keyword: "智能手机"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 名称命中比描述命中得分更高，手表不包含“手机”
+	if result.Total != 2 || result.Hits[0].ID != 1 || result.Hits[1].ID != 2 {
+		t.Fatalf("unexpected hits: %+v", result.Hits)
+	}
+	if len(result.Facets.Categories) != 2 || len(result.Facets.Prices) != 2 {
+		t.Errorf("unexpected facets: %+v", result.Facets)
+	}
+
+	result, _ = m.Search(ctx, &Query{Keyword: "智能", CategoryID: 1})
+	if result.Total != 2 {
+		t.Errorf("expect category filter to include descendants, got %+v", result.Hits)
+	}
+	result, _ = m.Search(ctx, &Query{Keyword: "智能", OnSaleOnly: true, Attributes: map[string]string{"品牌": "华为"}})
+	if result.Total != 1 || result.Hits[0].ID != 1 {
+		t.Errorf("unexpected filtered hits: %+v", result.Hits)
+	}
+	result, _ = m.Search(ctx, &Query{Keyword: "电脑"})
+	if result.Total != 0 {
+		t.Errorf("expect no hits, got %+v", result.Hits)
+	}
+	result, _ = m.Search(ctx, &Query{Offset: 1, Limit: 1, SkipFacets: true})
+	if result.Total != 3 || len(result.Hits) != 1 || result.Hits[0].ID != 2 || result.Facets != nil {
+		t.Errorf("unexpected page: %+v", result)
+	}
+}
+
+func TestMemoryIndexUpdate(t *testing.T) {
+	m := newTestIndex(t)
+	ctx := context.Background()
+
+	// 覆盖后旧的词不再命中
+	if err := m.Index(ctx, &Document{ID: 2, Name: "数据线", IsOnSale: true}); err != nil {
+		t.Fatal(err)
+	}
+	if result, _ := m.Search(ctx, &Query{Keyword: "手机壳"}); result.Total != 0 {
+		t.Errorf("expect stale terms removed, got %+v", result.Hits)
+	}
+	if result, _ := m.Search(ctx, &Query{Keyword: "数据线"}); result.Total != 1 {
+		t.Errorf("expect new terms indexed, got %+v", result.Hits)
+	}
+
+	if err := m.Delete(ctx, 1, 100); err != nil {
+		t.Fatal(err)
+	}
+	if result, _ := m.Search(ctx, &Query{Keyword: "华为智能"}); result.Total != 0 {
+		t.Errorf("expect deleted product not found, got %+v", result.Hits)
+	}
+
+	if err := m.Rebuild(ctx, []*Document{{ID: 9, Name: "华为平板"}}); err != nil {
+		t.Fatal(err)
+	}
+	if result, _ := m.Search(ctx, &Query{}); result.Total != 1 || result.Hits[0].ID != 9 {
+		t.Errorf("unexpected hits after rebuild: %+v", result.Hits)
+	}
+}
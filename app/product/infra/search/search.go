@@ -0,0 +1,112 @@
+package search
+
+import (
+	"context"
+	"fmt"
+)
+
+// 支持的搜索后端
+const (
+	BackendMemory = "memory"
+)
+
+// DefaultPriceBuckets 未配置时使用的价格分段边界，单位：分
+var DefaultPriceBuckets = []int64{0, 5000, 10000, 50000, 100000, 500000}
+
+// Document 被索引的商品
+type Document struct {
+	ID          int64
+	Name        string
+	Description string
+	Category    string
+	CategoryID  int64
+	CategoryIDs []int64 // 所属分类及其全部祖先分类，按分类筛选时包含子分类
+	Price       int64
+	IsOnSale    bool
+	Attributes  map[string]string
+}
+
+// Query 搜索条件，Keyword 为空时返回全部满足筛选条件的商品
+type Query struct {
+	Keyword    string
+	CategoryID int64
+	MinPrice   int64
+	MaxPrice   int64
+	OnSaleOnly bool
+	Attributes map[string]string // 属性筛选，需全部匹配
+	Offset     int
+	Limit      int  // 小于等于0时返回全部命中
+	SkipFacets bool // 只需要命中的商品时不统计分面
+}
+
+// Hit 命中的商品及相关度得分
+type Hit struct {
+	ID    int64
+	Score float64
+}
+
+// CategoryFacet 按分类统计的命中数，未关联分类表的商品 CategoryID 为0
+type CategoryFacet struct {
+	CategoryID int64
+	Name       string
+	Count      int64
+}
+
+// PriceFacet 按价格分段统计的命中数，区间为 [Min, Max)，Max 为0表示不设上限
+type PriceFacet struct {
+	Min   int64
+	Max   int64
+	Count int64
+}
+
+// AttributeValueFacet 属性值的命中数
+type AttributeValueFacet struct {
+	Value string
+	Count int64
+}
+
+// AttributeFacet 按属性统计的命中数
+type AttributeFacet struct {
+	Name   string
+	Values []AttributeValueFacet
+}
+
+// Facets 命中结果的分面统计
+type Facets struct {
+	Categories []CategoryFacet
+	Prices     []PriceFacet
+	Attributes []AttributeFacet
+}
+
+// Result 搜索结果，Hits 按得分降序排列
+type Result struct {
+	Hits   []Hit
+	Total  int64
+	Facets *Facets
+}
+
+// Searcher 商品搜索后端，实现需并发安全
+// 默认使用进程内倒排索引，数据量增大后可以实现基于 Elasticsearch 的后端替换
+type Searcher interface {
+	// Index 新增或覆盖商品
+	Index(ctx context.Context, docs ...*Document) error
+	// Delete 删除商品，不存在时忽略
+	Delete(ctx context.Context, ids ...int64) error
+	// Rebuild 用全量商品替换索引
+	Rebuild(ctx context.Context, docs []*Document) error
+	// Search 按条件搜索
+	Search(ctx context.Context, q *Query) (*Result, error)
+}
+
+// New 按配置创建搜索后端
+func New(backend string, priceBuckets []int64) (Searcher, error) {
+	if len(priceBuckets) == 0 {
+		priceBuckets = DefaultPriceBuckets
+	}
+	switch backend {
+	case "", BackendMemory:
+		return NewMemoryIndex(priceBuckets), nil
+	default:
+		return nil, fmt.Errorf("unsupported search backend: %s", backend)
+	}
+}
@@ -0,0 +1,83 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// isCJK 中日韩文字没有空格分词，按字切分
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// splitRuns 将文本切分为连续的字母数字串和连续的中日韩文字串，其余字符作为分隔符
+func splitRuns(text string, emit func(run []rune, cjk bool)) {
+	var run []rune
+	var runCJK bool
+	flush := func() {
+		if len(run) > 0 {
+			emit(run, runCJK)
+			run = nil
+		}
+	}
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case isCJK(r):
+			if !runCJK {
+				flush()
+			}
+			runCJK = true
+			run = append(run, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if runCJK {
+				flush()
+			}
+			runCJK = false
+			run = append(run, r)
+		default:
+			flush()
+		}
+	}
+	flush()
+}
+
+// Tokenize 生成索引词：字母数字串整体作为一个词，中日韩文字同时生成单字和相邻两字的二元词，
+// 这样单字查询和多字查询都能命中
+func Tokenize(text string) []string {
+	var terms []string
+	splitRuns(text, func(run []rune, cjk bool) {
+		if !cjk {
+			terms = append(terms, string(run))
+			return
+		}
+		for i := range run {
+			terms = append(terms, string(run[i]))
+			if i+1 < len(run) {
+				terms = append(terms, string(run[i:i+2]))
+			}
+		}
+	})
+	return terms
+}
+
+// QueryTerms 生成查询词并去重：多字的中日韩文字串只使用二元词，要求全部命中时相当于短语匹配
+func QueryTerms(text string) []string {
+	var terms []string
+	seen := make(map[string]bool)
+	add := func(term string) {
+		if !seen[term] {
+			seen[term] = true
+			terms = append(terms, term)
+		}
+	}
+	splitRuns(text, func(run []rune, cjk bool) {
+		if !cjk || len(run) == 1 {
+			add(string(run))
+			return
+		}
+		for i := 0; i+1 < len(run); i++ {
+			add(string(run[i : i+2]))
+		}
+	})
+	return terms
+}
@@ -0,0 +1,29 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	got := Tokenize("Apple iPhone15 智能手机, 5G")
+	want := []string{"apple", "iphone15", "智", "智能", "能", "能手", "手", "手机", "机", "5g"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expect %v, got %v", want, got)
+	}
+}
+
+func TestQueryTerms(t *testing.T) {
+	cases := map[string][]string{
+		"智能手机":       {"智能", "能手", "手机"},
+		"手 iPhone":   {"手", "iphone"},
+		"手机 手机":      {"手机"},
+		"  ,.;":      nil,
+		"华为Mate60手机": {"华为", "mate60", "手机"},
+	}
+	for text, want := range cases {
+		if got := QueryTerms(text); !reflect.DeepEqual(got, want) {
+			t.Errorf("%q: expect %v, got %v", text, want, got)
+		}
+	}
+}
@@ -6,7 +6,11 @@ import (
 	"context"
 	client "github.com/cloudwego/kitex/client"
 	callopt "github.com/cloudwego/kitex/client/callopt"
+	streaming "github.com/cloudwego/kitex/pkg/streaming"
+	transport "github.com/cloudwego/kitex/transport"
 	product "zqzqsb/gomall/app/product/kitex_gen/product"
+	"github.com/cloudwego/kitex/client/streamclient"
+	"github.com/cloudwego/kitex/client/callopt/streamcall"
 )
 
 // Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
@@ -15,9 +19,40 @@ type Client interface {
 	UpdateProduct(ctx context.Context, Req *product.UpdateProductReq, callOptions ...callopt.Option) (r *product.UpdateProductResp, err error)
 	GetProduct(ctx context.Context, Req *product.GetProductReq, callOptions ...callopt.Option) (r *product.GetProductResp, err error)
 	DeleteProduct(ctx context.Context, Req *product.DeleteProductReq, callOptions ...callopt.Option) (r *product.DeleteProductResp, err error)
+	SetProductOptions(ctx context.Context, Req *product.SetProductOptionsReq, callOptions ...callopt.Option) (r *product.SetProductOptionsResp, err error)
+	SaveSkus(ctx context.Context, Req *product.SaveSkusReq, callOptions ...callopt.Option) (r *product.SaveSkusResp, err error)
+	DeleteSku(ctx context.Context, Req *product.DeleteSkuReq, callOptions ...callopt.Option) (r *product.DeleteSkuResp, err error)
+	ListProductRevisions(ctx context.Context, Req *product.ListProductRevisionsReq, callOptions ...callopt.Option) (r *product.ListProductRevisionsResp, err error)
+	RestoreProduct(ctx context.Context, Req *product.RestoreProductReq, callOptions ...callopt.Option) (r *product.RestoreProductResp, err error)
 	ListProducts(ctx context.Context, Req *product.ListProductsReq, callOptions ...callopt.Option) (r *product.ListProductsResp, err error)
 	GetCategories(ctx context.Context, Req *product.GetCategoriesReq, callOptions ...callopt.Option) (r *product.GetCategoriesResp, err error)
+	SearchProducts(ctx context.Context, Req *product.SearchProductsReq, callOptions ...callopt.Option) (r *product.SearchProductsResp, err error)
+	CreateCategory(ctx context.Context, Req *product.CreateCategoryReq, callOptions ...callopt.Option) (r *product.CreateCategoryResp, err error)
+	UpdateCategory(ctx context.Context, Req *product.UpdateCategoryReq, callOptions ...callopt.Option) (r *product.UpdateCategoryResp, err error)
+	DeleteCategory(ctx context.Context, Req *product.DeleteCategoryReq, callOptions ...callopt.Option) (r *product.DeleteCategoryResp, err error)
 	UpdateStock(ctx context.Context, Req *product.UpdateStockReq, callOptions ...callopt.Option) (r *product.UpdateStockResp, err error)
+	ReserveStock(ctx context.Context, Req *product.ReserveStockReq, callOptions ...callopt.Option) (r *product.ReserveStockResp, err error)
+	ConfirmReservation(ctx context.Context, Req *product.ConfirmReservationReq, callOptions ...callopt.Option) (r *product.ConfirmReservationResp, err error)
+	ReleaseReservation(ctx context.Context, Req *product.ReleaseReservationReq, callOptions ...callopt.Option) (r *product.ReleaseReservationResp, err error)
+	BulkImportProducts(ctx context.Context, callOptions ...callopt.Option) (stream ProductService_BulkImportProductsClient, err error)
+	ExportProducts(ctx context.Context, Req *product.ExportProductsReq, callOptions ...callopt.Option) (stream ProductService_ExportProductsClient, err error)
+}
+
+// StreamClient is designed to provide Interface for Streaming APIs.
+type StreamClient interface {
+	BulkImportProducts(ctx context.Context, callOptions ...streamcall.Option) (stream ProductService_BulkImportProductsClient, err error)
+	ExportProducts(ctx context.Context, Req *product.ExportProductsReq, callOptions ...streamcall.Option) (stream ProductService_ExportProductsClient, err error)
+}
+
+type ProductService_BulkImportProductsClient interface {
+	streaming.Stream
+	Send(*product.BulkImportProductsReq) error
+	CloseAndRecv() (*product.BulkImportProductsResp, error)
+}
+
+type ProductService_ExportProductsClient interface {
+	streaming.Stream
+	Recv() (*product.ExportProductsResp, error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -25,6 +60,8 @@ func NewClient(destService string, opts ...client.Option) (Client, error) {
 	var options []client.Option
 	options = append(options, client.WithDestService(destService))
 
+	options = append(options, client.WithTransportProtocol(transport.GRPC))
+
 	options = append(options, opts...)
 
 	kc, err := client.NewClient(serviceInfo(), options...)
@@ -69,6 +106,31 @@ func (p *kProductServiceClient) DeleteProduct(ctx context.Context, Req *product.
 	return p.kClient.DeleteProduct(ctx, Req)
 }
 
+func (p *kProductServiceClient) SetProductOptions(ctx context.Context, Req *product.SetProductOptionsReq, callOptions ...callopt.Option) (r *product.SetProductOptionsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SetProductOptions(ctx, Req)
+}
+
+func (p *kProductServiceClient) SaveSkus(ctx context.Context, Req *product.SaveSkusReq, callOptions ...callopt.Option) (r *product.SaveSkusResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SaveSkus(ctx, Req)
+}
+
+func (p *kProductServiceClient) DeleteSku(ctx context.Context, Req *product.DeleteSkuReq, callOptions ...callopt.Option) (r *product.DeleteSkuResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteSku(ctx, Req)
+}
+
+func (p *kProductServiceClient) ListProductRevisions(ctx context.Context, Req *product.ListProductRevisionsReq, callOptions ...callopt.Option) (r *product.ListProductRevisionsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListProductRevisions(ctx, Req)
+}
+
+func (p *kProductServiceClient) RestoreProduct(ctx context.Context, Req *product.RestoreProductReq, callOptions ...callopt.Option) (r *product.RestoreProductResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RestoreProduct(ctx, Req)
+}
+
 func (p *kProductServiceClient) ListProducts(ctx context.Context, Req *product.ListProductsReq, callOptions ...callopt.Option) (r *product.ListProductsResp, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.ListProducts(ctx, Req)
@@ -79,7 +141,92 @@ func (p *kProductServiceClient) GetCategories(ctx context.Context, Req *product.
 	return p.kClient.GetCategories(ctx, Req)
 }
 
+func (p *kProductServiceClient) SearchProducts(ctx context.Context, Req *product.SearchProductsReq, callOptions ...callopt.Option) (r *product.SearchProductsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SearchProducts(ctx, Req)
+}
+
+func (p *kProductServiceClient) CreateCategory(ctx context.Context, Req *product.CreateCategoryReq, callOptions ...callopt.Option) (r *product.CreateCategoryResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateCategory(ctx, Req)
+}
+
+func (p *kProductServiceClient) UpdateCategory(ctx context.Context, Req *product.UpdateCategoryReq, callOptions ...callopt.Option) (r *product.UpdateCategoryResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateCategory(ctx, Req)
+}
+
+func (p *kProductServiceClient) DeleteCategory(ctx context.Context, Req *product.DeleteCategoryReq, callOptions ...callopt.Option) (r *product.DeleteCategoryResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteCategory(ctx, Req)
+}
+
 func (p *kProductServiceClient) UpdateStock(ctx context.Context, Req *product.UpdateStockReq, callOptions ...callopt.Option) (r *product.UpdateStockResp, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.UpdateStock(ctx, Req)
 }
+
+func (p *kProductServiceClient) ReserveStock(ctx context.Context, Req *product.ReserveStockReq, callOptions ...callopt.Option) (r *product.ReserveStockResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ReserveStock(ctx, Req)
+}
+
+func (p *kProductServiceClient) ConfirmReservation(ctx context.Context, Req *product.ConfirmReservationReq, callOptions ...callopt.Option) (r *product.ConfirmReservationResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ConfirmReservation(ctx, Req)
+}
+
+func (p *kProductServiceClient) ReleaseReservation(ctx context.Context, Req *product.ReleaseReservationReq, callOptions ...callopt.Option) (r *product.ReleaseReservationResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ReleaseReservation(ctx, Req)
+}
+
+func (p *kProductServiceClient) BulkImportProducts(ctx context.Context, callOptions ...callopt.Option) (stream ProductService_BulkImportProductsClient, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BulkImportProducts(ctx)
+}
+
+func (p *kProductServiceClient) ExportProducts(ctx context.Context, Req *product.ExportProductsReq, callOptions ...callopt.Option) (stream ProductService_ExportProductsClient, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ExportProducts(ctx, Req)
+}
+
+// NewStreamClient creates a stream client for the service's streaming APIs defined in IDL.
+func NewStreamClient(destService string, opts ...streamclient.Option) (StreamClient, error) {
+	var options []client.Option
+	options = append(options, client.WithDestService(destService))
+	options = append(options, client.WithTransportProtocol(transport.GRPC))
+	options = append(options, streamclient.GetClientOptions(opts)...)
+
+	kc, err := client.NewClient(serviceInfoForStreamClient(), options...)
+	if err != nil {
+		return nil, err
+	}
+	return &kProductServiceStreamClient{
+		kClient: newServiceClient(kc),
+	}, nil
+}
+
+// MustNewStreamClient creates a stream client for the service's streaming APIs defined in IDL.
+// It panics if any error occurs.
+func MustNewStreamClient(destService string, opts ...streamclient.Option) StreamClient {
+	kc, err := NewStreamClient(destService, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return kc
+}
+
+type kProductServiceStreamClient struct {
+	*kClient
+}
+
+func (p *kProductServiceStreamClient) BulkImportProducts(ctx context.Context, callOptions ...streamcall.Option) (stream ProductService_BulkImportProductsClient, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, streamcall.GetCallOptions(callOptions))
+	return p.kClient.BulkImportProducts(ctx)
+}
+
+func (p *kProductServiceStreamClient) ExportProducts(ctx context.Context, Req *product.ExportProductsReq, callOptions ...streamcall.Option) (stream ProductService_ExportProductsClient, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, streamcall.GetCallOptions(callOptions))
+	return p.kClient.ExportProducts(ctx, Req)
+}
@@ -1,20 +1,32 @@
 package main
 
 import (
+	"context"
 	"net"
 	"time"
 
 	"github.com/cloudwego/kitex/pkg/klog"
 	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/cloudwego/kitex/pkg/transmeta"
 	"github.com/cloudwego/kitex/server"
 	kitexlogrus "github.com/kitex-contrib/obs-opentelemetry/logging/logrus"
-	"zqzqsb/gomall/app/product/conf"
-	"zqzqsb/gomall/app/product/kitex_gen/product/productservice"
 	"go.uber.org/zap/zapcore"
 	"gopkg.in/natefinch/lumberjack.v2"
+	"zqzqsb.com/gomall/common/serversuite"
+	"zqzqsb/gomall/app/product/biz/dal"
+	"zqzqsb/gomall/app/product/biz/service"
+	"zqzqsb/gomall/app/product/conf"
+	"zqzqsb/gomall/app/product/kitex_gen/product/productservice"
 )
 
 func main() {
+	dal.Init()
+	hertzEngine = initHertz()
+	// 定时释放过期未确认的库存预占
+	service.StartReservationReaper(context.Background())
+	// 构建商品搜索索引
+	service.StartSearch(context.Background())
+
 	opts := kitexInit()
 
 	svr := productservice.NewServer(new(ProductServiceImpl), opts...)
@@ -36,23 +48,36 @@ func kitexInit() (opts []server.Option) {
 	}
 	opts = append(opts, server.WithServiceAddr(addr))
 
+	// 通过 Consul 注册服务，服务退出时自动注销
+	opts = append(opts, server.WithSuite(&serversuite.CommonServerSuite{
+		CurrentServiceName: conf.GetConf().Kitex.Service,
+		RegistryAddr:       conf.GetConf().Registry.Address(),
+		ServiceAddr:        conf.GetConf().Kitex.Address,
+		Weight:             conf.GetConf().Registry.Weight,
+		Env:                conf.GetEnv(),
+		Version:            conf.GetConf().Registry.Version,
+	}))
+
 	// service info
 	opts = append(opts, server.WithServerBasicInfo(&rpcinfo.EndpointBasicInfo{
 		ServiceName: conf.GetConf().Kitex.Service,
 	}))
 
+	// 接收网关透传的用户身份
+	opts = append(opts, server.WithMetaHandler(transmeta.ServerHTTP2Handler))
+
 	// klog
 	logger := kitexlogrus.NewLogger()
 	klog.SetLogger(logger)
 	klog.SetLevel(conf.LogLevel())
 	asyncWriter := &zapcore.BufferedWriteSyncer{
 		WS: zapcore.AddSync(&lumberjack.Logger{
-			Filename:	conf.GetConf().Kitex.LogFileName,
-			MaxSize:	conf.GetConf().Kitex.LogMaxSize,
-			MaxBackups:	conf.GetConf().Kitex.LogMaxBackups,
-			MaxAge:		conf.GetConf().Kitex.LogMaxAge,
+			Filename:   conf.GetConf().Kitex.LogFileName,
+			MaxSize:    conf.GetConf().Kitex.LogMaxSize,
+			MaxBackups: conf.GetConf().Kitex.LogMaxBackups,
+			MaxAge:     conf.GetConf().Kitex.LogMaxAge,
 		}),
-		FlushInterval:	time.Minute,
+		FlushInterval: time.Minute,
 	}
 	klog.SetOutput(asyncWriter)
 	server.RegisterShutdownHook(func() {
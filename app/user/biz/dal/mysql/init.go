@@ -31,7 +31,7 @@ func Init() {
 			SkipDefaultTransaction: true,
 		},
 	)
-	DB.AutoMigrate(&model.User{})
+	DB.AutoMigrate(&model.User{}, &model.Address{}, &model.LoginAudit{}, &model.MFARecoveryCode{}, &model.CasbinRule{})
 	if err != nil {
 		panic(err)
 	}
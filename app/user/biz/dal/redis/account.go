@@ -0,0 +1,30 @@
+package redis
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// accountTokenKey 邮箱验证、重置密码等一次性令牌的键，只保存令牌的摘要，Redis 泄露时令牌不可直接使用
+func accountTokenKey(purpose, token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return fmt.Sprintf("account:%s:%s", purpose, hex.EncodeToString(sum[:]))
+}
+
+// SaveAccountToken 保存一次性令牌对应的用户ID
+func SaveAccountToken(ctx context.Context, purpose, token string, userID int64, ttl time.Duration) error {
+	return RedisClient.Set(ctx, accountTokenKey(purpose, token), userID, ttl).Err()
+}
+
+// TakeAccountToken 取出并删除一次性令牌，保证令牌只能使用一次，不存在或已过期时返回 redis.Nil
+func TakeAccountToken(ctx context.Context, purpose, token string) (int64, error) {
+	val, err := RedisClient.GetDel(ctx, accountTokenKey(purpose, token)).Result()
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(val, 10, 64)
+}
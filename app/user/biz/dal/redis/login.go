@@ -0,0 +1,55 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// loginFailureKey 登录失败计数，scope 为 account 或 ip，记录失败次数和最近一次失败的时间
+func loginFailureKey(scope, key string) string {
+	return fmt.Sprintf("login:fail:%s:%s", scope, key)
+}
+
+// GetLoginFailures 读取登录失败次数和最近一次失败的时间，没有记录时次数为 0
+func GetLoginFailures(ctx context.Context, scope, key string) (int64, time.Time, error) {
+	vals, err := RedisClient.HMGet(ctx, loginFailureKey(scope, key), "count", "last").Result()
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	count, _ := parseInt(vals[0])
+	last, _ := parseInt(vals[1])
+	return count, time.UnixMilli(last), nil
+}
+
+// RecordLoginFailure 累加登录失败次数并返回累加后的次数，ttl 内没有新的失败时计数自动清零
+func RecordLoginFailure(ctx context.Context, scope, key string, ttl time.Duration) (int64, error) {
+	k := loginFailureKey(scope, key)
+	var incr *redis.IntCmd
+	_, err := RedisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.HIncrBy(ctx, k, "count", 1)
+		pipe.HSet(ctx, k, "last", time.Now().UnixMilli())
+		pipe.Expire(ctx, k, ttl)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+// ClearLoginFailures 登录成功后清除失败计数
+func ClearLoginFailures(ctx context.Context, scope, key string) error {
+	return RedisClient.Del(ctx, loginFailureKey(scope, key)).Err()
+}
+
+func parseInt(v interface{}) (int64, error) {
+	s, ok := v.(string)
+	if !ok {
+		return 0, nil
+	}
+	return strconv.ParseInt(s, 10, 64)
+}
@@ -0,0 +1,37 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// mfaEnrollKey 用户生成但尚未确认绑定的 TOTP 密钥
+func mfaEnrollKey(userID int64) string {
+	return fmt.Sprintf("mfa:enroll:%d", userID)
+}
+
+// mfaStepKey 已使用过的 TOTP 时间步
+func mfaStepKey(userID, step int64) string {
+	return fmt.Sprintf("mfa:step:%d:%d", userID, step)
+}
+
+// SaveMFAEnrollment 保存待绑定的 TOTP 密钥，重新生成时覆盖此前的密钥
+func SaveMFAEnrollment(ctx context.Context, userID int64, secret string, ttl time.Duration) error {
+	return RedisClient.Set(ctx, mfaEnrollKey(userID), secret, ttl).Err()
+}
+
+// GetMFAEnrollment 读取待绑定的 TOTP 密钥，不存在或已过期时返回 redis.Nil
+func GetMFAEnrollment(ctx context.Context, userID int64) (string, error) {
+	return RedisClient.Get(ctx, mfaEnrollKey(userID)).Result()
+}
+
+// DeleteMFAEnrollment 绑定完成后删除待绑定的密钥
+func DeleteMFAEnrollment(ctx context.Context, userID int64) error {
+	return RedisClient.Del(ctx, mfaEnrollKey(userID)).Err()
+}
+
+// UseTOTPStep 记录用户已使用的 TOTP 时间步，返回 false 表示该时间步的验证码已被使用过
+func UseTOTPStep(ctx context.Context, userID, step int64, ttl time.Duration) (bool, error) {
+	return RedisClient.SetNX(ctx, mfaStepKey(userID, step), 1, ttl).Result()
+}
@@ -0,0 +1,47 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+func oauthCodeKey(code string) string {
+	return fmt.Sprintf("oauth:code:%s", code)
+}
+
+func oauthRefreshKey(token string) string {
+	return fmt.Sprintf("oauth:refresh:%s", token)
+}
+
+// SaveOAuthCode 保存授权码对应的授权信息
+func SaveOAuthCode(ctx context.Context, code string, data []byte, ttl time.Duration) error {
+	return RedisClient.Set(ctx, oauthCodeKey(code), data, ttl).Err()
+}
+
+// TakeOAuthCode 取出并删除授权码，保证授权码只能使用一次，不存在时返回 redis.Nil
+func TakeOAuthCode(ctx context.Context, code string) ([]byte, error) {
+	return RedisClient.GetDel(ctx, oauthCodeKey(code)).Bytes()
+}
+
+// SaveOAuthRefreshToken 保存 OAuth2 刷新令牌对应的授权信息
+func SaveOAuthRefreshToken(ctx context.Context, token string, data []byte, ttl time.Duration) error {
+	return RedisClient.Set(ctx, oauthRefreshKey(token), data, ttl).Err()
+}
+
+// GetOAuthRefreshToken 读取刷新令牌对应的授权信息，不存在时返回 redis.Nil
+func GetOAuthRefreshToken(ctx context.Context, token string) ([]byte, time.Duration, error) {
+	pipe := RedisClient.Pipeline()
+	get := pipe.Get(ctx, oauthRefreshKey(token))
+	ttl := pipe.TTL(ctx, oauthRefreshKey(token))
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, 0, err
+	}
+	data, err := get.Bytes()
+	return data, ttl.Val(), err
+}
+
+// TakeOAuthRefreshToken 取出并删除刷新令牌，刷新令牌轮换后旧令牌立即失效
+func TakeOAuthRefreshToken(ctx context.Context, token string) ([]byte, error) {
+	return RedisClient.GetDel(ctx, oauthRefreshKey(token)).Bytes()
+}
@@ -0,0 +1,38 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"zqzqsb.com/gomall/common/jwtauth"
+)
+
+// RevokeToken 将 jti 加入黑名单，返回 false 表示该令牌此前已被吊销
+// 黑名单和令牌版本的键格式定义在 jwtauth 中，其他服务按同样的规则校验访问令牌
+func RevokeToken(ctx context.Context, jti string, ttl time.Duration) (bool, error) {
+	if ttl <= 0 {
+		// 令牌已经过期，无需再记录
+		return true, nil
+	}
+	return RedisClient.SetNX(ctx, jwtauth.TokenBlacklistKey(jti), 1, ttl).Result()
+}
+
+// IsTokenRevoked 检查 jti 是否在黑名单中
+func IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	return jwtauth.IsTokenRevoked(ctx, RedisClient, jti)
+}
+
+// GetTokenVersion 读取缓存的用户令牌版本，未命中时返回 redis.Nil
+func GetTokenVersion(ctx context.Context, userID int64) (int64, error) {
+	return jwtauth.GetTokenVersion(ctx, RedisClient, userID)
+}
+
+// FillTokenVersion 回填从数据库读到的令牌版本，已存在时不覆盖，避免旧值覆盖刚递增的新版本
+func FillTokenVersion(ctx context.Context, userID, version int64, ttl time.Duration) error {
+	return RedisClient.SetNX(ctx, jwtauth.TokenVersionKey(userID), version, ttl).Err()
+}
+
+// SetTokenVersion 在令牌版本递增后覆盖缓存
+func SetTokenVersion(ctx context.Context, userID, version int64, ttl time.Duration) error {
+	return RedisClient.Set(ctx, jwtauth.TokenVersionKey(userID), version, ttl).Err()
+}
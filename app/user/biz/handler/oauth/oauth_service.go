@@ -0,0 +1,180 @@
+package oauth
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	mw "zqzqsb.com/gomall/app/user/biz/router/middleware"
+	"zqzqsb.com/gomall/app/user/biz/service"
+	"zqzqsb.com/gomall/app/user/conf"
+)
+
+// Authorize 授权码流程的授权端点，复用用户服务登录后的 jwt cookie 识别用户，实现各客户端单点登录
+// @router /oauth2/authorize [GET]
+func Authorize(ctx context.Context, c *app.RequestContext) {
+	req := &service.AuthorizeRequest{
+		ResponseType:        c.Query("response_type"),
+		ClientID:            c.Query("client_id"),
+		RedirectURI:         c.Query("redirect_uri"),
+		Scope:               c.Query("scope"),
+		State:               c.Query("state"),
+		Nonce:               c.Query("nonce"),
+		CodeChallenge:       c.Query("code_challenge"),
+		CodeChallengeMethod: c.Query("code_challenge_method"),
+	}
+	if claims, err := currentUser(ctx, c); err == nil {
+		req.UserID = claims.UserID
+		req.TokenVersion = claims.Version
+		req.AuthTime = claims.AuthTime
+	}
+
+	redirectURL, err := service.NewOAuthAuthorizeService(ctx).Run(req)
+	if errors.Is(err, service.ErrLoginRequired) {
+		loginURL := conf.GetConf().OAuth.LoginURL
+		if loginURL == "" {
+			writeError(c, &service.OAuthError{Code: "login_required", Status: consts.StatusUnauthorized})
+			return
+		}
+		// 登录成功后由登录页跳回当前授权地址
+		back := string(c.Request.URI().FullURI())
+		c.Redirect(consts.StatusFound, []byte(loginURL+"?redirect="+url.QueryEscape(back)))
+		return
+	}
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+	c.Redirect(consts.StatusFound, []byte(redirectURL))
+}
+
+// Token 令牌端点，支持 authorization_code、refresh_token 和 client_credentials
+// @router /oauth2/token [POST]
+func Token(ctx context.Context, c *app.RequestContext) {
+	clientID, clientSecret := clientCredentials(c)
+	req := &service.OAuthTokenRequest{
+		GrantType:    c.PostForm("grant_type"),
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Code:         c.PostForm("code"),
+		RedirectURI:  c.PostForm("redirect_uri"),
+		CodeVerifier: c.PostForm("code_verifier"),
+		RefreshToken: c.PostForm("refresh_token"),
+		Scope:        c.PostForm("scope"),
+	}
+
+	resp, err := service.NewOAuthTokenService(ctx).Run(req)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+	c.Header("Cache-Control", "no-store")
+	c.JSON(consts.StatusOK, resp)
+}
+
+// Introspect 令牌自省端点，供资源服务校验令牌
+// @router /oauth2/introspect [POST]
+func Introspect(ctx context.Context, c *app.RequestContext) {
+	clientID, clientSecret := clientCredentials(c)
+	req := &service.IntrospectRequest{
+		ClientID:      clientID,
+		ClientSecret:  clientSecret,
+		Token:         c.PostForm("token"),
+		TokenTypeHint: c.PostForm("token_type_hint"),
+	}
+
+	resp, err := service.NewOAuthIntrospectService(ctx).Run(req)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, resp)
+}
+
+// UserInfo OIDC 用户信息端点
+// @router /oauth2/userinfo [GET]
+func UserInfo(ctx context.Context, c *app.RequestContext) {
+	token := strings.TrimPrefix(string(c.GetHeader("Authorization")), "Bearer ")
+	resp, err := service.NewOAuthUserInfoService(ctx).Run(token)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidToken) || errors.Is(err, service.ErrTokenRevoked) {
+			c.Header("WWW-Authenticate", `Bearer error="invalid_token"`)
+			writeError(c, &service.OAuthError{Code: "invalid_token", Status: consts.StatusUnauthorized})
+			return
+		}
+		writeError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, resp)
+}
+
+// JWKS 公钥集合端点
+// @router /oauth2/jwks [GET]
+func JWKS(ctx context.Context, c *app.RequestContext) {
+	keys, err := service.JWKS()
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, keys)
+}
+
+// OpenIDConfiguration OIDC Discovery 端点
+// @router /.well-known/openid-configuration [GET]
+func OpenIDConfiguration(ctx context.Context, c *app.RequestContext) {
+	c.JSON(consts.StatusOK, service.OpenIDConfiguration())
+}
+
+// currentUser 从 jwt cookie 或 Authorization 头中解析当前登录用户，并检查令牌是否已被吊销
+func currentUser(ctx context.Context, c *app.RequestContext) (*service.TokenClaims, error) {
+	mapClaims, err := mw.JwtMiddleware.GetClaimsFromJWT(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := service.ClaimsFromMap(mapClaims)
+	if err != nil || claims.Type != service.TokenTypeAccess {
+		return nil, service.ErrInvalidToken
+	}
+	if err = service.CheckTokenActive(ctx, claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// clientCredentials 优先从 HTTP Basic 认证中读取客户端凭据，其次读取表单参数
+func clientCredentials(c *app.RequestContext) (clientID, clientSecret string) {
+	auth := string(c.GetHeader("Authorization"))
+	if strings.HasPrefix(auth, "Basic ") {
+		if raw, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(auth, "Basic ")); err == nil {
+			if id, secret, ok := strings.Cut(string(raw), ":"); ok {
+				// RFC 6749 2.3.1 要求客户端凭据先做 form 编码
+				id, _ = url.QueryUnescape(id)
+				secret, _ = url.QueryUnescape(secret)
+				return id, secret
+			}
+		}
+	}
+	return c.PostForm("client_id"), c.PostForm("client_secret")
+}
+
+// writeError 按 OAuth2 错误格式返回，非 OAuth2 错误统一作为 server_error
+func writeError(c *app.RequestContext, err error) {
+	if e, ok := service.IsOAuthError(err); ok {
+		if e.Code == "invalid_client" {
+			c.Header("WWW-Authenticate", `Basic realm="oauth2"`)
+		}
+		c.JSON(e.Status, e)
+		return
+	}
+	hlog.Errorf("oauth2 internal error: %v", err)
+	c.JSON(consts.StatusInternalServerError, utils.H{
+		"error":             "server_error",
+		"error_description": "internal error",
+	})
+}
@@ -0,0 +1,53 @@
+package user
+
+import (
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+	"github.com/cloudwego/hertz/pkg/protocol"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+)
+
+const (
+	accessCookieName  = "jwt"
+	refreshCookieName = "refresh_token"
+)
+
+// setTokenCookies 以 http only cookie 的形式下发访问令牌和刷新令牌
+func setTokenCookies(c *app.RequestContext, token *user.TokenPair) {
+	now := time.Now().Unix()
+	c.SetCookie(accessCookieName, token.AccessToken, int(token.AccessExpire-now), "/", "",
+		protocol.CookieSameSiteDefaultMode, true, true)
+	c.SetCookie(refreshCookieName, token.RefreshToken, int(token.RefreshExpire-now), "/", "",
+		protocol.CookieSameSiteDefaultMode, true, true)
+}
+
+func clearTokenCookies(c *app.RequestContext) {
+	c.SetCookie(accessCookieName, "", -1, "/", "", protocol.CookieSameSiteDefaultMode, true, true)
+	c.SetCookie(refreshCookieName, "", -1, "/", "", protocol.CookieSameSiteDefaultMode, true, true)
+}
+
+// tokenResponse 与原先登录接口的响应格式保持一致，额外返回刷新令牌
+func tokenResponse(token *user.TokenPair) utils.H {
+	return utils.H{
+		"code":           consts.StatusOK,
+		"token":          token.AccessToken,
+		"expire":         time.Unix(token.AccessExpire, 0).Format(time.RFC3339),
+		"refresh_token":  token.RefreshToken,
+		"refresh_expire": time.Unix(token.RefreshExpire, 0).Format(time.RFC3339),
+		"message":        "success",
+	}
+}
+
+// mfaResponse 密码校验通过、等待二次验证时的响应
+func mfaResponse(resp *user.LoginResp) utils.H {
+	return utils.H{
+		"code":         consts.StatusOK,
+		"mfa_required": true,
+		"mfa_token":    resp.MfaToken,
+		"mfa_expire":   time.Unix(resp.MfaExpire, 0).Format(time.RFC3339),
+		"message":      "mfa required",
+	}
+}
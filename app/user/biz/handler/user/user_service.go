@@ -4,13 +4,18 @@ package user
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"strconv"
 
 	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/utils"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
 	"github.com/hertz-contrib/csrf"
+	"github.com/hertz-contrib/jwt"
 	"zqzqsb.com/gomall/app/user/biz/service"
+	bizutils "zqzqsb.com/gomall/app/user/biz/utils"
 	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
 )
 
@@ -49,16 +54,99 @@ func Login(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	userID := c.GetString("identity")
-	log.Println("user:", userID)
-
+	ctx = bizutils.WithClientInfo(ctx, c.ClientIP(), string(c.UserAgent()))
 	LoginService := service.NewLoginService(ctx)
 	resp, err := LoginService.Run(&req)
 	if err != nil {
-		c.String(consts.StatusInternalServerError, fmt.Sprintf("Registration failed: %v", err))
+		sendLoginFailed(c, err)
+		return
+	}
+	// 开启二次验证时不下发令牌 cookie，客户端需要携带 mfa_token 调用 /login/mfa
+	if resp.MfaRequired {
+		c.JSON(consts.StatusOK, mfaResponse(resp))
+		return
+	}
+
+	setTokenCookies(c, resp.Token)
+	c.JSON(consts.StatusOK, tokenResponse(resp.Token))
+}
+
+// VerifyMFA .
+// @router /login/mfa [POST]
+func VerifyMFA(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.VerifyMFAReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx = bizutils.WithClientInfo(ctx, c.ClientIP(), string(c.UserAgent()))
+	resp, err := service.NewVerifyMFAService(ctx).Run(&req)
+	if err != nil {
+		sendLoginFailed(c, err)
+		return
+	}
+
+	setTokenCookies(c, resp.Token)
+	c.JSON(consts.StatusOK, tokenResponse(resp.Token))
+}
+
+// RefreshToken .
+// @router /refresh [POST]
+func RefreshToken(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.RefreshTokenReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+	// 未在请求体中携带时从 cookie 中读取刷新令牌
+	if req.RefreshToken == "" {
+		req.RefreshToken = string(c.Cookie(refreshCookieName))
+	}
+
+	resp, err := service.NewRefreshTokenService(ctx).Run(&req)
+	if err != nil {
+		c.JSON(consts.StatusUnauthorized, utils.H{
+			"code":    consts.StatusUnauthorized,
+			"message": err.Error(),
+		})
+		return
+	}
+
+	setTokenCookies(c, resp.Token)
+	c.JSON(consts.StatusOK, tokenResponse(resp.Token))
+}
+
+// Logout .
+// @router /logout [POST]
+func Logout(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.LogoutReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+	// 访问令牌已由 JWT 中间件校验，刷新令牌未在请求体中携带时从 cookie 中读取
+	req.AccessToken = jwt.GetToken(ctx, c)
+	if req.RefreshToken == "" {
+		req.RefreshToken = string(c.Cookie(refreshCookieName))
+	}
+
+	resp, err := service.NewLogoutService(ctx).Run(&req)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, utils.H{
+			"code":    consts.StatusInternalServerError,
+			"message": err.Error(),
+		})
 		return
 	}
 
+	clearTokenCookies(c)
 	c.JSON(consts.StatusOK, resp)
 }
 
@@ -94,3 +182,633 @@ func Hello(ctx context.Context, c *app.RequestContext) {
 	}
 	c.JSON(consts.StatusOK, resp)
 }
+
+// GetProfile .
+// @router /user/profile [GET]
+func GetProfile(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.GetProfileReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用服务层查询用户资料
+	resp, err := service.NewGetProfileService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// UpdateProfile .
+// @router /user/profile [PUT]
+func UpdateProfile(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.UpdateProfileReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用服务层更新用户资料
+	resp, err := service.NewUpdateProfileService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ListAddresses .
+// @router /user/addresses [GET]
+func ListAddresses(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.ListAddressesReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用服务层查询收货地址列表
+	resp, err := service.NewListAddressesService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetAddress .
+// @router /user/addresses/{address_id} [GET]
+func GetAddress(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.GetAddressReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取收货地址ID
+	id, err := strconv.ParseInt(c.Param("address_id"), 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid address ID")
+		return
+	}
+	req.AddressId = id
+
+	// 调用服务层查询收货地址
+	resp, err := service.NewGetAddressService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// CreateAddress .
+// @router /user/addresses [POST]
+func CreateAddress(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.CreateAddressReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用服务层新增收货地址
+	resp, err := service.NewCreateAddressService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// UpdateAddress .
+// @router /user/addresses/{address_id} [PUT]
+func UpdateAddress(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.UpdateAddressReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取收货地址ID
+	id, err := strconv.ParseInt(c.Param("address_id"), 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid address ID")
+		return
+	}
+	req.AddressId = id
+
+	// 调用服务层修改收货地址
+	resp, err := service.NewUpdateAddressService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// DeleteAddress .
+// @router /user/addresses/{address_id} [DELETE]
+func DeleteAddress(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.DeleteAddressReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取收货地址ID
+	id, err := strconv.ParseInt(c.Param("address_id"), 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid address ID")
+		return
+	}
+	req.AddressId = id
+
+	// 调用服务层删除收货地址
+	resp, err := service.NewDeleteAddressService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// SetDefaultAddress .
+// @router /user/addresses/{address_id}/default [POST]
+func SetDefaultAddress(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.SetDefaultAddressReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 从路径参数获取收货地址ID
+	id, err := strconv.ParseInt(c.Param("address_id"), 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid address ID")
+		return
+	}
+	req.AddressId = id
+
+	// 调用服务层设置默认收货地址
+	resp, err := service.NewSetDefaultAddressService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// SendVerificationEmail .
+// @router /email/verification [POST]
+func SendVerificationEmail(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.SendVerificationEmailReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用服务层发送邮箱验证邮件
+	resp, err := service.NewSendVerificationEmailService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// VerifyEmail .
+// @router /email/verify [POST]
+func VerifyEmail(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.VerifyEmailReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用服务层验证邮箱
+	resp, err := service.NewVerifyEmailService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RequestPasswordReset .
+// @router /password/forgot [POST]
+func RequestPasswordReset(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.RequestPasswordResetReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用服务层发送重置密码邮件
+	resp, err := service.NewRequestPasswordResetService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ResetPassword .
+// @router /password/reset [POST]
+func ResetPassword(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.ResetPasswordReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用服务层重置密码
+	resp, err := service.NewResetPasswordService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetMFAStatus .
+// @router /user/mfa [GET]
+func GetMFAStatus(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.GetMFAStatusReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用服务层查询二次验证状态
+	resp, err := service.NewGetMFAStatusService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// EnrollMFA .
+// @router /user/mfa/enroll [POST]
+func EnrollMFA(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.EnrollMFAReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用服务层生成待绑定的密钥
+	resp, err := service.NewEnrollMFAService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ActivateMFA .
+// @router /user/mfa/activate [POST]
+func ActivateMFA(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.ActivateMFAReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用服务层确认绑定
+	resp, err := service.NewActivateMFAService(ctx).Run(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// DisableMFA .
+// @router /user/mfa/disable [POST]
+func DisableMFA(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.DisableMFAReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx = bizutils.WithClientInfo(ctx, c.ClientIP(), string(c.UserAgent()))
+	resp, err := service.NewDisableMFAService(ctx).Run(&req)
+	if err != nil {
+		sendMFAError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RegenerateRecoveryCodes .
+// @router /user/mfa/recovery_codes [POST]
+func RegenerateRecoveryCodes(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.RegenerateRecoveryCodesReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx = bizutils.WithClientInfo(ctx, c.ClientIP(), string(c.UserAgent()))
+	resp, err := service.NewRegenerateRecoveryCodesService(ctx).Run(&req)
+	if err != nil {
+		sendMFAError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// sendLoginFailed 登录失败返回 401，连续失败被限流时返回 429 并通过 Retry-After 告知等待时间
+func sendLoginFailed(c *app.RequestContext, err error) {
+	var throttled *service.LoginThrottledError
+	if errors.As(err, &throttled) {
+		c.Header("Retry-After", strconv.FormatInt(throttled.RetryAfterSeconds(), 10))
+		c.JSON(consts.StatusTooManyRequests, utils.H{
+			"code":    consts.StatusTooManyRequests,
+			"message": fmt.Sprintf("Login failed: %v", err),
+		})
+		return
+	}
+	c.JSON(consts.StatusUnauthorized, utils.H{
+		"code":    consts.StatusUnauthorized,
+		"message": fmt.Sprintf("Login failed: %v", err),
+	})
+}
+
+// sendMFAError 校验验证码失败返回 400，被限流时返回 429
+func sendMFAError(c *app.RequestContext, err error) {
+	var throttled *service.LoginThrottledError
+	if errors.As(err, &throttled) {
+		c.Header("Retry-After", strconv.FormatInt(throttled.RetryAfterSeconds(), 10))
+		c.String(consts.StatusTooManyRequests, err.Error())
+		return
+	}
+	c.String(consts.StatusBadRequest, err.Error())
+}
+
+// ListPolicies .
+// @router /admin/policies [GET]
+func ListPolicies(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.ListPoliciesReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+	// 可选的 subject 查询参数，只返回该用户或角色的策略
+	req.Subject = c.Query("subject")
+
+	// 调用服务层查询权限策略
+	resp, err := service.NewListPoliciesService(ctx).Run(&req)
+	if err != nil {
+		sendAdminError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// AddPolicy .
+// @router /admin/policies [POST]
+func AddPolicy(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.AddPolicyReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用服务层添加权限策略
+	resp, err := service.NewAddPolicyService(ctx).Run(&req)
+	if err != nil {
+		sendAdminError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RemovePolicy .
+// @router /admin/policies [DELETE]
+func RemovePolicy(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.RemovePolicyReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用服务层删除权限策略
+	resp, err := service.NewRemovePolicyService(ctx).Run(&req)
+	if err != nil {
+		sendAdminError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ListRoles .
+// @router /admin/roles [GET]
+func ListRoles(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.ListRolesReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+	// 可选的 subject 查询参数，只返回该用户或角色拥有的角色
+	req.Subject = c.Query("subject")
+
+	// 调用服务层查询角色授予关系
+	resp, err := service.NewListRolesService(ctx).Run(&req)
+	if err != nil {
+		sendAdminError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// AssignRole .
+// @router /admin/roles [POST]
+func AssignRole(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.AssignRoleReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用服务层授予角色
+	resp, err := service.NewAssignRoleService(ctx).Run(&req)
+	if err != nil {
+		sendAdminError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RevokeRole .
+// @router /admin/roles [DELETE]
+func RevokeRole(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.RevokeRoleReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用服务层撤销角色
+	resp, err := service.NewRevokeRoleService(ctx).Run(&req)
+	if err != nil {
+		sendAdminError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ListBlacklist .
+// @router /admin/blacklist [GET]
+func ListBlacklist(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.ListBlacklistReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用服务层查询黑名单
+	resp, err := service.NewListBlacklistService(ctx).Run(&req)
+	if err != nil {
+		sendAdminError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// AddToBlacklist .
+// @router /admin/blacklist [POST]
+func AddToBlacklist(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.AddToBlacklistReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 调用服务层将用户加入黑名单
+	resp, err := service.NewAddToBlacklistService(ctx).Run(&req)
+	if err != nil {
+		sendAdminError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// RemoveFromBlacklist .
+// @router /admin/blacklist/:user_id [DELETE]
+func RemoveFromBlacklist(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.RemoveFromBlacklistReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+	// 从路径参数获取用户ID
+	id, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
+	if err != nil {
+		c.String(consts.StatusBadRequest, "Invalid user ID")
+		return
+	}
+	req.UserId = id
+
+	// 调用服务层将用户移出黑名单
+	resp, err := service.NewRemoveFromBlacklistService(ctx).Run(&req)
+	if err != nil {
+		sendAdminError(c, err)
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// sendAdminError 非管理员返回 403，参数错误返回 400，其余返回 500
+func sendAdminError(c *app.RequestContext, err error) {
+	switch {
+	case errors.Is(err, service.ErrAdminRequired):
+		c.String(consts.StatusForbidden, err.Error())
+	case errors.Is(err, service.ErrInvalidPolicy), errors.Is(err, service.ErrInvalidRoleBinding),
+		errors.Is(err, service.ErrAdminSelf):
+		c.String(consts.StatusBadRequest, err.Error())
+	default:
+		c.String(consts.StatusInternalServerError, err.Error())
+	}
+}
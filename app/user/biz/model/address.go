@@ -0,0 +1,156 @@
+package model
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// MaxAddressesPerUser 每个用户最多保存的收货地址数量
+const MaxAddressesPerUser = 20
+
+// ErrTooManyAddresses 收货地址数量超过上限
+var ErrTooManyAddresses = errors.New("too many shipping addresses")
+
+// Address 用户的收货地址，每个用户至多一个默认地址
+type Address struct {
+	gorm.Model
+	UserID    int64  `gorm:"index;not null"`
+	Name      string `gorm:"type:varchar(64);not null"`
+	Phone     string `gorm:"type:varchar(32);not null"`
+	Province  string `gorm:"type:varchar(64)"`
+	City      string `gorm:"type:varchar(64)"`
+	District  string `gorm:"type:varchar(64)"`
+	Detail    string `gorm:"type:varchar(255);not null"`
+	ZipCode   string `gorm:"type:varchar(16)"`
+	IsDefault bool   `gorm:"not null;default:false"`
+}
+
+func (Address) TableName() string {
+	return "address"
+}
+
+// CreateAddress 创建收货地址，用户的第一个地址总是默认地址，设为默认时取消原默认地址
+func CreateAddress(db *gorm.DB, addr *Address) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		// 锁住用户行，避免并发创建时出现多个默认地址或超过数量上限
+		if err := lockUser(tx, addr.UserID); err != nil {
+			return err
+		}
+		var count int64
+		if err := tx.Model(&Address{}).Where("user_id = ?", addr.UserID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count >= MaxAddressesPerUser {
+			return ErrTooManyAddresses
+		}
+		if count == 0 {
+			addr.IsDefault = true
+		}
+		if addr.IsDefault {
+			if err := clearDefaultAddress(tx, addr.UserID); err != nil {
+				return err
+			}
+		}
+		return tx.Create(addr).Error
+	})
+}
+
+// GetAddress 查询用户的收货地址，不属于该用户的地址视为不存在
+func GetAddress(db *gorm.DB, userID int64, id uint) (*Address, error) {
+	var addr Address
+	err := db.Where("id = ? AND user_id = ?", id, userID).First(&addr).Error
+	return &addr, err
+}
+
+// GetDefaultAddress 查询用户的默认地址
+func GetDefaultAddress(db *gorm.DB, userID int64) (*Address, error) {
+	var addr Address
+	err := db.Where("user_id = ? AND is_default = ?", userID, true).First(&addr).Error
+	return &addr, err
+}
+
+// ListAddresses 查询用户的全部收货地址，默认地址在前
+func ListAddresses(db *gorm.DB, userID int64) ([]*Address, error) {
+	var addrs []*Address
+	err := db.Where("user_id = ?", userID).Order("is_default DESC, id DESC").Find(&addrs).Error
+	return addrs, err
+}
+
+// UpdateAddress 更新收货地址的内容，默认地址通过 SetDefaultAddress 修改
+func UpdateAddress(db *gorm.DB, addr *Address) error {
+	result := db.Model(&Address{}).Where("id = ? AND user_id = ?", addr.ID, addr.UserID).
+		Select("name", "phone", "province", "city", "district", "detail", "zip_code").
+		Updates(addr)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		_, err := GetAddress(db, addr.UserID, addr.ID)
+		return err
+	}
+	return nil
+}
+
+// DeleteAddress 删除收货地址，删除的是默认地址时将最近创建的地址设为默认
+func DeleteAddress(db *gorm.DB, userID int64, id uint) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := lockUser(tx, userID); err != nil {
+			return err
+		}
+		addr, err := GetAddress(tx, userID, id)
+		if err != nil {
+			return err
+		}
+		if err = tx.Delete(addr).Error; err != nil {
+			return err
+		}
+		if !addr.IsDefault {
+			return nil
+		}
+		var next Address
+		err = tx.Where("user_id = ?", userID).Order("id DESC").First(&next).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return tx.Model(&next).UpdateColumn("is_default", true).Error
+	})
+}
+
+// SetDefaultAddress 将收货地址设为默认，同时取消原默认地址
+func SetDefaultAddress(db *gorm.DB, userID int64, id uint) (*Address, error) {
+	var addr *Address
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := lockUser(tx, userID); err != nil {
+			return err
+		}
+		var err error
+		if addr, err = GetAddress(tx, userID, id); err != nil {
+			return err
+		}
+		if addr.IsDefault {
+			return nil
+		}
+		if err = clearDefaultAddress(tx, userID); err != nil {
+			return err
+		}
+		addr.IsDefault = true
+		return tx.Model(addr).UpdateColumn("is_default", true).Error
+	})
+	return addr, err
+}
+
+func clearDefaultAddress(tx *gorm.DB, userID int64) error {
+	return tx.Model(&Address{}).Where("user_id = ? AND is_default = ?", userID, true).
+		UpdateColumn("is_default", false).Error
+}
+
+// lockUser 在事务中锁住用户行，串行化同一用户的地址变更
+func lockUser(tx *gorm.DB, userID int64) error {
+	var user User
+	return tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").Where("id = ?", userID).First(&user).Error
+}
@@ -0,0 +1,18 @@
+package model
+
+// CasbinRule Casbin 策略规则，表结构与 casbin/gorm-adapter 一致，
+// ptype 为 p 时 v0-v3 依次为 sub、obj、act、eft，为 g 时 v0 继承 v1 的角色
+type CasbinRule struct {
+	ID    uint   `gorm:"primaryKey;autoIncrement"`
+	Ptype string `gorm:"size:100;uniqueIndex:unique_index"`
+	V0    string `gorm:"size:100;uniqueIndex:unique_index"`
+	V1    string `gorm:"size:100;uniqueIndex:unique_index"`
+	V2    string `gorm:"size:100;uniqueIndex:unique_index"`
+	V3    string `gorm:"size:100;uniqueIndex:unique_index"`
+	V4    string `gorm:"size:100;uniqueIndex:unique_index"`
+	V5    string `gorm:"size:100;uniqueIndex:unique_index"`
+}
+
+func (CasbinRule) TableName() string {
+	return "casbin_rule"
+}
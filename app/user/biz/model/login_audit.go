@@ -0,0 +1,39 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 登录审计的结果
+const (
+	LoginResultSuccess         = "success"
+	LoginResultUnknownEmail    = "unknown_email"
+	LoginResultWrongPassword   = "wrong_password"
+	LoginResultLocked          = "locked"
+	LoginResultThrottled       = "throttled"
+	LoginResultEmailUnverified = "email_unverified"
+	LoginResultMFARequired     = "mfa_required" // 密码正确，等待二次验证
+	LoginResultMFAFailed       = "mfa_failed"
+)
+
+// LoginAudit 登录审计记录，记录每次登录的结果、来源 IP 和 User-Agent
+type LoginAudit struct {
+	ID        uint      `gorm:"primaryKey"`
+	UserID    int64     `gorm:"index"` // 邮箱未注册时为 0
+	Email     string    `gorm:"type:varchar(255);index"`
+	IP        string    `gorm:"type:varchar(64);index"`
+	UserAgent string    `gorm:"type:varchar(512)"`
+	Success   bool      `gorm:"not null"`
+	Result    string    `gorm:"type:varchar(32);not null"`
+	CreatedAt time.Time `gorm:"index"`
+}
+
+func (LoginAudit) TableName() string {
+	return "login_audit"
+}
+
+func CreateLoginAudit(db *gorm.DB, audit *LoginAudit) error {
+	return db.Create(audit).Error
+}
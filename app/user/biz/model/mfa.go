@@ -0,0 +1,89 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// MFARecoveryCode 二次验证的恢复码，只保存摘要，每个恢复码只能使用一次
+type MFARecoveryCode struct {
+	ID        uint       `gorm:"primaryKey"`
+	UserID    int64      `gorm:"uniqueIndex:idx_user_code;not null"`
+	CodeHash  string     `gorm:"uniqueIndex:idx_user_code;type:char(64);not null"`
+	UsedAt    *time.Time // 为空表示未使用
+	CreatedAt time.Time
+}
+
+func (MFARecoveryCode) TableName() string {
+	return "mfa_recovery_code"
+}
+
+// EnableMFA 保存 TOTP 密钥并开启二次验证，同时替换用户的恢复码；已开启时返回 gorm.ErrRecordNotFound
+func EnableMFA(db *gorm.DB, userID int64, secret string, codeHashes []string) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&User{}).Where("id = ? AND mfa_enabled = ?", userID, false).
+			UpdateColumns(map[string]interface{}{"mfa_enabled": true, "mfa_secret": secret})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return replaceRecoveryCodes(tx, userID, codeHashes)
+	})
+}
+
+// DisableMFA 关闭二次验证，清除密钥和恢复码
+func DisableMFA(db *gorm.DB, userID int64) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&User{}).Where("id = ?", userID).
+			UpdateColumns(map[string]interface{}{"mfa_enabled": false, "mfa_secret": ""})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.Where("user_id = ?", userID).Delete(&MFARecoveryCode{}).Error
+	})
+}
+
+// ReplaceRecoveryCodes 重新生成恢复码，此前的恢复码全部作废
+func ReplaceRecoveryCodes(db *gorm.DB, userID int64, codeHashes []string) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		return replaceRecoveryCodes(tx, userID, codeHashes)
+	})
+}
+
+func replaceRecoveryCodes(tx *gorm.DB, userID int64, codeHashes []string) error {
+	if err := tx.Where("user_id = ?", userID).Delete(&MFARecoveryCode{}).Error; err != nil {
+		return err
+	}
+	if len(codeHashes) == 0 {
+		return nil
+	}
+	codes := make([]MFARecoveryCode, 0, len(codeHashes))
+	for _, hash := range codeHashes {
+		codes = append(codes, MFARecoveryCode{UserID: userID, CodeHash: hash})
+	}
+	return tx.Create(&codes).Error
+}
+
+// UseRecoveryCode 将未使用的恢复码标记为已使用，恢复码不存在或已使用时返回 false
+func UseRecoveryCode(db *gorm.DB, userID int64, codeHash string) (bool, error) {
+	result := db.Model(&MFARecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		UpdateColumn("used_at", time.Now())
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// CountRecoveryCodes 返回用户剩余可用的恢复码个数
+func CountRecoveryCodes(db *gorm.DB, userID int64) (int64, error) {
+	var count int64
+	err := db.Model(&MFARecoveryCode{}).Where("user_id = ? AND used_at IS NULL", userID).Count(&count).Error
+	return count, err
+}
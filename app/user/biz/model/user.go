@@ -11,6 +11,13 @@ type User struct {
 	Email             string    `gorm:"uniqueIndex;type:varchar(255);not null"`
 	PasswordHashed    string    `gorm:"type:varchar(255);null"`
 	PasswordChangedAt time.Time `gorm:"type:timestamp;not null"`
+	TokenVersion      int64     `gorm:"not null;default:0"` // 令牌版本，递增后此前签发的所有令牌失效
+	Nickname          string    `gorm:"type:varchar(64)"`
+	AvatarURL         string    `gorm:"type:varchar(512)"`
+	Phone             string    `gorm:"type:varchar(32)"`
+	EmailVerified     bool      `gorm:"not null;default:false"`
+	MFAEnabled        bool      `gorm:"not null;default:false"` // 开启后登录需要二次验证
+	MFASecret         string    `gorm:"type:varchar(64)"`       // TOTP 密钥，base32 编码
 }
 
 func (User) TableName() string {
@@ -25,4 +32,76 @@ func GetbyEmail(db *gorm.DB, email string) (*User, error) {
 	var user User
 	err := db.Where("email = ?", email).First(&user).Error
 	return &user, err
-}
\ No newline at end of file
+}
+
+func GetByID(db *gorm.DB, id int64) (*User, error) {
+	var user User
+	err := db.Where("id = ?", id).First(&user).Error
+	return &user, err
+}
+
+// UpdateProfile 更新用户资料，只更新 fields 中的列
+func UpdateProfile(db *gorm.DB, id int64, fields map[string]interface{}) error {
+	result := db.Model(&User{}).Where("id = ?", id).Updates(fields)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		// 值未变化时 RowsAffected 也为 0，确认用户确实存在
+		_, err := GetByID(db, id)
+		return err
+	}
+	return nil
+}
+
+// MarkEmailVerified 将用户的邮箱标记为已验证
+func MarkEmailVerified(db *gorm.DB, id int64) error {
+	result := db.Model(&User{}).Where("id = ?", id).UpdateColumn("email_verified", true)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		_, err := GetByID(db, id)
+		return err
+	}
+	return nil
+}
+
+// ResetPassword 更新密码和密码修改时间并递增令牌版本，返回新的令牌版本。
+// 重置密码的链接只发到注册邮箱，能完成重置即证明邮箱属于该用户，同时标记邮箱已验证
+func ResetPassword(db *gorm.DB, id int64, passwordHashed string) (int64, error) {
+	var version int64
+	err := db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&User{}).Where("id = ?", id).UpdateColumns(map[string]interface{}{
+			"password_hashed":     passwordHashed,
+			"password_changed_at": time.Now(),
+			"token_version":       gorm.Expr("token_version + 1"),
+			"email_verified":      true,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.Model(&User{}).Where("id = ?", id).Pluck("token_version", &version).Error
+	})
+	return version, err
+}
+
+// BumpTokenVersion 递增用户的令牌版本并返回新版本
+func BumpTokenVersion(db *gorm.DB, id int64) (int64, error) {
+	var version int64
+	err := db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&User{}).Where("id = ?", id).
+			UpdateColumn("token_version", gorm.Expr("token_version + 1"))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.Model(&User{}).Where("id = ?", id).Pluck("token_version", &version).Error
+	})
+	return version, err
+}
@@ -4,33 +4,104 @@ import (
 	"context"
 	"log"
 	"strconv"
+	"time"
 
 	"github.com/casbin/casbin/v2"
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/common/utils"
+	goredis "github.com/redis/go-redis/v9"
+	"zqzqsb.com/gomall/app/user/biz/dal/mysql"
+	"zqzqsb.com/gomall/app/user/biz/dal/redis"
 	"zqzqsb.com/gomall/app/user/biz/service"
+	"zqzqsb.com/gomall/app/user/conf"
+	"zqzqsb.com/gomall/app/user/infra/rbac"
+	"zqzqsb.com/gomall/common/mtl"
 )
 
 var permissionSvc *service.PermissionService
 
+const (
+	defaultModelFile  = "conf/rbac_model.conf"
+	defaultPolicyFile = "conf/rbac_policy.csv"
+)
+
+// InitCasbin 从 MySQL 加载权限策略，多个实例共享同一份策略。
+// 数据库中还没有策略时先导入策略文件中的初始策略，并为配置中的用户授予 admin 角色
 func InitCasbin() (*casbin.Enforcer, error) {
-	enforcer, err := casbin.NewEnforcer("conf/rbac_model.conf", "conf/rbac_policy.csv")
+	cfg := conf.GetConf().Casbin
+	if cfg.ModelFile == "" {
+		cfg.ModelFile = defaultModelFile
+	}
+	if cfg.PolicyFile == "" {
+		cfg.PolicyFile = defaultPolicyFile
+	}
+	adapter := rbac.NewAdapter(mysql.DB)
+	imported, err := adapter.ImportFileIfEmpty(cfg.ModelFile, cfg.PolicyFile)
 	if err != nil {
 		return nil, err
 	}
+	if imported {
+		log.Printf("imported initial casbin policy from %s", cfg.PolicyFile)
+	}
 
-	// 加载策略
-	err = enforcer.LoadPolicy()
+	// 创建执行器时会从数据库加载策略，之后的增删由适配器自动写回数据库
+	enforcer, err := casbin.NewEnforcer(cfg.ModelFile, adapter)
 	if err != nil {
 		return nil, err
 	}
 
 	// 初始化权限服务
 	permissionSvc = service.NewPermissionService(enforcer)
+	if mtl.Register != nil {
+		if err := rbac.RegisterMetrics(mtl.Register); err != nil {
+			return nil, err
+		}
+	}
+	rbac.ObserveSync("reload", nil)
+
+	// 本实例的策略变更通过 Redis 通知其他实例，收到其他实例的通知时增量更新本地策略
+	watcher, err := rbac.NewWatcher(context.Background(), policyRedisClient(), cfg.Channel)
+	if err != nil {
+		return nil, err
+	}
+	if err := enforcer.SetWatcher(watcher); err != nil {
+		return nil, err
+	}
+	if err := watcher.SetUpdateCallback(permissionSvc.SyncPolicy); err != nil {
+		return nil, err
+	}
+	if cfg.SyncInterval > 0 {
+		go reloadPolicyPeriodically(cfg.SyncInterval)
+	}
+
+	for _, id := range cfg.Admins {
+		if _, err := permissionSvc.AddRoleForUser(strconv.FormatInt(id, 10), service.RoleAdmin); err != nil {
+			return nil, err
+		}
+	}
 
 	return enforcer, nil
 }
 
+// policyRedisClient 启用 Redis Cluster 时通过集群发布订阅，否则使用单节点
+func policyRedisClient() goredis.UniversalClient {
+	if conf.GetConf().RedisCluster.Enabled && redis.ClusterClient != nil {
+		return redis.ClusterClient
+	}
+	return redis.RedisClient
+}
+
+// reloadPolicyPeriodically 定期从数据库全量加载策略，兜底 Redis 连接中断期间丢失的变更通知
+func reloadPolicyPeriodically(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if err := permissionSvc.ReloadPolicy(); err != nil {
+			log.Printf("reload casbin policy failed: %v", err)
+		}
+	}
+}
+
 func NewCasbinMiddleware(enforcer *casbin.Enforcer) app.HandlerFunc {
 	return func(ctx context.Context, c *app.RequestContext) {
 		// 从请求中获取用户信息
@@ -56,7 +127,7 @@ func NewCasbinMiddleware(enforcer *casbin.Enforcer) app.HandlerFunc {
 		}
 
 		// 检查权限
-		ok, err := enforcer.Enforce(user, path, method)
+		ok, err := permissionSvc.Enforce(user, path, method)
 		log.Printf("Casbin result - ok: %v, err: %v", ok, err)
 
 		if err != nil {
@@ -3,6 +3,7 @@ package mw
 import (
 	"context"
 	"log"
+	"strings"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/app/server"
@@ -21,6 +22,10 @@ func InitCSRF(h *server.Hertz) {
 		// 	}
 		// 	return false
 		// }),
+		// OAuth2 端点由客户端凭据或授权码保护，第三方客户端无法携带 csrf token
+		csrf.WithNext(func(c context.Context, ctx *app.RequestContext) bool {
+			return strings.HasPrefix(string(ctx.Request.URI().Path()), "/oauth2/")
+		}),
 		csrf.WithErrorFunc(func(c context.Context, ctx *app.RequestContext) {
 			ctx.String(400, ctx.Errors.Last().Error())
 			ctx.Abort()
@@ -2,21 +2,19 @@ package mw // 定义包名为 mw，通常用于存放中间件相关的代码
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"log"
 	"net/http"
-	"time"
 
 	// 导入项目内部的包
 	// 数据库访问层，用于检查用户信息
 	"github.com/cloudwego/hertz/pkg/app"          // Hertz 框架的 app 包，处理请求上下文
 	"github.com/cloudwego/hertz/pkg/common/hlog"  // Hertz 的日志包
 	"github.com/cloudwego/hertz/pkg/common/utils" // Hertz 的工具包，包含辅助函数
-	"github.com/cloudwego/hertz/pkg/protocol"
-	"github.com/cloudwego/hertz/pkg/protocol/consts"
-	"github.com/hertz-contrib/jwt" // Hertz 的 JWT 中间件包
+	"github.com/hertz-contrib/jwt"                // Hertz 的 JWT 中间件包
 	"zqzqsb.com/gomall/app/user/biz/service"
-	"zqzqsb.com/gomall/app/user/kitex_gen/user"
+	"zqzqsb.com/gomall/app/user/conf"
+	"zqzqsb.com/gomall/common/identity"
 )
 
 // 全局变量，用于存储初始化后的 JWT 中间件实例
@@ -25,60 +23,25 @@ var (
 	IdentityKey   = "identity"            // 用于在 JWT 载荷中存储用户身份的键
 )
 
+// Authorizator 拒绝令牌的原因在请求上下文中的键
+const revokedReasonKey = "jwt_reject_reason"
+
 // InitJwt 初始化 JWT 中间件
 func InitJwt() {
 	var err error
 	// 创建新的 JWT 中间件实例并配置相关参数
 	JwtMiddleware, err = jwt.New(&jwt.HertzJWTMiddleware{
-		Realm:      "test zone",          // 认证领域，用于在 WWW-Authenticate 头中返回
-		Key:        []byte("secret key"), // 用于签名 JWT 的密钥，请确保使用足够复杂且安全的密钥
-		Timeout:    time.Hour,            // JWT 的有效期，此处设置为 1 小时
-		MaxRefresh: time.Hour,            // 允许刷新 JWT 的最大时间，此处设置为 1 小时
+		Realm: "test zone", // 认证领域，用于在 WWW-Authenticate 头中返回
+		// 签名密钥与其他服务保持一致，令牌由 service.IssueTokenPair 在登录和刷新时签发
+		Key:     []byte(conf.GetConf().Jwt.Secret),
+		Timeout: conf.GetConf().Jwt.AccessTTL,
 		// 表示在解析请求时，会尝试从以下几处获取 Token：
 		// HTTP Header 中的 Authorization 字段
-		// URL 查询参数 ?token=xxx
 		// Cookie 名为 jwt
 		// （按照这个顺序依次查找）
-		TokenLookup:   "cookie: jwt", // 定义从哪里查找 JWT
-		TokenHeadName: "Bearer",      // JWT 在请求头中的前缀
-		// 自定义登录成功后的响应格式
-		LoginResponse: func(ctx context.Context, c *app.RequestContext, code int, token string, expire time.Time) {
-			// 直接set一个http only cookie 给客户端
-			c.SetCookie(
-				"jwt", // Cookie名称
-				token, // Cookie值
-				3600,  // 过期时间(秒)
-				"/",   // 路径
-				"",    // 域名(留空表示当前域)
-				protocol.CookieSameSiteDefaultMode,
-				true, // Secure
-				true, // HttpOnly
-			)
-			c.JSON(http.StatusOK, utils.H{
-				"code":    code,                        // 状态码
-				"token":   token,                       // 生成的 JWT Token
-				"expire":  expire.Format(time.RFC3339), // 过期时间
-				"message": "success",
-			})
-		},
-		// 认证函数，用于验证用户登录信息
-		Authenticator: func(ctx context.Context, c *app.RequestContext) (interface{}, error) {
-			var err error
-			var req user.LoginReq
-			err = c.BindAndValidate(&req)
-			if err != nil {
-				c.String(consts.StatusBadRequest, err.Error())
-				return nil, err
-			}
-			LoginService := service.NewLoginService(ctx)
-			resp, err := LoginService.Run(&req)
-			if err != nil {
-				c.String(consts.StatusInternalServerError, fmt.Sprintf("Login failed: %v", err))
-				return nil, err
-			}
-			return resp.UserId, nil
-		},
-		IdentityKey: IdentityKey, // 设置用于标识用户身份的键
+		TokenLookup:   "header: Authorization, cookie: jwt", // 定义从哪里查找 JWT
+		TokenHeadName: "Bearer",                             // JWT 在请求头中的前缀
+		IdentityKey:   IdentityKey,                          // 设置用于标识用户身份的键
 		// 从 JWT 载荷中提取用户身份信息 当挂载中间键时 会自动提取cookie中的jwt到ctx中
 		IdentityHandler: func(ctx context.Context, c *app.RequestContext) interface{} {
 			claims := jwt.ExtractClaims(ctx, c)
@@ -101,23 +64,33 @@ func InitJwt() {
 			log.Printf("identity in token: %v", f64)
 			return int64(f64)
 		},
-		// 将用户数据转换为 JWT 载荷中的声明
-		PayloadFunc: func(data interface{}) jwt.MapClaims {
-			switch v := data.(type) {
-			case uint:
-				return jwt.MapClaims{IdentityKey: v}
-			case int64:
-				return jwt.MapClaims{IdentityKey: v}
-			case int32:
-				// 这里把 int32 转成 int64 或直接存 float64
-				return jwt.MapClaims{IdentityKey: int64(v)}
-			default:
-				return jwt.MapClaims{}
+		// 签名和有效期校验通过后，再检查令牌类型、jti 黑名单和用户的令牌版本
+		Authorizator: func(data interface{}, ctx context.Context, c *app.RequestContext) bool {
+			claims, err := service.ClaimsFromMap(jwt.ExtractClaims(ctx, c))
+			if err == nil && claims.Type == service.TokenTypeMFA {
+				// 登录时只校验了密码，二次验证通过后才签发访问令牌
+				c.Set(revokedReasonKey, service.ErrMFARequired)
+				return false
+			}
+			if err != nil || claims.Type != service.TokenTypeAccess {
+				c.Set(revokedReasonKey, service.ErrInvalidToken)
+				return false
 			}
+			if err := service.CheckTokenActive(ctx, claims); err != nil {
+				hlog.CtxWarnf(ctx, "token of user %d rejected: %v", claims.UserID, err)
+				c.Set(revokedReasonKey, err)
+				return false
+			}
+			return true
 		},
-
 		// 自定义 HTTP 状态消息函数，用于记录错误日志并返回错误消息
 		HTTPStatusMessageFunc: func(e error, ctx context.Context, c *app.RequestContext) string {
+			if errors.Is(e, jwt.ErrForbidden) {
+				// Authorizator 拒绝时返回具体原因
+				if reason, ok := c.Get(revokedReasonKey); ok {
+					e = reason.(error)
+				}
+			}
 			hlog.CtxErrorf(ctx, "jwt biz err = %+v", e.Error()) // 记录错误日志
 			return e.Error()                                    // 返回错误消息
 		},
@@ -134,3 +107,19 @@ func InitJwt() {
 		panic(err)
 	}
 }
+
+// IdentityMiddleware 将 JWT 中的用户身份写入上下文，服务层统一通过上下文获取，与 RPC 调用保持一致
+func IdentityMiddleware() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		id, ok := c.Get(IdentityKey)
+		userID, _ := id.(int64)
+		if !ok || userID <= 0 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, utils.H{
+				"code":    http.StatusUnauthorized,
+				"message": "invalid identity in token",
+			})
+			return
+		}
+		c.Next(identity.WithUserID(ctx, userID))
+	}
+}
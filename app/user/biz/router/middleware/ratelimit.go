@@ -0,0 +1,46 @@
+package mw
+
+import (
+	"log"
+	"sync"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/app/server"
+	"github.com/redis/go-redis/v9"
+	"zqzqsb.com/gomall/app/user/conf"
+	"zqzqsb.com/gomall/common/ratelimit"
+)
+
+// InitRateLimit 为 HTTP 请求挂载与 RPC 相同规则的单机限流，按客户端 IP 区分调用方
+func InitRateLimit(h *server.Hertz) {
+	cfg := conf.GetConf().RateLimit.Server
+	if !cfg.Enabled() {
+		return
+	}
+	h.Use(ratelimit.NewHertzMiddleware(ratelimit.New(cfg), ratelimit.KeyByIdentityOrIP(IdentityKey)))
+	log.Println("init rate limit success")
+}
+
+var (
+	authRateLimit     app.HandlerFunc
+	authRateLimitOnce sync.Once
+)
+
+// AuthRateLimit /login、/register 共用的分布式令牌桶，多个实例共享配额，
+// Hertz 在 dal 初始化之前创建，因此与 session 一样单独创建 Redis 客户端
+func AuthRateLimit() app.HandlerFunc {
+	authRateLimitOnce.Do(initAuthRateLimit)
+	return authRateLimit
+}
+
+func initAuthRateLimit() {
+	config := conf.GetConf()
+	client := redis.NewClient(&redis.Options{
+		Addr:     config.Redis.Address,
+		Username: config.Redis.Username,
+		Password: config.Redis.Password,
+		DB:       config.Redis.DB,
+	})
+	bucket := ratelimit.NewRedisTokenBucket(client, "ratelimit:auth", config.RateLimit.Auth)
+	authRateLimit = ratelimit.NewRedisHertzMiddleware(bucket, ratelimit.KeyByIdentityOrIP(IdentityKey))
+}
@@ -0,0 +1,47 @@
+// Code generated by hertz generator.
+
+package oauth
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func rootMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _openidconfigurationMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _oauth2Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _authorizeMw() []app.HandlerFunc {
+	// 授权端点自行识别登录用户，未登录时跳转到登录页，不挂载 JWT 中间件
+	return nil
+}
+
+func _introspectMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _jwksMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _tokenMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _userinfoMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
@@ -4,11 +4,14 @@ package router
 
 import (
 	"github.com/cloudwego/hertz/pkg/app/server"
+	oauth "zqzqsb.com/gomall/app/user/biz/router/oauth"
 	user "zqzqsb.com/gomall/app/user/biz/router/user"
 )
 
 // GeneratedRegister registers routers generated by IDL.
 func GeneratedRegister(r *server.Hertz) {
 	//INSERT_POINT: DO NOT DELETE THIS LINE!
+	oauth.Register(r)
+
 	user.Register(r)
 }
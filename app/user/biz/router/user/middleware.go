@@ -18,13 +18,197 @@ func _helloMw() []app.HandlerFunc {
 }
 
 func _loginMw() []app.HandlerFunc {
-	// 返回 JWT 中间件的 LoginHandler 作为处理函数
-	return []app.HandlerFunc{
-		mw.JwtMiddleware.LoginHandler, // 处理登录请求并生成 JWT Token
-	}
+	// 令牌由 user.Login 调用登录服务后签发，这里只做防暴力破解的限流
+	return []app.HandlerFunc{mw.AuthRateLimit()}
+}
+
+func _refreshtokenMw() []app.HandlerFunc {
+	// 访问令牌可能已过期，刷新接口只校验刷新令牌
+	return nil
+}
+
+func _logoutMw() []app.HandlerFunc {
+	// your code...
+	return nil
 }
 
 func _registerMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.AuthRateLimit()}
+}
+
+func _userMw() []app.HandlerFunc {
+	// 服务层从上下文获取用户身份，与 RPC 调用保持一致
+	return []app.HandlerFunc{mw.IdentityMiddleware()}
+}
+
+func _getprofileMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updateprofileMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listaddressesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createaddressMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _addressesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getaddressMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updateaddressMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deleteaddressMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _address_idMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _setdefaultaddressMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _emailMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _sendverificationemailMw() []app.HandlerFunc {
+	// 每次请求都会发出邮件，与登录共用限流，防止被用来轰炸邮箱
+	return []app.HandlerFunc{mw.AuthRateLimit()}
+}
+
+func _verifyemailMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.AuthRateLimit()}
+}
+
+func _passwordMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _requestpasswordresetMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.AuthRateLimit()}
+}
+
+func _resetpasswordMw() []app.HandlerFunc {
+	return []app.HandlerFunc{mw.AuthRateLimit()}
+}
+
+func _login0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _verifymfaMw() []app.HandlerFunc {
+	// 与登录共用限流，验证码的失败次数由服务层按账号和来源 IP 统计
+	return []app.HandlerFunc{mw.AuthRateLimit()}
+}
+
+func _getmfastatusMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _mfaMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _enrollmfaMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _activatemfaMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _disablemfaMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _regeneraterecoverycodesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _adminMw() []app.HandlerFunc {
+	// Casbin 中间件已校验 admin 角色，服务层通过上下文获取当前用户并再次校验
+	return []app.HandlerFunc{mw.IdentityMiddleware()}
+}
+
+func _listpoliciesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _addpolicyMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _removepolicyMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listrolesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _assignroleMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _revokeroleMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listblacklistMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _addtoblacklistMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _blacklistMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _removefromblacklistMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
@@ -18,10 +18,21 @@ import (
 func Register(r *server.Hertz) {
     publicGroup := r.Group("/", rootMw()...)
     {
-        publicGroup.POST("/login", _loginMw()...)
+        publicGroup.POST("/login", append(_loginMw(), user.Login)...)
+        _login := publicGroup.Group("/login", _login0Mw()...)
+        _login.POST("/mfa", append(_verifymfaMw(), user.VerifyMFA)...)
+        publicGroup.POST("/refresh", append(_refreshtokenMw(), user.RefreshToken)...)
         publicGroup.POST("/register", append(_registerMw(), user.Register)...)
 
         publicGroup.GET("/hello", append(_helloMw(), user.Hello)...)
+
+		// 邮箱验证和找回密码
+		_email := publicGroup.Group("/email", _emailMw()...)
+		_email.POST("/verification", append(_sendverificationemailMw(), user.SendVerificationEmail)...)
+		_email.POST("/verify", append(_verifyemailMw(), user.VerifyEmail)...)
+		_password := publicGroup.Group("/password", _passwordMw()...)
+		_password.POST("/forgot", append(_requestpasswordresetMw(), user.RequestPasswordReset)...)
+		_password.POST("/reset", append(_resetpasswordMw(), user.ResetPassword)...)
 		
     }
 
@@ -37,6 +48,41 @@ func Register(r *server.Hertz) {
 			panic(err)
 		}
 		privateGroup.Use(mw.NewCasbinMiddleware(enforce))
+        privateGroup.POST("/logout", append(_logoutMw(), user.Logout)...)
+
+		// 用户资料和收货地址
+		_user := privateGroup.Group("/user", _userMw()...)
+		_user.GET("/profile", append(_getprofileMw(), user.GetProfile)...)
+		_user.PUT("/profile", append(_updateprofileMw(), user.UpdateProfile)...)
+		_user.GET("/addresses", append(_listaddressesMw(), user.ListAddresses)...)
+		_user.POST("/addresses", append(_createaddressMw(), user.CreateAddress)...)
+		_addresses := _user.Group("/addresses", _addressesMw()...)
+		_addresses.GET("/:address_id", append(_getaddressMw(), user.GetAddress)...)
+		_addresses.PUT("/:address_id", append(_updateaddressMw(), user.UpdateAddress)...)
+		_addresses.DELETE("/:address_id", append(_deleteaddressMw(), user.DeleteAddress)...)
+		_address_id := _addresses.Group("/:address_id", _address_idMw()...)
+		_address_id.POST("/default", append(_setdefaultaddressMw(), user.SetDefaultAddress)...)
+
+		// 二次验证
+		_user.GET("/mfa", append(_getmfastatusMw(), user.GetMFAStatus)...)
+		_mfa := _user.Group("/mfa", _mfaMw()...)
+		_mfa.POST("/enroll", append(_enrollmfaMw(), user.EnrollMFA)...)
+		_mfa.POST("/activate", append(_activatemfaMw(), user.ActivateMFA)...)
+		_mfa.POST("/disable", append(_disablemfaMw(), user.DisableMFA)...)
+		_mfa.POST("/recovery_codes", append(_regeneraterecoverycodesMw(), user.RegenerateRecoveryCodes)...)
+
+		// 权限管理
+		_admin := privateGroup.Group("/admin", _adminMw()...)
+		_admin.GET("/policies", append(_listpoliciesMw(), user.ListPolicies)...)
+		_admin.POST("/policies", append(_addpolicyMw(), user.AddPolicy)...)
+		_admin.DELETE("/policies", append(_removepolicyMw(), user.RemovePolicy)...)
+		_admin.GET("/roles", append(_listrolesMw(), user.ListRoles)...)
+		_admin.POST("/roles", append(_assignroleMw(), user.AssignRole)...)
+		_admin.DELETE("/roles", append(_revokeroleMw(), user.RevokeRole)...)
+		_admin.GET("/blacklist", append(_listblacklistMw(), user.ListBlacklist)...)
+		_admin.POST("/blacklist", append(_addtoblacklistMw(), user.AddToBlacklist)...)
+		_blacklist := _admin.Group("/blacklist", _blacklistMw()...)
+		_blacklist.DELETE("/:user_id", append(_removefromblacklistMw(), user.RemoveFromBlacklist)...)
         // ... 其他需要鉴权的路由
     }
 }
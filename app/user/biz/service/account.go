@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"zqzqsb.com/gomall/app/user/biz/dal/redis"
+	"zqzqsb.com/gomall/app/user/biz/model"
+	"zqzqsb.com/gomall/app/user/conf"
+	"zqzqsb.com/gomall/app/user/infra/mail"
+)
+
+const (
+	// 一次性令牌的用途，不同用途的令牌不能混用
+	accountTokenVerifyEmail   = "verify_email"
+	accountTokenResetPassword = "reset_password"
+
+	defaultVerifyTokenTTL = 24 * time.Hour
+	defaultResetTokenTTL  = 30 * time.Minute
+)
+
+var (
+	ErrInvalidAccountToken = errors.New("链接无效或已过期")
+	ErrEmailNotVerified    = errors.New("邮箱尚未验证，请先完成邮箱验证")
+)
+
+func verifyTokenTTL() time.Duration {
+	if ttl := conf.GetConf().Account.VerifyTokenTTL; ttl > 0 {
+		return ttl
+	}
+	return defaultVerifyTokenTTL
+}
+
+func resetTokenTTL() time.Duration {
+	if ttl := conf.GetConf().Account.ResetTokenTTL; ttl > 0 {
+		return ttl
+	}
+	return defaultResetTokenTTL
+}
+
+// issueAccountToken 生成一次性令牌并保存到 Redis，令牌只通过邮件发给用户
+func issueAccountToken(ctx context.Context, purpose string, userID int64, ttl time.Duration) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(b)
+	if err := redis.SaveAccountToken(ctx, purpose, token, userID, ttl); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// accountLink 在页面地址上附加令牌参数
+func accountLink(base, token string) string {
+	u, err := url.Parse(base)
+	if err != nil {
+		return base + "?token=" + url.QueryEscape(token)
+	}
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// sendVerificationEmail 为用户签发邮箱验证令牌并发送验证邮件
+func sendVerificationEmail(ctx context.Context, row *model.User) error {
+	ttl := verifyTokenTTL()
+	token, err := issueAccountToken(ctx, accountTokenVerifyEmail, int64(row.ID), ttl)
+	if err != nil {
+		return err
+	}
+	return mail.Send(ctx, &mail.Message{
+		To:      row.Email,
+		Subject: "验证你的 GoMall 邮箱",
+		Body: fmt.Sprintf("你好，\n\n请在 %s 内打开以下链接完成邮箱验证：\n\n%s\n\n如果这不是你本人的操作，请忽略这封邮件。\n",
+			ttl, accountLink(conf.GetConf().Account.VerifyURL, token)),
+	})
+}
+
+// sendPasswordResetEmail 为用户签发重置密码令牌并发送重置密码邮件
+func sendPasswordResetEmail(ctx context.Context, row *model.User) error {
+	ttl := resetTokenTTL()
+	token, err := issueAccountToken(ctx, accountTokenResetPassword, int64(row.ID), ttl)
+	if err != nil {
+		return err
+	}
+	return mail.Send(ctx, &mail.Message{
+		To:      row.Email,
+		Subject: "重置你的 GoMall 密码",
+		Body: fmt.Sprintf("你好，\n\n请在 %s 内打开以下链接重置密码，链接只能使用一次：\n\n%s\n\n如果这不是你本人的操作，请忽略这封邮件，你的密码不会被修改。\n",
+			ttl, accountLink(conf.GetConf().Account.ResetURL, token)),
+	})
+}
@@ -0,0 +1,16 @@
+package service
+
+import "testing"
+
+func TestAccountLink(t *testing.T) {
+	cases := map[string]string{
+		"http://example.com/reset":      "http://example.com/reset?token=ab%2Bc",
+		"http://example.com/reset?a=1":  "http://example.com/reset?a=1&token=ab%2Bc",
+		"http://example.com/verify#top": "http://example.com/verify?token=ab%2Bc#top",
+	}
+	for base, want := range cases {
+		if got := accountLink(base, "ab+c"); got != want {
+			t.Fatalf("accountLink(%q) = %q, want %q", base, got, want)
+		}
+	}
+}
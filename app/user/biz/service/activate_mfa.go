@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	goredis "github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+	"zqzqsb.com/gomall/app/user/biz/dal/mysql"
+	"zqzqsb.com/gomall/app/user/biz/dal/redis"
+	"zqzqsb.com/gomall/app/user/biz/model"
+	"zqzqsb.com/gomall/app/user/infra/totp"
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+)
+
+type ActivateMFAService struct {
+	ctx context.Context
+} // NewActivateMFAService new ActivateMFAService
+func NewActivateMFAService(ctx context.Context) *ActivateMFAService {
+	return &ActivateMFAService{ctx: ctx}
+}
+
+// Run 用待绑定密钥生成的验证码确认绑定，开启二次验证并返回一组恢复码，恢复码只在这里返回一次
+func (s *ActivateMFAService) Run(req *user.ActivateMFAReq) (resp *user.ActivateMFAResp, err error) {
+	if req.Code == "" {
+		return nil, ErrInvalidMFACode
+	}
+	row, err := currentMFAUser(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	if row.MFAEnabled {
+		return nil, ErrMFAAlreadyEnabled
+	}
+	userID := int64(row.ID)
+
+	secret, err := redis.GetMFAEnrollment(s.ctx, userID)
+	if errors.Is(err, goredis.Nil) {
+		return nil, ErrMFAEnrollExpired
+	}
+	if err != nil {
+		return nil, err
+	}
+	step, ok := totp.Validate(secret, req.Code, time.Now(), mfaSkew())
+	if !ok {
+		return nil, ErrInvalidMFACode
+	}
+
+	codes, hashes, err := generateRecoveryCodes(recoveryCodeCount())
+	if err != nil {
+		return nil, err
+	}
+	err = model.EnableMFA(mysql.DB, userID, secret, hashes)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrMFAAlreadyEnabled
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 绑定用的验证码不能再用于登录
+	ttl := time.Duration(2*mfaSkew()+1) * totp.Period * time.Second
+	if _, err := redis.UseTOTPStep(s.ctx, userID, step, ttl); err != nil {
+		hlog.CtxWarnf(s.ctx, "mark totp step of user %d failed: %v", userID, err)
+	}
+	if err := redis.DeleteMFAEnrollment(s.ctx, userID); err != nil {
+		hlog.CtxWarnf(s.ctx, "delete mfa enrollment of user %d failed: %v", userID, err)
+	}
+	return &user.ActivateMFAResp{RecoveryCodes: codes}, nil
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+)
+
+type AddPolicyService struct {
+	ctx context.Context
+} // NewAddPolicyService new AddPolicyService
+func NewAddPolicyService(ctx context.Context) *AddPolicyService {
+	return &AddPolicyService{ctx: ctx}
+}
+
+// Run 添加权限策略，策略已存在时 success 为 false
+func (s *AddPolicyService) Run(req *user.AddPolicyReq) (resp *user.AddPolicyResp, err error) {
+	svc, _, err := requireAdmin(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = validatePolicy(req.Policy); err != nil {
+		return nil, err
+	}
+	p := req.Policy
+	added, err := svc.AddPolicy(p.Subject, p.Object, p.Action, p.Effect)
+	if err != nil {
+		return nil, err
+	}
+	return &user.AddPolicyResp{Success: added}, nil
+}
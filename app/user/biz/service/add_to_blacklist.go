@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+)
+
+type AddToBlacklistService struct {
+	ctx context.Context
+} // NewAddToBlacklistService new AddToBlacklistService
+func NewAddToBlacklistService(ctx context.Context) *AddToBlacklistService {
+	return &AddToBlacklistService{ctx: ctx}
+}
+
+// Run 将用户加入黑名单，被封禁的用户访问任何需要登录的接口都会被拒绝
+func (s *AddToBlacklistService) Run(req *user.AddToBlacklistReq) (resp *user.AddToBlacklistResp, err error) {
+	svc, userID, err := requireAdmin(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserId <= 0 {
+		return nil, ErrInvalidPolicy
+	}
+	if req.UserId == userID {
+		return nil, ErrAdminSelf
+	}
+	added, err := svc.AddToBlacklist(strconv.FormatInt(req.UserId, 10))
+	if err != nil {
+		return nil, err
+	}
+	return &user.AddToBlacklistResp{Success: added}, nil
+}
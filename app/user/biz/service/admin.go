@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strconv"
+
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+	"zqzqsb.com/gomall/common/identity"
+)
+
+// 策略字段的最大长度，与 casbin_rule 表的列宽一致
+const maxPolicyFieldLength = 100
+
+var (
+	ErrAdminRequired         = errors.New("需要管理员权限")
+	ErrPermissionUnavailable = errors.New("权限服务未初始化")
+	ErrInvalidPolicy         = errors.New("无效的权限策略")
+	ErrInvalidRoleBinding    = errors.New("无效的角色授予")
+	ErrAdminSelf             = errors.New("不能对自己执行该操作")
+)
+
+// 策略的 action 只能是 HTTP 方法，或者匹配全部方法的 .*
+var policyActions = map[string]bool{
+	"GET": true, "POST": true, "PUT": true, "PATCH": true, "DELETE": true, "HEAD": true, "OPTIONS": true,
+	blacklistAction: true,
+}
+
+// requireAdmin 校验当前用户拥有 admin 角色，HTTP 接口已经过 Casbin 中间件，
+// 这里的校验保证经网关的 RPC 调用同样受到保护
+func requireAdmin(ctx context.Context) (*PermissionService, int64, error) {
+	userID, err := identity.GetUserID(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if permissionService == nil {
+		return nil, 0, ErrPermissionUnavailable
+	}
+	ok, err := permissionService.HasRole(strconv.FormatInt(userID, 10), RoleAdmin)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !ok {
+		return nil, 0, ErrAdminRequired
+	}
+	return permissionService, userID, nil
+}
+
+func validPolicyField(v string) bool {
+	return v != "" && len(v) <= maxPolicyFieldLength
+}
+
+// validatePolicy 校验策略的各个字段，object 必须是合法的正则表达式
+func validatePolicy(p *user.Policy) error {
+	if p == nil || !validPolicyField(p.Subject) || !validPolicyField(p.Object) {
+		return ErrInvalidPolicy
+	}
+	if !policyActions[p.Action] {
+		return ErrInvalidPolicy
+	}
+	if p.Effect != EffectAllow && p.Effect != EffectDeny {
+		return ErrInvalidPolicy
+	}
+	if _, err := regexp.Compile(p.Object); err != nil {
+		return ErrInvalidPolicy
+	}
+	return nil
+}
+
+func validateRoleBinding(b *user.RoleBinding) error {
+	if b == nil || !validPolicyField(b.Subject) || !validPolicyField(b.Role) || b.Subject == b.Role {
+		return ErrInvalidRoleBinding
+	}
+	return nil
+}
+
+func toPolicies(rules [][]string) []*user.Policy {
+	policies := make([]*user.Policy, 0, len(rules))
+	for _, r := range rules {
+		if len(r) < 4 {
+			continue
+		}
+		policies = append(policies, &user.Policy{Subject: r[0], Object: r[1], Action: r[2], Effect: r[3]})
+	}
+	return policies
+}
+
+func toRoleBindings(rules [][]string) []*user.RoleBinding {
+	bindings := make([]*user.RoleBinding, 0, len(rules))
+	for _, r := range rules {
+		if len(r) < 2 {
+			continue
+		}
+		bindings = append(bindings, &user.RoleBinding{Subject: r[0], Role: r[1]})
+	}
+	return bindings
+}
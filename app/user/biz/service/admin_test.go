@@ -0,0 +1,43 @@
+package service
+
+import (
+	"testing"
+
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+)
+
+func TestValidatePolicy(t *testing.T) {
+	valid := []*user.Policy{
+		{Subject: "admin", Object: "^/admin/.*$", Action: "GET", Effect: EffectAllow},
+		{Subject: "6", Object: ".*", Action: ".*", Effect: EffectDeny},
+	}
+	for _, p := range valid {
+		if err := validatePolicy(p); err != nil {
+			t.Fatalf("%v should be valid: %v", p, err)
+		}
+	}
+
+	invalid := []*user.Policy{
+		nil,
+		{Object: "/hello", Action: "GET", Effect: EffectAllow},
+		{Subject: "1", Object: "/hello", Action: "get", Effect: EffectAllow},
+		{Subject: "1", Object: "/hello", Action: "GET", Effect: "maybe"},
+		{Subject: "1", Object: "^/user/(", Action: "GET", Effect: EffectAllow},
+	}
+	for _, p := range invalid {
+		if err := validatePolicy(p); err != ErrInvalidPolicy {
+			t.Fatalf("%v should be invalid, got %v", p, err)
+		}
+	}
+}
+
+func TestValidateRoleBinding(t *testing.T) {
+	if err := validateRoleBinding(&user.RoleBinding{Subject: "1", Role: RoleAdmin}); err != nil {
+		t.Fatal(err)
+	}
+	for _, b := range []*user.RoleBinding{nil, {Subject: "1"}, {Subject: RoleAdmin, Role: RoleAdmin}} {
+		if err := validateRoleBinding(b); err != ErrInvalidRoleBinding {
+			t.Fatalf("%v should be invalid, got %v", b, err)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+)
+
+type AssignRoleService struct {
+	ctx context.Context
+} // NewAssignRoleService new AssignRoleService
+func NewAssignRoleService(ctx context.Context) *AssignRoleService {
+	return &AssignRoleService{ctx: ctx}
+}
+
+// Run 为用户或角色授予角色，已拥有该角色时 success 为 false
+func (s *AssignRoleService) Run(req *user.AssignRoleReq) (resp *user.AssignRoleResp, err error) {
+	svc, _, err := requireAdmin(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = validateRoleBinding(req.Binding); err != nil {
+		return nil, err
+	}
+	added, err := svc.AddRoleForUser(req.Binding.Subject, req.Binding.Role)
+	if err != nil {
+		return nil, err
+	}
+	return &user.AssignRoleResp{Success: added}, nil
+}
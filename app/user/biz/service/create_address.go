@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+
+	"zqzqsb.com/gomall/app/user/biz/dal/mysql"
+	"zqzqsb.com/gomall/app/user/biz/model"
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+	"zqzqsb.com/gomall/common/identity"
+)
+
+type CreateAddressService struct {
+	ctx context.Context
+} // NewCreateAddressService new CreateAddressService
+func NewCreateAddressService(ctx context.Context) *CreateAddressService {
+	return &CreateAddressService{ctx: ctx}
+}
+
+// Run 为当前用户新增收货地址
+func (s *CreateAddressService) Run(req *user.CreateAddressReq) (resp *user.CreateAddressResp, err error) {
+	userID, err := identity.GetUserID(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	addr := &model.Address{
+		UserID:    userID,
+		Name:      req.Name,
+		Phone:     req.Phone,
+		Province:  req.Province,
+		City:      req.City,
+		District:  req.District,
+		Detail:    req.Detail,
+		ZipCode:   req.ZipCode,
+		IsDefault: req.IsDefault,
+	}
+	if err = validateAddress(addr); err != nil {
+		return nil, err
+	}
+	if err = model.CreateAddress(mysql.DB, addr); err != nil {
+		return nil, err
+	}
+	return &user.CreateAddressResp{Address: toAddress(addr)}, nil
+}
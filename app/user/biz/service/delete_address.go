@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"zqzqsb.com/gomall/app/user/biz/dal/mysql"
+	"zqzqsb.com/gomall/app/user/biz/model"
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+	"zqzqsb.com/gomall/common/identity"
+)
+
+type DeleteAddressService struct {
+	ctx context.Context
+} // NewDeleteAddressService new DeleteAddressService
+func NewDeleteAddressService(ctx context.Context) *DeleteAddressService {
+	return &DeleteAddressService{ctx: ctx}
+}
+
+// Run 删除当前用户的收货地址，删除默认地址时最近创建的另一个地址成为默认地址
+func (s *DeleteAddressService) Run(req *user.DeleteAddressReq) (resp *user.DeleteAddressResp, err error) {
+	userID, err := identity.GetUserID(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = model.DeleteAddress(mysql.DB, userID, uint(req.AddressId))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrAddressNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &user.DeleteAddressResp{Success: true}, nil
+}
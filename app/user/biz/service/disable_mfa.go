@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+
+	"zqzqsb.com/gomall/app/user/biz/dal/mysql"
+	"zqzqsb.com/gomall/app/user/biz/model"
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+)
+
+type DisableMFAService struct {
+	ctx context.Context
+} // NewDisableMFAService new DisableMFAService
+func NewDisableMFAService(ctx context.Context) *DisableMFAService {
+	return &DisableMFAService{ctx: ctx}
+}
+
+// Run 校验验证码或恢复码后关闭二次验证，避免仅凭被盗的访问令牌关闭
+func (s *DisableMFAService) Run(req *user.DisableMFAReq) (resp *user.DisableMFAResp, err error) {
+	row, err := currentMFAUser(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !row.MFAEnabled {
+		return nil, ErrMFANotEnabled
+	}
+	if err = checkMFACode(newMFAGuard(s.ctx, row), row, req.Code); err != nil {
+		return nil, err
+	}
+	if err = model.DisableMFA(mysql.DB, int64(row.ID)); err != nil {
+		return nil, err
+	}
+	return &user.DisableMFAResp{Success: true}, nil
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"zqzqsb.com/gomall/app/user/biz/dal/redis"
+	"zqzqsb.com/gomall/app/user/conf"
+	"zqzqsb.com/gomall/app/user/infra/totp"
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+)
+
+type EnrollMFAService struct {
+	ctx context.Context
+} // NewEnrollMFAService new EnrollMFAService
+func NewEnrollMFAService(ctx context.Context) *EnrollMFAService {
+	return &EnrollMFAService{ctx: ctx}
+}
+
+// Run 生成待绑定的 TOTP 密钥，用户在验证器应用中添加后需要通过 ActivateMFA 提交验证码确认
+func (s *EnrollMFAService) Run(req *user.EnrollMFAReq) (resp *user.EnrollMFAResp, err error) {
+	row, err := currentMFAUser(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	if row.MFAEnabled {
+		return nil, ErrMFAAlreadyEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	ttl := mfaEnrollTTL()
+	if err = redis.SaveMFAEnrollment(s.ctx, int64(row.ID), secret, ttl); err != nil {
+		return nil, err
+	}
+	return &user.EnrollMFAResp{
+		Secret:     secret,
+		OtpauthUri: totp.URI(conf.GetConf().MFA.Issuer, row.Email, secret),
+		Expire:     time.Now().Add(ttl).Unix(),
+	}, nil
+}
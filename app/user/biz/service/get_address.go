@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"zqzqsb.com/gomall/app/user/biz/dal/mysql"
+	"zqzqsb.com/gomall/app/user/biz/model"
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+	"zqzqsb.com/gomall/common/identity"
+)
+
+type GetAddressService struct {
+	ctx context.Context
+} // NewGetAddressService new GetAddressService
+func NewGetAddressService(ctx context.Context) *GetAddressService {
+	return &GetAddressService{ctx: ctx}
+}
+
+// Run 查询当前用户的收货地址，address_id 为 0 时返回默认地址，下单时由订单服务调用解析收货地址
+func (s *GetAddressService) Run(req *user.GetAddressReq) (resp *user.GetAddressResp, err error) {
+	userID, err := identity.GetUserID(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *model.Address
+	if req.AddressId == 0 {
+		row, err = model.GetDefaultAddress(mysql.DB, userID)
+	} else {
+		row, err = model.GetAddress(mysql.DB, userID, uint(req.AddressId))
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrAddressNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &user.GetAddressResp{Address: toAddress(row)}, nil
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+
+	"zqzqsb.com/gomall/app/user/biz/dal/mysql"
+	"zqzqsb.com/gomall/app/user/biz/model"
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+)
+
+type GetMFAStatusService struct {
+	ctx context.Context
+} // NewGetMFAStatusService new GetMFAStatusService
+func NewGetMFAStatusService(ctx context.Context) *GetMFAStatusService {
+	return &GetMFAStatusService{ctx: ctx}
+}
+
+// Run 查询当前用户是否开启了二次验证以及剩余的恢复码个数
+func (s *GetMFAStatusService) Run(req *user.GetMFAStatusReq) (resp *user.GetMFAStatusResp, err error) {
+	row, err := currentMFAUser(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp = &user.GetMFAStatusResp{Enabled: row.MFAEnabled}
+	if row.MFAEnabled {
+		resp.RecoveryCodesRemaining, err = model.CountRecoveryCodes(mysql.DB, int64(row.ID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
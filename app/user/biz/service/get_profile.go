@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+
+	"zqzqsb.com/gomall/app/user/biz/dal/mysql"
+	"zqzqsb.com/gomall/app/user/biz/model"
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+	"zqzqsb.com/gomall/common/identity"
+)
+
+type GetProfileService struct {
+	ctx context.Context
+} // NewGetProfileService new GetProfileService
+func NewGetProfileService(ctx context.Context) *GetProfileService {
+	return &GetProfileService{ctx: ctx}
+}
+
+// Run 查询当前用户的资料
+func (s *GetProfileService) Run(req *user.GetProfileReq) (resp *user.GetProfileResp, err error) {
+	userID, err := identity.GetUserID(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	row, err := model.GetByID(mysql.DB, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &user.GetProfileResp{Profile: toProfile(row)}, nil
+}
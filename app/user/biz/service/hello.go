@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+
 	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+	"zqzqsb.com/gomall/common/identity"
 )
 
 type HelloService struct {
@@ -12,9 +14,8 @@ func NewHelloService(ctx context.Context) *HelloService {
 	return &HelloService{ctx: ctx}
 }
 
-// Run create note info
+// Run 返回网关透传的当前用户ID，未登录时为 0
 func (s *HelloService) Run(req *user.HelloReq) (resp *user.HelloResp, err error) {
-	// Finish your business logic.
-
-	return
+	userID, _ := identity.GetUserID(s.ctx)
+	return &user.HelloResp{UserId: userID}, nil
 }
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+
+	"zqzqsb.com/gomall/app/user/biz/dal/mysql"
+	"zqzqsb.com/gomall/app/user/biz/model"
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+	"zqzqsb.com/gomall/common/identity"
+)
+
+type ListAddressesService struct {
+	ctx context.Context
+} // NewListAddressesService new ListAddressesService
+func NewListAddressesService(ctx context.Context) *ListAddressesService {
+	return &ListAddressesService{ctx: ctx}
+}
+
+// Run 查询当前用户的全部收货地址，默认地址在前
+func (s *ListAddressesService) Run(req *user.ListAddressesReq) (resp *user.ListAddressesResp, err error) {
+	userID, err := identity.GetUserID(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := model.ListAddresses(mysql.DB, userID)
+	if err != nil {
+		return nil, err
+	}
+	resp = &user.ListAddressesResp{Addresses: make([]*user.Address, 0, len(rows))}
+	for _, row := range rows {
+		resp.Addresses = append(resp.Addresses, toAddress(row))
+	}
+	return resp, nil
+}
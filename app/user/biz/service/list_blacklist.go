@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+)
+
+type ListBlacklistService struct {
+	ctx context.Context
+} // NewListBlacklistService new ListBlacklistService
+func NewListBlacklistService(ctx context.Context) *ListBlacklistService {
+	return &ListBlacklistService{ctx: ctx}
+}
+
+// Run 查询黑名单中的用户
+func (s *ListBlacklistService) Run(req *user.ListBlacklistReq) (resp *user.ListBlacklistResp, err error) {
+	svc, _, err := requireAdmin(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	subjects, err := svc.ListBlacklist()
+	if err != nil {
+		return nil, err
+	}
+	resp = &user.ListBlacklistResp{UserIds: make([]int64, 0, len(subjects))}
+	for _, sub := range subjects {
+		// 黑名单策略也可能针对角色，这里只返回用户
+		if id, err := strconv.ParseInt(sub, 10, 64); err == nil {
+			resp.UserIds = append(resp.UserIds, id)
+		}
+	}
+	return resp, nil
+}
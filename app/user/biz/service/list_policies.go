@@ -0,0 +1,27 @@
+package service
+
+import (
+	"context"
+
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+)
+
+type ListPoliciesService struct {
+	ctx context.Context
+} // NewListPoliciesService new ListPoliciesService
+func NewListPoliciesService(ctx context.Context) *ListPoliciesService {
+	return &ListPoliciesService{ctx: ctx}
+}
+
+// Run 查询权限策略，subject 为空时返回全部策略
+func (s *ListPoliciesService) Run(req *user.ListPoliciesReq) (resp *user.ListPoliciesResp, err error) {
+	svc, _, err := requireAdmin(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := svc.ListPolicies(req.Subject)
+	if err != nil {
+		return nil, err
+	}
+	return &user.ListPoliciesResp{Policies: toPolicies(rules)}, nil
+}
@@ -0,0 +1,27 @@
+package service
+
+import (
+	"context"
+
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+)
+
+type ListRolesService struct {
+	ctx context.Context
+} // NewListRolesService new ListRolesService
+func NewListRolesService(ctx context.Context) *ListRolesService {
+	return &ListRolesService{ctx: ctx}
+}
+
+// Run 查询角色授予关系，subject 为空时返回全部
+func (s *ListRolesService) Run(req *user.ListRolesReq) (resp *user.ListRolesResp, err error) {
+	svc, _, err := requireAdmin(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := svc.ListRoleBindings(req.Subject)
+	if err != nil {
+		return nil, err
+	}
+	return &user.ListRolesResp{Bindings: toRoleBindings(rules)}, nil
+}
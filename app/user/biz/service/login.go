@@ -3,14 +3,21 @@ package service
 import (
 	"context"
 	"errors"
-	"log"
+	"strings"
+	"time"
 
 	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
 	"zqzqsb.com/gomall/app/user/biz/dal/mysql"
 	"zqzqsb.com/gomall/app/user/biz/model"
+	"zqzqsb.com/gomall/app/user/biz/utils"
+	"zqzqsb.com/gomall/app/user/conf"
 	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
 )
 
+// ErrInvalidCredentials 邮箱不存在和密码错误返回同样的错误，避免泄露邮箱是否已注册
+var ErrInvalidCredentials = errors.New("邮箱或密码错误")
+
 type LoginService struct {
 	ctx context.Context
 } // NewLoginService new LoginService
@@ -18,32 +25,78 @@ func NewLoginService(ctx context.Context) *LoginService {
 	return &LoginService{ctx: ctx}
 }
 
-// Run create note info
+// Run 校验邮箱和密码并签发令牌，按账号和来源 IP 统计连续失败次数，
+// 失败过多时逐步延迟直至临时锁定，每次尝试都写入登录审计。
+// 开启二次验证的用户不直接签发令牌，而是返回 mfa_token，由 VerifyMFA 完成登录
 func (s *LoginService) Run(req *user.LoginReq) (resp *user.LoginResp, err error) {
-	// Finish your business logic.
-
-	log.Println(req.String())
-	// verify empty
 	if req.Email == "" || req.Password == "" {
-		return nil, errors.New("empty Email or Password")
+		return nil, ErrEmptyFields
+	}
+
+	guard := &loginGuard{
+		ctx:   s.ctx,
+		email: strings.ToLower(strings.TrimSpace(req.Email)),
+		ip:    utils.GetClientIP(s.ctx),
+	}
+	userAgent := utils.GetUserAgent(s.ctx)
+
+	if err = guard.check(); err != nil {
+		guard.auditThrottled(0, err, userAgent)
+		return nil, err
 	}
 
 	// fetch user
-	row, err := model.GetbyEmail(mysql.DB, req.Email)
+	row, err := model.GetbyEmail(mysql.DB, guard.email)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		compareDummyPassword(req.Password)
+		guard.recordFailure()
+		guard.audit(0, model.LoginResultUnknownEmail, userAgent)
+		return nil, ErrInvalidCredentials
+	}
 	if err != nil {
 		return nil, err
 	}
+	userID := int64(row.ID)
 
 	// compare password
-	err = bcrypt.CompareHashAndPassword([]byte(row.PasswordHashed), []byte(req.Password))
+	if err = bcrypt.CompareHashAndPassword([]byte(row.PasswordHashed), []byte(req.Password)); err != nil {
+		guard.recordFailure()
+		guard.audit(userID, model.LoginResultWrongPassword, userAgent)
+		return nil, ErrInvalidCredentials
+	}
+
+	// 开启邮箱验证后，未验证邮箱的用户不能登录；密码校验通过后才提示，不泄露邮箱状态
+	if conf.GetConf().Account.RequireVerifiedEmail && !row.EmailVerified {
+		guard.recordSuccess()
+		guard.audit(userID, model.LoginResultEmailUnverified, userAgent)
+		return nil, ErrEmailNotVerified
+	}
+
+	// 开启二次验证的用户只签发等待二次验证的令牌，失败计数在二次验证通过后才清除
+	if row.MFAEnabled {
+		mfaToken, expire, err := issueMFAToken(userID, row.TokenVersion)
+		if err != nil {
+			return nil, err
+		}
+		guard.audit(userID, model.LoginResultMFARequired, userAgent)
+		return &user.LoginResp{
+			UserId:      int32(row.ID),
+			MfaRequired: true,
+			MfaToken:    mfaToken,
+			MfaExpire:   expire.Unix(),
+		}, nil
+	}
+	guard.recordSuccess()
+
+	// issue access & refresh token
+	token, err := IssueTokenPair(userID, row.TokenVersion, time.Time{})
 	if err != nil {
 		return nil, err
 	}
+	guard.audit(userID, model.LoginResultSuccess, userAgent)
 
-	// return user id
-	resp = &user.LoginResp{
+	return &user.LoginResp{
 		UserId: int32(row.ID),
-	}
-
-	return
+		Token:  token,
+	}, nil
 }
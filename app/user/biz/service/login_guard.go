@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"golang.org/x/crypto/bcrypt"
+	"zqzqsb.com/gomall/app/user/biz/dal/mysql"
+	"zqzqsb.com/gomall/app/user/biz/dal/redis"
+	"zqzqsb.com/gomall/app/user/biz/model"
+	"zqzqsb.com/gomall/app/user/conf"
+)
+
+const (
+	loginScopeAccount = "account"
+	loginScopeIP      = "ip"
+)
+
+// LoginThrottledError 连续登录失败次数过多，需要等待 RetryAfter 后才能再次尝试。
+// 账号不存在时同样按邮箱计数，不会因此泄露邮箱是否已注册
+type LoginThrottledError struct {
+	RetryAfter time.Duration
+	Locked     bool // 失败次数达到锁定阈值，而不只是延迟
+}
+
+// RetryAfterSeconds 向上取整的等待秒数，用于 Retry-After 响应头
+func (e *LoginThrottledError) RetryAfterSeconds() int64 {
+	return int64((e.RetryAfter + time.Second - 1) / time.Second)
+}
+
+func (e *LoginThrottledError) Error() string {
+	return fmt.Sprintf("登录尝试过于频繁，请 %d 秒后重试", e.RetryAfterSeconds())
+}
+
+// BizStatusCode 实现 kerrors.BizStatusErrorIface，RPC 调用方据此区分限流和凭证错误
+func (e *LoginThrottledError) BizStatusCode() int32 {
+	return consts.StatusTooManyRequests
+}
+
+func (e *LoginThrottledError) BizMessage() string {
+	return e.Error()
+}
+
+func (e *LoginThrottledError) BizExtra() map[string]string {
+	return map[string]string{"retry_after": strconv.FormatInt(e.RetryAfterSeconds(), 10)}
+}
+
+// loginWait 根据连续失败次数和最近一次失败的时间计算还需等待多久才能再次尝试，返回 0 表示可以尝试
+func loginWait(t conf.LoginThrottle, count int64, last, now time.Time) time.Duration {
+	var wait time.Duration
+	switch {
+	case t.LockAfter > 0 && count >= t.LockAfter:
+		wait = t.LockDuration
+	case t.DelayAfter > 0 && count >= t.DelayAfter && t.BaseDelay > 0:
+		wait = t.BaseDelay
+		for i := t.DelayAfter; i < count && (t.MaxDelay <= 0 || wait < t.MaxDelay); i++ {
+			wait *= 2
+		}
+		if t.MaxDelay > 0 && wait > t.MaxDelay {
+			wait = t.MaxDelay
+		}
+	default:
+		return 0
+	}
+	if remain := last.Add(wait).Sub(now); remain > 0 {
+		return remain
+	}
+	return 0
+}
+
+// failureTTL 失败计数的保留时间，锁定期间计数不能过期
+func failureTTL(t conf.LoginThrottle) time.Duration {
+	ttl := t.Window
+	if t.LockDuration > ttl {
+		ttl = t.LockDuration
+	}
+	if ttl <= 0 {
+		ttl = 15 * time.Minute
+	}
+	return ttl
+}
+
+// loginGuard 一次登录尝试涉及的账号和来源 IP
+type loginGuard struct {
+	ctx   context.Context
+	email string
+	ip    string
+}
+
+func (g *loginGuard) subjects() map[string]string {
+	subjects := map[string]string{loginScopeAccount: g.email}
+	if g.ip != "" {
+		subjects[loginScopeIP] = g.ip
+	}
+	return subjects
+}
+
+func throttleOf(scope string) conf.LoginThrottle {
+	if scope == loginScopeIP {
+		return conf.GetConf().Login.IP
+	}
+	return conf.GetConf().Login.Account
+}
+
+// check 账号或来源 IP 处于延迟或锁定期间时返回 LoginThrottledError，Redis 不可用时不限制登录
+func (g *loginGuard) check() error {
+	now := time.Now()
+	var (
+		wait   time.Duration
+		locked bool
+	)
+	for scope, key := range g.subjects() {
+		count, last, err := redis.GetLoginFailures(g.ctx, scope, key)
+		if err != nil {
+			hlog.CtxWarnf(g.ctx, "get login failures of %s failed: %v", scope, err)
+			continue
+		}
+		t := throttleOf(scope)
+		w := loginWait(t, count, last, now)
+		if w > wait {
+			wait = w
+		}
+		if w > 0 && t.LockAfter > 0 && count >= t.LockAfter {
+			locked = true
+		}
+	}
+	if wait > 0 {
+		return &LoginThrottledError{RetryAfter: wait, Locked: locked}
+	}
+	return nil
+}
+
+func (g *loginGuard) recordFailure() {
+	for scope, key := range g.subjects() {
+		if _, err := redis.RecordLoginFailure(g.ctx, scope, key, failureTTL(throttleOf(scope))); err != nil {
+			hlog.CtxWarnf(g.ctx, "record login failure of %s failed: %v", scope, err)
+		}
+	}
+}
+
+// recordSuccess 登录成功只清除账号的失败计数，来源 IP 的计数不清除，避免攻击者用自己的账号重置计数
+func (g *loginGuard) recordSuccess() {
+	if err := redis.ClearLoginFailures(g.ctx, loginScopeAccount, g.email); err != nil {
+		hlog.CtxWarnf(g.ctx, "clear login failures failed: %v", err)
+	}
+}
+
+// audit 记录登录审计，写入失败不影响登录结果
+func (g *loginGuard) audit(userID int64, result, userAgent string) {
+	if len(userAgent) > 512 {
+		userAgent = userAgent[:512]
+	}
+	err := model.CreateLoginAudit(mysql.DB, &model.LoginAudit{
+		UserID:    userID,
+		Email:     g.email,
+		IP:        g.ip,
+		UserAgent: strings.ToValidUTF8(userAgent, ""),
+		Success:   result == model.LoginResultSuccess,
+		Result:    result,
+	})
+	if err != nil {
+		hlog.CtxErrorf(g.ctx, "create login audit failed: %v", err)
+	}
+}
+
+// auditThrottled 记录因限流或锁定被拒绝的尝试
+func (g *loginGuard) auditThrottled(userID int64, err error, userAgent string) {
+	result := model.LoginResultThrottled
+	var throttled *LoginThrottledError
+	if errors.As(err, &throttled) && throttled.Locked {
+		result = model.LoginResultLocked
+	}
+	g.audit(userID, result, userAgent)
+}
+
+var (
+	dummyHash     []byte
+	dummyHashOnce sync.Once
+)
+
+// compareDummyPassword 邮箱不存在时同样执行一次 bcrypt 比较，避免通过响应时间判断邮箱是否已注册
+func compareDummyPassword(password string) {
+	dummyHashOnce.Do(func() {
+		b := make([]byte, 16)
+		_, _ = rand.Read(b)
+		dummyHash, _ = bcrypt.GenerateFromPassword(b, bcrypt.DefaultCost)
+	})
+	_ = bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+}
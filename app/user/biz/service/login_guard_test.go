@@ -0,0 +1,58 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"zqzqsb.com/gomall/app/user/conf"
+)
+
+func TestLoginWait(t *testing.T) {
+	throttle := conf.LoginThrottle{
+		DelayAfter:   3,
+		BaseDelay:    time.Second,
+		MaxDelay:     8 * time.Second,
+		LockAfter:    10,
+		LockDuration: 15 * time.Minute,
+	}
+	now := time.Now()
+	cases := []struct {
+		count int64
+		want  time.Duration
+	}{
+		{0, 0},
+		{2, 0},
+		{3, time.Second},
+		{4, 2 * time.Second},
+		{5, 4 * time.Second},
+		{6, 8 * time.Second},
+		{9, 8 * time.Second}, // 不超过 MaxDelay
+		{10, 15 * time.Minute},
+	}
+	for _, c := range cases {
+		if got := loginWait(throttle, c.count, now, now); got != c.want {
+			t.Fatalf("count %d: want %v, got %v", c.count, c.want, got)
+		}
+	}
+
+	// 等待时间从最近一次失败开始计算
+	if got := loginWait(throttle, 4, now.Add(-time.Second), now); got != time.Second {
+		t.Fatalf("want 1s remaining, got %v", got)
+	}
+	if got := loginWait(throttle, 10, now.Add(-time.Hour), now); got != 0 {
+		t.Fatalf("lock should have expired, got %v", got)
+	}
+	if got := loginWait(conf.LoginThrottle{}, 100, now, now); got != 0 {
+		t.Fatalf("zero config should not throttle, got %v", got)
+	}
+}
+
+func TestLoginThrottledError(t *testing.T) {
+	err := &LoginThrottledError{RetryAfter: 1500 * time.Millisecond}
+	if err.Error() != "登录尝试过于频繁，请 2 秒后重试" {
+		t.Fatalf("unexpected message: %s", err.Error())
+	}
+	if err.BizStatusCode() != 429 || err.BizExtra()["retry_after"] != "2" {
+		t.Fatalf("unexpected biz status: %d %v", err.BizStatusCode(), err.BizExtra())
+	}
+}
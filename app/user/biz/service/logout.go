@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+)
+
+type LogoutService struct {
+	ctx context.Context
+}
+
+// NewLogoutService new LogoutService
+func NewLogoutService(ctx context.Context) *LogoutService {
+	return &LogoutService{ctx: ctx}
+}
+
+// Run 吊销当前的访问令牌和刷新令牌，all_devices 时递增令牌版本使该用户所有令牌失效
+func (s *LogoutService) Run(req *user.LogoutReq) (resp *user.LogoutResp, err error) {
+	access, err := ParseToken(req.AccessToken, TokenTypeAccess)
+	if err != nil {
+		return nil, err
+	}
+	if err = CheckTokenActive(s.ctx, access); err != nil {
+		return nil, err
+	}
+
+	if req.AllDevices {
+		if err = RevokeAllTokens(s.ctx, access.UserID); err != nil {
+			hlog.CtxErrorf(s.ctx, "revoke all tokens of user %d failed: %v", access.UserID, err)
+			return nil, err
+		}
+		return &user.LogoutResp{Success: true}, nil
+	}
+
+	if _, err = revokeToken(s.ctx, access); err != nil {
+		hlog.CtxErrorf(s.ctx, "revoke access token failed: %v", err)
+		return nil, err
+	}
+
+	// 刷新令牌可选，只吊销属于同一用户的有效刷新令牌
+	if req.RefreshToken != "" {
+		refresh, err := ParseToken(req.RefreshToken, TokenTypeRefresh)
+		if err == nil && refresh.UserID == access.UserID {
+			if _, err = revokeToken(s.ctx, refresh); err != nil {
+				hlog.CtxErrorf(s.ctx, "revoke refresh token failed: %v", err)
+				return nil, err
+			}
+		}
+	}
+	return &user.LogoutResp{Success: true}, nil
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+)
+
+func TestLogout_Run(t *testing.T) {
+	ctx := context.Background()
+	s := NewLogoutService(ctx)
+	// init req and assert value
+
+	req := &user.LogoutReq{}
+	resp, err := s.Run(req)
+	t.Logf("err: %v", err)
+	t.Logf("resp: %v", resp)
+
+	// todo: edit your unit test
+
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"zqzqsb.com/gomall/app/user/biz/dal/mysql"
+	"zqzqsb.com/gomall/app/user/biz/dal/redis"
+	"zqzqsb.com/gomall/app/user/biz/model"
+	"zqzqsb.com/gomall/app/user/biz/utils"
+	"zqzqsb.com/gomall/app/user/conf"
+	"zqzqsb.com/gomall/app/user/infra/totp"
+	"zqzqsb.com/gomall/common/identity"
+)
+
+const (
+	defaultMFAPendingTTL = 5 * time.Minute
+	defaultMFAEnrollTTL  = 10 * time.Minute
+	defaultRecoveryCodes = 10
+
+	// 恢复码去掉了容易混淆的 0、1、i、l、o，10 位约 49 比特熵，展示时每 5 位用 - 分隔
+	recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+	recoveryCodeLength   = 10
+)
+
+var (
+	ErrMFANotEnabled     = errors.New("尚未开启二次验证")
+	ErrMFAAlreadyEnabled = errors.New("已开启二次验证")
+	ErrMFAEnrollExpired  = errors.New("绑定已过期，请重新生成密钥")
+	ErrInvalidMFACode    = errors.New("验证码错误")
+)
+
+func mfaPendingTTL() time.Duration {
+	if ttl := conf.GetConf().MFA.PendingTTL; ttl > 0 {
+		return ttl
+	}
+	return defaultMFAPendingTTL
+}
+
+func mfaEnrollTTL() time.Duration {
+	if ttl := conf.GetConf().MFA.EnrollTTL; ttl > 0 {
+		return ttl
+	}
+	return defaultMFAEnrollTTL
+}
+
+func mfaSkew() int {
+	if skew := conf.GetConf().MFA.Skew; skew > 0 {
+		return skew
+	}
+	return 0
+}
+
+func recoveryCodeCount() int {
+	if n := conf.GetConf().MFA.RecoveryCodes; n > 0 {
+		return n
+	}
+	return defaultRecoveryCodes
+}
+
+// issueMFAToken 签发等待二次验证的令牌，令牌类型不是 access，JWT 中间件不会将其当作访问令牌接受
+func issueMFAToken(userID, version int64) (string, time.Time, error) {
+	now := time.Now()
+	return signToken(userID, TokenTypeMFA, version, now, now, mfaPendingTTL())
+}
+
+// normalizeRecoveryCode 忽略大小写、空格和分隔符
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
+
+func hashRecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(normalizeRecoveryCode(code)))
+	return hex.EncodeToString(sum[:])
+}
+
+// generateRecoveryCodes 生成 n 个恢复码，返回展示给用户的明文和保存到数据库的摘要
+func generateRecoveryCodes(n int) (codes, hashes []string, err error) {
+	codes = make([]string, 0, n)
+	hashes = make([]string, 0, n)
+	max := big.NewInt(int64(len(recoveryCodeAlphabet)))
+	for i := 0; i < n; i++ {
+		b := make([]byte, recoveryCodeLength)
+		for j := range b {
+			k, err := rand.Int(rand.Reader, max)
+			if err != nil {
+				return nil, nil, err
+			}
+			b[j] = recoveryCodeAlphabet[k.Int64()]
+		}
+		code := string(b[:recoveryCodeLength/2]) + "-" + string(b[recoveryCodeLength/2:])
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+// verifyMFACode 校验 TOTP 验证码或恢复码。同一时间步的验证码只能使用一次，恢复码使用后即作废
+func verifyMFACode(ctx context.Context, row *model.User, code string) (bool, error) {
+	code = strings.TrimSpace(code)
+	userID := int64(row.ID)
+	if step, ok := totp.Validate(row.MFASecret, code, time.Now(), mfaSkew()); ok {
+		ttl := time.Duration(2*mfaSkew()+1) * totp.Period * time.Second
+		return redis.UseTOTPStep(ctx, userID, step, ttl)
+	}
+	if len(normalizeRecoveryCode(code)) != recoveryCodeLength {
+		return false, nil
+	}
+	return model.UseRecoveryCode(mysql.DB, userID, hashRecoveryCode(code))
+}
+
+// newMFAGuard 二次验证的失败次数与登录共用账号和来源 IP 的计数，
+// 避免通过反复提交验证码暴力破解，也避免重新登录时清空二次验证的失败计数
+func newMFAGuard(ctx context.Context, row *model.User) *loginGuard {
+	return &loginGuard{
+		ctx:   ctx,
+		email: strings.ToLower(row.Email),
+		ip:    utils.GetClientIP(ctx),
+	}
+}
+
+// checkMFACode 在限流保护下校验验证码，失败时累加失败次数
+func checkMFACode(guard *loginGuard, row *model.User, code string) error {
+	if err := guard.check(); err != nil {
+		return err
+	}
+	if code == "" {
+		return ErrInvalidMFACode
+	}
+	ok, err := verifyMFACode(guard.ctx, row, code)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		guard.recordFailure()
+		return ErrInvalidMFACode
+	}
+	return nil
+}
+
+// currentMFAUser 查询当前登录用户，用于二次验证的管理接口
+func currentMFAUser(ctx context.Context) (*model.User, error) {
+	userID, err := identity.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return model.GetByID(mysql.DB, userID)
+}
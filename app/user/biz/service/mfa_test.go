@@ -0,0 +1,33 @@
+package service
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerateRecoveryCodes(t *testing.T) {
+	codes, hashes, err := generateRecoveryCodes(defaultRecoveryCodes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(codes) != defaultRecoveryCodes || len(hashes) != len(codes) {
+		t.Fatalf("want %d codes, got %d codes and %d hashes", defaultRecoveryCodes, len(codes), len(hashes))
+	}
+	seen := map[string]bool{}
+	for i, code := range codes {
+		if len(code) != recoveryCodeLength+1 || code[recoveryCodeLength/2] != '-' {
+			t.Fatalf("unexpected code format: %s", code)
+		}
+		if seen[code] {
+			t.Fatalf("duplicate code: %s", code)
+		}
+		seen[code] = true
+		if hashes[i] == code || len(hashes[i]) != 64 {
+			t.Fatalf("code should be stored hashed: %s", hashes[i])
+		}
+		// 用户输入时忽略大小写和分隔符
+		if hashRecoveryCode(strings.ToUpper(strings.ReplaceAll(code, "-", " "))) != hashes[i] {
+			t.Fatalf("normalized code should match hash: %s", code)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"zqzqsb.com/gomall/app/user/conf"
+)
+
+const (
+	GrantTypeAuthorizationCode = "authorization_code"
+	GrantTypeRefreshToken      = "refresh_token"
+	GrantTypeClientCredentials = "client_credentials"
+
+	ScopeOpenID = "openid"
+	ScopeEmail  = "email"
+
+	PKCEMethodS256 = "S256"
+
+	defaultOAuthCodeTTL    = 5 * time.Minute
+	defaultOAuthAccessTTL  = 15 * time.Minute
+	defaultOAuthRefreshTTL = 7 * 24 * time.Hour
+)
+
+// ErrLoginRequired 授权时用户尚未登录，需要先跳转到登录页
+var ErrLoginRequired = errors.New("login required")
+
+// OAuthError 符合 RFC 6749 第 5.2 节的错误响应
+type OAuthError struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+	Status      int    `json:"-"`
+}
+
+func (e *OAuthError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Description)
+}
+
+// IsOAuthError 判断错误是否为需要按 OAuth2 格式返回的错误
+func IsOAuthError(err error) (*OAuthError, bool) {
+	var e *OAuthError
+	if errors.As(err, &e) {
+		return e, true
+	}
+	return nil, false
+}
+
+func oauthErr(code string, status int, format string, args ...interface{}) *OAuthError {
+	return &OAuthError{Code: code, Description: fmt.Sprintf(format, args...), Status: status}
+}
+
+func errInvalidRequest(format string, args ...interface{}) *OAuthError {
+	return oauthErr("invalid_request", http.StatusBadRequest, format, args...)
+}
+
+func errInvalidClient(format string, args ...interface{}) *OAuthError {
+	return oauthErr("invalid_client", http.StatusUnauthorized, format, args...)
+}
+
+func errInvalidGrant(format string, args ...interface{}) *OAuthError {
+	return oauthErr("invalid_grant", http.StatusBadRequest, format, args...)
+}
+
+func errUnauthorizedClient(format string, args ...interface{}) *OAuthError {
+	return oauthErr("unauthorized_client", http.StatusBadRequest, format, args...)
+}
+
+func errInvalidScope(format string, args ...interface{}) *OAuthError {
+	return oauthErr("invalid_scope", http.StatusBadRequest, format, args...)
+}
+
+// oauthClient 查找注册的客户端
+func oauthClient(clientID string) (*conf.OAuthClient, bool) {
+	clients := conf.GetConf().OAuth.Clients
+	for i := range clients {
+		if clients[i].ID == clientID {
+			return &clients[i], true
+		}
+	}
+	return nil, false
+}
+
+// authenticateClient 校验客户端身份，公开客户端不允许携带密钥，机密客户端必须携带正确的密钥
+func authenticateClient(clientID, clientSecret string) (*conf.OAuthClient, error) {
+	client, ok := oauthClient(clientID)
+	if !ok {
+		return nil, errInvalidClient("unknown client")
+	}
+	if isPublicClient(client) {
+		if clientSecret != "" {
+			return nil, errInvalidClient("public client must not use a secret")
+		}
+		return client, nil
+	}
+	if subtle.ConstantTimeCompare([]byte(client.Secret), []byte(clientSecret)) != 1 {
+		return nil, errInvalidClient("client authentication failed")
+	}
+	return client, nil
+}
+
+func isPublicClient(client *conf.OAuthClient) bool {
+	return client.Secret == ""
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveScope 校验请求的 scope 是否都在客户端允许范围内，未指定时使用客户端的全部 scope
+func resolveScope(requested string, allowed []string) (string, error) {
+	scopes := strings.Fields(requested)
+	if len(scopes) == 0 {
+		return strings.Join(allowed, " "), nil
+	}
+	for _, s := range scopes {
+		if !containsString(allowed, s) {
+			return "", errInvalidScope("scope %q is not allowed", s)
+		}
+	}
+	return strings.Join(scopes, " "), nil
+}
+
+func hasScope(scope, s string) bool {
+	return containsString(strings.Fields(scope), s)
+}
+
+// verifyPKCE 按 RFC 7636 校验 code_verifier，只支持 S256
+func verifyPKCE(challenge, verifier string) bool {
+	if len(verifier) < 43 || len(verifier) > 128 {
+		return false
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	expected := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(challenge)) == 1
+}
+
+// appendQuery 在回调地址上追加参数，保留回调地址原有的查询参数
+func appendQuery(rawURL string, params map[string]string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	q := u.Query()
+	for k, v := range params {
+		if v != "" {
+			q.Set(k, v)
+		}
+	}
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+func oauthCodeTTL() time.Duration {
+	if ttl := conf.GetConf().OAuth.CodeTTL; ttl > 0 {
+		return ttl
+	}
+	return defaultOAuthCodeTTL
+}
+
+func oauthAccessTTL() time.Duration {
+	if ttl := conf.GetConf().OAuth.AccessTTL; ttl > 0 {
+		return ttl
+	}
+	return defaultOAuthAccessTTL
+}
+
+func oauthRefreshTTL() time.Duration {
+	if ttl := conf.GetConf().OAuth.RefreshTTL; ttl > 0 {
+		return ttl
+	}
+	return defaultOAuthRefreshTTL
+}
+
+// oauthIssuer 签发者地址，去掉末尾的斜杠
+func oauthIssuer() string {
+	return strings.TrimRight(conf.GetConf().OAuth.Issuer, "/")
+}
+
+// randomToken 生成 url 安全的随机串，用于授权码和刷新令牌
+func randomToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"zqzqsb.com/gomall/app/user/biz/dal/redis"
+)
+
+// AuthorizeRequest 授权端点的请求参数，UserID 为当前登录用户，未登录时为 0
+type AuthorizeRequest struct {
+	ResponseType        string
+	ClientID            string
+	RedirectURI         string
+	Scope               string
+	State               string
+	Nonce               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+
+	UserID       int64
+	TokenVersion int64
+	AuthTime     time.Time
+}
+
+// authorizationCode 授权码在 Redis 中保存的授权信息
+type authorizationCode struct {
+	ClientID      string `json:"client_id"`
+	RedirectURI   string `json:"redirect_uri"`
+	UserID        int64  `json:"user_id"`
+	TokenVersion  int64  `json:"token_version"`
+	Scope         string `json:"scope"`
+	Nonce         string `json:"nonce,omitempty"`
+	CodeChallenge string `json:"code_challenge,omitempty"`
+	AuthTime      int64  `json:"auth_time"`
+}
+
+type OAuthAuthorizeService struct {
+	ctx context.Context
+}
+
+// NewOAuthAuthorizeService new OAuthAuthorizeService
+func NewOAuthAuthorizeService(ctx context.Context) *OAuthAuthorizeService {
+	return &OAuthAuthorizeService{ctx: ctx}
+}
+
+// Run 处理授权码流程的授权请求，返回携带授权码或错误信息的回调地址。
+// 客户端或回调地址无效时不能跳转，直接返回 *OAuthError；用户未登录时返回 ErrLoginRequired。
+// 内部客户端均为一方应用，用户登录后直接授权，不再单独展示授权确认页。
+func (s *OAuthAuthorizeService) Run(req *AuthorizeRequest) (redirectURL string, err error) {
+	client, ok := oauthClient(req.ClientID)
+	if !ok {
+		return "", errInvalidClient("unknown client")
+	}
+	if req.RedirectURI == "" || !containsString(client.RedirectURIs, req.RedirectURI) {
+		return "", errInvalidRequest("redirect_uri is not registered for this client")
+	}
+
+	fail := func(e *OAuthError) (string, error) {
+		return appendQuery(req.RedirectURI, map[string]string{
+			"error":             e.Code,
+			"error_description": e.Description,
+			"state":             req.State,
+		}), nil
+	}
+
+	if req.ResponseType != "code" {
+		return fail(oauthErr("unsupported_response_type", 0, "only response_type=code is supported"))
+	}
+	if !containsString(client.GrantTypes, GrantTypeAuthorizationCode) {
+		return fail(errUnauthorizedClient("client is not allowed to use authorization_code"))
+	}
+	scope, err := resolveScope(req.Scope, client.Scopes)
+	if err != nil {
+		return fail(err.(*OAuthError))
+	}
+	// 公开客户端必须使用 PKCE，机密客户端携带时同样校验
+	if req.CodeChallenge == "" && isPublicClient(client) {
+		return fail(errInvalidRequest("code_challenge is required for public clients"))
+	}
+	if req.CodeChallenge != "" && req.CodeChallengeMethod != PKCEMethodS256 {
+		return fail(errInvalidRequest("code_challenge_method must be S256"))
+	}
+
+	if req.UserID == 0 {
+		return "", ErrLoginRequired
+	}
+
+	code, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(&authorizationCode{
+		ClientID:      client.ID,
+		RedirectURI:   req.RedirectURI,
+		UserID:        req.UserID,
+		TokenVersion:  req.TokenVersion,
+		Scope:         scope,
+		Nonce:         req.Nonce,
+		CodeChallenge: req.CodeChallenge,
+		AuthTime:      req.AuthTime.Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+	if err = redis.SaveOAuthCode(s.ctx, code, data, oauthCodeTTL()); err != nil {
+		hlog.CtxErrorf(s.ctx, "save oauth code failed: %v", err)
+		return "", err
+	}
+
+	return appendQuery(req.RedirectURI, map[string]string{
+		"code":  code,
+		"state": req.State,
+	}), nil
+}
@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	goredis "github.com/redis/go-redis/v9"
+	"zqzqsb.com/gomall/app/user/biz/dal/mysql"
+	"zqzqsb.com/gomall/app/user/biz/dal/redis"
+	"zqzqsb.com/gomall/app/user/biz/model"
+)
+
+// IntrospectRequest 令牌自省请求（RFC 7662），调用方必须是机密客户端
+type IntrospectRequest struct {
+	ClientID      string
+	ClientSecret  string
+	Token         string
+	TokenTypeHint string
+}
+
+// IntrospectResponse 令牌自省响应，令牌无效时只返回 active=false
+type IntrospectResponse struct {
+	Active    bool   `json:"active"`
+	Scope     string `json:"scope,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+	Sub       string `json:"sub,omitempty"`
+	Aud       string `json:"aud,omitempty"`
+	Iss       string `json:"iss,omitempty"`
+	Exp       int64  `json:"exp,omitempty"`
+	Iat       int64  `json:"iat,omitempty"`
+	Jti       string `json:"jti,omitempty"`
+	TokenType string `json:"token_type,omitempty"`
+}
+
+type OAuthIntrospectService struct {
+	ctx context.Context
+}
+
+// NewOAuthIntrospectService new OAuthIntrospectService
+func NewOAuthIntrospectService(ctx context.Context) *OAuthIntrospectService {
+	return &OAuthIntrospectService{ctx: ctx}
+}
+
+// Run 校验访问令牌或刷新令牌是否仍然有效
+func (s *OAuthIntrospectService) Run(req *IntrospectRequest) (resp *IntrospectResponse, err error) {
+	client, err := authenticateClient(req.ClientID, req.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+	if isPublicClient(client) {
+		return nil, errUnauthorizedClient("public clients cannot introspect tokens")
+	}
+	if req.Token == "" {
+		return nil, errInvalidRequest("token is required")
+	}
+
+	if req.TokenTypeHint != "refresh_token" {
+		if resp, err = s.introspectAccessToken(req.Token); err != nil || resp.Active {
+			return resp, err
+		}
+	}
+	return s.introspectRefreshToken(req.Token)
+}
+
+func (s *OAuthIntrospectService) introspectAccessToken(token string) (*IntrospectResponse, error) {
+	claims, err := parseOAuthAccessToken(s.ctx, token)
+	if errors.Is(err, ErrInvalidToken) || errors.Is(err, ErrTokenRevoked) {
+		return &IntrospectResponse{Active: false}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &IntrospectResponse{Active: true, TokenType: "Bearer"}
+	resp.Scope, _ = claims[claimScope].(string)
+	resp.ClientID, _ = claims[claimClientID].(string)
+	resp.Sub, _ = claims["sub"].(string)
+	resp.Aud, _ = claims["aud"].(string)
+	resp.Iss, _ = claims["iss"].(string)
+	resp.Jti, _ = claims[claimJTI].(string)
+	if exp, ok := claims["exp"].(float64); ok {
+		resp.Exp = int64(exp)
+	}
+	if iat, ok := claims["iat"].(float64); ok {
+		resp.Iat = int64(iat)
+	}
+	return resp, nil
+}
+
+func (s *OAuthIntrospectService) introspectRefreshToken(token string) (*IntrospectResponse, error) {
+	data, ttl, err := redis.GetOAuthRefreshToken(s.ctx, token)
+	if errors.Is(err, goredis.Nil) {
+		return &IntrospectResponse{Active: false}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var grant oauthGrant
+	if err = json.Unmarshal(data, &grant); err != nil {
+		return nil, err
+	}
+	version, err := currentTokenVersion(s.ctx, grant.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if version != grant.TokenVersion {
+		return &IntrospectResponse{Active: false}, nil
+	}
+	return &IntrospectResponse{
+		Active:    true,
+		Scope:     grant.Scope,
+		ClientID:  grant.ClientID,
+		Sub:       strconv.FormatInt(grant.UserID, 10),
+		Iss:       oauthIssuer(),
+		Exp:       time.Now().Add(ttl).Unix(),
+		TokenType: "refresh_token",
+	}, nil
+}
+
+// parseOAuthAccessToken 校验 RS256 访问令牌，并检查 jti 黑名单和用户的令牌版本
+func parseOAuthAccessToken(ctx context.Context, token string) (jwt.MapClaims, error) {
+	key, err := loadOAuthKey()
+	if err != nil {
+		return nil, err
+	}
+	claims, err := key.parse(token, oauthIssuer())
+	if err != nil {
+		return nil, err
+	}
+	if typ, _ := claims[claimType].(string); typ != TokenTypeAccess {
+		return nil, ErrInvalidToken
+	}
+	jti, _ := claims[claimJTI].(string)
+	revoked, err := redis.IsTokenRevoked(ctx, jti)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, ErrTokenRevoked
+	}
+	// 客户端凭据签发的令牌没有 ver 声明
+	if ver, ok := claims[claimVersion].(float64); ok {
+		sub, _ := claims["sub"].(string)
+		userID, err := strconv.ParseInt(sub, 10, 64)
+		if err != nil {
+			return nil, ErrInvalidToken
+		}
+		version, err := currentTokenVersion(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if int64(ver) != version {
+			return nil, ErrTokenRevoked
+		}
+	}
+	return claims, nil
+}
+
+// UserInfoResponse OIDC UserInfo 端点的响应
+type UserInfoResponse struct {
+	Sub   string `json:"sub"`
+	Email string `json:"email,omitempty"`
+}
+
+type OAuthUserInfoService struct {
+	ctx context.Context
+}
+
+// NewOAuthUserInfoService new OAuthUserInfoService
+func NewOAuthUserInfoService(ctx context.Context) *OAuthUserInfoService {
+	return &OAuthUserInfoService{ctx: ctx}
+}
+
+// Run 根据携带 openid scope 的访问令牌返回用户信息
+func (s *OAuthUserInfoService) Run(accessToken string) (resp *UserInfoResponse, err error) {
+	claims, err := parseOAuthAccessToken(s.ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	scope, _ := claims[claimScope].(string)
+	if _, isUser := claims[claimVersion]; !isUser || !hasScope(scope, ScopeOpenID) {
+		return nil, oauthErr("insufficient_scope", http.StatusForbidden, "openid scope is required")
+	}
+
+	sub, _ := claims["sub"].(string)
+	userID, err := strconv.ParseInt(sub, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	resp = &UserInfoResponse{Sub: sub}
+	if hasScope(scope, ScopeEmail) {
+		row, err := model.GetByID(mysql.DB, userID)
+		if err != nil {
+			return nil, err
+		}
+		resp.Email = row.Email
+	}
+	return resp, nil
+}
+
+// OpenIDConfiguration OIDC Discovery 文档
+func OpenIDConfiguration() map[string]interface{} {
+	issuer := oauthIssuer()
+	return map[string]interface{}{
+		"issuer":                                issuer,
+		"authorization_endpoint":                issuer + "/oauth2/authorize",
+		"token_endpoint":                        issuer + "/oauth2/token",
+		"introspection_endpoint":                issuer + "/oauth2/introspect",
+		"userinfo_endpoint":                     issuer + "/oauth2/userinfo",
+		"jwks_uri":                              issuer + "/oauth2/jwks",
+		"response_types_supported":              []string{"code"},
+		"grant_types_supported":                 []string{GrantTypeAuthorizationCode, GrantTypeRefreshToken, GrantTypeClientCredentials},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{PKCEMethodS256},
+		"scopes_supported":                      []string{ScopeOpenID, "profile", ScopeEmail},
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post", "none"},
+	}
+}
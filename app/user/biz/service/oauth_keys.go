@@ -0,0 +1,128 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/golang-jwt/jwt/v4"
+	"zqzqsb.com/gomall/app/user/conf"
+)
+
+// JSONWebKey RFC 7517 中的 RSA 公钥
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// JSONWebKeySet JWKS 端点返回的公钥集合
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// rsaSigningKey RS256 签名密钥，kid 为公钥的 RFC 7638 指纹
+type rsaSigningKey struct {
+	kid     string
+	private *rsa.PrivateKey
+}
+
+var (
+	oauthKey     *rsaSigningKey
+	oauthKeyErr  error
+	oauthKeyOnce sync.Once
+)
+
+// loadOAuthKey 读取配置的私钥，未配置时生成临时密钥，重启后此前签发的令牌将无法验证
+func loadOAuthKey() (*rsaSigningKey, error) {
+	oauthKeyOnce.Do(func() {
+		var private *rsa.PrivateKey
+		path := conf.GetConf().OAuth.PrivateKeyFile
+		if path == "" {
+			hlog.Warn("oauth private_key_file is not set, generating an ephemeral RSA key")
+			private, oauthKeyErr = rsa.GenerateKey(rand.Reader, 2048)
+		} else {
+			var pemBytes []byte
+			pemBytes, oauthKeyErr = os.ReadFile(path)
+			if oauthKeyErr == nil {
+				private, oauthKeyErr = jwt.ParseRSAPrivateKeyFromPEM(pemBytes)
+			}
+		}
+		if oauthKeyErr != nil {
+			oauthKeyErr = fmt.Errorf("load oauth signing key: %w", oauthKeyErr)
+			return
+		}
+		oauthKey = newRSASigningKey(private)
+	})
+	return oauthKey, oauthKeyErr
+}
+
+func newRSASigningKey(private *rsa.PrivateKey) *rsaSigningKey {
+	return &rsaSigningKey{kid: rsaThumbprint(&private.PublicKey), private: private}
+}
+
+// rsaThumbprint 按 RFC 7638 计算公钥指纹，成员按字典序排列
+func rsaThumbprint(pub *rsa.PublicKey) string {
+	n, e := encodeRSAPublicKey(pub)
+	sum := sha256.Sum256([]byte(fmt.Sprintf(`{"e":"%s","kty":"RSA","n":"%s"}`, e, n)))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func encodeRSAPublicKey(pub *rsa.PublicKey) (n, e string) {
+	n = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+	e = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	return n, e
+}
+
+func (k *rsaSigningKey) jwk() JSONWebKey {
+	n, e := encodeRSAPublicKey(&k.private.PublicKey)
+	return JSONWebKey{Kty: "RSA", Use: "sig", Alg: "RS256", Kid: k.kid, N: n, E: e}
+}
+
+// sign 使用 RS256 签名，typ 为 JWT 头中的类型，访问令牌使用 at+jwt（RFC 9068）
+func (k *rsaSigningKey) sign(claims jwt.MapClaims, typ string) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = k.kid
+	token.Header["typ"] = typ
+	return token.SignedString(k.private)
+}
+
+// parse 校验签名、有效期和签发者
+func (k *rsaSigningKey) parse(tokenString, issuer string) (jwt.MapClaims, error) {
+	token, err := jwt.Parse(tokenString, func(t *jwt.Token) (interface{}, error) {
+		if t.Method != jwt.SigningMethodRS256 {
+			return nil, errors.New("unexpected signing method")
+		}
+		if kid, _ := t.Header["kid"].(string); kid != k.kid {
+			return nil, errors.New("unknown key id")
+		}
+		return &k.private.PublicKey, nil
+	})
+	if err != nil || !token.Valid {
+		return nil, ErrInvalidToken
+	}
+	claims := token.Claims.(jwt.MapClaims)
+	if !claims.VerifyIssuer(issuer, true) {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
+}
+
+// JWKS 返回用于验证 OAuth2 访问令牌和 ID Token 的公钥集合
+func JWKS() (*JSONWebKeySet, error) {
+	key, err := loadOAuthKey()
+	if err != nil {
+		return nil, err
+	}
+	return &JSONWebKeySet{Keys: []JSONWebKey{key.jwk()}}, nil
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+func TestVerifyPKCE(t *testing.T) {
+	// challenge = BASE64URL(SHA256(verifier))
+	verifier := "dBjftJeZ4CVP-mJ92K8ySeHKS-TJgKVpc1aWqLj-vPg"
+	challenge := "FJuW3xq_J3gtiYf2ETKFzy8o_vRHfyBvqswzkEwEx2c"
+	if !verifyPKCE(challenge, verifier) {
+		t.Fatal("expected verifier to match challenge")
+	}
+	if verifyPKCE(challenge, verifier[:42]+"x") {
+		t.Fatal("expected mismatched verifier to fail")
+	}
+	if verifyPKCE(challenge, "short") {
+		t.Fatal("expected too short verifier to fail")
+	}
+}
+
+func TestResolveScope(t *testing.T) {
+	allowed := []string{"openid", "profile", "email"}
+	if scope, err := resolveScope("", allowed); err != nil || scope != "openid profile email" {
+		t.Fatalf("got %q, %v", scope, err)
+	}
+	if scope, err := resolveScope("openid  email", allowed); err != nil || scope != "openid email" {
+		t.Fatalf("got %q, %v", scope, err)
+	}
+	if _, err := resolveScope("openid admin", allowed); err == nil {
+		t.Fatal("expected invalid_scope")
+	} else if e, ok := IsOAuthError(err); !ok || e.Code != "invalid_scope" {
+		t.Fatalf("unexpected err: %v", err)
+	}
+}
+
+func TestAppendQuery(t *testing.T) {
+	got := appendQuery("https://app.example.com/cb?from=mall", map[string]string{"code": "abc", "state": ""})
+	u, err := url.Parse(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := u.Query()
+	if q.Get("from") != "mall" || q.Get("code") != "abc" || q.Has("state") {
+		t.Fatalf("unexpected url: %s", got)
+	}
+}
+
+func TestRSASigningKey(t *testing.T) {
+	private, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := newRSASigningKey(private)
+	if key.kid != rsaThumbprint(&private.PublicKey) || key.jwk().Kid != key.kid {
+		t.Fatal("kid should be the key thumbprint")
+	}
+
+	token, err := key.sign(jwt.MapClaims{
+		"iss": "https://mall.example.com",
+		"sub": "1",
+		"exp": time.Now().Add(time.Minute).Unix(),
+	}, "at+jwt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims, err := key.parse(token, "https://mall.example.com")
+	if err != nil || claims["sub"] != "1" {
+		t.Fatalf("unexpected claims: %v, %v", claims, err)
+	}
+	if _, err := key.parse(token, "https://other.example.com"); err != ErrInvalidToken {
+		t.Fatalf("want ErrInvalidToken for wrong issuer, got %v", err)
+	}
+
+	other, _ := rsa.GenerateKey(rand.Reader, 2048)
+	if _, err := newRSASigningKey(other).parse(token, "https://mall.example.com"); err != ErrInvalidToken {
+		t.Fatalf("want ErrInvalidToken for unknown key, got %v", err)
+	}
+}
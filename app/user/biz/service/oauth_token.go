@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/golang-jwt/jwt/v4"
+	goredis "github.com/redis/go-redis/v9"
+	"zqzqsb.com/gomall/app/user/biz/dal/mysql"
+	"zqzqsb.com/gomall/app/user/biz/dal/redis"
+	"zqzqsb.com/gomall/app/user/biz/model"
+	"zqzqsb.com/gomall/app/user/conf"
+)
+
+const (
+	// OAuth2 访问令牌中的客户端和授权范围声明（RFC 9068）
+	claimClientID = "client_id"
+	claimScope    = "scope"
+)
+
+// OAuthTokenRequest 令牌端点的请求参数
+type OAuthTokenRequest struct {
+	GrantType    string
+	ClientID     string
+	ClientSecret string
+	Code         string
+	RedirectURI  string
+	CodeVerifier string
+	RefreshToken string
+	Scope        string
+}
+
+// OAuthTokenResponse 令牌端点的响应（RFC 6749 第 5.1 节）
+type OAuthTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+}
+
+// oauthGrant 一次授权的信息，刷新令牌在 Redis 中同样保存该结构
+type oauthGrant struct {
+	ClientID     string `json:"client_id"`
+	UserID       int64  `json:"user_id"`
+	TokenVersion int64  `json:"token_version"`
+	Scope        string `json:"scope"`
+	Nonce        string `json:"nonce,omitempty"`
+	AuthTime     int64  `json:"auth_time"`
+}
+
+type OAuthTokenService struct {
+	ctx context.Context
+}
+
+// NewOAuthTokenService new OAuthTokenService
+func NewOAuthTokenService(ctx context.Context) *OAuthTokenService {
+	return &OAuthTokenService{ctx: ctx}
+}
+
+// Run 按 grant_type 签发令牌
+func (s *OAuthTokenService) Run(req *OAuthTokenRequest) (resp *OAuthTokenResponse, err error) {
+	if req.ClientID == "" {
+		return nil, errInvalidClient("client_id is required")
+	}
+	client, err := authenticateClient(req.ClientID, req.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+	if !containsString(client.GrantTypes, req.GrantType) {
+		if req.GrantType != GrantTypeAuthorizationCode && req.GrantType != GrantTypeRefreshToken &&
+			req.GrantType != GrantTypeClientCredentials {
+			return nil, oauthErr("unsupported_grant_type", http.StatusBadRequest, "grant_type %q is not supported", req.GrantType)
+		}
+		return nil, errUnauthorizedClient("client is not allowed to use %s", req.GrantType)
+	}
+
+	switch req.GrantType {
+	case GrantTypeAuthorizationCode:
+		return s.exchangeCode(client, req)
+	case GrantTypeRefreshToken:
+		return s.refresh(client, req)
+	default:
+		return s.clientCredentials(client, req)
+	}
+}
+
+func (s *OAuthTokenService) exchangeCode(client *conf.OAuthClient, req *OAuthTokenRequest) (*OAuthTokenResponse, error) {
+	if req.Code == "" {
+		return nil, errInvalidRequest("code is required")
+	}
+	// 授权码无论校验是否通过都只能使用一次
+	data, err := redis.TakeOAuthCode(s.ctx, req.Code)
+	if errors.Is(err, goredis.Nil) {
+		return nil, errInvalidGrant("authorization code is invalid or expired")
+	}
+	if err != nil {
+		return nil, err
+	}
+	var code authorizationCode
+	if err = json.Unmarshal(data, &code); err != nil {
+		return nil, err
+	}
+
+	if code.ClientID != client.ID {
+		return nil, errInvalidGrant("authorization code was issued to another client")
+	}
+	if code.RedirectURI != req.RedirectURI {
+		return nil, errInvalidGrant("redirect_uri does not match")
+	}
+	if code.CodeChallenge != "" && !verifyPKCE(code.CodeChallenge, req.CodeVerifier) {
+		return nil, errInvalidGrant("code_verifier does not match")
+	}
+
+	return s.issue(client, &oauthGrant{
+		ClientID:     client.ID,
+		UserID:       code.UserID,
+		TokenVersion: code.TokenVersion,
+		Scope:        code.Scope,
+		Nonce:        code.Nonce,
+		AuthTime:     code.AuthTime,
+	})
+}
+
+func (s *OAuthTokenService) refresh(client *conf.OAuthClient, req *OAuthTokenRequest) (*OAuthTokenResponse, error) {
+	if req.RefreshToken == "" {
+		return nil, errInvalidRequest("refresh_token is required")
+	}
+	data, err := redis.TakeOAuthRefreshToken(s.ctx, req.RefreshToken)
+	if errors.Is(err, goredis.Nil) {
+		return nil, errInvalidGrant("refresh token is invalid or expired")
+	}
+	if err != nil {
+		return nil, err
+	}
+	var grant oauthGrant
+	if err = json.Unmarshal(data, &grant); err != nil {
+		return nil, err
+	}
+	if grant.ClientID != client.ID {
+		return nil, errInvalidGrant("refresh token was issued to another client")
+	}
+
+	// 刷新时只能缩小授权范围
+	if req.Scope != "" {
+		scope, err := resolveScope(req.Scope, strings.Fields(grant.Scope))
+		if err != nil {
+			return nil, err
+		}
+		grant.Scope = scope
+	}
+	// 刷新时签发的 ID Token 不携带 nonce（OIDC Core 12.2）
+	grant.Nonce = ""
+	return s.issue(client, &grant)
+}
+
+func (s *OAuthTokenService) clientCredentials(client *conf.OAuthClient, req *OAuthTokenRequest) (*OAuthTokenResponse, error) {
+	if isPublicClient(client) {
+		return nil, errUnauthorizedClient("public clients cannot use client_credentials")
+	}
+	scope, err := resolveScope(req.Scope, client.Scopes)
+	if err != nil {
+		return nil, err
+	}
+	return s.issue(client, &oauthGrant{ClientID: client.ID, Scope: scope})
+}
+
+// issue 签发访问令牌，用户授权时额外签发刷新令牌和 ID Token
+func (s *OAuthTokenService) issue(client *conf.OAuthClient, grant *oauthGrant) (*OAuthTokenResponse, error) {
+	key, err := loadOAuthKey()
+	if err != nil {
+		return nil, err
+	}
+
+	var email string
+	if grant.UserID != 0 {
+		// 用户的令牌版本已递增（退出所有设备、修改密码等）时拒绝签发
+		version, err := currentTokenVersion(s.ctx, grant.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if version != grant.TokenVersion {
+			return nil, errInvalidGrant("grant has been revoked")
+		}
+		if hasScope(grant.Scope, ScopeEmail) {
+			row, err := model.GetByID(mysql.DB, grant.UserID)
+			if err != nil {
+				return nil, err
+			}
+			email = row.Email
+		}
+	}
+
+	now := time.Now()
+	ttl := oauthAccessTTL()
+	jti, err := newJTI()
+	if err != nil {
+		return nil, err
+	}
+	claims := jwt.MapClaims{
+		"iss":         oauthIssuer(),
+		"aud":         client.ID,
+		"iat":         now.Unix(),
+		"exp":         now.Add(ttl).Unix(),
+		claimJTI:      jti,
+		claimType:     TokenTypeAccess,
+		claimClientID: client.ID,
+		claimScope:    grant.Scope,
+	}
+	if grant.UserID != 0 {
+		claims["sub"] = strconv.FormatInt(grant.UserID, 10)
+		claims[claimVersion] = grant.TokenVersion
+	} else {
+		claims["sub"] = client.ID
+	}
+	accessToken, err := key.sign(claims, "at+jwt")
+	if err != nil {
+		return nil, err
+	}
+	resp := &OAuthTokenResponse{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(ttl / time.Second),
+		Scope:       grant.Scope,
+	}
+
+	if grant.UserID == 0 {
+		return resp, nil
+	}
+
+	if containsString(client.GrantTypes, GrantTypeRefreshToken) {
+		refreshToken, err := randomToken()
+		if err != nil {
+			return nil, err
+		}
+		data, err := json.Marshal(grant)
+		if err != nil {
+			return nil, err
+		}
+		if err = redis.SaveOAuthRefreshToken(s.ctx, refreshToken, data, oauthRefreshTTL()); err != nil {
+			hlog.CtxErrorf(s.ctx, "save oauth refresh token failed: %v", err)
+			return nil, err
+		}
+		resp.RefreshToken = refreshToken
+	}
+
+	if hasScope(grant.Scope, ScopeOpenID) {
+		idClaims := jwt.MapClaims{
+			"iss":       oauthIssuer(),
+			"sub":       strconv.FormatInt(grant.UserID, 10),
+			"aud":       client.ID,
+			"iat":       now.Unix(),
+			"exp":       now.Add(ttl).Unix(),
+			"auth_time": grant.AuthTime,
+		}
+		if grant.Nonce != "" {
+			idClaims["nonce"] = grant.Nonce
+		}
+		if email != "" {
+			idClaims["email"] = email
+		}
+		if resp.IDToken, err = key.sign(idClaims, "JWT"); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
@@ -1,9 +1,24 @@
 package service
 
 import (
+	"fmt"
+	"log"
 	"sync"
 
 	"github.com/casbin/casbin/v2"
+	"zqzqsb.com/gomall/app/user/infra/rbac"
+)
+
+const (
+	// RoleAdmin 管理员角色，可以访问 /admin 下的管理接口
+	RoleAdmin = "admin"
+
+	EffectAllow = "allow"
+	EffectDeny  = "deny"
+
+	// 黑名单以 deny 策略的形式保存，匹配该用户的所有路径和方法
+	blacklistObject = ".*"
+	blacklistAction = ".*"
 )
 
 // PermissionService 权限管理服务
@@ -27,48 +42,164 @@ func NewPermissionService(enforcer *casbin.Enforcer) *PermissionService {
 	return permissionService
 }
 
-// AddPolicy 添加权限策略
-func (s *PermissionService) AddPolicy(sub, obj, act string, eft string) error {
+// Enforce 检查用户是否有权限访问 obj
+func (s *PermissionService) Enforce(sub, obj, act string) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.enforcer.Enforce(sub, obj, act)
+}
+
+// AddPolicy 添加权限策略，策略由适配器写入数据库，返回 false 表示策略已存在
+func (s *PermissionService) AddPolicy(sub, obj, act string, eft string) (bool, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	_, err := s.enforcer.AddPolicy(sub, obj, act, eft)
-	if err != nil {
-		return err
+	return s.enforcer.AddPolicy(sub, obj, act, eft)
+}
+
+// RemovePolicy 删除权限策略，返回 false 表示策略不存在
+func (s *PermissionService) RemovePolicy(sub, obj, act string, eft string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.enforcer.RemovePolicy(sub, obj, act, eft)
+}
+
+// ListPolicies 查询权限策略，sub 为空时返回全部策略
+func (s *PermissionService) ListPolicies(sub string) ([][]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if sub == "" {
+		return s.enforcer.GetPolicy()
 	}
-	return s.enforcer.SavePolicy()
+	return s.enforcer.GetFilteredPolicy(0, sub)
+}
+
+// AddRoleForUser 为用户或角色授予角色，返回 false 表示已拥有该角色
+func (s *PermissionService) AddRoleForUser(sub, role string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.enforcer.AddRoleForUser(sub, role)
 }
 
-// RemovePolicy 删除权限策略
-func (s *PermissionService) RemovePolicy(sub, obj, act string) error {
+// DeleteRoleForUser 撤销用户或角色的角色，返回 false 表示未拥有该角色
+func (s *PermissionService) DeleteRoleForUser(sub, role string) (bool, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	_, err := s.enforcer.RemovePolicy(sub, obj, act)
+	return s.enforcer.DeleteRoleForUser(sub, role)
+}
+
+// ListRoleBindings 查询角色授予关系，每一项为 [用户或角色, 角色]，sub 为空时返回全部
+func (s *PermissionService) ListRoleBindings(sub string) ([][]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if sub == "" {
+		return s.enforcer.GetGroupingPolicy()
+	}
+	return s.enforcer.GetFilteredGroupingPolicy(0, sub)
+}
+
+// HasRole 检查用户是否直接或通过角色继承拥有 role
+func (s *PermissionService) HasRole(sub, role string) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	roles, err := s.enforcer.GetImplicitRolesForUser(sub)
 	if err != nil {
-		return err
+		return false, err
+	}
+	for _, r := range roles {
+		if r == role {
+			return true, nil
+		}
 	}
-	return s.enforcer.SavePolicy()
+	return false, nil
 }
 
 // AddToBlacklist 将用户添加到黑名单
-func (s *PermissionService) AddToBlacklist(userID string) error {
-	return s.AddPolicy(userID, ".*", ".*", "deny")
+func (s *PermissionService) AddToBlacklist(userID string) (bool, error) {
+	return s.AddPolicy(userID, blacklistObject, blacklistAction, EffectDeny)
 }
 
 // RemoveFromBlacklist 将用户从黑名单移除
-func (s *PermissionService) RemoveFromBlacklist(userID string) error {
-	return s.RemovePolicy(userID, ".*", ".*")
+func (s *PermissionService) RemoveFromBlacklist(userID string) (bool, error) {
+	return s.RemovePolicy(userID, blacklistObject, blacklistAction, EffectDeny)
 }
 
 // IsBlacklisted 检查用户是否在黑名单中
 func (s *PermissionService) IsBlacklisted(userID string) (bool, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	return s.enforcer.HasPolicy(userID, ".*", ".*", "deny")
+	return s.enforcer.HasPolicy(userID, blacklistObject, blacklistAction, EffectDeny)
+}
+
+// ListBlacklist 返回黑名单中的用户
+func (s *PermissionService) ListBlacklist() ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	policies, err := s.enforcer.GetFilteredPolicy(1, blacklistObject, blacklistAction, EffectDeny)
+	if err != nil {
+		return nil, err
+	}
+	users := make([]string, 0, len(policies))
+	for _, p := range policies {
+		users = append(users, p[0])
+	}
+	return users, nil
 }
 
-// ReloadPolicy 重新加载权限策略
+// ReloadPolicy 从数据库重新加载全部权限策略
 func (s *PermissionService) ReloadPolicy() error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	return s.enforcer.LoadPolicy()
+	err := s.enforcer.LoadPolicy()
+	rbac.ObserveSync("reload", err)
+	return err
+}
+
+// SyncPolicy 应用其他实例发布的策略变更，作为 rbac.Watcher 的回调。
+// 变更已由发布方写入数据库，这里只更新本地内存中的策略，增量更新失败时退回全量重新加载
+func (s *PermissionService) SyncPolicy(payload string) {
+	msg, err := rbac.ParseMessage(payload)
+	if err != nil {
+		log.Printf("invalid casbin policy message: %v", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	err = s.applyPolicyMessage(msg)
+	if err != nil {
+		log.Printf("apply casbin policy message %s failed, reload all policies: %v", msg.Method, err)
+		err = s.enforcer.LoadPolicy()
+	}
+	rbac.ObserveSync(msg.Method, err)
+}
+
+func (s *PermissionService) applyPolicyMessage(msg *rbac.Message) error {
+	// 关闭自动保存，避免把其他实例已经写入的变更再写一次数据库
+	s.enforcer.EnableAutoSave(false)
+	defer s.enforcer.EnableAutoSave(true)
+
+	// 逐条应用，已存在或已删除的策略不影响其余策略
+	switch msg.Method {
+	case rbac.MethodAddPolicies:
+		for _, rule := range msg.Rules {
+			if _, err := s.enforcer.SelfAddPolicy(msg.Sec, msg.Ptype, rule); err != nil {
+				return err
+			}
+		}
+	case rbac.MethodRemovePolicies:
+		for _, rule := range msg.Rules {
+			if _, err := s.enforcer.SelfRemovePolicy(msg.Sec, msg.Ptype, rule); err != nil {
+				return err
+			}
+		}
+	case rbac.MethodRemoveFilteredPolicy:
+		if _, err := s.enforcer.SelfRemoveFilteredPolicy(msg.Sec, msg.Ptype, msg.FieldIndex, msg.FieldValues...); err != nil {
+			return err
+		}
+	case rbac.MethodUpdate, rbac.MethodSavePolicy:
+		return s.enforcer.LoadPolicy()
+	default:
+		return fmt.Errorf("unknown method %q", msg.Method)
+	}
+	return nil
 }
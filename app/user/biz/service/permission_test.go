@@ -0,0 +1,56 @@
+package service
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/casbin/casbin/v2"
+	"zqzqsb.com/gomall/app/user/infra/rbac"
+)
+
+func TestSyncPolicy(t *testing.T) {
+	enforcer, err := casbin.NewEnforcer("../../conf/rbac_model.conf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &PermissionService{enforcer: enforcer}
+	sync := func(msg *rbac.Message) {
+		data, _ := json.Marshal(msg)
+		s.SyncPolicy(string(data))
+	}
+
+	sync(&rbac.Message{Method: rbac.MethodAddPolicies, Sec: "p", Ptype: "p",
+		Rules: [][]string{{"6", blacklistObject, blacklistAction, EffectDeny}}})
+	if ok, _ := s.IsBlacklisted("6"); !ok {
+		t.Fatal("user 6 should be blacklisted after sync")
+	}
+	// deny 策略覆盖所有 allow 策略
+	if _, err = s.AddPolicy("*", "^/products$", "GET", EffectAllow); err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := s.Enforce("6", "/products", "GET"); ok {
+		t.Fatal("blacklisted user 6 should be denied")
+	}
+	if ok, _ := s.Enforce("8", "/products", "GET"); !ok {
+		t.Fatal("user 8 should be allowed")
+	}
+	// 重复的通知不影响结果
+	sync(&rbac.Message{Method: rbac.MethodAddPolicies, Sec: "p", Ptype: "p",
+		Rules: [][]string{{"6", blacklistObject, blacklistAction, EffectDeny}}})
+
+	sync(&rbac.Message{Method: rbac.MethodAddPolicies, Sec: "g", Ptype: "g", Rules: [][]string{{"7", RoleAdmin}}})
+	if ok, _ := s.HasRole("7", RoleAdmin); !ok {
+		t.Fatal("user 7 should have admin role after sync")
+	}
+
+	sync(&rbac.Message{Method: rbac.MethodRemovePolicies, Sec: "p", Ptype: "p",
+		Rules: [][]string{{"6", blacklistObject, blacklistAction, EffectDeny}}})
+	if ok, _ := s.IsBlacklisted("6"); ok {
+		t.Fatal("user 6 should be removed from blacklist after sync")
+	}
+
+	sync(&rbac.Message{Method: rbac.MethodRemoveFilteredPolicy, Sec: "g", Ptype: "g", FieldValues: []string{"7"}})
+	if ok, _ := s.HasRole("7", RoleAdmin); ok {
+		t.Fatal("user 7 should lose admin role after sync")
+	}
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"zqzqsb.com/gomall/app/user/biz/model"
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+)
+
+var (
+	ErrInvalidNickname  = errors.New("昵称不能超过32个字符")
+	ErrInvalidAvatarURL = errors.New("无效的头像地址")
+	ErrInvalidPhone     = errors.New("无效的手机号")
+	ErrAddressRequired  = errors.New("收货人、联系电话和详细地址不能为空")
+	ErrAddressTooLong   = errors.New("收货地址字段过长")
+	ErrAddressNotFound  = errors.New("收货地址不存在")
+	phoneRegex          = regexp.MustCompile(`^\+?[0-9-]{6,20}$`)
+)
+
+const (
+	maxNicknameLen  = 32
+	maxAvatarURLLen = 512
+)
+
+func validateNickname(nickname string) error {
+	if utf8.RuneCountInString(nickname) > maxNicknameLen {
+		return ErrInvalidNickname
+	}
+	return nil
+}
+
+// validateAvatarURL 头像只允许 http(s) 地址
+func validateAvatarURL(raw string) error {
+	if len(raw) > maxAvatarURLLen {
+		return ErrInvalidAvatarURL
+	}
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidAvatarURL
+	}
+	return nil
+}
+
+func validatePhone(phone string) error {
+	if !phoneRegex.MatchString(phone) {
+		return ErrInvalidPhone
+	}
+	return nil
+}
+
+// validateAddress 校验并规整收货地址，去掉各字段首尾的空白
+func validateAddress(addr *model.Address) error {
+	fields := []*string{&addr.Name, &addr.Phone, &addr.Province, &addr.City, &addr.District, &addr.Detail, &addr.ZipCode}
+	for _, f := range fields {
+		*f = strings.TrimSpace(*f)
+	}
+	if addr.Name == "" || addr.Phone == "" || addr.Detail == "" {
+		return ErrAddressRequired
+	}
+	if err := validatePhone(addr.Phone); err != nil {
+		return err
+	}
+	if utf8.RuneCountInString(addr.Name) > 64 || utf8.RuneCountInString(addr.Province) > 64 ||
+		utf8.RuneCountInString(addr.City) > 64 || utf8.RuneCountInString(addr.District) > 64 ||
+		utf8.RuneCountInString(addr.Detail) > 255 || len(addr.ZipCode) > 16 {
+		return ErrAddressTooLong
+	}
+	return nil
+}
+
+func toProfile(row *model.User) *user.Profile {
+	return &user.Profile{
+		UserId:    int64(row.ID),
+		Email:     row.Email,
+		Nickname:  row.Nickname,
+		AvatarUrl: row.AvatarURL,
+		Phone:     row.Phone,
+	}
+}
+
+func toAddress(addr *model.Address) *user.Address {
+	return &user.Address{
+		Id:        int64(addr.ID),
+		UserId:    addr.UserID,
+		Name:      addr.Name,
+		Phone:     addr.Phone,
+		Province:  addr.Province,
+		City:      addr.City,
+		District:  addr.District,
+		Detail:    addr.Detail,
+		ZipCode:   addr.ZipCode,
+		IsDefault: addr.IsDefault,
+	}
+}
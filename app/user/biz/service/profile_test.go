@@ -0,0 +1,41 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"zqzqsb.com/gomall/app/user/biz/model"
+)
+
+func TestValidateAvatarURL(t *testing.T) {
+	for _, raw := range []string{"https://cdn.example.com/a.png", "http://example.com/a"} {
+		if err := validateAvatarURL(raw); err != nil {
+			t.Fatalf("%s: unexpected err: %v", raw, err)
+		}
+	}
+	for _, raw := range []string{"javascript:alert(1)", "/a.png", "https://", "https://a.com/" + strings.Repeat("a", maxAvatarURLLen)} {
+		if err := validateAvatarURL(raw); err != ErrInvalidAvatarURL {
+			t.Fatalf("%s: want ErrInvalidAvatarURL, got %v", raw, err)
+		}
+	}
+}
+
+func TestValidateAddress(t *testing.T) {
+	addr := &model.Address{Name: " 张三 ", Phone: "13800000000", Detail: " 某路1号 "}
+	if err := validateAddress(addr); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if addr.Name != "张三" || addr.Detail != "某路1号" {
+		t.Fatalf("fields not trimmed: %+v", addr)
+	}
+
+	if err := validateAddress(&model.Address{Name: "张三", Phone: "13800000000", Detail: "  "}); err != ErrAddressRequired {
+		t.Fatalf("want ErrAddressRequired, got %v", err)
+	}
+	if err := validateAddress(&model.Address{Name: "张三", Phone: "call me", Detail: "某路1号"}); err != ErrInvalidPhone {
+		t.Fatalf("want ErrInvalidPhone, got %v", err)
+	}
+	if err := validateAddress(&model.Address{Name: "张三", Phone: "13800000000", Detail: strings.Repeat("路", 256)}); err != ErrAddressTooLong {
+		t.Fatalf("want ErrAddressTooLong, got %v", err)
+	}
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+)
+
+type RefreshTokenService struct {
+	ctx context.Context
+}
+
+// NewRefreshTokenService new RefreshTokenService
+func NewRefreshTokenService(ctx context.Context) *RefreshTokenService {
+	return &RefreshTokenService{ctx: ctx}
+}
+
+// Run 校验刷新令牌并签发新的令牌对，旧的刷新令牌只能使用一次
+func (s *RefreshTokenService) Run(req *user.RefreshTokenReq) (resp *user.RefreshTokenResp, err error) {
+	if req.RefreshToken == "" {
+		return nil, ErrInvalidToken
+	}
+
+	claims, err := ParseToken(req.RefreshToken, TokenTypeRefresh)
+	if err != nil {
+		return nil, err
+	}
+	if err = CheckTokenActive(s.ctx, claims); err != nil {
+		return nil, err
+	}
+
+	// 先吊销旧的刷新令牌，并发使用同一个刷新令牌时只有一个请求能拿到新令牌
+	ok, err := revokeToken(s.ctx, claims)
+	if err != nil {
+		hlog.CtxErrorf(s.ctx, "revoke refresh token failed: %v", err)
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrTokenRevoked
+	}
+
+	token, err := IssueTokenPair(claims.UserID, claims.Version, claims.AuthTime)
+	if err != nil {
+		return nil, err
+	}
+	return &user.RefreshTokenResp{UserId: claims.UserID, Token: token}, nil
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+
+	"zqzqsb.com/gomall/app/user/biz/dal/mysql"
+	"zqzqsb.com/gomall/app/user/biz/model"
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+)
+
+type RegenerateRecoveryCodesService struct {
+	ctx context.Context
+} // NewRegenerateRecoveryCodesService new RegenerateRecoveryCodesService
+func NewRegenerateRecoveryCodesService(ctx context.Context) *RegenerateRecoveryCodesService {
+	return &RegenerateRecoveryCodesService{ctx: ctx}
+}
+
+// Run 校验验证码或恢复码后重新生成恢复码，此前的恢复码全部作废
+func (s *RegenerateRecoveryCodesService) Run(req *user.RegenerateRecoveryCodesReq) (resp *user.RegenerateRecoveryCodesResp, err error) {
+	row, err := currentMFAUser(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !row.MFAEnabled {
+		return nil, ErrMFANotEnabled
+	}
+	if err = checkMFACode(newMFAGuard(s.ctx, row), row, req.Code); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := generateRecoveryCodes(recoveryCodeCount())
+	if err != nil {
+		return nil, err
+	}
+	if err = model.ReplaceRecoveryCodes(mysql.DB, int64(row.ID), hashes); err != nil {
+		return nil, err
+	}
+	return &user.RegenerateRecoveryCodesResp{RecoveryCodes: codes}, nil
+}
@@ -115,6 +115,11 @@ func (s *RegisterService) Run(req *user.RegisterReq) (resp *user.RegisterResp, e
 		return nil, errors.New("用户创建失败")
 	}
 
+	// 8. 发送邮箱验证邮件，发送失败不影响注册，用户可以稍后重新发送
+	if err = sendVerificationEmail(s.ctx, newUser); err != nil {
+		hlog.CtxWarnf(s.ctx, "send verification email to user %d failed: %v", newUser.ID, err)
+	}
+
 	hlog.CtxInfof(s.ctx, "User registered successfully with email: %s", req.Email)
 	return &user.RegisterResp{UserId: int32(newUser.ID)}, nil
 }
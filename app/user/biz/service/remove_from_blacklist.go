@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+)
+
+type RemoveFromBlacklistService struct {
+	ctx context.Context
+} // NewRemoveFromBlacklistService new RemoveFromBlacklistService
+func NewRemoveFromBlacklistService(ctx context.Context) *RemoveFromBlacklistService {
+	return &RemoveFromBlacklistService{ctx: ctx}
+}
+
+// Run 将用户从黑名单移除
+func (s *RemoveFromBlacklistService) Run(req *user.RemoveFromBlacklistReq) (resp *user.RemoveFromBlacklistResp, err error) {
+	svc, _, err := requireAdmin(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserId <= 0 {
+		return nil, ErrInvalidPolicy
+	}
+	removed, err := svc.RemoveFromBlacklist(strconv.FormatInt(req.UserId, 10))
+	if err != nil {
+		return nil, err
+	}
+	return &user.RemoveFromBlacklistResp{Success: removed}, nil
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+)
+
+type RemovePolicyService struct {
+	ctx context.Context
+} // NewRemovePolicyService new RemovePolicyService
+func NewRemovePolicyService(ctx context.Context) *RemovePolicyService {
+	return &RemovePolicyService{ctx: ctx}
+}
+
+// Run 删除权限策略，策略不存在时 success 为 false
+func (s *RemovePolicyService) Run(req *user.RemovePolicyReq) (resp *user.RemovePolicyResp, err error) {
+	svc, _, err := requireAdmin(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = validatePolicy(req.Policy); err != nil {
+		return nil, err
+	}
+	p := req.Policy
+	removed, err := svc.RemovePolicy(p.Subject, p.Object, p.Action, p.Effect)
+	if err != nil {
+		return nil, err
+	}
+	return &user.RemovePolicyResp{Success: removed}, nil
+}
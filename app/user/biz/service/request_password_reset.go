@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
+	"zqzqsb.com/gomall/app/user/biz/dal/mysql"
+	"zqzqsb.com/gomall/app/user/biz/model"
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+)
+
+type RequestPasswordResetService struct {
+	ctx context.Context
+} // NewRequestPasswordResetService new RequestPasswordResetService
+func NewRequestPasswordResetService(ctx context.Context) *RequestPasswordResetService {
+	return &RequestPasswordResetService{ctx: ctx}
+}
+
+// Run 向已注册的邮箱发送重置密码邮件，邮箱未注册时同样返回成功，避免泄露账号是否存在
+func (s *RequestPasswordResetService) Run(req *user.RequestPasswordResetReq) (resp *user.RequestPasswordResetResp, err error) {
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	if !emailRegex.MatchString(email) {
+		return nil, ErrInvalidEmail
+	}
+
+	row, err := model.GetbyEmail(mysql.DB, email)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		hlog.CtxInfof(s.ctx, "password reset requested for unknown email")
+		return &user.RequestPasswordResetResp{Success: true}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err = sendPasswordResetEmail(s.ctx, row); err != nil {
+		hlog.CtxErrorf(s.ctx, "send password reset email to user %d failed: %v", row.ID, err)
+		return nil, errors.New("邮件发送失败，请稍后重试")
+	}
+	return &user.RequestPasswordResetResp{Success: true}, nil
+}
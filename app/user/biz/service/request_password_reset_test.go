@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+	"testing"
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+)
+
+func TestRequestPasswordReset_Run(t *testing.T) {
+	ctx := context.Background()
+	s := NewRequestPasswordResetService(ctx)
+	// init req and assert value
+
+	req := &user.RequestPasswordResetReq{}
+	resp, err := s.Run(req)
+	t.Logf("err: %v", err)
+	t.Logf("resp: %v", resp)
+
+	// todo: edit your unit test
+
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	goredis "github.com/redis/go-redis/v9"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+	"zqzqsb.com/gomall/app/user/biz/dal/mysql"
+	"zqzqsb.com/gomall/app/user/biz/dal/redis"
+	"zqzqsb.com/gomall/app/user/biz/model"
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+)
+
+type ResetPasswordService struct {
+	ctx context.Context
+} // NewResetPasswordService new ResetPasswordService
+func NewResetPasswordService(ctx context.Context) *ResetPasswordService {
+	return &ResetPasswordService{ctx: ctx}
+}
+
+// Run 消费重置密码令牌并设置新密码，同时更新密码修改时间、递增令牌版本，
+// 此前签发的访问令牌和刷新令牌全部失效
+func (s *ResetPasswordService) Run(req *user.ResetPasswordReq) (resp *user.ResetPasswordResp, err error) {
+	if req.Token == "" {
+		return nil, ErrInvalidAccountToken
+	}
+	// 先校验密码再消费令牌，密码不合规时用户可以用同一个链接重试
+	if req.Password != req.PasswordConfirm {
+		return nil, ErrPasswordMismatch
+	}
+	if !NewRegisterService(s.ctx).validatePassword(req.Password) {
+		return nil, ErrPasswordTooWeak
+	}
+	passwordHashed, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		hlog.CtxErrorf(s.ctx, "Failed to hash password: %v", err)
+		return nil, errors.New("服务器内部错误")
+	}
+
+	userID, err := redis.TakeAccountToken(s.ctx, accountTokenResetPassword, req.Token)
+	if errors.Is(err, goredis.Nil) {
+		return nil, ErrInvalidAccountToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := model.ResetPassword(mysql.DB, userID, string(passwordHashed))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrInvalidAccountToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	// 缓存中没有版本时网关视为未吊销，不能删除缓存，只能重试覆盖；
+	// 仍然失败时返回错误，不把旧令牌仍然有效的重置报告为成功
+	if err = setTokenVersion(s.ctx, userID, version); err != nil {
+		hlog.CtxErrorf(s.ctx, "set token version of user %d failed: %v", userID, err)
+		return nil, err
+	}
+	hlog.CtxInfof(s.ctx, "password of user %d reset", userID)
+	return &user.ResetPasswordResp{UserId: userID}, nil
+}
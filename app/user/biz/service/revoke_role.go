@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+)
+
+type RevokeRoleService struct {
+	ctx context.Context
+} // NewRevokeRoleService new RevokeRoleService
+func NewRevokeRoleService(ctx context.Context) *RevokeRoleService {
+	return &RevokeRoleService{ctx: ctx}
+}
+
+// Run 撤销用户或角色的角色，管理员不能撤销自己的 admin 角色，避免没有管理员可用
+func (s *RevokeRoleService) Run(req *user.RevokeRoleReq) (resp *user.RevokeRoleResp, err error) {
+	svc, userID, err := requireAdmin(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = validateRoleBinding(req.Binding); err != nil {
+		return nil, err
+	}
+	if req.Binding.Role == RoleAdmin && req.Binding.Subject == strconv.FormatInt(userID, 10) {
+		return nil, ErrAdminSelf
+	}
+	removed, err := svc.DeleteRoleForUser(req.Binding.Subject, req.Binding.Role)
+	if err != nil {
+		return nil, err
+	}
+	return &user.RevokeRoleResp{Success: removed}, nil
+}
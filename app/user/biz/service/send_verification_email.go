@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
+	"zqzqsb.com/gomall/app/user/biz/dal/mysql"
+	"zqzqsb.com/gomall/app/user/biz/model"
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+)
+
+type SendVerificationEmailService struct {
+	ctx context.Context
+} // NewSendVerificationEmailService new SendVerificationEmailService
+func NewSendVerificationEmailService(ctx context.Context) *SendVerificationEmailService {
+	return &SendVerificationEmailService{ctx: ctx}
+}
+
+// Run 重新发送邮箱验证邮件，邮箱未注册或已验证时同样返回成功，避免泄露账号是否存在
+func (s *SendVerificationEmailService) Run(req *user.SendVerificationEmailReq) (resp *user.SendVerificationEmailResp, err error) {
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	if !emailRegex.MatchString(email) {
+		return nil, ErrInvalidEmail
+	}
+
+	row, err := model.GetbyEmail(mysql.DB, email)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &user.SendVerificationEmailResp{Success: true}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if row.EmailVerified {
+		return &user.SendVerificationEmailResp{Success: true}, nil
+	}
+
+	if err = sendVerificationEmail(s.ctx, row); err != nil {
+		hlog.CtxErrorf(s.ctx, "send verification email to user %d failed: %v", row.ID, err)
+		return nil, errors.New("邮件发送失败，请稍后重试")
+	}
+	return &user.SendVerificationEmailResp{Success: true}, nil
+}
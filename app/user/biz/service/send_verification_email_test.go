@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+	"testing"
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+)
+
+func TestSendVerificationEmail_Run(t *testing.T) {
+	ctx := context.Background()
+	s := NewSendVerificationEmailService(ctx)
+	// init req and assert value
+
+	req := &user.SendVerificationEmailReq{}
+	resp, err := s.Run(req)
+	t.Logf("err: %v", err)
+	t.Logf("resp: %v", resp)
+
+	// todo: edit your unit test
+
+}
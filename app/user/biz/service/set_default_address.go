@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"zqzqsb.com/gomall/app/user/biz/dal/mysql"
+	"zqzqsb.com/gomall/app/user/biz/model"
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+	"zqzqsb.com/gomall/common/identity"
+)
+
+type SetDefaultAddressService struct {
+	ctx context.Context
+} // NewSetDefaultAddressService new SetDefaultAddressService
+func NewSetDefaultAddressService(ctx context.Context) *SetDefaultAddressService {
+	return &SetDefaultAddressService{ctx: ctx}
+}
+
+// Run 将收货地址设为当前用户的默认地址
+func (s *SetDefaultAddressService) Run(req *user.SetDefaultAddressReq) (resp *user.SetDefaultAddressResp, err error) {
+	userID, err := identity.GetUserID(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := model.SetDefaultAddress(mysql.DB, userID, uint(req.AddressId))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrAddressNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &user.SetDefaultAddressResp{Address: toAddress(addr)}, nil
+}
@@ -0,0 +1,254 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/golang-jwt/jwt/v4"
+	goredis "github.com/redis/go-redis/v9"
+	"zqzqsb.com/gomall/app/user/biz/dal/mysql"
+	"zqzqsb.com/gomall/app/user/biz/dal/redis"
+	"zqzqsb.com/gomall/app/user/biz/model"
+	"zqzqsb.com/gomall/app/user/conf"
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+)
+
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+	TokenTypeMFA     = "mfa" // 密码校验通过、等待二次验证的令牌，只能用于 VerifyMFA
+
+	// 访问令牌沿用 identity 作为用户 ID 的声明，与其他服务的 JWT 中间件保持一致；
+	// 刷新令牌和二次验证令牌使用 uid，避免被其他服务当作访问令牌接受
+	claimIdentity = "identity"
+	claimUserID   = "uid"
+	claimJTI      = "jti"
+	claimType     = "typ"
+	claimVersion  = "ver"
+	claimExpire   = "exp"
+
+	defaultAccessTTL  = 15 * time.Minute
+	defaultRefreshTTL = 7 * 24 * time.Hour
+)
+
+var (
+	ErrInvalidToken = errors.New("无效的令牌")
+	ErrTokenRevoked = errors.New("令牌已失效，请重新登录")
+	ErrMFARequired  = errors.New("需要完成二次验证")
+)
+
+// TokenClaims 解析后的令牌声明
+type TokenClaims struct {
+	UserID    int64
+	JTI       string
+	Type      string
+	Version   int64
+	ExpiresAt time.Time
+	AuthTime  time.Time // 首次登录时间，刷新令牌时保持不变
+}
+
+func accessTTL() time.Duration {
+	if ttl := conf.GetConf().Jwt.AccessTTL; ttl > 0 {
+		return ttl
+	}
+	return defaultAccessTTL
+}
+
+func refreshTTL() time.Duration {
+	if ttl := conf.GetConf().Jwt.RefreshTTL; ttl > 0 {
+		return ttl
+	}
+	return defaultRefreshTTL
+}
+
+func signingKey() []byte {
+	return []byte(conf.GetConf().Jwt.Secret)
+}
+
+func newJTI() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func signToken(userID int64, typ string, version int64, now, authTime time.Time, ttl time.Duration) (string, time.Time, error) {
+	jti, err := newJTI()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	expire := now.Add(ttl)
+	claims := jwt.MapClaims{
+		claimJTI:     jti,
+		claimType:    typ,
+		claimVersion: version,
+		claimExpire:  expire.Unix(),
+		"iat":        now.Unix(),
+		"orig_iat":   authTime.Unix(),
+	}
+	if typ == TokenTypeAccess {
+		claims[claimIdentity] = userID
+	} else {
+		claims[claimUserID] = userID
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(signingKey())
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return token, expire, nil
+}
+
+// IssueTokenPair 为用户签发一对访问令牌和刷新令牌，version 为用户当前的令牌版本，
+// authTime 为用户登录时间，为零值时取当前时间
+func IssueTokenPair(userID, version int64, authTime time.Time) (*user.TokenPair, error) {
+	now := time.Now()
+	if authTime.IsZero() {
+		authTime = now
+	}
+	access, accessExpire, err := signToken(userID, TokenTypeAccess, version, now, authTime, accessTTL())
+	if err != nil {
+		return nil, err
+	}
+	refresh, refreshExpire, err := signToken(userID, TokenTypeRefresh, version, now, authTime, refreshTTL())
+	if err != nil {
+		return nil, err
+	}
+	return &user.TokenPair{
+		AccessToken:   access,
+		AccessExpire:  accessExpire.Unix(),
+		RefreshToken:  refresh,
+		RefreshExpire: refreshExpire.Unix(),
+	}, nil
+}
+
+// ParseToken 校验签名和有效期并解析出指定类型的令牌声明
+func ParseToken(tokenString, typ string) (*TokenClaims, error) {
+	token, err := jwt.Parse(tokenString, func(t *jwt.Token) (interface{}, error) {
+		if t.Method != jwt.SigningMethodHS256 {
+			return nil, ErrInvalidToken
+		}
+		return signingKey(), nil
+	})
+	if err != nil || !token.Valid {
+		return nil, ErrInvalidToken
+	}
+	claims, err := ClaimsFromMap(token.Claims.(jwt.MapClaims))
+	if err != nil {
+		return nil, err
+	}
+	if claims.Type != typ {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
+}
+
+// ClaimsFromMap 从 JWT 载荷中提取令牌声明，数字类型的声明在 JSON 解码后为 float64
+func ClaimsFromMap(m map[string]interface{}) (*TokenClaims, error) {
+	claims := &TokenClaims{}
+	claims.JTI, _ = m[claimJTI].(string)
+	claims.Type, _ = m[claimType].(string)
+	if claims.JTI == "" || claims.Type == "" {
+		return nil, ErrInvalidToken
+	}
+
+	idKey := claimIdentity
+	if claims.Type != TokenTypeAccess {
+		idKey = claimUserID
+	}
+	id, ok := m[idKey].(float64)
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+	claims.UserID = int64(id)
+
+	ver, ok := m[claimVersion].(float64)
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+	claims.Version = int64(ver)
+
+	exp, ok := m[claimExpire].(float64)
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+	claims.ExpiresAt = time.Unix(int64(exp), 0)
+
+	if origIat, ok := m["orig_iat"].(float64); ok {
+		claims.AuthTime = time.Unix(int64(origIat), 0)
+	}
+	return claims, nil
+}
+
+// CheckTokenActive 检查令牌是否已被吊销，或者签发后用户的令牌版本已被递增
+func CheckTokenActive(ctx context.Context, claims *TokenClaims) error {
+	revoked, err := redis.IsTokenRevoked(ctx, claims.JTI)
+	if err != nil {
+		return fmt.Errorf("check token blacklist: %w", err)
+	}
+	if revoked {
+		return ErrTokenRevoked
+	}
+	version, err := currentTokenVersion(ctx, claims.UserID)
+	if err != nil {
+		return err
+	}
+	if claims.Version != version {
+		return ErrTokenRevoked
+	}
+	return nil
+}
+
+// currentTokenVersion 优先读取 Redis 缓存，未命中时回源数据库
+func currentTokenVersion(ctx context.Context, userID int64) (int64, error) {
+	version, err := redis.GetTokenVersion(ctx, userID)
+	if err == nil {
+		return version, nil
+	}
+	if !errors.Is(err, goredis.Nil) {
+		return 0, fmt.Errorf("get token version: %w", err)
+	}
+
+	row, err := model.GetByID(mysql.DB, userID)
+	if err != nil {
+		return 0, err
+	}
+	if err := redis.FillTokenVersion(ctx, userID, row.TokenVersion, refreshTTL()); err != nil {
+		hlog.CtxWarnf(ctx, "fill token version of user %d failed: %v", userID, err)
+	}
+	return row.TokenVersion, nil
+}
+
+// revokeToken 将令牌加入黑名单直到其自然过期，返回 false 表示该令牌此前已被吊销
+func revokeToken(ctx context.Context, claims *TokenClaims) (bool, error) {
+	return redis.RevokeToken(ctx, claims.JTI, time.Until(claims.ExpiresAt))
+}
+
+// RevokeAllTokens 递增用户的令牌版本，使此前签发的所有令牌失效
+func RevokeAllTokens(ctx context.Context, userID int64) error {
+	version, err := model.BumpTokenVersion(mysql.DB, userID)
+	if err != nil {
+		return err
+	}
+	return setTokenVersion(ctx, userID, version)
+}
+
+// setTokenVersionAttempts 令牌版本写入缓存的最大尝试次数
+const setTokenVersionAttempts = 3
+
+// setTokenVersion 将递增后的令牌版本写入缓存，失败时重试
+// 缓存中的旧版本要到刷新令牌有效期结束才过期，写入失败期间旧令牌仍能通过校验
+func setTokenVersion(ctx context.Context, userID, version int64) (err error) {
+	for i := 0; i < setTokenVersionAttempts; i++ {
+		if err = redis.SetTokenVersion(ctx, userID, version, refreshTTL()); err == nil {
+			return nil
+		}
+		hlog.CtxWarnf(ctx, "set token version of user %d failed, attempt %d: %v", userID, i+1, err)
+	}
+	return err
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClaimsFromMap(t *testing.T) {
+	exp := time.Now().Add(time.Hour).Unix()
+
+	access, err := ClaimsFromMap(map[string]interface{}{
+		"identity": float64(7), "jti": "a", "typ": TokenTypeAccess, "ver": float64(2), "exp": float64(exp),
+	})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if access.UserID != 7 || access.Version != 2 || access.ExpiresAt.Unix() != exp {
+		t.Fatalf("unexpected claims: %+v", access)
+	}
+
+	// 刷新令牌使用 uid 而不是 identity
+	if _, err := ClaimsFromMap(map[string]interface{}{
+		"identity": float64(7), "jti": "r", "typ": TokenTypeRefresh, "ver": float64(0), "exp": float64(exp),
+	}); err != ErrInvalidToken {
+		t.Fatalf("want ErrInvalidToken, got %v", err)
+	}
+	refresh, err := ClaimsFromMap(map[string]interface{}{
+		"uid": float64(7), "jti": "r", "typ": TokenTypeRefresh, "ver": float64(0), "exp": float64(exp),
+	})
+	if err != nil || refresh.UserID != 7 {
+		t.Fatalf("unexpected refresh claims: %+v, %v", refresh, err)
+	}
+
+	// 旧版本签发的令牌没有 jti 和版本号
+	if _, err := ClaimsFromMap(map[string]interface{}{"identity": float64(7), "exp": float64(exp)}); err != ErrInvalidToken {
+		t.Fatalf("want ErrInvalidToken, got %v", err)
+	}
+}
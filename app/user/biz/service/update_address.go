@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"zqzqsb.com/gomall/app/user/biz/dal/mysql"
+	"zqzqsb.com/gomall/app/user/biz/model"
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+	"zqzqsb.com/gomall/common/identity"
+)
+
+type UpdateAddressService struct {
+	ctx context.Context
+} // NewUpdateAddressService new UpdateAddressService
+func NewUpdateAddressService(ctx context.Context) *UpdateAddressService {
+	return &UpdateAddressService{ctx: ctx}
+}
+
+// Run 修改当前用户的收货地址，已下单的订单保存的是地址快照，不受影响
+func (s *UpdateAddressService) Run(req *user.UpdateAddressReq) (resp *user.UpdateAddressResp, err error) {
+	userID, err := identity.GetUserID(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.AddressId <= 0 {
+		return nil, ErrAddressNotFound
+	}
+
+	addr := &model.Address{
+		UserID:   userID,
+		Name:     req.Name,
+		Phone:    req.Phone,
+		Province: req.Province,
+		City:     req.City,
+		District: req.District,
+		Detail:   req.Detail,
+		ZipCode:  req.ZipCode,
+	}
+	addr.ID = uint(req.AddressId)
+	if err = validateAddress(addr); err != nil {
+		return nil, err
+	}
+	err = model.UpdateAddress(mysql.DB, addr)
+	if err == nil {
+		addr, err = model.GetAddress(mysql.DB, userID, addr.ID)
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrAddressNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &user.UpdateAddressResp{Address: toAddress(addr)}, nil
+}
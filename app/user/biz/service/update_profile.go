@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"zqzqsb.com/gomall/app/user/biz/dal/mysql"
+	"zqzqsb.com/gomall/app/user/biz/model"
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+	"zqzqsb.com/gomall/common/identity"
+)
+
+type UpdateProfileService struct {
+	ctx context.Context
+} // NewUpdateProfileService new UpdateProfileService
+func NewUpdateProfileService(ctx context.Context) *UpdateProfileService {
+	return &UpdateProfileService{ctx: ctx}
+}
+
+// Run 更新当前用户的昵称、头像和手机号，为空的字段不修改
+func (s *UpdateProfileService) Run(req *user.UpdateProfileReq) (resp *user.UpdateProfileResp, err error) {
+	userID, err := identity.GetUserID(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]interface{}, 3)
+	if nickname := strings.TrimSpace(req.Nickname); nickname != "" {
+		if err = validateNickname(nickname); err != nil {
+			return nil, err
+		}
+		fields["nickname"] = nickname
+	}
+	if avatar := strings.TrimSpace(req.AvatarUrl); avatar != "" {
+		if err = validateAvatarURL(avatar); err != nil {
+			return nil, err
+		}
+		fields["avatar_url"] = avatar
+	}
+	if phone := strings.TrimSpace(req.Phone); phone != "" {
+		if err = validatePhone(phone); err != nil {
+			return nil, err
+		}
+		fields["phone"] = phone
+	}
+	if len(fields) > 0 {
+		if err = model.UpdateProfile(mysql.DB, userID, fields); err != nil {
+			return nil, err
+		}
+	}
+
+	row, err := model.GetByID(mysql.DB, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &user.UpdateProfileResp{Profile: toProfile(row)}, nil
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	goredis "github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+	"zqzqsb.com/gomall/app/user/biz/dal/mysql"
+	"zqzqsb.com/gomall/app/user/biz/dal/redis"
+	"zqzqsb.com/gomall/app/user/biz/model"
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+)
+
+type VerifyEmailService struct {
+	ctx context.Context
+} // NewVerifyEmailService new VerifyEmailService
+func NewVerifyEmailService(ctx context.Context) *VerifyEmailService {
+	return &VerifyEmailService{ctx: ctx}
+}
+
+// Run 消费邮箱验证令牌并将邮箱标记为已验证，令牌使用一次后即失效
+func (s *VerifyEmailService) Run(req *user.VerifyEmailReq) (resp *user.VerifyEmailResp, err error) {
+	if req.Token == "" {
+		return nil, ErrInvalidAccountToken
+	}
+	userID, err := redis.TakeAccountToken(s.ctx, accountTokenVerifyEmail, req.Token)
+	if errors.Is(err, goredis.Nil) {
+		return nil, ErrInvalidAccountToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = model.MarkEmailVerified(mysql.DB, userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrInvalidAccountToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &user.VerifyEmailResp{UserId: userID}, nil
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"zqzqsb.com/gomall/app/user/biz/dal/mysql"
+	"zqzqsb.com/gomall/app/user/biz/model"
+	"zqzqsb.com/gomall/app/user/biz/utils"
+	user "zqzqsb.com/gomall/app/user/kitex_gen/user"
+)
+
+type VerifyMFAService struct {
+	ctx context.Context
+} // NewVerifyMFAService new VerifyMFAService
+func NewVerifyMFAService(ctx context.Context) *VerifyMFAService {
+	return &VerifyMFAService{ctx: ctx}
+}
+
+// Run 校验登录时签发的 mfa_token 和验证码，通过后签发访问令牌和刷新令牌，mfa_token 随即作废
+func (s *VerifyMFAService) Run(req *user.VerifyMFAReq) (resp *user.VerifyMFAResp, err error) {
+	if req.MfaToken == "" {
+		return nil, ErrInvalidToken
+	}
+	claims, err := ParseToken(req.MfaToken, TokenTypeMFA)
+	if err != nil {
+		return nil, err
+	}
+	if err = CheckTokenActive(s.ctx, claims); err != nil {
+		return nil, err
+	}
+
+	row, err := model.GetByID(mysql.DB, claims.UserID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrInvalidToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !row.MFAEnabled {
+		return nil, ErrMFANotEnabled
+	}
+
+	guard := newMFAGuard(s.ctx, row)
+	userAgent := utils.GetUserAgent(s.ctx)
+	if err = checkMFACode(guard, row, req.Code); err != nil {
+		if errors.Is(err, ErrInvalidMFACode) {
+			guard.audit(claims.UserID, model.LoginResultMFAFailed, userAgent)
+		} else {
+			guard.auditThrottled(claims.UserID, err, userAgent)
+		}
+		return nil, err
+	}
+
+	// mfa_token 只能使用一次，并发提交时只有一个请求能拿到令牌
+	revoked, err := revokeToken(s.ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+	if !revoked {
+		return nil, ErrTokenRevoked
+	}
+	guard.recordSuccess()
+
+	token, err := IssueTokenPair(claims.UserID, row.TokenVersion, claims.AuthTime)
+	if err != nil {
+		return nil, err
+	}
+	guard.audit(claims.UserID, model.LoginResultSuccess, userAgent)
+
+	return &user.VerifyMFAResp{
+		UserId: claims.UserID,
+		Token:  token,
+	}, nil
+}
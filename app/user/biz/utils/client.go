@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"context"
+	"net"
+
+	"github.com/bytedance/gopkg/cloud/metainfo"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"zqzqsb.com/gomall/common/identity"
+)
+
+type clientInfoKey struct{}
+
+type clientInfo struct {
+	ip        string
+	userAgent string
+}
+
+// WithClientInfo 将客户端 IP 和 User-Agent 写入上下文，HTTP 请求由 handler 写入
+// handler 中的 c.ClientIP() 由 initHertz 挂载的 clientip.New 解析，只采信可信代理的转发头，登录风控按 IP 计数不会被伪造绕过
+func WithClientInfo(ctx context.Context, ip, userAgent string) context.Context {
+	return context.WithValue(ctx, clientInfoKey{}, clientInfo{ip: ip, userAgent: userAgent})
+}
+
+// GetClientIP 获取客户端IP，RPC 请求优先取网关透传的客户端 IP，没有时取调用方地址
+func GetClientIP(ctx context.Context) string {
+	if info, ok := ctx.Value(clientInfoKey{}).(clientInfo); ok {
+		return info.ip
+	}
+	if ip, ok := metainfo.GetPersistentValue(ctx, identity.ClientIPKey); ok && ip != "" {
+		return ip
+	}
+	ri := rpcinfo.GetRPCInfo(ctx)
+	if ri == nil || ri.From() == nil || ri.From().Address() == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(ri.From().Address().String())
+	if err != nil {
+		return ri.From().Address().String()
+	}
+	return host
+}
+
+// GetUserAgent 获取客户端 User-Agent，RPC 请求取网关透传的值
+func GetUserAgent(ctx context.Context) string {
+	if info, ok := ctx.Value(clientInfoKey{}).(clientInfo); ok {
+		return info.userAgent
+	}
+	ua, _ := metainfo.GetPersistentValue(ctx, identity.UserAgentKey)
+	return ua
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/kr/pretty"
 	"gopkg.in/validator.v2"
 	"gopkg.in/yaml.v2"
+	"zqzqsb.com/gomall/common/ratelimit"
 )
 
 var (
@@ -25,6 +26,14 @@ type Config struct {
 	Redis       Redis       `yaml:"redis"`
 	RedisCluster RedisCluster `yaml:"redis_cluster"`
 	Registry    Registry    `yaml:"registry"`
+	Jwt         Jwt         `yaml:"jwt"`
+	OAuth       OAuth       `yaml:"oauth"`
+	RateLimit   RateLimit   `yaml:"rate_limit"`
+	Mail        Mail        `yaml:"mail"`
+	Account     Account     `yaml:"account"`
+	Login       Login       `yaml:"login"`
+	MFA         MFA         `yaml:"mfa"`
+	Casbin      Casbin      `yaml:"casbin"`
 }
 
 type MySQL struct {
@@ -59,12 +68,119 @@ type Kitex struct {
 	LogMaxBackups int    `yaml:"log_max_backups"`
 	LogMaxAge     int    `yaml:"log_max_age"`
 	MetricsPort   string `yaml:"metrics_port"`
+	// TrustedProxies HTTP 请求经过的可信代理（IP 或 CIDR），只有来自这些地址的 X-Forwarded-For 才被采信
+	TrustedProxies []string `yaml:"trusted_proxies"`
 }
 
 type Registry struct {
 	RegistryAddress []string `yaml:"registry_address"`
 	Username        string   `yaml:"username"`
 	Password        string   `yaml:"password"`
+	Weight          int      `yaml:"weight"`  // 实例权重，调用方按权重负载均衡
+	Version         string   `yaml:"version"` // 作为 version 标签注册到 Consul
+}
+
+// Address 首个注册中心地址，未配置时返回空，不注册也不发现服务
+func (r Registry) Address() string {
+	if len(r.RegistryAddress) == 0 {
+		return ""
+	}
+	return r.RegistryAddress[0]
+}
+
+// Jwt 令牌签发配置，secret 需与其他服务校验 JWT 时使用的保持一致
+type Jwt struct {
+	Secret     string        `yaml:"secret"`
+	AccessTTL  time.Duration `yaml:"access_ttl"`  // 访问令牌有效期
+	RefreshTTL time.Duration `yaml:"refresh_ttl"` // 刷新令牌有效期
+}
+
+// OAuth OAuth2/OIDC 授权服务配置
+type OAuth struct {
+	Issuer         string        `yaml:"issuer"`           // 签发者，也是各端点的对外地址前缀
+	PrivateKeyFile string        `yaml:"private_key_file"` // RS256 签名私钥（PEM），为空时启动后临时生成
+	LoginURL       string        `yaml:"login_url"`        // 未登录时跳转的登录页，授权地址通过 redirect 参数带回
+	CodeTTL        time.Duration `yaml:"code_ttl"`         // 授权码有效期
+	AccessTTL      time.Duration `yaml:"access_ttl"`       // 访问令牌有效期
+	RefreshTTL     time.Duration `yaml:"refresh_ttl"`      // 刷新令牌有效期
+	Clients        []OAuthClient `yaml:"clients"`
+}
+
+// OAuthClient 注册的 OAuth2 客户端
+type OAuthClient struct {
+	ID           string   `yaml:"id"`
+	Secret       string   `yaml:"secret"` // 为空表示公开客户端（如前端单页应用），必须使用 PKCE
+	Name         string   `yaml:"name"`
+	RedirectURIs []string `yaml:"redirect_uris"`
+	GrantTypes   []string `yaml:"grant_types"`
+	Scopes       []string `yaml:"scopes"`
+}
+
+// RateLimit 限流配置，Server 同时用于 RPC 和 HTTP 请求，Auth 为 /login、/register 的分布式令牌桶
+type RateLimit struct {
+	Server ratelimit.Config `yaml:"server"`
+	Auth   ratelimit.Rule   `yaml:"auth"`
+}
+
+// Mail 邮件发送配置，driver 为 smtp、file（写入 outbox_dir）或 memory，为空时使用 memory
+type Mail struct {
+	Driver    string `yaml:"driver"`
+	From      string `yaml:"from"`
+	SMTP      SMTP   `yaml:"smtp"`
+	OutboxDir string `yaml:"outbox_dir"`
+}
+
+type SMTP struct {
+	Host     string `yaml:"host"`
+	Port     int    `yaml:"port"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+}
+
+// Account 邮箱验证和找回密码配置
+type Account struct {
+	VerifyTokenTTL       time.Duration `yaml:"verify_token_ttl"`       // 邮箱验证令牌有效期
+	ResetTokenTTL        time.Duration `yaml:"reset_token_ttl"`        // 重置密码令牌有效期
+	RequireVerifiedEmail bool          `yaml:"require_verified_email"` // 开启后邮箱未验证的用户不能登录
+	VerifyURL            string        `yaml:"verify_url"`             // 邮件中的验证页面地址，令牌通过 token 参数带上
+	ResetURL             string        `yaml:"reset_url"`              // 邮件中的重置密码页面地址，令牌通过 token 参数带上
+}
+
+// Login 登录防暴力破解配置，分别按账号和来源 IP 统计连续失败次数
+type Login struct {
+	Account LoginThrottle `yaml:"account"`
+	IP      LoginThrottle `yaml:"ip"`
+}
+
+// LoginThrottle 连续失败 DelayAfter 次后，每次失败需要等待的时间从 BaseDelay 开始翻倍，不超过 MaxDelay；
+// 连续失败 LockAfter 次后锁定 LockDuration。Window 内没有新的失败时计数清零，LockAfter 为 0 表示不限制
+type LoginThrottle struct {
+	Window       time.Duration `yaml:"window"`
+	DelayAfter   int64         `yaml:"delay_after"`
+	BaseDelay    time.Duration `yaml:"base_delay"`
+	MaxDelay     time.Duration `yaml:"max_delay"`
+	LockAfter    int64         `yaml:"lock_after"`
+	LockDuration time.Duration `yaml:"lock_duration"`
+}
+
+// MFA 二次验证配置
+type MFA struct {
+	Issuer        string        `yaml:"issuer"`         // 验证器应用中显示的服务名称
+	PendingTTL    time.Duration `yaml:"pending_ttl"`    // 密码校验通过后等待二次验证的令牌有效期
+	EnrollTTL     time.Duration `yaml:"enroll_ttl"`     // 生成密钥后完成绑定的期限
+	Skew          int           `yaml:"skew"`           // 允许前后偏差的时间步数
+	RecoveryCodes int           `yaml:"recovery_codes"` // 每次生成的恢复码个数
+}
+
+// Casbin 权限策略配置，策略保存在 MySQL 中，数据库为空时从 PolicyFile 导入初始策略
+type Casbin struct {
+	ModelFile  string  `yaml:"model_file"`
+	PolicyFile string  `yaml:"policy_file"`
+	Admins     []int64 `yaml:"admins"` // 启动时授予 admin 角色的用户ID，用于初始化第一个管理员
+
+	// 策略变更通过 Redis 发布订阅同步到其他实例，SyncInterval 为定期全量重新加载的间隔，用于兜底连接中断期间丢失的通知，0 表示不定期加载
+	Channel      string        `yaml:"channel"`
+	SyncInterval time.Duration `yaml:"sync_interval"`
 }
 
 // GetConf gets configuration instance
@@ -29,3 +29,206 @@ func (s *UserServiceImpl) Hello(ctx context.Context, req *user.HelloReq) (resp *
 
 	return resp, err
 }
+
+// RefreshToken implements the UserServiceImpl interface.
+func (s *UserServiceImpl) RefreshToken(ctx context.Context, req *user.RefreshTokenReq) (resp *user.RefreshTokenResp, err error) {
+	resp, err = service.NewRefreshTokenService(ctx).Run(req)
+
+	return resp, err
+}
+
+// Logout implements the UserServiceImpl interface.
+func (s *UserServiceImpl) Logout(ctx context.Context, req *user.LogoutReq) (resp *user.LogoutResp, err error) {
+	resp, err = service.NewLogoutService(ctx).Run(req)
+
+	return resp, err
+}
+
+// GetProfile implements the UserServiceImpl interface.
+func (s *UserServiceImpl) GetProfile(ctx context.Context, req *user.GetProfileReq) (resp *user.GetProfileResp, err error) {
+	resp, err = service.NewGetProfileService(ctx).Run(req)
+
+	return resp, err
+}
+
+// UpdateProfile implements the UserServiceImpl interface.
+func (s *UserServiceImpl) UpdateProfile(ctx context.Context, req *user.UpdateProfileReq) (resp *user.UpdateProfileResp, err error) {
+	resp, err = service.NewUpdateProfileService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ListAddresses implements the UserServiceImpl interface.
+func (s *UserServiceImpl) ListAddresses(ctx context.Context, req *user.ListAddressesReq) (resp *user.ListAddressesResp, err error) {
+	resp, err = service.NewListAddressesService(ctx).Run(req)
+
+	return resp, err
+}
+
+// GetAddress implements the UserServiceImpl interface.
+func (s *UserServiceImpl) GetAddress(ctx context.Context, req *user.GetAddressReq) (resp *user.GetAddressResp, err error) {
+	resp, err = service.NewGetAddressService(ctx).Run(req)
+
+	return resp, err
+}
+
+// CreateAddress implements the UserServiceImpl interface.
+func (s *UserServiceImpl) CreateAddress(ctx context.Context, req *user.CreateAddressReq) (resp *user.CreateAddressResp, err error) {
+	resp, err = service.NewCreateAddressService(ctx).Run(req)
+
+	return resp, err
+}
+
+// UpdateAddress implements the UserServiceImpl interface.
+func (s *UserServiceImpl) UpdateAddress(ctx context.Context, req *user.UpdateAddressReq) (resp *user.UpdateAddressResp, err error) {
+	resp, err = service.NewUpdateAddressService(ctx).Run(req)
+
+	return resp, err
+}
+
+// DeleteAddress implements the UserServiceImpl interface.
+func (s *UserServiceImpl) DeleteAddress(ctx context.Context, req *user.DeleteAddressReq) (resp *user.DeleteAddressResp, err error) {
+	resp, err = service.NewDeleteAddressService(ctx).Run(req)
+
+	return resp, err
+}
+
+// SetDefaultAddress implements the UserServiceImpl interface.
+func (s *UserServiceImpl) SetDefaultAddress(ctx context.Context, req *user.SetDefaultAddressReq) (resp *user.SetDefaultAddressResp, err error) {
+	resp, err = service.NewSetDefaultAddressService(ctx).Run(req)
+
+	return resp, err
+}
+
+// SendVerificationEmail implements the UserServiceImpl interface.
+func (s *UserServiceImpl) SendVerificationEmail(ctx context.Context, req *user.SendVerificationEmailReq) (resp *user.SendVerificationEmailResp, err error) {
+	resp, err = service.NewSendVerificationEmailService(ctx).Run(req)
+
+	return resp, err
+}
+
+// VerifyEmail implements the UserServiceImpl interface.
+func (s *UserServiceImpl) VerifyEmail(ctx context.Context, req *user.VerifyEmailReq) (resp *user.VerifyEmailResp, err error) {
+	resp, err = service.NewVerifyEmailService(ctx).Run(req)
+
+	return resp, err
+}
+
+// RequestPasswordReset implements the UserServiceImpl interface.
+func (s *UserServiceImpl) RequestPasswordReset(ctx context.Context, req *user.RequestPasswordResetReq) (resp *user.RequestPasswordResetResp, err error) {
+	resp, err = service.NewRequestPasswordResetService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ResetPassword implements the UserServiceImpl interface.
+func (s *UserServiceImpl) ResetPassword(ctx context.Context, req *user.ResetPasswordReq) (resp *user.ResetPasswordResp, err error) {
+	resp, err = service.NewResetPasswordService(ctx).Run(req)
+
+	return resp, err
+}
+
+// VerifyMFA implements the UserServiceImpl interface.
+func (s *UserServiceImpl) VerifyMFA(ctx context.Context, req *user.VerifyMFAReq) (resp *user.VerifyMFAResp, err error) {
+	resp, err = service.NewVerifyMFAService(ctx).Run(req)
+
+	return resp, err
+}
+
+// GetMFAStatus implements the UserServiceImpl interface.
+func (s *UserServiceImpl) GetMFAStatus(ctx context.Context, req *user.GetMFAStatusReq) (resp *user.GetMFAStatusResp, err error) {
+	resp, err = service.NewGetMFAStatusService(ctx).Run(req)
+
+	return resp, err
+}
+
+// EnrollMFA implements the UserServiceImpl interface.
+func (s *UserServiceImpl) EnrollMFA(ctx context.Context, req *user.EnrollMFAReq) (resp *user.EnrollMFAResp, err error) {
+	resp, err = service.NewEnrollMFAService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ActivateMFA implements the UserServiceImpl interface.
+func (s *UserServiceImpl) ActivateMFA(ctx context.Context, req *user.ActivateMFAReq) (resp *user.ActivateMFAResp, err error) {
+	resp, err = service.NewActivateMFAService(ctx).Run(req)
+
+	return resp, err
+}
+
+// DisableMFA implements the UserServiceImpl interface.
+func (s *UserServiceImpl) DisableMFA(ctx context.Context, req *user.DisableMFAReq) (resp *user.DisableMFAResp, err error) {
+	resp, err = service.NewDisableMFAService(ctx).Run(req)
+
+	return resp, err
+}
+
+// RegenerateRecoveryCodes implements the UserServiceImpl interface.
+func (s *UserServiceImpl) RegenerateRecoveryCodes(ctx context.Context, req *user.RegenerateRecoveryCodesReq) (resp *user.RegenerateRecoveryCodesResp, err error) {
+	resp, err = service.NewRegenerateRecoveryCodesService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ListPolicies implements the UserServiceImpl interface.
+func (s *UserServiceImpl) ListPolicies(ctx context.Context, req *user.ListPoliciesReq) (resp *user.ListPoliciesResp, err error) {
+	resp, err = service.NewListPoliciesService(ctx).Run(req)
+
+	return resp, err
+}
+
+// AddPolicy implements the UserServiceImpl interface.
+func (s *UserServiceImpl) AddPolicy(ctx context.Context, req *user.AddPolicyReq) (resp *user.AddPolicyResp, err error) {
+	resp, err = service.NewAddPolicyService(ctx).Run(req)
+
+	return resp, err
+}
+
+// RemovePolicy implements the UserServiceImpl interface.
+func (s *UserServiceImpl) RemovePolicy(ctx context.Context, req *user.RemovePolicyReq) (resp *user.RemovePolicyResp, err error) {
+	resp, err = service.NewRemovePolicyService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ListRoles implements the UserServiceImpl interface.
+func (s *UserServiceImpl) ListRoles(ctx context.Context, req *user.ListRolesReq) (resp *user.ListRolesResp, err error) {
+	resp, err = service.NewListRolesService(ctx).Run(req)
+
+	return resp, err
+}
+
+// AssignRole implements the UserServiceImpl interface.
+func (s *UserServiceImpl) AssignRole(ctx context.Context, req *user.AssignRoleReq) (resp *user.AssignRoleResp, err error) {
+	resp, err = service.NewAssignRoleService(ctx).Run(req)
+
+	return resp, err
+}
+
+// RevokeRole implements the UserServiceImpl interface.
+func (s *UserServiceImpl) RevokeRole(ctx context.Context, req *user.RevokeRoleReq) (resp *user.RevokeRoleResp, err error) {
+	resp, err = service.NewRevokeRoleService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ListBlacklist implements the UserServiceImpl interface.
+func (s *UserServiceImpl) ListBlacklist(ctx context.Context, req *user.ListBlacklistReq) (resp *user.ListBlacklistResp, err error) {
+	resp, err = service.NewListBlacklistService(ctx).Run(req)
+
+	return resp, err
+}
+
+// AddToBlacklist implements the UserServiceImpl interface.
+func (s *UserServiceImpl) AddToBlacklist(ctx context.Context, req *user.AddToBlacklistReq) (resp *user.AddToBlacklistResp, err error) {
+	resp, err = service.NewAddToBlacklistService(ctx).Run(req)
+
+	return resp, err
+}
+
+// RemoveFromBlacklist implements the UserServiceImpl interface.
+func (s *UserServiceImpl) RemoveFromBlacklist(ctx context.Context, req *user.RemoveFromBlacklistReq) (resp *user.RemoveFromBlacklistResp, err error) {
+	resp, err = service.NewRemoveFromBlacklistService(ctx).Run(req)
+
+	return resp, err
+}
@@ -20,10 +20,11 @@ import (
 	"github.com/cloudwego/kitex/pkg/remote/trans/detection"
 	"github.com/cloudwego/kitex/pkg/remote/trans/netpoll"
 	"github.com/cloudwego/kitex/pkg/remote/trans/nphttp2"
-	consulapi "github.com/hashicorp/consul/api"
 	"github.com/hertz-contrib/cors"
 	"zqzqsb.com/gomall/app/user/biz/router"
 	mw "zqzqsb.com/gomall/app/user/biz/router/middleware"
+	"zqzqsb.com/gomall/app/user/conf"
+	"zqzqsb.com/gomall/common/clientip"
 )
 
 type mixTransHandlerFactory struct {
@@ -77,6 +78,13 @@ func initHertz() *route.Engine {
 	h := hertzServer.New(hertzServer.WithIdleTimeout(0))
 	log.Println("init hertz")
 
+	// 只采信可信代理写入的转发头，避免伪造 X-Forwarded-For 绕过按 IP 的限流和登录风控
+	clientIP, err := clientip.New(conf.GetConf().Kitex.TrustedProxies)
+	if err != nil {
+		panic(err)
+	}
+	h.SetClientIPFunc(clientIP)
+
 	// add a ping route to test
 	h.GET("/ping", func(c context.Context, ctx *app.RequestContext) {
 		ctx.JSON(consts.StatusOK, utils.H{"ping": "pong"})
@@ -92,8 +100,9 @@ func initHertz() *route.Engine {
 		MaxAge:           12 * time.Hour,
 	}))
 
-	// 注册 session 和 csrf
+	// 注册限流、session 和 csrf
 	mw.InitJwt()
+	mw.InitRateLimit(h)
 
 	mw.InitSession(h)
 	mw.InitCSRF(h)
@@ -109,46 +118,4 @@ func initHertz() *route.Engine {
 	return h.Engine
 }
 
-func registerServiceWithConsul(serviceID, serviceName, serviceAddress string, servicePort int) {
-	// 配置 Consul 客户端
-	config := consulapi.DefaultConfig()
-	config.Address = "127.0.0.1:8500"
-	client, err := consulapi.NewClient(config)
-
-	if err != nil {
-		log.Fatalf("Failed to create Consul client: %v", err)
-	}
-
-	// 定义服务注册信息
-	registration := &consulapi.AgentServiceRegistration{
-		ID:      serviceID,
-		Name:    serviceName,
-		Address: serviceAddress,
-		Port:    servicePort,
-		Check: &consulapi.AgentServiceCheck{
-			HTTP:     fmt.Sprintf("http://%s:%d/ping", serviceAddress, servicePort),
-			Interval: "10s",
-			Timeout:  "5s",
-		},
-	}
-
-	// 注册服务
-	err = client.Agent().ServiceRegister(registration)
-	if err != nil {
-		log.Fatalf("Failed to register service with Consul: %v", err)
-	}
-	log.Printf("Service %s registered with Consul", serviceName)
-}
-
 var hertzEngine *route.Engine
-
-func init() {
-
-	hertzEngine = initHertz()
-	serviceID := "user-http-001"
-	serviceName := "user-service-http"
-	serviceAddress := "192.168.110.112"
-	servicePort := 8888
-
-	registerServiceWithConsul(serviceID, serviceName, serviceAddress, servicePort)
-}
@@ -0,0 +1,73 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"zqzqsb.com/gomall/app/user/conf"
+)
+
+const (
+	DriverSMTP   = "smtp"
+	DriverFile   = "file"
+	DriverMemory = "memory"
+)
+
+// Message 一封纯文本邮件
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer 邮件发送器，验证邮件和找回密码邮件都通过它发送
+type Mailer interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+var (
+	mailer Mailer
+	mu     sync.RWMutex
+)
+
+// Init 按配置初始化邮件发送器，未配置时使用内存发件箱，邮件不会真正发出
+func Init() {
+	m, err := New(conf.GetConf().Mail)
+	if err != nil {
+		panic(err)
+	}
+	SetMailer(m)
+}
+
+// New 按配置创建邮件发送器
+func New(c conf.Mail) (Mailer, error) {
+	switch c.Driver {
+	case DriverSMTP:
+		return NewSMTPMailer(c.From, c.SMTP), nil
+	case DriverFile:
+		return NewFileOutbox(c.OutboxDir)
+	case DriverMemory, "":
+		return NewMemoryOutbox(), nil
+	default:
+		return nil, fmt.Errorf("unknown mail driver %q", c.Driver)
+	}
+}
+
+// SetMailer 替换全局的邮件发送器，测试中可以注入内存发件箱
+func SetMailer(m Mailer) {
+	mu.Lock()
+	defer mu.Unlock()
+	mailer = m
+}
+
+// Send 使用全局的邮件发送器发送邮件
+func Send(ctx context.Context, msg *Message) error {
+	mu.RLock()
+	m := mailer
+	mu.RUnlock()
+	if m == nil {
+		return fmt.Errorf("mailer is not initialized")
+	}
+	return m.Send(ctx, msg)
+}
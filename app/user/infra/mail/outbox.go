@@ -0,0 +1,71 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// MemoryOutbox 将邮件保存在内存中，用于测试和本地开发
+type MemoryOutbox struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+func NewMemoryOutbox() *MemoryOutbox {
+	return &MemoryOutbox{}
+}
+
+func (o *MemoryOutbox) Send(ctx context.Context, msg *Message) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.messages = append(o.messages, *msg)
+	return nil
+}
+
+// Messages 返回已发送邮件的副本
+func (o *MemoryOutbox) Messages() []Message {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return append([]Message(nil), o.messages...)
+}
+
+// Last 返回最近发送给 to 的邮件
+func (o *MemoryOutbox) Last(to string) (Message, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for i := len(o.messages) - 1; i >= 0; i-- {
+		if o.messages[i].To == to {
+			return o.messages[i], true
+		}
+	}
+	return Message{}, false
+}
+
+// FileOutbox 将每封邮件写成目录下的一个 .eml 文件，用于本地开发时查看邮件内容
+type FileOutbox struct {
+	dir string
+	seq uint64
+	mu  sync.Mutex
+}
+
+func NewFileOutbox(dir string) (*FileOutbox, error) {
+	if dir == "" {
+		dir = "log/outbox"
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileOutbox{dir: dir}, nil
+}
+
+func (o *FileOutbox) Send(ctx context.Context, msg *Message) error {
+	o.mu.Lock()
+	o.seq++
+	name := fmt.Sprintf("%s-%d.eml", time.Now().Format("20060102T150405.000000"), o.seq)
+	o.mu.Unlock()
+	return os.WriteFile(filepath.Join(o.dir, name), buildMessage("outbox@localhost", msg), 0o600)
+}
@@ -0,0 +1,52 @@
+package mail
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestMemoryOutbox(t *testing.T) {
+	o := NewMemoryOutbox()
+	ctx := context.Background()
+	_ = o.Send(ctx, &Message{To: "a@example.com", Subject: "1"})
+	_ = o.Send(ctx, &Message{To: "b@example.com", Subject: "2"})
+	_ = o.Send(ctx, &Message{To: "a@example.com", Subject: "3"})
+
+	if n := len(o.Messages()); n != 3 {
+		t.Fatalf("want 3 messages, got %d", n)
+	}
+	if m, ok := o.Last("a@example.com"); !ok || m.Subject != "3" {
+		t.Fatalf("unexpected last message: %+v, %v", m, ok)
+	}
+	if _, ok := o.Last("c@example.com"); ok {
+		t.Fatal("want no message for c@example.com")
+	}
+}
+
+func TestFileOutbox(t *testing.T) {
+	dir := t.TempDir()
+	o, err := NewFileOutbox(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = o.Send(context.Background(), &Message{To: "a@example.com", Subject: "重置密码", Body: "line1\nline2"}); err != nil {
+		t.Fatal(err)
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 {
+		t.Fatalf("want 1 file, got %d", len(entries))
+	}
+	data, _ := os.ReadFile(dir + "/" + entries[0].Name())
+	if !strings.Contains(string(data), "To: a@example.com\r\n") || !strings.Contains(string(data), "line1\r\nline2") {
+		t.Fatalf("unexpected message:\n%s", data)
+	}
+}
+
+func TestBuildMessageStripsHeaderNewlines(t *testing.T) {
+	data := string(buildMessage("noreply@example.com", &Message{To: "a@example.com\r\nBcc: evil@example.com", Subject: "hi"}))
+	if strings.Contains(data, "\r\nBcc:") {
+		t.Fatalf("header injection not prevented:\n%s", data)
+	}
+}
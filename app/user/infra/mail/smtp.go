@@ -0,0 +1,70 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"zqzqsb.com/gomall/app/user/conf"
+)
+
+// SMTPMailer 通过 SMTP 服务器发送邮件，服务器支持 STARTTLS 时自动启用
+type SMTPMailer struct {
+	from     string // 邮件头中的发件人，可以带显示名称
+	envelope string // SMTP 信封中的发件地址
+	addr     string
+	auth     smtp.Auth
+}
+
+func NewSMTPMailer(from string, c conf.SMTP) *SMTPMailer {
+	m := &SMTPMailer{
+		from:     from,
+		envelope: from,
+		addr:     net.JoinHostPort(c.Host, strconv.Itoa(c.Port)),
+	}
+	if addr, err := mail.ParseAddress(from); err == nil {
+		m.envelope = addr.Address
+	}
+	if c.Username != "" {
+		m.auth = smtp.PlainAuth("", c.Username, c.Password, c.Host)
+	}
+	return m
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, msg *Message) error {
+	// net/smtp 不支持 context，只能在发送前检查是否已取消
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := smtp.SendMail(m.addr, m.auth, m.envelope, []string{msg.To}, buildMessage(m.from, msg)); err != nil {
+		return fmt.Errorf("send mail to %s: %w", msg.To, err)
+	}
+	return nil
+}
+
+// buildMessage 生成 RFC 5322 格式的邮件，主题按 RFC 2047 编码以支持中文
+func buildMessage(from string, msg *Message) []byte {
+	var buf bytes.Buffer
+	header := func(k, v string) {
+		// 去掉换行，防止邮件头注入
+		v = strings.NewReplacer("\r", "", "\n", "").Replace(v)
+		buf.WriteString(k + ": " + v + "\r\n")
+	}
+	header("From", from)
+	header("To", msg.To)
+	header("Subject", mime.BEncoding.Encode("UTF-8", msg.Subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=UTF-8")
+	header("Content-Transfer-Encoding", "8bit")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+	return buf.Bytes()
+}
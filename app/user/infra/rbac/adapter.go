@@ -0,0 +1,182 @@
+// Package rbac 提供基于 MySQL 的 Casbin 策略存储，多个实例共享同一份策略，
+// 策略变更只写入增删的规则，不再整体回写策略文件
+package rbac
+
+import (
+	"errors"
+
+	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/persist"
+	fileadapter "github.com/casbin/casbin/v2/persist/file-adapter"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	bizmodel "zqzqsb.com/gomall/app/user/biz/model"
+)
+
+const maxRuleFields = 6
+
+var ErrRuleTooLong = errors.New("策略规则的字段过多")
+
+// Adapter 基于 gorm 的 Casbin 适配器，实现 persist.BatchAdapter
+type Adapter struct {
+	db *gorm.DB
+}
+
+var _ persist.BatchAdapter = (*Adapter)(nil)
+
+func NewAdapter(db *gorm.DB) *Adapter {
+	return &Adapter{db: db}
+}
+
+// toRule 将 Casbin 的规则转换为表记录
+func toRule(ptype string, rule []string) (bizmodel.CasbinRule, error) {
+	if len(rule) > maxRuleFields {
+		return bizmodel.CasbinRule{}, ErrRuleTooLong
+	}
+	r := bizmodel.CasbinRule{Ptype: ptype}
+	fields := []*string{&r.V0, &r.V1, &r.V2, &r.V3, &r.V4, &r.V5}
+	for i, v := range rule {
+		*fields[i] = v
+	}
+	return r, nil
+}
+
+// toLine 将表记录转换为 ptype 开头的规则，去掉末尾的空字段
+func toLine(r bizmodel.CasbinRule) []string {
+	line := []string{r.Ptype, r.V0, r.V1, r.V2, r.V3, r.V4, r.V5}
+	for len(line) > 1 && line[len(line)-1] == "" {
+		line = line[:len(line)-1]
+	}
+	return line
+}
+
+// whereRule 按规则的全部字段匹配，未使用的字段为空字符串
+func whereRule(db *gorm.DB, r bizmodel.CasbinRule) *gorm.DB {
+	return db.Where("ptype = ? AND v0 = ? AND v1 = ? AND v2 = ? AND v3 = ? AND v4 = ? AND v5 = ?",
+		r.Ptype, r.V0, r.V1, r.V2, r.V3, r.V4, r.V5)
+}
+
+// LoadPolicy 从数据库加载全部规则
+func (a *Adapter) LoadPolicy(m model.Model) error {
+	var rules []bizmodel.CasbinRule
+	if err := a.db.Order("id").Find(&rules).Error; err != nil {
+		return err
+	}
+	for _, r := range rules {
+		if err := persist.LoadPolicyArray(toLine(r), m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SavePolicy 用内存中的策略整体替换数据库中的规则
+func (a *Adapter) SavePolicy(m model.Model) error {
+	rules, err := modelRules(m)
+	if err != nil {
+		return err
+	}
+	return a.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("1 = 1").Delete(&bizmodel.CasbinRule{}).Error; err != nil {
+			return err
+		}
+		return insertRules(tx, rules)
+	})
+}
+
+func modelRules(m model.Model) ([]bizmodel.CasbinRule, error) {
+	var rules []bizmodel.CasbinRule
+	for _, sec := range []string{"p", "g"} {
+		for ptype, ast := range m[sec] {
+			for _, rule := range ast.Policy {
+				r, err := toRule(ptype, rule)
+				if err != nil {
+					return nil, err
+				}
+				rules = append(rules, r)
+			}
+		}
+	}
+	return rules, nil
+}
+
+// insertRules 批量写入规则，已存在的规则直接跳过，其他实例先写入同一条规则时不会报错
+func insertRules(db *gorm.DB, rules []bizmodel.CasbinRule) error {
+	if len(rules) == 0 {
+		return nil
+	}
+	return db.Clauses(clause.OnConflict{DoNothing: true}).Create(&rules).Error
+}
+
+func (a *Adapter) AddPolicy(sec string, ptype string, rule []string) error {
+	return a.AddPolicies(sec, ptype, [][]string{rule})
+}
+
+func (a *Adapter) AddPolicies(sec string, ptype string, rules [][]string) error {
+	rows := make([]bizmodel.CasbinRule, 0, len(rules))
+	for _, rule := range rules {
+		r, err := toRule(ptype, rule)
+		if err != nil {
+			return err
+		}
+		rows = append(rows, r)
+	}
+	return insertRules(a.db, rows)
+}
+
+func (a *Adapter) RemovePolicy(sec string, ptype string, rule []string) error {
+	return a.RemovePolicies(sec, ptype, [][]string{rule})
+}
+
+func (a *Adapter) RemovePolicies(sec string, ptype string, rules [][]string) error {
+	return a.db.Transaction(func(tx *gorm.DB) error {
+		for _, rule := range rules {
+			r, err := toRule(ptype, rule)
+			if err != nil {
+				return err
+			}
+			if err := whereRule(tx, r).Delete(&bizmodel.CasbinRule{}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// RemoveFilteredPolicy 删除从 fieldIndex 开始的字段与 fieldValues 匹配的规则，值为空的字段不参与匹配
+func (a *Adapter) RemoveFilteredPolicy(sec string, ptype string, fieldIndex int, fieldValues ...string) error {
+	if fieldIndex < 0 || fieldIndex+len(fieldValues) > maxRuleFields {
+		return ErrRuleTooLong
+	}
+	query := a.db.Where("ptype = ?", ptype)
+	columns := []string{"v0", "v1", "v2", "v3", "v4", "v5"}
+	for i, v := range fieldValues {
+		if v != "" {
+			query = query.Where(columns[fieldIndex+i]+" = ?", v)
+		}
+	}
+	return query.Delete(&bizmodel.CasbinRule{}).Error
+}
+
+// ImportFileIfEmpty 数据库中还没有任何规则时，从策略文件导入初始规则，返回是否执行了导入
+func (a *Adapter) ImportFileIfEmpty(modelPath, policyPath string) (bool, error) {
+	var count int64
+	if err := a.db.Model(&bizmodel.CasbinRule{}).Count(&count).Error; err != nil {
+		return false, err
+	}
+	if count > 0 {
+		return false, nil
+	}
+	m, err := model.NewModelFromFile(modelPath)
+	if err != nil {
+		return false, err
+	}
+	if err := fileadapter.NewAdapter(policyPath).LoadPolicy(m); err != nil {
+		return false, err
+	}
+	rules, err := modelRules(m)
+	if err != nil {
+		return false, err
+	}
+	return true, insertRules(a.db, rules)
+}
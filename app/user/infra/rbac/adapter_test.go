@@ -0,0 +1,30 @@
+package rbac
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRuleConversion(t *testing.T) {
+	cases := []struct {
+		ptype string
+		rule  []string
+	}{
+		{"p", []string{"admin", "^/admin/.*$", "GET", "allow"}},
+		{"g", []string{"1", "admin"}},
+	}
+	for _, c := range cases {
+		r, err := toRule(c.ptype, c.rule)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := append([]string{c.ptype}, c.rule...)
+		if got := toLine(r); !reflect.DeepEqual(got, want) {
+			t.Fatalf("want %v, got %v", want, got)
+		}
+	}
+
+	if _, err := toRule("p", []string{"1", "2", "3", "4", "5", "6", "7"}); err != ErrRuleTooLong {
+		t.Fatalf("want ErrRuleTooLong, got %v", err)
+	}
+}
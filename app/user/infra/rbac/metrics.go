@@ -0,0 +1,41 @@
+package rbac
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	lastSyncTime = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "casbin_policy_last_sync_timestamp_seconds",
+		Help: "最近一次成功加载或同步权限策略的时间",
+	})
+	syncTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "casbin_policy_sync_total",
+		Help: "权限策略同步次数，method 为变更类型或 reload",
+	}, []string{"method", "result"})
+)
+
+// RegisterMetrics 注册策略同步的监控指标
+func RegisterMetrics(reg prometheus.Registerer) error {
+	for _, c := range []prometheus.Collector{lastSyncTime, syncTotal} {
+		if err := reg.Register(c); err != nil {
+			if _, ok := err.(prometheus.AlreadyRegisteredError); !ok {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ObserveSync 记录一次策略同步的结果，成功时更新最近同步时间
+func ObserveSync(method string, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	} else {
+		lastSyncTime.Set(float64(time.Now().Unix()))
+	}
+	syncTotal.WithLabelValues(method, result).Inc()
+}
@@ -0,0 +1,162 @@
+package rbac
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"sync"
+
+	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/persist"
+	"github.com/redis/go-redis/v9"
+)
+
+// DefaultChannel 策略变更通知使用的 Redis 频道
+const DefaultChannel = "casbin:policy"
+
+// 策略变更的类型，Update 和 SavePolicy 需要其他实例整体重新加载策略
+const (
+	MethodUpdate               = "Update"
+	MethodSavePolicy           = "SavePolicy"
+	MethodAddPolicies          = "AddPolicies"
+	MethodRemovePolicies       = "RemovePolicies"
+	MethodRemoveFilteredPolicy = "RemoveFilteredPolicy"
+)
+
+// Message 策略变更通知，Instance 用于忽略本实例自己发布的通知
+type Message struct {
+	Instance    string     `json:"instance"`
+	Method      string     `json:"method"`
+	Sec         string     `json:"sec,omitempty"`
+	Ptype       string     `json:"ptype,omitempty"`
+	Rules       [][]string `json:"rules,omitempty"`
+	FieldIndex  int        `json:"field_index,omitempty"`
+	FieldValues []string   `json:"field_values,omitempty"`
+}
+
+// ParseMessage 解析策略变更通知
+func ParseMessage(payload string) (*Message, error) {
+	msg := &Message{}
+	if err := json.Unmarshal([]byte(payload), msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// Watcher 通过 Redis 发布订阅在多个实例之间同步策略变更，实现 persist.WatcherEx。
+// client 可以是单节点客户端，也可以是集群客户端，集群模式下 PUBLISH 会广播到所有节点
+type Watcher struct {
+	client   redis.UniversalClient
+	channel  string
+	instance string
+	pubsub   *redis.PubSub
+
+	mu       sync.RWMutex
+	callback func(string)
+}
+
+var _ persist.WatcherEx = (*Watcher)(nil)
+
+// NewWatcher 订阅策略变更频道，channel 为空时使用 DefaultChannel
+func NewWatcher(ctx context.Context, client redis.UniversalClient, channel string) (*Watcher, error) {
+	if channel == "" {
+		channel = DefaultChannel
+	}
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	w := &Watcher{
+		client:   client,
+		channel:  channel,
+		instance: hex.EncodeToString(b),
+		pubsub:   client.Subscribe(ctx, channel),
+	}
+	// 等待订阅确认，避免启动后立即发生的变更丢失
+	if _, err := w.pubsub.Receive(ctx); err != nil {
+		w.pubsub.Close()
+		return nil, err
+	}
+	go w.run()
+	return w, nil
+}
+
+// run 处理其他实例发布的通知，连接断开时 go-redis 会自动重新订阅
+func (w *Watcher) run() {
+	for msg := range w.pubsub.Channel() {
+		m, err := ParseMessage(msg.Payload)
+		if err != nil {
+			log.Printf("invalid casbin policy message: %v", err)
+			continue
+		}
+		if m.Instance == w.instance {
+			continue
+		}
+		w.mu.RLock()
+		callback := w.callback
+		w.mu.RUnlock()
+		if callback != nil {
+			callback(msg.Payload)
+		}
+	}
+}
+
+// SetUpdateCallback 设置收到其他实例通知时的回调，参数为通知的原始内容，可用 ParseMessage 解析
+func (w *Watcher) SetUpdateCallback(callback func(string)) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.callback = callback
+	return nil
+}
+
+func (w *Watcher) publish(msg *Message) error {
+	msg.Instance = w.instance
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return w.client.Publish(context.Background(), w.channel, data).Err()
+}
+
+func (w *Watcher) Update() error {
+	return w.publish(&Message{Method: MethodUpdate})
+}
+
+func (w *Watcher) UpdateForAddPolicy(sec, ptype string, params ...string) error {
+	return w.UpdateForAddPolicies(sec, ptype, params)
+}
+
+func (w *Watcher) UpdateForRemovePolicy(sec, ptype string, params ...string) error {
+	return w.UpdateForRemovePolicies(sec, ptype, params)
+}
+
+func (w *Watcher) UpdateForRemoveFilteredPolicy(sec, ptype string, fieldIndex int, fieldValues ...string) error {
+	return w.publish(&Message{
+		Method:      MethodRemoveFilteredPolicy,
+		Sec:         sec,
+		Ptype:       ptype,
+		FieldIndex:  fieldIndex,
+		FieldValues: fieldValues,
+	})
+}
+
+func (w *Watcher) UpdateForSavePolicy(model model.Model) error {
+	return w.publish(&Message{Method: MethodSavePolicy})
+}
+
+func (w *Watcher) UpdateForAddPolicies(sec string, ptype string, rules ...[]string) error {
+	return w.publish(&Message{Method: MethodAddPolicies, Sec: sec, Ptype: ptype, Rules: rules})
+}
+
+func (w *Watcher) UpdateForRemovePolicies(sec string, ptype string, rules ...[]string) error {
+	return w.publish(&Message{Method: MethodRemovePolicies, Sec: sec, Ptype: ptype, Rules: rules})
+}
+
+// Close 取消订阅，之后不再调用回调
+func (w *Watcher) Close() {
+	if err := w.pubsub.Close(); err != nil {
+		log.Printf("close casbin watcher failed: %v", err)
+	}
+}
@@ -0,0 +1,32 @@
+package rbac
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestParseMessage(t *testing.T) {
+	msg := &Message{
+		Instance: "a",
+		Method:   MethodAddPolicies,
+		Sec:      "p",
+		Ptype:    "p",
+		Rules:    [][]string{{"6", ".*", ".*", "deny"}},
+	}
+	data, err := json.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ParseMessage(string(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, msg) {
+		t.Fatalf("want %+v, got %+v", msg, got)
+	}
+
+	if _, err := ParseMessage("not json"); err == nil {
+		t.Fatal("invalid payload should fail")
+	}
+}
@@ -0,0 +1,67 @@
+package totp
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+)
+
+// RFC 6238 附录 B 的 SHA1 测试向量，取 8 位验证码的后 6 位
+func TestCode(t *testing.T) {
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	cases := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, c := range cases {
+		got, err := Code(secret, time.Unix(c.unix, 0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != c.want {
+			t.Fatalf("time %d: want %s, got %s", c.unix, c.want, got)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1700000000, 0)
+	prev, _ := Code(secret, now.Add(-Period*time.Second))
+
+	step, ok := Validate(secret, prev, now, 1)
+	if !ok || step != Step(now)-1 {
+		t.Fatalf("previous step should be accepted within skew, got %d %v", step, ok)
+	}
+	if _, ok := Validate(secret, prev, now, 0); ok {
+		t.Fatal("previous step should be rejected without skew")
+	}
+	if _, ok := Validate(secret, "12345", now, 1); ok {
+		t.Fatal("short code should be rejected")
+	}
+	if _, ok := Validate("not base32!", "123456", now, 1); ok {
+		t.Fatal("invalid secret should be rejected")
+	}
+}
+
+func TestURI(t *testing.T) {
+	uri := URI("GoMall", "a@b.com", "ABC")
+	if !strings.HasPrefix(uri, "otpauth://totp/GoMall:a@b.com?") {
+		t.Fatalf("unexpected label: %s", uri)
+	}
+	for _, part := range []string{"secret=ABC", "issuer=GoMall", "digits=6", "period=30"} {
+		if !strings.Contains(uri, part) {
+			t.Fatalf("%s missing in %s", part, uri)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/cloudwego/kitex/pkg/klog"
 	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/cloudwego/kitex/pkg/transmeta"
 	"github.com/cloudwego/kitex/server"
 	"github.com/joho/godotenv"
 	kitexlogrus "github.com/kitex-contrib/obs-opentelemetry/logging/logrus"
@@ -14,23 +15,16 @@ import (
 	"gopkg.in/natefinch/lumberjack.v2"
 	"zqzqsb.com/gomall/app/user/biz/dal"
 	"zqzqsb.com/gomall/app/user/conf"
+	"zqzqsb.com/gomall/app/user/infra/mail"
 	"zqzqsb.com/gomall/app/user/kitex_gen/user/userservice"
 	"zqzqsb.com/gomall/common/mtl"
 	"zqzqsb.com/gomall/common/serversuite"
 )
 
-var (
-	// rigister server to consul
-	serviceID      = "user-rpc-001"
-	serviceName    = "user-service-rpc"
-	serviceAddress = "192.168.110.112"
-	servicePort    = 8888
-	consulAddr     = "127.0.0.1:8500"
-)
-
 func main() {
 	err := godotenv.Load()
-	mtl.InitMetrics(serviceName, conf.GetConf().Kitex.MetricsPort, consulAddr)
+	serviceName := conf.GetConf().Kitex.Service
+	mtl.InitMetrics(serviceName, conf.GetConf().Kitex.MetricsPort, conf.GetConf().Registry.Address())
 	p := mtl.InitTracing(serviceName)
 	defer p.Shutdown(context.Background())
 
@@ -39,6 +33,9 @@ func main() {
 	}
 
 	dal.Init()
+	mail.Init()
+	// 注册路由时需要从 MySQL 加载权限策略，Hertz 必须在 dal 初始化之后创建
+	hertzEngine = initHertz()
 	opts := kitexInit()
 	svr := userservice.NewServer(new(UserServiceImpl), opts...)
 
@@ -61,15 +58,23 @@ func kitexInit() (opts []server.Option) {
 		panic(err)
 	}
 	opts = append(opts, server.WithServiceAddr(addr))
+	// 通过 Consul 注册服务，服务退出时自动注销
 	opts = append(opts, server.WithSuite(&serversuite.CommonServerSuite{
-		CurrentServiceName: serviceName,
+		CurrentServiceName: conf.GetConf().Kitex.Service,
+		RegistryAddr:       conf.GetConf().Registry.Address(),
+		ServiceAddr:        conf.GetConf().Kitex.Address,
+		Weight:             conf.GetConf().Registry.Weight,
+		Env:                conf.GetEnv(),
+		Version:            conf.GetConf().Registry.Version,
+		RateLimit:          conf.GetConf().RateLimit.Server,
 	}))
 	// service info
 	opts = append(opts, server.WithServerBasicInfo(&rpcinfo.EndpointBasicInfo{
 		ServiceName: conf.GetConf().Kitex.Service,
 	}))
 
-	registerServiceWithConsul(serviceID, serviceName, serviceAddress, servicePort)
+	// 接收网关透传的用户身份
+	opts = append(opts, server.WithMetaHandler(transmeta.ServerHTTP2Handler))
 
 	// klog
 	logger := kitexlogrus.NewLogger()
@@ -0,0 +1,57 @@
+// Package clientip 按可信代理解析 HTTP 请求的客户端 IP。
+// Hertz 默认信任任意来源的 X-Forwarded-For / X-Real-IP，客户端伪造转发头即可冒充任意 IP，
+// 各服务创建 Hertz 时通过 SetClientIPFunc 挂载 New 返回的函数，c.ClientIP() 才能用于限流和风控。
+package clientip
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// remoteIPHeaders 可信代理写入客户端 IP 的请求头，按顺序取第一个有效值
+var remoteIPHeaders = []string{"X-Forwarded-For", "X-Real-IP"}
+
+// New 返回只采信可信代理转发头的 ClientIP 函数
+// trustedProxies 为代理的 IP 或 CIDR，未配置时不信任任何转发头，直接使用连接的对端地址
+func New(trustedProxies []string) (app.ClientIP, error) {
+	cidrs, err := ParseCIDRs(trustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	return app.ClientIPWithOption(app.ClientIPOptions{
+		RemoteIPHeaders: remoteIPHeaders,
+		TrustedCIDRs:    cidrs,
+	}), nil
+}
+
+// ParseCIDRs 解析可信代理列表，单个 IP 视为只包含该地址的网段
+func ParseCIDRs(proxies []string) ([]*net.IPNet, error) {
+	var cidrs []*net.IPNet
+	for _, p := range proxies {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if !strings.Contains(p, "/") {
+			ip := net.ParseIP(p)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", p)
+			}
+			bits := 8 * net.IPv6len
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 8*net.IPv4len
+			}
+			cidrs = append(cidrs, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, cidr, err := net.ParseCIDR(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", p, err)
+		}
+		cidrs = append(cidrs, cidr)
+	}
+	return cidrs, nil
+}
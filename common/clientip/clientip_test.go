@@ -0,0 +1,60 @@
+package clientip
+
+import (
+	"net"
+	"testing"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/network"
+)
+
+// remoteConn 只用于指定请求的对端地址
+type remoteConn struct {
+	network.Conn
+	addr net.Addr
+}
+
+func (c remoteConn) RemoteAddr() net.Addr { return c.addr }
+
+func newRequest(remote string, headers map[string]string) *app.RequestContext {
+	c := app.NewContext(0)
+	addr, _ := net.ResolveTCPAddr("tcp", remote)
+	c.SetConn(remoteConn{addr: addr})
+	for k, v := range headers {
+		c.Request.Header.Set(k, v)
+	}
+	return c
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		proxies []string
+		remote  string
+		headers map[string]string
+		want    string
+	}{
+		{"no proxy ignores forwarded header", nil, "203.0.113.7:5000", map[string]string{"X-Forwarded-For": "1.2.3.4"}, "203.0.113.7"},
+		{"no proxy ignores real ip header", nil, "203.0.113.7:5000", map[string]string{"X-Real-IP": "1.2.3.4"}, "203.0.113.7"},
+		{"untrusted remote", []string{"10.0.0.0/8"}, "203.0.113.7:5000", map[string]string{"X-Forwarded-For": "1.2.3.4"}, "203.0.113.7"},
+		{"trusted proxy", []string{"10.0.0.0/8"}, "10.0.0.2:5000", map[string]string{"X-Forwarded-For": "198.51.100.9"}, "198.51.100.9"},
+		{"trusted single ip", []string{"10.0.0.2"}, "10.0.0.2:5000", map[string]string{"X-Real-IP": "198.51.100.9"}, "198.51.100.9"},
+		// 代理追加在末尾，客户端自己写入的前缀不被采信
+		{"forged prefix behind proxy", []string{"10.0.0.0/8"}, "10.0.0.2:5000", map[string]string{"X-Forwarded-For": "1.2.3.4, 198.51.100.9"}, "198.51.100.9"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fn, err := New(tt.proxies)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := fn(newRequest(tt.remote, tt.headers)); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := New([]string{"not-an-ip"}); err == nil {
+		t.Error("invalid trusted proxy should be rejected")
+	}
+}
@@ -9,7 +9,10 @@ import (
 
 type CommonClientSuite struct {
 	CurrentServiceName string
-	RegisteryAddr      string
+	// RegisteryAddr Consul 地址，非空时通过 Consul 发现下游实例，否则需要调用方指定 HostPorts
+	RegisteryAddr string
+	// Policy 超时、熔断、重试和降级策略，零值表示不启用
+	Policy Policy
 }
 
 func (s CommonClientSuite) Options() []client.Option {
@@ -22,5 +25,10 @@ func (s CommonClientSuite) Options() []client.Option {
 		// 使用 OpenTelemetry 的链路追踪
 		client.WithSuite(tracing.NewClientSuite()),
 	}
+	if s.RegisteryAddr != "" {
+		opts = append(opts, resolverOption(s.RegisteryAddr))
+	}
+	opts = append(opts, s.Policy.LoadBalance.option())
+	opts = append(opts, s.Policy.options()...)
 	return opts
 }
@@ -0,0 +1,54 @@
+package clientsuite
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+
+	"github.com/bytedance/gopkg/cloud/metainfo"
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/loadbalance"
+	consul "github.com/kitex-contrib/registry-consul"
+	"zqzqsb.com/gomall/common/identity"
+)
+
+const (
+	LoadBalanceWeightedRoundRobin = "weighted_round_robin"
+	LoadBalanceConsistentHash     = "consistent_hash"
+
+	// defaultHashKey 一致性哈希默认按用户身份选择实例
+	defaultHashKey = identity.UserIDKey
+)
+
+// LoadBalance 负载均衡策略，默认按实例权重轮询
+type LoadBalance struct {
+	Type string `yaml:"type"`
+	// HashKey 一致性哈希使用的 persistent 元信息 key，请求未携带时随机选择实例
+	HashKey string `yaml:"hash_key"`
+}
+
+func (lb LoadBalance) option() client.Option {
+	if lb.Type != LoadBalanceConsistentHash {
+		return client.WithLoadBalancer(loadbalance.NewWeightedRoundRobinBalancer())
+	}
+	key := lb.HashKey
+	if key == "" {
+		key = defaultHashKey
+	}
+	return client.WithLoadBalancer(loadbalance.NewConsistBalancer(loadbalance.NewConsistentHashOption(
+		func(ctx context.Context, request interface{}) string {
+			if v, ok := metainfo.GetPersistentValue(ctx, key); ok && v != "" {
+				return v
+			}
+			return strconv.FormatUint(rand.Uint64(), 36)
+		})))
+}
+
+// resolverOption 通过 Consul 解析下游服务的实例
+func resolverOption(registryAddr string) client.Option {
+	r, err := consul.NewConsulResolver(registryAddr)
+	if err != nil {
+		panic(err)
+	}
+	return client.WithResolver(r)
+}
@@ -0,0 +1,173 @@
+package clientsuite
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/circuitbreak"
+	"github.com/cloudwego/kitex/pkg/fallback"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/retry"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+)
+
+// Policy 下游调用的治理策略，零值表示沿用 Kitex 默认行为（无熔断、无重试、无降级），负载均衡默认加权轮询
+type Policy struct {
+	ConnectTimeout time.Duration            `yaml:"connect_timeout"`
+	RPCTimeout     time.Duration            `yaml:"rpc_timeout"`
+	MethodTimeouts map[string]time.Duration `yaml:"method_timeouts"` // 按方法覆盖 RPCTimeout
+	CircuitBreaker CircuitBreaker           `yaml:"circuit_breaker"`
+	Retry          Retry                    `yaml:"retry"`
+	LoadBalance    LoadBalance              `yaml:"load_balance"`
+
+	// fallbacks 按方法注册的降级函数，只能通过 RegisterFallback 在代码中注册
+	fallbacks map[string]fallback.RealReqRespFunc
+}
+
+// CircuitBreaker 按错误率熔断，统计窗口内请求数不少于 MinSample 且错误率达到 ErrRate 时熔断
+type CircuitBreaker struct {
+	Enable    bool    `yaml:"enable"`
+	ErrRate   float64 `yaml:"err_rate"`
+	MinSample int64   `yaml:"min_sample"`
+}
+
+// Retry 失败重试策略，只对 Methods 中列出的幂等方法生效，MaxRetryTimes 不超过 5
+type Retry struct {
+	MaxRetryTimes int           `yaml:"max_retry_times"`
+	MaxDuration   time.Duration `yaml:"max_duration"` // 包含首次调用在内的总耗时上限，超过后不再重试
+	BackoffMin    time.Duration `yaml:"backoff_min"`
+	BackoffMax    time.Duration `yaml:"backoff_max"`
+	Methods       []string      `yaml:"methods"`
+}
+
+// RegisterFallback 为方法注册降级函数，调用因超时、熔断或连接失败出错时执行，
+// 返回非 nil 的 resp 即作为本次调用的结果；业务错误不会触发降级
+func (p *Policy) RegisterFallback(method string, fn fallback.RealReqRespFunc) {
+	if p.fallbacks == nil {
+		p.fallbacks = make(map[string]fallback.RealReqRespFunc)
+	}
+	p.fallbacks[method] = fn
+}
+
+// Degradable 错误是否由下游不可用引起，只有这类错误才值得降级
+func Degradable(err error) bool {
+	if err == nil {
+		return false
+	}
+	return kerrors.IsTimeoutError(err) ||
+		errors.Is(err, kerrors.ErrCircuitBreak) ||
+		errors.Is(err, kerrors.ErrGetConnection) ||
+		errors.Is(err, kerrors.ErrServiceDiscovery) ||
+		errors.Is(err, kerrors.ErrLoadbalance) ||
+		errors.Is(err, kerrors.ErrNoMoreInstance) ||
+		errors.Is(err, kerrors.ErrOverlimit)
+}
+
+func (p Policy) options() []client.Option {
+	var opts []client.Option
+	if p.ConnectTimeout > 0 {
+		opts = append(opts, client.WithConnectTimeout(p.ConnectTimeout))
+	}
+	if p.RPCTimeout > 0 {
+		opts = append(opts, client.WithRPCTimeout(p.RPCTimeout))
+	}
+	if len(p.MethodTimeouts) > 0 {
+		opts = append(opts, client.WithTimeoutProvider(methodTimeouts(p.MethodTimeouts)))
+	}
+	if p.CircuitBreaker.Enable {
+		opts = append(opts, client.WithCircuitBreaker(p.CircuitBreaker.suite()))
+	}
+	if policies := p.Retry.methodPolicies(); len(policies) > 0 {
+		opts = append(opts, client.WithRetryMethodPolicies(policies))
+	}
+	if len(p.fallbacks) > 0 {
+		opts = append(opts, client.WithFallback(fallback.NewFallbackPolicy(fallback.UnwrapHelper(p.fallback))))
+	}
+	return opts
+}
+
+// fallback 按被调方法分发到注册的降级函数
+func (p Policy) fallback(ctx context.Context, req, resp interface{}, err error) (interface{}, error) {
+	if !Degradable(err) {
+		return nil, err
+	}
+	fn, ok := p.fallbacks[rpcinfo.GetRPCInfo(ctx).To().Method()]
+	if !ok {
+		return nil, err
+	}
+	return fn(ctx, req, resp, err)
+}
+
+// methodTimeouts 按方法覆盖 RPC 超时，未配置的方法沿用客户端的默认超时
+type methodTimeouts map[string]time.Duration
+
+func (m methodTimeouts) Timeouts(ri rpcinfo.RPCInfo) rpcinfo.Timeouts {
+	d, ok := m[ri.To().Method()]
+	if !ok {
+		return nil
+	}
+	return timeouts{rpc: d, base: ri.Config()}
+}
+
+type timeouts struct {
+	rpc  time.Duration
+	base rpcinfo.RPCConfig
+}
+
+func (t timeouts) RPCTimeout() time.Duration       { return t.rpc }
+func (t timeouts) ConnectTimeout() time.Duration   { return t.base.ConnectTimeout() }
+func (t timeouts) ReadWriteTimeout() time.Duration { return t.base.ReadWriteTimeout() }
+
+// suite 构造熔断器，服务级熔断按 调用方/被调服务/方法 分别统计，均使用同一份配置
+func (c CircuitBreaker) suite() *circuitbreak.CBSuite {
+	cfg := circuitbreak.GetDefaultCBConfig()
+	if c.ErrRate > 0 {
+		cfg.ErrRate = c.ErrRate
+	}
+	if c.MinSample > 0 {
+		cfg.MinSample = c.MinSample
+	}
+
+	var (
+		cbs  *circuitbreak.CBSuite
+		seen sync.Map
+	)
+	cbs = circuitbreak.NewCBSuite(func(ri rpcinfo.RPCInfo) string {
+		key := circuitbreak.RPCInfo2Key(ri)
+		// 首次出现的 key 在熔断器读取配置前写入，否则会使用 Kitex 的默认配置
+		if _, loaded := seen.LoadOrStore(key, struct{}{}); !loaded {
+			cbs.UpdateServiceCBConfig(key, cfg)
+		}
+		return key
+	})
+	cbs.UpdateInstanceCBConfig(cfg)
+	return cbs
+}
+
+// methodPolicies 为幂等方法构造失败重试策略，非幂等方法重试可能造成重复写入，不在此处配置
+func (r Retry) methodPolicies() map[string]retry.Policy {
+	if r.MaxRetryTimes <= 0 || len(r.Methods) == 0 {
+		return nil
+	}
+	fp := retry.NewFailurePolicy()
+	fp.WithMaxRetryTimes(r.MaxRetryTimes)
+	if r.MaxDuration > 0 {
+		fp.WithMaxDurationMS(uint32(r.MaxDuration / time.Millisecond))
+	}
+	minMS, maxMS := int(r.BackoffMin/time.Millisecond), int(r.BackoffMax/time.Millisecond)
+	switch {
+	case maxMS > minMS && minMS >= 0:
+		fp.WithRandomBackOff(minMS, maxMS)
+	case minMS > 0:
+		fp.WithFixedBackOff(minMS)
+	}
+
+	policies := make(map[string]retry.Policy, len(r.Methods))
+	for _, method := range r.Methods {
+		policies[method] = retry.BuildFailurePolicy(fp)
+	}
+	return policies
+}
@@ -0,0 +1,63 @@
+package clientsuite
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/retry"
+)
+
+func TestDegradable(t *testing.T) {
+	cases := []struct {
+		err  error
+		want bool
+	}{
+		{nil, false},
+		{errors.New("product not found"), false},
+		{kerrors.ErrRPCTimeout, true},
+		{kerrors.ErrServiceCircuitBreak, true},
+		{kerrors.ErrGetConnection.WithCause(errors.New("connection refused")), true},
+		{kerrors.ErrNoInstance, true},
+	}
+	for _, c := range cases {
+		if got := Degradable(c.err); got != c.want {
+			t.Errorf("Degradable(%v) = %v, want %v", c.err, got, c.want)
+		}
+	}
+}
+
+func TestRetryMethodPolicies(t *testing.T) {
+	if p := (Retry{Methods: []string{"GetProduct"}}).methodPolicies(); p != nil {
+		t.Fatalf("retry without max_retry_times should be disabled, got %v", p)
+	}
+
+	r := Retry{MaxRetryTimes: 2, MaxDuration: time.Second, BackoffMin: 10 * time.Millisecond, BackoffMax: 50 * time.Millisecond, Methods: []string{"GetProduct"}}
+	policies := r.methodPolicies()
+	if len(policies) != 1 {
+		t.Fatalf("expect policy for 1 method, got %d", len(policies))
+	}
+	fp := policies["GetProduct"].FailurePolicy
+	if fp == nil || fp.StopPolicy.MaxRetryTimes != 2 || fp.StopPolicy.MaxDurationMS != 1000 {
+		t.Fatalf("unexpected failure policy %+v", fp)
+	}
+	if fp.BackOffPolicy.BackOffType != retry.RandomBackOffType {
+		t.Fatalf("expect random backoff, got %v", fp.BackOffPolicy.BackOffType)
+	}
+}
+
+func TestPolicyFallback(t *testing.T) {
+	fbErr := errors.New("degraded")
+	var p Policy
+	p.RegisterFallback("GetProduct", func(ctx context.Context, req, resp interface{}, err error) (interface{}, error) {
+		return nil, fbErr
+	})
+
+	// 业务错误不降级，原样返回
+	bizErr := errors.New("product not found")
+	if _, err := p.fallback(context.Background(), nil, nil, bizErr); err != bizErr {
+		t.Fatalf("biz error should not be degraded, got %v", err)
+	}
+}
@@ -0,0 +1,54 @@
+// Package identity 在网关和各服务之间通过 Kitex 元信息透传请求身份，
+// 所有服务共用同一套 key，网关写入的身份在下游读取时不会因 key 不一致而丢失。
+package identity
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/bytedance/gopkg/cloud/metainfo"
+)
+
+// 网关与各服务之间透传请求身份使用的 Kitex 元信息 key
+// 使用 persistent 值，调用链上的下游服务都能拿到同一份信息
+const (
+	// UserIDKey 用户身份
+	UserIDKey = "USER_ID"
+	// ClientIPKey 客户端 IP，部分支付渠道下单和登录风控时需要
+	ClientIPKey = "CLIENT_IP"
+	// UserAgentKey 客户端 User-Agent，用户服务记录登录审计时需要
+	UserAgentKey = "USER_AGENT"
+)
+
+// ErrUnauthenticated 上下文中没有合法的用户身份
+var ErrUnauthenticated = errors.New("unauthenticated")
+
+// WithUserID 将用户ID写入上下文
+func WithUserID(ctx context.Context, userID int64) context.Context {
+	return metainfo.WithPersistentValue(ctx, UserIDKey, strconv.FormatInt(userID, 10))
+}
+
+// GetUserID 从上下文中获取用户ID
+// HTTP 请求由 JWT 中间件写入，RPC 请求由网关或调用方通过元信息透传
+func GetUserID(ctx context.Context) (int64, error) {
+	val, ok := metainfo.GetPersistentValue(ctx, UserIDKey)
+	if !ok {
+		return 0, ErrUnauthenticated
+	}
+	userID, err := strconv.ParseInt(val, 10, 64)
+	if err != nil || userID <= 0 {
+		return 0, ErrUnauthenticated
+	}
+	return userID, nil
+}
+
+// WithClientIP 将客户端 IP 写入元信息，随 RPC 透传到下游
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return metainfo.WithPersistentValue(ctx, ClientIPKey, ip)
+}
+
+// WithUserAgent 将客户端 User-Agent 写入元信息，随 RPC 透传到下游
+func WithUserAgent(ctx context.Context, ua string) context.Context {
+	return metainfo.WithPersistentValue(ctx, UserAgentKey, ua)
+}
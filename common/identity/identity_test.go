@@ -0,0 +1,31 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bytedance/gopkg/cloud/metainfo"
+)
+
+func TestGetUserID(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		want    int64
+		wantErr error
+	}{
+		{"written by WithUserID", WithUserID(context.Background(), 42), 42, nil},
+		{"missing", context.Background(), 0, ErrUnauthenticated},
+		{"not a number", metainfo.WithPersistentValue(context.Background(), UserIDKey, "abc"), 0, ErrUnauthenticated},
+		{"not positive", WithUserID(context.Background(), 0), 0, ErrUnauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetUserID(tt.ctx)
+			if got != tt.want || !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetUserID() = %d, %v, want %d, %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
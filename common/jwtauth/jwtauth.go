@@ -0,0 +1,93 @@
+// Package jwtauth 校验用户服务签发的访问令牌是否仍然有效。
+// 签名和有效期由 hertz-contrib/jwt 校验，这里只检查令牌类型和用户服务写入 Redis 的吊销状态，
+// 所有解析 JWT 的服务共用同一套键格式和规则。
+package jwtauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/hertz-contrib/jwt"
+	"github.com/redis/go-redis/v9"
+)
+
+// TokenTypeAccess 访问令牌的 typ，刷新令牌和等待二次验证的令牌不能用于访问接口
+const TokenTypeAccess = "access"
+
+var (
+	// ErrNotAccessToken 令牌不是访问令牌或缺少用户身份
+	ErrNotAccessToken = errors.New("not an access token")
+	// ErrTokenRevoked 令牌已被吊销，或用户在令牌签发后登出全部设备、重置了密码
+	ErrTokenRevoked = errors.New("token has been revoked")
+)
+
+// TokenBlacklistKey 已吊销令牌的 jti 黑名单，键的过期时间与令牌本身的过期时间一致
+func TokenBlacklistKey(jti string) string {
+	return fmt.Sprintf("jwt:blacklist:%s", jti)
+}
+
+// TokenVersionKey 用户当前的令牌版本，版本低于此值的令牌均已失效
+func TokenVersionKey(userID int64) string {
+	return fmt.Sprintf("user:token_version:%d", userID)
+}
+
+// IsTokenRevoked 检查 jti 是否在黑名单中
+func IsTokenRevoked(ctx context.Context, rdb redis.Cmdable, jti string) (bool, error) {
+	n, err := rdb.Exists(ctx, TokenBlacklistKey(jti)).Result()
+	return n > 0, err
+}
+
+// GetTokenVersion 读取缓存的用户令牌版本，未命中时返回 redis.Nil
+func GetTokenVersion(ctx context.Context, rdb redis.Cmdable, userID int64) (int64, error) {
+	val, err := rdb.Get(ctx, TokenVersionKey(userID)).Result()
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(val, 10, 64)
+}
+
+// Check 检查 JWT 载荷是否为 userID 的有效访问令牌
+// 令牌版本只在缓存命中时比较，未命中说明用户没有执行过全端登出；
+// Redis 不可用时只记录告警并放行，避免 Redis 故障导致所有接口不可用
+func Check(ctx context.Context, rdb redis.Cmdable, claims map[string]interface{}, userID int64) error {
+	if typ, _ := claims["typ"].(string); typ != TokenTypeAccess || userID <= 0 {
+		return ErrNotAccessToken
+	}
+
+	if jti, _ := claims["jti"].(string); jti != "" {
+		revoked, err := IsTokenRevoked(ctx, rdb, jti)
+		if err != nil {
+			hlog.CtxWarnf(ctx, "check token blacklist failed: %v", err)
+		} else if revoked {
+			return ErrTokenRevoked
+		}
+	}
+
+	ver, _ := claims["ver"].(float64)
+	version, err := GetTokenVersion(ctx, rdb, userID)
+	switch {
+	case err == nil:
+		if int64(ver) != version {
+			return ErrTokenRevoked
+		}
+	case !errors.Is(err, redis.Nil):
+		hlog.CtxWarnf(ctx, "get token version of user %d failed: %v", userID, err)
+	}
+	return nil
+}
+
+// Authorizator 返回 hertz-contrib/jwt 的 Authorizator，data 为 IdentityHandler 解析出的 int64 用户ID
+func Authorizator(rdb redis.Cmdable) func(data interface{}, ctx context.Context, c *app.RequestContext) bool {
+	return func(data interface{}, ctx context.Context, c *app.RequestContext) bool {
+		userID, _ := data.(int64)
+		if err := Check(ctx, rdb, jwt.ExtractClaims(ctx, c), userID); err != nil {
+			hlog.CtxInfof(ctx, "token of user %d rejected: %v", userID, err)
+			return false
+		}
+		return true
+	}
+}
@@ -0,0 +1,104 @@
+package jwtauth
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/app/server"
+	"github.com/cloudwego/hertz/pkg/common/ut"
+	gojwt "github.com/golang-jwt/jwt/v4"
+	"github.com/hertz-contrib/jwt"
+	"github.com/redis/go-redis/v9"
+)
+
+var testSecret = []byte("test secret")
+
+func newTestServer(t *testing.T, rdb redis.Cmdable) *server.Hertz {
+	mw, err := jwt.New(&jwt.HertzJWTMiddleware{
+		Key:         testSecret,
+		TokenLookup: "header: Authorization",
+		IdentityKey: "identity",
+		IdentityHandler: func(ctx context.Context, c *app.RequestContext) interface{} {
+			f64, _ := jwt.ExtractClaims(ctx, c)["identity"].(float64)
+			return int64(f64)
+		},
+		Authorizator: Authorizator(rdb),
+		Unauthorized: func(ctx context.Context, c *app.RequestContext, code int, message string) {
+			c.String(http.StatusUnauthorized, message)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := server.New()
+	h.GET("/me", mw.MiddlewareFunc(), func(ctx context.Context, c *app.RequestContext) {
+		c.String(http.StatusOK, "ok")
+	})
+	return h
+}
+
+func signToken(t *testing.T, typ, jti string, version int64) string {
+	token := gojwt.NewWithClaims(gojwt.SigningMethodHS256, gojwt.MapClaims{
+		"identity": 42,
+		"typ":      typ,
+		"jti":      jti,
+		"ver":      version,
+		"exp":      time.Now().Add(time.Hour).Unix(),
+		"orig_iat": time.Now().Unix(),
+	})
+	s, err := token.SignedString(testSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestAuthorizator(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	h := newTestServer(t, rdb)
+
+	// jti 被吊销、令牌版本已递增、刷新令牌冒充访问令牌都应返回 401
+	mr.Set(TokenBlacklistKey("revoked"), "1")
+	mr.Set(TokenVersionKey(42), "2")
+	cases := []struct {
+		name  string
+		token string
+		code  int
+	}{
+		{"valid", signToken(t, TokenTypeAccess, "a", 2), http.StatusOK},
+		{"revoked jti", signToken(t, TokenTypeAccess, "revoked", 2), http.StatusUnauthorized},
+		{"old version", signToken(t, TokenTypeAccess, "b", 1), http.StatusUnauthorized},
+		{"refresh token", signToken(t, "refresh", "c", 2), http.StatusUnauthorized},
+	}
+	for _, c := range cases {
+		w := ut.PerformRequest(h.Engine, http.MethodGet, "/me", nil,
+			ut.Header{Key: "Authorization", Value: "Bearer " + c.token})
+		if got := w.Result().StatusCode(); got != c.code {
+			t.Errorf("%s: expect %d, got %d", c.name, c.code, got)
+		}
+	}
+}
+
+func TestCheckWithoutVersion(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	claims := map[string]interface{}{"typ": TokenTypeAccess, "jti": "a", "ver": float64(0)}
+
+	// 版本未缓存说明用户没有执行过全端登出
+	if err := Check(context.Background(), rdb, claims, 42); err != nil {
+		t.Fatal(err)
+	}
+	// Redis 不可用时放行
+	mr.Close()
+	if err := Check(context.Background(), rdb, claims, 42); err != nil {
+		t.Fatal(err)
+	}
+	if err := Check(context.Background(), rdb, claims, 0); err != ErrNotAccessToken {
+		t.Fatalf("expect %v, got %v", ErrNotAccessToken, err)
+	}
+}
@@ -0,0 +1,68 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// KeyFunc 从 HTTP 请求中提取限流的调用方
+type KeyFunc func(ctx context.Context, c *app.RequestContext) string
+
+// KeyByIdentityOrIP 已登录时按用户身份限流，否则按客户端 IP 限流
+// 客户端 IP 取自 c.ClientIP()，Hertz 需挂载 clientip.New，否则伪造 X-Forwarded-For 即可绕过限流
+func KeyByIdentityOrIP(identityKey string) KeyFunc {
+	return func(ctx context.Context, c *app.RequestContext) string {
+		if id, ok := c.Get(identityKey); ok && id != nil {
+			return fmt.Sprintf("user:%v", id)
+		}
+		return "ip:" + c.ClientIP()
+	}
+}
+
+// KeyByIP 按客户端 IP 限流，与 KeyByIdentityOrIP 一样依赖 clientip.New 解析的客户端 IP
+func KeyByIP(ctx context.Context, c *app.RequestContext) string {
+	return "ip:" + c.ClientIP()
+}
+
+// NewHertzMiddleware 与 Kitex 服务端限流对应的 HTTP 中间件，方法维度使用路由模板
+func NewHertzMiddleware(l *Limiter, key KeyFunc) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		if !l.Allow(c.FullPath(), key(ctx, c)) {
+			tooManyRequests(c, time.Second)
+			return
+		}
+		c.Next(ctx)
+	}
+}
+
+// NewRedisHertzMiddleware 使用分布式令牌桶限流，Redis 不可用时放行，避免限流组件故障导致服务不可用
+func NewRedisHertzMiddleware(b *RedisTokenBucket, key KeyFunc) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		allowed, wait, err := b.Allow(ctx, key(ctx, c))
+		if err != nil {
+			hlog.CtxWarnf(ctx, "redis rate limit failed, request allowed: %v", err)
+			c.Next(ctx)
+			return
+		}
+		if !allowed {
+			tooManyRequests(c, wait)
+			return
+		}
+		c.Next(ctx)
+	}
+}
+
+func tooManyRequests(c *app.RequestContext, wait time.Duration) {
+	c.Header("Retry-After", fmt.Sprint(int(math.Ceil(wait.Seconds()))))
+	c.AbortWithStatusJSON(consts.StatusTooManyRequests, utils.H{
+		"code":    consts.StatusTooManyRequests,
+		"message": "请求过于频繁，请稍后再试",
+	})
+}
@@ -0,0 +1,57 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/network"
+	"zqzqsb.com/gomall/common/clientip"
+)
+
+// remoteConn 只用于指定请求的对端地址
+type remoteConn struct {
+	network.Conn
+	addr net.Addr
+}
+
+func (c remoteConn) RemoteAddr() net.Addr { return c.addr }
+
+func TestKeyIgnoresForgedForwardedFor(t *testing.T) {
+	fn, err := clientip.New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newRequest := func(forwarded string) *app.RequestContext {
+		c := app.NewContext(0)
+		c.SetConn(remoteConn{addr: &net.TCPAddr{IP: net.ParseIP("203.0.113.7"), Port: 5000}})
+		c.SetClientIPFunc(fn)
+		if forwarded != "" {
+			c.Request.Header.Set("X-Forwarded-For", forwarded)
+			c.Request.Header.Set("X-Real-IP", forwarded)
+		}
+		return c
+	}
+
+	keys := []struct {
+		name string
+		key  KeyFunc
+	}{
+		{"KeyByIP", KeyByIP},
+		{"KeyByIdentityOrIP", KeyByIdentityOrIP("identity")},
+	}
+	for _, k := range keys {
+		t.Run(k.name, func(t *testing.T) {
+			want := k.key(context.Background(), newRequest(""))
+			if want != "ip:203.0.113.7" {
+				t.Fatalf("got key %s, want ip:203.0.113.7", want)
+			}
+			for _, forged := range []string{"1.2.3.4", "1.2.3.4, 5.6.7.8"} {
+				if got := k.key(context.Background(), newRequest(forged)); got != want {
+					t.Errorf("forged X-Forwarded-For %q changed key to %s", forged, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// Rule 令牌桶规则，QPS 为每秒补充的令牌数，Burst 为桶容量，QPS <= 0 表示不限流
+type Rule struct {
+	QPS   float64 `yaml:"qps"`
+	Burst int     `yaml:"burst"`
+}
+
+func (r Rule) enabled() bool {
+	return r.QPS > 0
+}
+
+// burst 未配置桶容量时允许一秒的突发流量
+func (r Rule) burst() float64 {
+	if r.Burst > 0 {
+		return float64(r.Burst)
+	}
+	if r.QPS < 1 {
+		return 1
+	}
+	return r.QPS
+}
+
+// Config 服务端限流配置，零值表示不限流
+type Config struct {
+	Global    Rule            `yaml:"global"`     // 整个服务实例的总 QPS
+	PerMethod map[string]Rule `yaml:"per_method"` // 按方法限流，key 为 RPC 方法名或 HTTP 路由
+	PerCaller Rule            `yaml:"per_caller"` // 每个调用方的 QPS，调用方为上游服务名或客户端 IP
+	Callers   map[string]Rule `yaml:"callers"`    // 指定调用方的 QPS，覆盖 PerCaller
+}
+
+// Enabled 是否配置了任意一条限流规则
+func (c Config) Enabled() bool {
+	if c.Global.enabled() || c.PerCaller.enabled() {
+		return true
+	}
+	for _, r := range c.PerMethod {
+		if r.enabled() {
+			return true
+		}
+	}
+	for _, r := range c.Callers {
+		if r.enabled() {
+			return true
+		}
+	}
+	return false
+}
+
+// TokenBucket 单机令牌桶
+type TokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucket 创建令牌桶，初始时桶是满的
+func NewTokenBucket(rule Rule) *TokenBucket {
+	return &TokenBucket{rate: rule.QPS, burst: rule.burst(), tokens: rule.burst(), last: time.Now()}
+}
+
+// Allow 尝试取走一个令牌
+func (b *TokenBucket) Allow() bool {
+	return b.allowAt(time.Now())
+}
+
+func (b *TokenBucket) allowAt(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill(now)
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+func (b *TokenBucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens += elapsed * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+	}
+}
+
+// full 桶已补满，与新建的桶等价，可以回收
+func (b *TokenBucket) full(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill(now)
+	return b.tokens >= b.burst
+}
+
+// maxKeys 按 key 限流时保留的桶数量上限，超过后回收已补满的桶
+const maxKeys = 10000
+
+// KeyedLimiter 按 key（调用方）分别限流，每个 key 一个令牌桶
+type KeyedLimiter struct {
+	rule    Rule
+	mu      sync.Mutex
+	buckets map[string]*TokenBucket
+}
+
+func NewKeyedLimiter(rule Rule) *KeyedLimiter {
+	return &KeyedLimiter{rule: rule, buckets: make(map[string]*TokenBucket)}
+}
+
+// Allow 尝试为 key 取走一个令牌
+func (l *KeyedLimiter) Allow(key string) bool {
+	l.mu.Lock()
+	b, ok := l.buckets[key]
+	if !ok {
+		if len(l.buckets) >= maxKeys {
+			l.sweep(time.Now())
+		}
+		b = NewTokenBucket(l.rule)
+		l.buckets[key] = b
+	}
+	l.mu.Unlock()
+	return b.Allow()
+}
+
+func (l *KeyedLimiter) sweep(now time.Time) {
+	for k, b := range l.buckets {
+		if b.full(now) {
+			delete(l.buckets, k)
+		}
+	}
+}
+
+// Limiter 按 Config 组合全局、方法和调用方三个维度的限流，任一维度超限即拒绝
+type Limiter struct {
+	global    *TokenBucket
+	methods   map[string]*TokenBucket
+	perCaller *KeyedLimiter
+	callers   map[string]*TokenBucket
+}
+
+// New 根据配置创建限流器，未配置的维度不限流
+func New(cfg Config) *Limiter {
+	l := &Limiter{methods: make(map[string]*TokenBucket), callers: make(map[string]*TokenBucket)}
+	if cfg.Global.enabled() {
+		l.global = NewTokenBucket(cfg.Global)
+	}
+	for method, rule := range cfg.PerMethod {
+		if rule.enabled() {
+			l.methods[method] = NewTokenBucket(rule)
+		}
+	}
+	if cfg.PerCaller.enabled() {
+		l.perCaller = NewKeyedLimiter(cfg.PerCaller)
+	}
+	for caller, rule := range cfg.Callers {
+		if rule.enabled() {
+			l.callers[caller] = NewTokenBucket(rule)
+		}
+	}
+	return l
+}
+
+// Allow 判断一次请求是否放行，调用方维度先于方法和全局维度检查，避免单个调用方耗尽全局配额
+func (l *Limiter) Allow(method, caller string) bool {
+	if caller != "" {
+		if b, ok := l.callers[caller]; ok {
+			if !b.Allow() {
+				return false
+			}
+		} else if l.perCaller != nil && !l.perCaller.Allow(caller) {
+			return false
+		}
+	}
+	if b, ok := l.methods[method]; ok && !b.Allow() {
+		return false
+	}
+	if l.global != nil && !l.global.Allow() {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,53 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTokenBucket(t *testing.T) {
+	b := NewTokenBucket(Rule{QPS: 2, Burst: 2})
+	now := b.last
+	if !b.allowAt(now) || !b.allowAt(now) {
+		t.Fatal("burst should be allowed")
+	}
+	if b.allowAt(now) {
+		t.Fatal("bucket should be empty")
+	}
+	// 2 QPS 下 500ms 补充一个令牌
+	if !b.allowAt(now.Add(500 * time.Millisecond)) {
+		t.Fatal("token should be refilled")
+	}
+	if b.allowAt(now.Add(500 * time.Millisecond)) {
+		t.Fatal("only one token should be refilled")
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	l := New(Config{
+		PerMethod: map[string]Rule{"Login": {QPS: 1, Burst: 3}},
+		PerCaller: Rule{QPS: 1, Burst: 1},
+		Callers:   map[string]Rule{"gateway": {QPS: 100, Burst: 100}},
+	})
+
+	// 普通调用方每个只有 1 个令牌
+	if !l.Allow("Hello", "10.0.0.1") || l.Allow("Hello", "10.0.0.1") {
+		t.Fatal("per caller limit not applied")
+	}
+	if !l.Allow("Hello", "10.0.0.2") {
+		t.Fatal("callers should not share buckets")
+	}
+	// 指定调用方使用自己的配额，但仍受方法维度限制
+	for i := 0; i < 3; i++ {
+		if !l.Allow("Login", "gateway") {
+			t.Fatalf("request %d should be allowed", i)
+		}
+	}
+	if l.Allow("Login", "gateway") {
+		t.Fatal("per method limit not applied")
+	}
+
+	if New(Config{}).Allow("Hello", "") != true || (Config{}).Enabled() {
+		t.Fatal("zero config should not limit")
+	}
+}
@@ -0,0 +1,67 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// tokenBucketScript 在 Redis 中原子地补充并扣减令牌，时间取自 Redis 服务器，避免各实例时钟不一致。
+// KEYS[1] 桶的 key；ARGV[1] 每秒补充的令牌数；ARGV[2] 桶容量。
+// 返回 {是否放行, 下一个令牌可用前需要等待的毫秒数}
+var tokenBucketScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local t = redis.call("TIME")
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+
+local bucket = redis.call("HMGET", KEYS[1], "tokens", "ts")
+local tokens = tonumber(bucket[1])
+local ts = tonumber(bucket[2])
+if tokens == nil then
+	tokens = burst
+	ts = now
+end
+
+local elapsed = math.max(0, now - ts)
+tokens = math.min(burst, tokens + elapsed * rate / 1000)
+
+local allowed = 0
+local wait = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	wait = math.ceil((1 - tokens) * 1000 / rate)
+end
+
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "ts", now)
+redis.call("PEXPIRE", KEYS[1], math.ceil(burst * 1000 / rate) + 1000)
+return {allowed, wait}
+`)
+
+// RedisTokenBucket 基于 Redis 的分布式令牌桶，多个实例共享同一个 key 的配额
+type RedisTokenBucket struct {
+	client redis.Scripter
+	prefix string
+	rule   Rule
+}
+
+// NewRedisTokenBucket 创建分布式令牌桶，prefix 用于区分不同用途的桶
+func NewRedisTokenBucket(client redis.Scripter, prefix string, rule Rule) *RedisTokenBucket {
+	return &RedisTokenBucket{client: client, prefix: prefix, rule: rule}
+}
+
+// Allow 尝试为 key 取走一个令牌，被拒绝时返回建议的重试等待时间
+func (b *RedisTokenBucket) Allow(ctx context.Context, key string) (bool, time.Duration, error) {
+	if !b.rule.enabled() {
+		return true, 0, nil
+	}
+	res, err := tokenBucketScript.Run(ctx, b.client, []string{b.prefix + ":" + key},
+		b.rule.QPS, b.rule.burst()).Int64Slice()
+	if err != nil {
+		return false, 0, err
+	}
+	return res[0] == 1, time.Duration(res[1]) * time.Millisecond, nil
+}
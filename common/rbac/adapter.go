@@ -0,0 +1,93 @@
+// Package rbac 为用户服务以外的服务加载 Casbin 策略。各服务管理接口的访问规则来自本服务的策略文件，
+// 角色授予关系和黑名单由用户服务维护在共享的 casbin_rule 表中，其他服务只读取不修改
+package rbac
+
+import (
+	"errors"
+	"log"
+
+	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/persist"
+	fileadapter "github.com/casbin/casbin/v2/persist/file-adapter"
+	"gorm.io/gorm"
+)
+
+var ErrReadOnly = errors.New("权限策略只能通过用户服务的管理接口修改")
+
+// casbinRule 用户服务 casbin_rule 表的记录，表结构由用户服务维护
+type casbinRule struct {
+	Ptype string
+	V0    string
+	V1    string
+	V2    string
+	V3    string
+	V4    string
+	V5    string
+}
+
+func (casbinRule) TableName() string {
+	return "casbin_rule"
+}
+
+// toLine 将表记录转换为 ptype 开头的规则，去掉末尾的空字段
+func toLine(r casbinRule) []string {
+	line := []string{r.Ptype, r.V0, r.V1, r.V2, r.V3, r.V4, r.V5}
+	for len(line) > 1 && line[len(line)-1] == "" {
+		line = line[:len(line)-1]
+	}
+	return line
+}
+
+// Adapter 只读的 Casbin 适配器，依次加载策略文件和数据库中的规则，db 为 nil 时只加载策略文件
+type Adapter struct {
+	db         *gorm.DB
+	policyFile string
+}
+
+var _ persist.Adapter = (*Adapter)(nil)
+
+func NewAdapter(db *gorm.DB, policyFile string) *Adapter {
+	return &Adapter{db: db, policyFile: policyFile}
+}
+
+func (a *Adapter) LoadPolicy(m model.Model) error {
+	if a.policyFile != "" {
+		if err := fileadapter.NewAdapter(a.policyFile).LoadPolicy(m); err != nil {
+			return err
+		}
+	}
+	if a.db == nil {
+		return nil
+	}
+	// 用户服务还未初始化策略表时只使用策略文件，此时所有角色都不生效，需要确认数据库配置
+	if !a.db.Migrator().HasTable(&casbinRule{}) {
+		log.Printf("casbin_rule table not found, roles and blacklist from user service are not loaded")
+		return nil
+	}
+	var rules []casbinRule
+	if err := a.db.Order("id").Find(&rules).Error; err != nil {
+		return err
+	}
+	for _, r := range rules {
+		if err := persist.LoadPolicyArray(toLine(r), m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *Adapter) SavePolicy(model.Model) error {
+	return ErrReadOnly
+}
+
+func (a *Adapter) AddPolicy(sec string, ptype string, rule []string) error {
+	return ErrReadOnly
+}
+
+func (a *Adapter) RemovePolicy(sec string, ptype string, rule []string) error {
+	return ErrReadOnly
+}
+
+func (a *Adapter) RemoveFilteredPolicy(sec string, ptype string, fieldIndex int, fieldValues ...string) error {
+	return ErrReadOnly
+}
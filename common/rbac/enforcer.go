@@ -0,0 +1,114 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+const (
+	// DefaultChannel 用户服务发布策略变更的 Redis 频道
+	DefaultChannel = "casbin:policy"
+)
+
+var ErrNotInitialized = errors.New("权限策略未加载")
+
+var enforcer *casbin.SyncedEnforcer
+
+// Init 加载权限策略，之后可通过 Watch 和 ReloadPeriodically 跟随用户服务的策略变更
+func Init(db *gorm.DB, modelFile, policyFile string) error {
+	e, err := casbin.NewSyncedEnforcer(modelFile, NewAdapter(db, policyFile))
+	if err != nil {
+		return err
+	}
+	// 策略只读，避免误调用写接口时尝试写回
+	e.EnableAutoSave(false)
+	enforcer = e
+	return nil
+}
+
+// Enforce 检查用户是否有权限访问 obj
+// 用户服务的黑名单以 deny 策略保存，由模型的 policy_effect 拒绝黑名单中用户的所有请求
+func Enforce(sub, obj, act string) (bool, error) {
+	if enforcer == nil {
+		return false, ErrNotInitialized
+	}
+	return enforcer.Enforce(sub, obj, act)
+}
+
+// HasRole 检查用户是否直接或通过角色继承拥有 role 角色
+func HasRole(sub, role string) (bool, error) {
+	if enforcer == nil {
+		return false, ErrNotInitialized
+	}
+	roles, err := enforcer.GetImplicitRolesForUser(sub)
+	if err != nil {
+		return false, err
+	}
+	for _, r := range roles {
+		if r == role {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Reload 重新加载策略文件和数据库中的全部规则
+func Reload() error {
+	if enforcer == nil {
+		return ErrNotInitialized
+	}
+	return enforcer.LoadPolicy()
+}
+
+// Watch 订阅用户服务发布的策略变更，收到任何变更都整体重新加载。
+// 其他服务只关心角色和黑名单，变更不频繁，不做增量更新
+func Watch(ctx context.Context, client redis.UniversalClient, channel string) error {
+	if channel == "" {
+		channel = DefaultChannel
+	}
+	pubsub := client.Subscribe(ctx, channel)
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return err
+	}
+	go func() {
+		defer pubsub.Close()
+		ch := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case _, ok := <-ch:
+				if !ok {
+					return
+				}
+				if err := Reload(); err != nil {
+					log.Printf("reload casbin policy failed: %v", err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// ReloadPeriodically 定期重新加载策略，兜底 Redis 连接中断期间丢失的变更通知
+func ReloadPeriodically(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := Reload(); err != nil {
+				log.Printf("reload casbin policy failed: %v", err)
+			}
+		}
+	}
+}
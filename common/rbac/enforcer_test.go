@@ -0,0 +1,81 @@
+package rbac
+
+import (
+	"testing"
+)
+
+// testdata 中 1 为 admin，2 为 editor，3 为被拉黑的 admin，4 没有角色
+func initTestPolicy(t *testing.T) {
+	t.Helper()
+	if err := Init(nil, "testdata/rbac_model.conf", "testdata/rbac_policy.csv"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { enforcer = nil })
+}
+
+func TestEnforce(t *testing.T) {
+	if _, err := Enforce("1", "/admin/items", "GET"); err != ErrNotInitialized {
+		t.Fatalf("got error %v, want %v", err, ErrNotInitialized)
+	}
+	initTestPolicy(t)
+
+	tests := []struct {
+		sub, obj, act string
+		want          bool
+	}{
+		{"1", "/admin/items", "GET", true},
+		{"1", "/admin/items/7", "DELETE", true},
+		{"1", "/admin/items/7", "PUT", false},
+		{"1", "/admin/items/7/extra", "DELETE", false},
+		{"2", "/admin/items", "GET", true},
+		{"2", "/admin/items/7", "DELETE", false},
+		{"3", "/admin/items", "GET", false},
+		{"3", "/items", "GET", false},
+		{"4", "/admin/items", "GET", false},
+		{"4", "/items", "GET", true},
+	}
+	for _, tt := range tests {
+		got, err := Enforce(tt.sub, tt.obj, tt.act)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("Enforce(%s, %s, %s) = %v, want %v", tt.sub, tt.obj, tt.act, got, tt.want)
+		}
+	}
+}
+
+func TestHasRole(t *testing.T) {
+	initTestPolicy(t)
+
+	tests := []struct {
+		sub  string
+		role string
+		want bool
+	}{
+		{"1", "admin", true},
+		{"2", "admin", false},
+		{"2", "editor", true},
+		{"4", "editor", false},
+	}
+	for _, tt := range tests {
+		got, err := HasRole(tt.sub, tt.role)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("HasRole(%s, %s) = %v, want %v", tt.sub, tt.role, got, tt.want)
+		}
+	}
+}
+
+func TestAdapterReadOnly(t *testing.T) {
+	initTestPolicy(t)
+	// 策略只读，修改内存中的策略时不写回适配器
+	if _, err := enforcer.AddPolicy("4", "^/admin/items$", "GET", "allow"); err != nil {
+		t.Fatal(err)
+	}
+	if err := enforcer.SavePolicy(); err != ErrReadOnly {
+		t.Errorf("got error %v, want %v", err, ErrReadOnly)
+	}
+}
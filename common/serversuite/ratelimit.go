@@ -0,0 +1,42 @@
+package serversuite
+
+import (
+	"context"
+	"net"
+
+	"github.com/cloudwego/kitex/pkg/endpoint"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"zqzqsb.com/gomall/common/ratelimit"
+)
+
+// rateLimitMiddleware 按全局、方法和调用方限流，超限时返回 kerrors.ErrQPSOverLimit
+func rateLimitMiddleware(l *ratelimit.Limiter) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, req, resp interface{}) error {
+			ri := rpcinfo.GetRPCInfo(ctx)
+			if ri != nil && !l.Allow(ri.To().Method(), caller(ri)) {
+				return kerrors.ErrQPSOverLimit
+			}
+			return next(ctx, req, resp)
+		}
+	}
+}
+
+// caller 优先使用上游服务名，未携带时使用对端 IP
+func caller(ri rpcinfo.RPCInfo) string {
+	from := ri.From()
+	if from == nil {
+		return ""
+	}
+	if name := from.ServiceName(); name != "" {
+		return name
+	}
+	if addr := from.Address(); addr != nil {
+		if host, _, err := net.SplitHostPort(addr.String()); err == nil {
+			return host
+		}
+		return addr.String()
+	}
+	return ""
+}
@@ -0,0 +1,84 @@
+package serversuite
+
+import (
+	"errors"
+	"net"
+	"strconv"
+
+	"github.com/cloudwego/kitex/pkg/registry"
+	"github.com/cloudwego/kitex/server"
+	consul "github.com/kitex-contrib/registry-consul"
+)
+
+// registryOptions 通过 Kitex 注册中心将服务注册到 Consul，服务退出时由 Kitex 自动注销
+func (s *CommonServerSuite) registryOptions() ([]server.Option, error) {
+	r, err := consul.NewConsulRegister(s.RegistryAddr)
+	if err != nil {
+		return nil, err
+	}
+	info := &registry.Info{
+		ServiceName: s.CurrentServiceName,
+		Weight:      s.Weight,
+		Tags:        s.tags(),
+	}
+	if s.ServiceAddr != "" {
+		addr, err := advertiseAddr(s.ServiceAddr)
+		if err != nil {
+			return nil, err
+		}
+		info.Addr = addr
+		info.SkipListenAddr = true
+	}
+	return []server.Option{server.WithRegistry(r), server.WithRegistryInfo(info)}, nil
+}
+
+// tags 注册到 Consul 的标签，未配置的标签不注册
+func (s *CommonServerSuite) tags() map[string]string {
+	tags := make(map[string]string, 2)
+	if s.Env != "" {
+		tags["env"] = s.Env
+	}
+	if s.Version != "" {
+		tags["version"] = s.Version
+	}
+	return tags
+}
+
+// advertiseAddr 注册到 Consul 的地址，监听全部网卡（如 0.0.0.0:8888、:8888）时使用本机的 IPv4 地址
+func advertiseAddr(listenAddr string) (net.Addr, error) {
+	host, portStr, err := net.SplitHostPort(listenAddr)
+	if err != nil {
+		return nil, err
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil || port <= 0 {
+		return nil, errors.New("invalid service port " + portStr)
+	}
+	ip := net.ParseIP(host)
+	if host != "" && ip == nil {
+		// 主机名交给解析器处理
+		return net.ResolveTCPAddr("tcp", listenAddr)
+	}
+	if ip == nil || ip.IsUnspecified() {
+		if ip, err = localIPv4(); err != nil {
+			return nil, err
+		}
+	}
+	return &net.TCPAddr{IP: ip, Port: port}, nil
+}
+
+// localIPv4 本机第一个非回环的 IPv4 地址
+func localIPv4() (net.IP, error) {
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return nil, err
+	}
+	for _, addr := range addrs {
+		if ipNet, ok := addr.(*net.IPNet); ok && !ipNet.IP.IsLoopback() {
+			if ip := ipNet.IP.To4(); ip != nil {
+				return ip, nil
+			}
+		}
+	}
+	return nil, errors.New("no non-loopback ipv4 address found")
+}
@@ -0,0 +1,40 @@
+package serversuite
+
+import (
+	"net"
+	"testing"
+)
+
+func TestAdvertiseAddr(t *testing.T) {
+	addr, err := advertiseAddr("10.0.0.8:8888")
+	if err != nil || addr.String() != "10.0.0.8:8888" {
+		t.Fatalf("specified ip should be kept, got %v %v", addr, err)
+	}
+
+	for _, listen := range []string{":8883", "0.0.0.0:8888"} {
+		addr, err := advertiseAddr(listen)
+		if err != nil {
+			// 没有非回环网卡的环境无法获取本机地址
+			t.Skipf("no local ipv4: %v", err)
+		}
+		ip := addr.(*net.TCPAddr).IP
+		if ip.IsUnspecified() || ip.IsLoopback() {
+			t.Fatalf("listen on %s should advertise local ip, got %v", listen, addr)
+		}
+	}
+
+	if _, err := advertiseAddr("127.0.0.1"); err == nil {
+		t.Fatal("address without port should be rejected")
+	}
+}
+
+func TestTags(t *testing.T) {
+	s := &CommonServerSuite{Env: "online", Version: "v1"}
+	tags := s.tags()
+	if tags["env"] != "online" || tags["version"] != "v1" {
+		t.Fatalf("unexpected tags %v", tags)
+	}
+	if len((&CommonServerSuite{}).tags()) != 0 {
+		t.Fatal("empty env and version should not be registered")
+	}
+}
@@ -1,15 +1,28 @@
 package serversuite
 
 import (
+	"github.com/cloudwego/kitex/pkg/klog"
 	"github.com/cloudwego/kitex/pkg/rpcinfo"
 	"github.com/cloudwego/kitex/server"
 	prometheus "github.com/kitex-contrib/monitor-prometheus"
 	"github.com/kitex-contrib/obs-opentelemetry/tracing"
 	"zqzqsb.com/gomall/common/mtl"
+	"zqzqsb.com/gomall/common/ratelimit"
 )
 
 type CommonServerSuite struct {
 	CurrentServiceName string
+	// RegistryAddr Consul 地址，为空时不注册服务
+	RegistryAddr string
+	// ServiceAddr 服务监听地址，作为注册到 Consul 的地址
+	ServiceAddr string
+	// Weight 实例权重，用于调用方的加权负载均衡，为 0 时使用 Kitex 默认权重
+	Weight int
+	// Env、Version 作为 env、version 标签注册到 Consul
+	Env     string
+	Version string
+	// RateLimit 服务端限流配置，零值表示不限流
+	RateLimit ratelimit.Config
 }
 
 func (s *CommonServerSuite) Options() []server.Option {
@@ -22,5 +35,15 @@ func (s *CommonServerSuite) Options() []server.Option {
 		// 使用 OpenTelemetry 的链路追踪
 		server.WithSuite(tracing.NewServerSuite()),
 	}
+	if s.RateLimit.Enabled() {
+		opts = append(opts, server.WithMiddleware(rateLimitMiddleware(ratelimit.New(s.RateLimit))))
+	}
+	if s.RegistryAddr != "" {
+		registryOpts, err := s.registryOptions()
+		if err != nil {
+			klog.Fatalf("register %s to consul failed: %v", s.CurrentServiceName, err)
+		}
+		opts = append(opts, registryOpts...)
+	}
 	return opts
 }